@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/cmpserver"
+	"github.com/argoproj/argo-cd/cmpserver/apiclient"
+	"github.com/argoproj/argo-cd/cmpserver/plugin"
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/errors"
+	"github.com/argoproj/argo-cd/util/cli"
+)
+
+const (
+	// CLIName is the name of the CLI
+	cliName = "argocd-cmp-server"
+)
+
+func newCommand() *cobra.Command {
+	var (
+		logLevel   string
+		configFile string
+	)
+	var command = cobra.Command{
+		Use:   cliName,
+		Short: "Run argocd-cmp-server, which serves a config management plugin in a sidecar of the repo server",
+		RunE: func(c *cobra.Command, args []string) error {
+			cli.SetLogLevel(logLevel)
+
+			config, err := plugin.ReadPluginConfig(configFile)
+			errors.CheckError(err)
+
+			socketPath := filepath.Join(apiclient.GetPluginSockFilePath(), config.Metadata.Name+".sock")
+			// remove the socket of a previous run of the sidecar
+			if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+				errors.CheckError(err)
+			}
+			server := cmpserver.NewServer(config)
+			grpc := server.CreateGRPC()
+			listener, err := net.Listen("unix", socketPath)
+			errors.CheckError(err)
+
+			log.Infof("argocd-cmp-server %s serving plugin %s on %s", common.GetVersion(), config.Metadata.Name, listener.Addr())
+			err = grpc.Serve(listener)
+			errors.CheckError(err)
+			return nil
+		},
+	}
+
+	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&configFile, "config-file", common.DefaultPluginConfigFilePath, "Path of the manifest of the config management plugin")
+	return &command
+}
+
+func main() {
+	if err := newCommand().Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
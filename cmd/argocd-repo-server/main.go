@@ -31,6 +31,11 @@ func newCommand() *cobra.Command {
 	var (
 		logLevel               string
 		parallelismLimit       int64
+		parallelismLimitRepo   int64
+		gitFetchDepth          int
+		gitPartialClone        bool
+		gitSparseCheckout      bool
+		envFromSecrets         []string
 		listenPort             int
 		metricsPort            int
 		cacheSrc               func() (*cache.Cache, error)
@@ -48,8 +53,12 @@ func newCommand() *cobra.Command {
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 
-			metricsServer := metrics.NewMetricsServer(git.NewFactory())
-			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, parallelismLimit)
+			if gitSparseCheckout && parallelismLimitRepo > 1 {
+				log.Warn("Sparse checkouts are disabled, since the manifests are generated in worktrees, which are checked out completely")
+			}
+
+			metricsServer := metrics.NewMetricsServer(git.NewFactoryWithFetchOptions(git.FetchOptions{Depth: gitFetchDepth, PartialClone: gitPartialClone}))
+			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, parallelismLimit, parallelismLimitRepo, gitSparseCheckout, envFromSecrets)
 			errors.CheckError(err)
 
 			grpc := server.CreateGRPC()
@@ -71,6 +80,11 @@ func newCommand() *cobra.Command {
 
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().Int64Var(&parallelismLimit, "parallelismlimit", 0, "Limit on number of concurrent manifests generate requests. Any value less the 1 means no limit.")
+	command.Flags().Int64Var(&parallelismLimitRepo, "parallelismlimit-per-repo", 1, "Limit on number of concurrent manifests generate requests from the same repository. Any value greater than 1 generates the manifests in up to that many worktrees of the repository, rather than one at a time in its clone.")
+	command.Flags().IntVar(&gitFetchDepth, "git-fetch-depth", 0, "Number of commits of the history to fetch from Git repositories, which also limits fetches to the revision to check out. Any value less than 1 means the full history.")
+	command.Flags().BoolVar(&gitPartialClone, "git-partial-clone", false, "Fetch only the file contents of revisions that are checked out, if the Git server supports partial clones")
+	command.Flags().BoolVar(&gitSparseCheckout, "git-sparse-checkout", false, "Check out only the path and the Helm value files of applications when generating their manifests")
+	command.Flags().StringSliceVar(&envFromSecrets, "env-from-secret-allowlist", []string{}, "Names or glob patterns of the names of the environment variables of plugins, Helm and Kustomize which applications may source from secrets (e.g. GITHUB_TOKEN,VAULT_*)")
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortRepoServer, "Listen on given port for incoming connections")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortRepoServerMetrics, "Start metrics server on given port")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/errors"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/util/cert"
+	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// NewCertCommand returns a new instance of an `argocd-util cert` command
+func NewCertCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "cert",
+		Short: "Manage the repository certificates in the Argo CD ConfigMaps",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewCertImportCommand())
+	return command
+}
+
+// NewCertImportCommand returns a new instance of an `argocd-util cert import` command
+func NewCertImportCommand() *cobra.Command {
+	var (
+		clientConfig   clientcmd.ClientConfig
+		knownHostsFile string
+		tlsCertsDir    string
+		prune          bool
+		dryRun         bool
+	)
+	var command = cobra.Command{
+		Use:   "import [--ssh-known-hosts FILE] [--tls-certs-dir DIR]",
+		Short: "Synchronize the SSH known hosts and TLS certificates ConfigMaps with a known_hosts file and a directory of CA bundles",
+		Run: func(c *cobra.Command, args []string) {
+			if knownHostsFile == "" && tlsCertsDir == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeClientset := kubernetes.NewForConfigOrDie(config)
+			argoDB := db.NewDB(namespace, settings.NewSettingsManager(context.Background(), kubeClientset, namespace), kubeClientset)
+
+			// Read and parse all input first, so nothing is changed if any
+			// of it is invalid.
+			desired := make(map[string][]appsv1.RepositoryCertificate)
+			if knownHostsFile != "" {
+				desired["ssh"], err = readSSHKnownHostsCertificates(knownHostsFile)
+				errors.CheckError(err)
+			}
+			if tlsCertsDir != "" {
+				desired["https"], err = readTLSCertificatesDir(tlsCertsDir)
+				errors.CheckError(err)
+			}
+
+			var dryRunMsg string
+			if dryRun {
+				dryRunMsg = " (dry run)"
+			}
+			for _, certType := range []string{"ssh", "https"} {
+				certificates, ok := desired[certType]
+				if !ok {
+					continue
+				}
+				results, err := db.SyncRepoCertificates(context.Background(), argoDB, certType, certificates, prune, dryRun)
+				errors.CheckError(err)
+				for _, result := range results {
+					if result.Certificate.CertSubType != "" {
+						fmt.Printf("%s %s (%s) %s%s\n", certType, result.Certificate.ServerName, result.Certificate.CertSubType, result.Action, dryRunMsg)
+					} else {
+						fmt.Printf("%s %s %s%s\n", certType, result.Certificate.ServerName, result.Action, dryRunMsg)
+					}
+				}
+			}
+		},
+	}
+
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().StringVar(&knownHostsFile, "ssh-known-hosts", "", "Synchronize the SSH known hosts entries with this file in ssh_known_hosts format")
+	command.Flags().StringVar(&tlsCertsDir, "tls-certs-dir", "", "Synchronize the TLS certificates with the files in this directory, each named after its server with an optional .crt or .pem extension")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print what will be performed")
+	command.Flags().BoolVar(&prune, "prune", false, "Prune entries of the synchronized types which do not appear in the input")
+
+	return &command
+}
+
+// Reads the SSH known hosts entries from a file in ssh_known_hosts format
+func readSSHKnownHostsCertificates(path string) ([]appsv1.RepositoryCertificate, error) {
+	entries, err := certutil.ParseSSHKnownHostsFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+	certificates := make([]appsv1.RepositoryCertificate, 0, len(entries))
+	for _, entry := range entries {
+		hostname, subType, data, err := certutil.TokenizeSSHKnownHostsEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		if _, _, err := certutil.KnownHostsLineToPublicKey(entry); err != nil {
+			return nil, fmt.Errorf("Invalid SSH known hosts entry for '%s' in '%s': %v", hostname, path, err)
+		}
+		certificates = append(certificates, appsv1.RepositoryCertificate{
+			ServerName:  hostname,
+			CertType:    "ssh",
+			CertSubType: subType,
+			CertData:    data,
+		})
+	}
+	return certificates, nil
+}
+
+// Reads the TLS certificates from the files in dir. Each file holds the
+// certificates of a single server in PEM or DER format, and is named after
+// the server, optionally with a .crt or .pem extension.
+func readTLSCertificatesDir(dir string) ([]appsv1.RepositoryCertificate, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	certificates := make([]appsv1.RepositoryCertificate, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+		serverName := strings.TrimSuffix(strings.TrimSuffix(file.Name(), ".crt"), ".pem")
+		if !certutil.IsValidTLSServerName(serverName) {
+			return nil, fmt.Errorf("Invalid server name '%s' derived from file '%s'", serverName, file.Name())
+		}
+		path := filepath.Join(dir, file.Name())
+		pemCertificates, err := certutil.ParseTLSCertificateInputFromPath(path)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read TLS certificates from '%s': %v", path, err)
+		}
+		pemCertificates, _, err = certutil.DedupeTLSCertificates(pemCertificates)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid TLS certificate in '%s': %v", path, err)
+		}
+		if len(pemCertificates) == 0 {
+			return nil, fmt.Errorf("No TLS certificates found in '%s'", path)
+		}
+		certificates = append(certificates, appsv1.RepositoryCertificate{
+			ServerName: serverName,
+			CertType:   "https",
+			CertData:   []byte(strings.Join(pemCertificates, "\n")),
+		})
+	}
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].ServerName < certificates[j].ServerName
+	})
+	return certificates, nil
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/shlex"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -29,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/jsonpath"
 
 	"github.com/argoproj/argo-cd/controller"
 	"github.com/argoproj/argo-cd/errors"
@@ -45,8 +48,11 @@ import (
 	"github.com/argoproj/argo-cd/util/config"
 	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/hook"
+	ksutil "github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/kustomize"
 	"github.com/argoproj/argo-cd/util/resource"
 	"github.com/argoproj/argo-cd/util/templates"
 )
@@ -91,6 +97,8 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
+	command.AddCommand(NewApplicationLogsCommand(clientOpts))
+	command.AddCommand(NewApplicationResourcesCommand(clientOpts))
 	return command
 }
 
@@ -101,10 +109,15 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 		fileURL string
 		appName string
 		upsert  bool
+		sources []string
 	)
 	var command = &cobra.Command{
 		Use:   "create APPNAME",
 		Short: "Create an application from a git location",
+		Example: `  # Create an application of a Helm chart, with value files of another Git repository
+  argocd app create guestbook --dest-server https://kubernetes.default.svc --dest-namespace guestbook \
+    --source repo=https://github.com/argoproj/argocd-example-apps.git,ref=values \
+    --source repo=https://charts.example.com,chart=guestbook,revision=1.2.0,values=$values/guestbook/prod.yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			var app argoappv1.Application
 			argocdClient := argocdclient.NewClientOrDie(clientOpts)
@@ -136,6 +149,20 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				}
 				setAppOptions(c.Flags(), &app, &appOpts)
 				setParameterOverrides(&app, appOpts.parameters)
+				if len(sources) > 0 {
+					for _, flag := range []string{"repo", "path", "helm-chart", "values", "values-literal-file", "values-repo", "helm-set", "helm-set-string", "helm-set-file"} {
+						if c.Flags().Changed(flag) {
+							errors.CheckError(fmt.Errorf("Cannot use --%s with --source", flag))
+						}
+					}
+					// the revision flag has a default, which only applies to a single source
+					app.Spec.Source.TargetRevision = ""
+					for _, sourceStr := range sources {
+						source, err := parseApplicationSource(sourceStr)
+						errors.CheckError(err)
+						app.Spec.Sources = append(app.Spec.Sources, source)
+					}
+				}
 			}
 			if app.Name == "" {
 				c.HelpFunc()(c, args)
@@ -155,6 +182,7 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	command.Flags().StringVar(&appName, "name", "", "A name for the app, ignored if a file is set (DEPRECATED)")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override application with the same name even if supplied application spec is different from existing spec")
 	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL to Kubernetes manifests for the app")
+	command.Flags().StringArrayVar(&sources, "source", []string{}, "A source of an application with multiple sources, as comma separated options repo, path, chart, revision, ref and values (e.g. --source repo=https://github.com/argoproj/argocd-example-apps.git,path=guestbook), ignored if a file is set")
 	// Only complete files with appropriate extension.
 	err := command.Flags().SetAnnotation("file", cobra.BashCompFilenameExt, []string{"json", "yaml", "yml"})
 	if err != nil {
@@ -253,10 +281,20 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string) {
 	fmt.Printf(printOpFmtStr, "Server:", app.Spec.Destination.Server)
 	fmt.Printf(printOpFmtStr, "Namespace:", app.Spec.Destination.Namespace)
 	fmt.Printf(printOpFmtStr, "URL:", appURL)
-	fmt.Printf(printOpFmtStr, "Repo:", app.Spec.Source.RepoURL)
-	fmt.Printf(printOpFmtStr, "Target:", app.Spec.Source.TargetRevision)
-	fmt.Printf(printOpFmtStr, "Path:", app.Spec.Source.Path)
-	printAppSourceDetails(&app.Spec.Source)
+	if app.Spec.HasMultipleSources() {
+		for i, source := range app.Spec.Sources {
+			fmt.Printf(printOpFmtStr, fmt.Sprintf("Source %d:", i+1), formatApplicationSource(source))
+		}
+	} else {
+		fmt.Printf(printOpFmtStr, "Repo:", app.Spec.Source.RepoURL)
+		fmt.Printf(printOpFmtStr, "Target:", app.Spec.Source.TargetRevision)
+		if app.Spec.Source.IsHelmChart() {
+			fmt.Printf(printOpFmtStr, "Chart:", app.Spec.Source.Chart)
+		} else {
+			fmt.Printf(printOpFmtStr, "Path:", app.Spec.Source.Path)
+		}
+		printAppSourceDetails(&app.Spec.Source)
+	}
 	var syncPolicy string
 	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
 		syncPolicy = "Automated"
@@ -268,16 +306,33 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string) {
 	}
 	fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicy)
 	syncStatusStr := string(app.Status.Sync.Status)
+	if app.Spec.HasMultipleSources() {
+		if len(app.Status.Sync.Revisions) > 0 {
+			syncStatusStr += fmt.Sprintf(" (%s)", strings.Join(app.Status.Sync.Revisions, ", "))
+		}
+		fmt.Printf(printOpFmtStr, "Sync Status:", syncStatusStr)
+		printAppHealthStatus(app)
+		return
+	}
 	switch app.Status.Sync.Status {
 	case argoappv1.SyncStatusCodeSynced:
 		syncStatusStr += fmt.Sprintf(" to %s", app.Spec.Source.TargetRevision)
 	case argoappv1.SyncStatusCodeOutOfSync:
 		syncStatusStr += fmt.Sprintf(" from %s", app.Spec.Source.TargetRevision)
 	}
-	if !git.IsCommitSHA(app.Spec.Source.TargetRevision) && !git.IsTruncatedCommitSHA(app.Spec.Source.TargetRevision) && len(app.Status.Sync.Revision) > 7 {
+	if app.Spec.Source.IsHelmChart() {
+		// Chart versions are short, and can't be truncated like commit SHAs
+		if app.Status.Sync.Revision != "" && app.Status.Sync.Revision != app.Spec.Source.TargetRevision {
+			syncStatusStr += fmt.Sprintf(" (%s)", app.Status.Sync.Revision)
+		}
+	} else if !git.IsCommitSHA(app.Spec.Source.TargetRevision) && !git.IsTruncatedCommitSHA(app.Spec.Source.TargetRevision) && len(app.Status.Sync.Revision) > 7 {
 		syncStatusStr += fmt.Sprintf(" (%s)", app.Status.Sync.Revision[0:7])
 	}
 	fmt.Printf(printOpFmtStr, "Sync Status:", syncStatusStr)
+	printAppHealthStatus(app)
+}
+
+func printAppHealthStatus(app *argoappv1.Application) {
 	healthStr := app.Status.Health.Status
 	if app.Status.Health.Message != "" {
 		healthStr = fmt.Sprintf("%s (%s)", app.Status.Health.Status, app.Status.Health.Message)
@@ -348,6 +403,9 @@ func printParams(app *argoappv1.Application) {
 		for _, p := range app.Spec.Source.Helm.Parameters {
 			fmt.Fprintf(w, "%s\t%s\n", p.Name, truncateString(p.Value, paramLenLimit))
 		}
+		for _, p := range app.Spec.Source.Helm.FileParameters {
+			fmt.Fprintf(w, "%s\tfile %s\n", p.Name, p.Path)
+		}
 	}
 	_ = w.Flush()
 }
@@ -399,18 +457,52 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 			app.Spec.Source.RepoURL = appOpts.repoURL
 		case "path":
 			app.Spec.Source.Path = appOpts.appPath
+		case "helm-chart":
+			app.Spec.Source.Chart = appOpts.chart
 		case "env":
 			setKsonnetOpt(&app.Spec.Source, &appOpts.env)
 		case "revision":
 			app.Spec.Source.TargetRevision = appOpts.revision
 		case "values":
 			setHelmOpt(&app.Spec.Source, appOpts.valuesFiles, nil)
+		case "values-literal-file":
+			values, err := readHelmValuesLiteral(appOpts.valuesLiteralFile)
+			errors.CheckError(err)
+			setHelmValuesLiteral(&app.Spec.Source, values)
+		case "helm-set":
+			errors.CheckError(setHelmParameters(&app.Spec.Source, appOpts.helmSets, false))
+		case "helm-set-string":
+			errors.CheckError(setHelmParameters(&app.Spec.Source, appOpts.helmSetStrings, true))
+		case "helm-set-file":
+			errors.CheckError(setHelmFileParameters(&app.Spec.Source, appOpts.helmSetFiles))
+		case "values-repo":
+			valuesRepos, err := parseHelmValueFilesRepos(appOpts.valuesRepos)
+			errors.CheckError(err)
+			setHelmValueFilesRepos(&app.Spec.Source, valuesRepos)
 		case "release-name":
 			setHelmOpt(&app.Spec.Source, nil, &appOpts.releaseName)
+		case "helm-version":
+			setHelmVersion(&app.Spec.Source, appOpts.helmVersion)
 		case "directory-recurse":
-			app.Spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{Recurse: appOpts.directoryRecurse}
+			setDirectoryOpts(&app.Spec.Source, func(directory *argoappv1.ApplicationSourceDirectory) {
+				directory.Recurse = appOpts.directoryRecurse
+			})
+		case "directory-include":
+			setDirectoryOpts(&app.Spec.Source, func(directory *argoappv1.ApplicationSourceDirectory) {
+				directory.Include = appOpts.directoryInclude
+			})
+		case "directory-exclude":
+			setDirectoryOpts(&app.Spec.Source, func(directory *argoappv1.ApplicationSourceDirectory) {
+				directory.Exclude = appOpts.directoryExclude
+			})
+		case "directory-substitute":
+			setDirectoryOpts(&app.Spec.Source, func(directory *argoappv1.ApplicationSourceDirectory) {
+				directory.Substitute = appOpts.directorySubstitute
+			})
 		case "config-management-plugin":
 			app.Spec.Source.Plugin = &argoappv1.ApplicationSourcePlugin{Name: appOpts.configManagementPlugin}
+		case "plugin-param":
+			errors.CheckError(setPluginParameters(&app.Spec.Source, appOpts.pluginParameters))
 		case "dest-server":
 			app.Spec.Destination.Server = appOpts.destServer
 		case "dest-namespace":
@@ -419,8 +511,26 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 			app.Spec.Project = appOpts.project
 		case "nameprefix":
 			setKustomizeOpt(&app.Spec.Source, &appOpts.namePrefix)
+		case "kustomize-version":
+			setKustomizeVersion(&app.Spec.Source, appOpts.kustomizeVersion)
+		case "kustomize-component":
+			setKustomizeComponents(&app.Spec.Source, appOpts.kustomizeComponents)
+		case "kustomize-replica":
+			errors.CheckError(setKustomizeReplicas(&app.Spec.Source, appOpts.kustomizeReplicas))
+		case "kustomize-patch-file":
+			errors.CheckError(addKustomizePatches(&app.Spec.Source, appOpts.kustomizePatchFiles))
 		case "jsonnet-tlas":
 			setJsonnetOpt(&app.Spec.Source, appOpts.jsonnetTlaParameters)
+		case "jsonnet-ext-var-str":
+			errors.CheckError(setJsonnetExtVars(&app.Spec.Source, appOpts.jsonnetExtVarStrs, false, false))
+		case "jsonnet-ext-var-code":
+			errors.CheckError(setJsonnetExtVars(&app.Spec.Source, appOpts.jsonnetExtVarCodes, true, false))
+		case "jsonnet-ext-var-str-file":
+			errors.CheckError(setJsonnetExtVars(&app.Spec.Source, appOpts.jsonnetExtVarStrFiles, false, true))
+		case "jsonnet-ext-var-code-file":
+			errors.CheckError(setJsonnetExtVars(&app.Spec.Source, appOpts.jsonnetExtVarCodeFiles, true, true))
+		case "jsonnet-libs":
+			setJsonnetLibs(&app.Spec.Source, appOpts.jsonnetLibs)
 		case "sync-policy":
 			switch appOpts.syncPolicy {
 			case "automated":
@@ -440,6 +550,13 @@ func setAppOptions(flags *pflag.FlagSet, app *argoappv1.Application, appOpts *ap
 		}
 		app.Spec.SyncPolicy.Automated.Prune = appOpts.autoPrune
 	}
+	// the TLAs of files are set after --jsonnet-tlas, which replaces all TLAs
+	if flags.Changed("jsonnet-tla-str-file") {
+		errors.CheckError(setJsonnetTLAs(&app.Spec.Source, appOpts.jsonnetTlaStrFiles, false, true))
+	}
+	if flags.Changed("jsonnet-tla-code-file") {
+		errors.CheckError(setJsonnetTLAs(&app.Spec.Source, appOpts.jsonnetTlaCodeFiles, true, true))
+	}
 
 	return visited
 }
@@ -468,6 +585,95 @@ func setKustomizeOpt(src *argoappv1.ApplicationSource, namePrefix *string) {
 	}
 }
 
+func setHelmVersion(src *argoappv1.ApplicationSource, version string) {
+	if src.Helm == nil {
+		src.Helm = &argoappv1.ApplicationSourceHelm{}
+	}
+	src.Helm.Version = version
+	if src.Helm.IsZero() {
+		src.Helm = nil
+	}
+}
+
+func setKustomizeVersion(src *argoappv1.ApplicationSource, version string) {
+	if src.Kustomize == nil {
+		src.Kustomize = &argoappv1.ApplicationSourceKustomize{}
+	}
+	src.Kustomize.Version = version
+	if src.Kustomize.IsZero() {
+		src.Kustomize = nil
+	}
+}
+
+// setKustomizeComponents adds the components to the kustomize options which are not added yet
+func setKustomizeComponents(src *argoappv1.ApplicationSource, components []string) {
+	if src.Kustomize == nil {
+		src.Kustomize = &argoappv1.ApplicationSourceKustomize{}
+	}
+	for _, component := range components {
+		found := false
+		for _, c := range src.Kustomize.Components {
+			if c == component {
+				found = true
+				break
+			}
+		}
+		if !found {
+			src.Kustomize.Components = append(src.Kustomize.Components, component)
+		}
+	}
+}
+
+// setKustomizeReplicas updates existing or appends new replica overrides of the form name=count
+func setKustomizeReplicas(src *argoappv1.ApplicationSource, replicas []string) error {
+	if src.Kustomize == nil {
+		src.Kustomize = &argoappv1.ApplicationSourceKustomize{}
+	}
+	for _, replicaStr := range replicas {
+		parts := strings.SplitN(replicaStr, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("Expected replicas of the form: name=count. Received: %s", replicaStr)
+		}
+		count, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || count < 0 {
+			return fmt.Errorf("Invalid replica count '%s' of %s", parts[1], parts[0])
+		}
+		newReplica := argoappv1.KustomizeReplica{Name: parts[0], Count: count}
+		found := false
+		for i, r := range src.Kustomize.Replicas {
+			if r.Name == newReplica.Name {
+				found = true
+				src.Kustomize.Replicas[i] = newReplica
+				break
+			}
+		}
+		if !found {
+			src.Kustomize.Replicas = append(src.Kustomize.Replicas, newReplica)
+		}
+	}
+	return nil
+}
+
+// addKustomizePatches adds the patches of files to the kustomize options. A file holds either a strategic merge
+// patch, or a patch with its target in the format of the patches of the kustomize options.
+func addKustomizePatches(src *argoappv1.ApplicationSource, patchFiles []string) error {
+	if src.Kustomize == nil {
+		src.Kustomize = &argoappv1.ApplicationSourceKustomize{}
+	}
+	for _, patchFile := range patchFiles {
+		data, err := ioutil.ReadFile(patchFile)
+		if err != nil {
+			return err
+		}
+		var patch argoappv1.KustomizePatch
+		if err := yaml.Unmarshal(data, &patch); err != nil || patch.Patch == "" {
+			patch = argoappv1.KustomizePatch{Patch: string(data)}
+		}
+		src.Kustomize.Patches = append(src.Kustomize.Patches, patch)
+	}
+	return nil
+}
+
 func setHelmOpt(src *argoappv1.ApplicationSource, valueFiles []string, releaseName *string) {
 	if src.Helm == nil {
 		src.Helm = &argoappv1.ApplicationSourceHelm{}
@@ -483,6 +689,134 @@ func setHelmOpt(src *argoappv1.ApplicationSource, valueFiles []string, releaseNa
 	}
 }
 
+func setHelmValueFilesRepos(src *argoappv1.ApplicationSource, valuesRepos []argoappv1.HelmValueFilesRepo) {
+	if src.Helm == nil {
+		src.Helm = &argoappv1.ApplicationSourceHelm{}
+	}
+	src.Helm.ValueFilesRepos = valuesRepos
+	if src.Helm.IsZero() {
+		src.Helm = nil
+	}
+}
+
+func setHelmValuesLiteral(src *argoappv1.ApplicationSource, values string) {
+	if src.Helm == nil {
+		src.Helm = &argoappv1.ApplicationSourceHelm{}
+	}
+	src.Helm.Values = values
+	if src.Helm.IsZero() {
+		src.Helm = nil
+	}
+}
+
+// readHelmValuesLiteral reads the inline values of a Helm source from a YAML file, or from stdin if the path is "-"
+func readHelmValuesLiteral(path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return "", err
+	}
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return "", fmt.Errorf("Values of %s are not a YAML object: %v", path, err)
+	}
+	return string(data), nil
+}
+
+// parseHelmValueFilesRepos parses the repositories of value files given as comma separated options, e.g.
+// ref=values,repo=https://github.com/argoproj/argocd-example-apps.git,revision=master
+func parseHelmValueFilesRepos(valuesRepoStrs []string) ([]argoappv1.HelmValueFilesRepo, error) {
+	var valuesRepos []argoappv1.HelmValueFilesRepo
+	for _, valuesRepoStr := range valuesRepoStrs {
+		var valuesRepo argoappv1.HelmValueFilesRepo
+		for _, option := range strings.Split(valuesRepoStr, ",") {
+			parts := strings.SplitN(option, "=", 2)
+			if len(parts) != 2 || parts[1] == "" {
+				return nil, fmt.Errorf("Expected values repo option of the form key=value. Received: %s", option)
+			}
+			switch parts[0] {
+			case "ref":
+				valuesRepo.Ref = parts[1]
+			case "repo":
+				valuesRepo.RepoURL = parts[1]
+			case "revision":
+				valuesRepo.TargetRevision = parts[1]
+			default:
+				return nil, fmt.Errorf("Unknown values repo option '%s', must be one of: ref, repo, revision", parts[0])
+			}
+		}
+		if valuesRepo.Ref == "" || valuesRepo.RepoURL == "" {
+			return nil, fmt.Errorf("Values repo '%s' requires a ref and a repo", valuesRepoStr)
+		}
+		valuesRepos = append(valuesRepos, valuesRepo)
+	}
+	return valuesRepos, nil
+}
+
+// parseApplicationSource parses a source given as comma separated options, e.g.
+// repo=https://github.com/argoproj/argocd-example-apps.git,path=helm-guestbook,values=values-prod.yaml
+func parseApplicationSource(sourceStr string) (argoappv1.ApplicationSource, error) {
+	var source argoappv1.ApplicationSource
+	var valueFiles []string
+	for _, option := range strings.Split(sourceStr, ",") {
+		parts := strings.SplitN(option, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return source, fmt.Errorf("Expected source option of the form key=value. Received: %s", option)
+		}
+		switch parts[0] {
+		case "repo":
+			source.RepoURL = parts[1]
+		case "path":
+			source.Path = parts[1]
+		case "chart":
+			source.Chart = parts[1]
+		case "revision":
+			source.TargetRevision = parts[1]
+		case "ref":
+			source.Ref = parts[1]
+		case "values":
+			valueFiles = append(valueFiles, parts[1])
+		default:
+			return source, fmt.Errorf("Unknown source option '%s', must be one of: repo, path, chart, revision, ref, values", parts[0])
+		}
+	}
+	if source.RepoURL == "" {
+		return source, fmt.Errorf("Source '%s' does not have a repo", sourceStr)
+	}
+	if len(valueFiles) > 0 {
+		setHelmOpt(&source, valueFiles, nil)
+	}
+	return source, nil
+}
+
+// formatApplicationSource formats a source as it is given to --source
+func formatApplicationSource(source argoappv1.ApplicationSource) string {
+	options := []string{"repo=" + source.RepoURL}
+	if source.Path != "" {
+		options = append(options, "path="+source.Path)
+	}
+	if source.Chart != "" {
+		options = append(options, "chart="+source.Chart)
+	}
+	if source.TargetRevision != "" {
+		options = append(options, "revision="+source.TargetRevision)
+	}
+	if source.Ref != "" {
+		options = append(options, "ref="+source.Ref)
+	}
+	if source.Helm != nil {
+		for _, valueFile := range source.Helm.ValueFiles {
+			options = append(options, "values="+valueFile)
+		}
+	}
+	return strings.Join(options, ",")
+}
+
 func setJsonnetOpt(src *argoappv1.ApplicationSource, tlaParameters []string) {
 	if src.Directory == nil {
 		src.Directory = &argoappv1.ApplicationSourceDirectory{}
@@ -510,55 +844,170 @@ func setJsonnetOpt(src *argoappv1.ApplicationSource, tlaParameters []string) {
 
 }
 
+// mergeJsonnetVars updates existing or appends new jsonnet variables of the form name=value
+func mergeJsonnetVars(vars []argoappv1.JsonnetVar, params []string, code bool, file bool) ([]argoappv1.JsonnetVar, error) {
+	for _, paramStr := range params {
+		parts := strings.SplitN(paramStr, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Expected jsonnet variable of the form: name=value. Received: %s", paramStr)
+		}
+		newVar := argoappv1.JsonnetVar{Name: parts[0], Value: parts[1], Code: code, File: file}
+		found := false
+		for i, v := range vars {
+			if v.Name == newVar.Name {
+				found = true
+				vars[i] = newVar
+				break
+			}
+		}
+		if !found {
+			vars = append(vars, newVar)
+		}
+	}
+	return vars, nil
+}
+
+// setJsonnetExtVars sets the jsonnet external variables of the form name=value, or name=path if they are read from files
+func setJsonnetExtVars(src *argoappv1.ApplicationSource, params []string, code bool, file bool) error {
+	if src.Directory == nil {
+		src.Directory = &argoappv1.ApplicationSourceDirectory{}
+	}
+	vars, err := mergeJsonnetVars(src.Directory.Jsonnet.ExtVars, params, code, file)
+	if err != nil {
+		return err
+	}
+	src.Directory.Jsonnet.ExtVars = vars
+	return nil
+}
+
+// setJsonnetTLAs sets the jsonnet top level arguments of the form name=value, or name=path if they are read from files
+func setJsonnetTLAs(src *argoappv1.ApplicationSource, params []string, code bool, file bool) error {
+	if src.Directory == nil {
+		src.Directory = &argoappv1.ApplicationSourceDirectory{}
+	}
+	vars, err := mergeJsonnetVars(src.Directory.Jsonnet.TLAs, params, code, file)
+	if err != nil {
+		return err
+	}
+	src.Directory.Jsonnet.TLAs = vars
+	return nil
+}
+
+// setDirectoryOpts updates the directory options of the source, which are removed if they are empty
+func setDirectoryOpts(src *argoappv1.ApplicationSource, update func(directory *argoappv1.ApplicationSourceDirectory)) {
+	if src.Directory == nil {
+		src.Directory = &argoappv1.ApplicationSourceDirectory{}
+	}
+	update(src.Directory)
+	if src.Directory.IsZero() {
+		src.Directory = nil
+	}
+}
+
+func setJsonnetLibs(src *argoappv1.ApplicationSource, libs []string) {
+	if src.Directory == nil {
+		src.Directory = &argoappv1.ApplicationSourceDirectory{}
+	}
+	src.Directory.Jsonnet.Libs = libs
+	if src.Directory.IsZero() {
+		src.Directory = nil
+	}
+}
+
 type appOptions struct {
 	repoURL                string
 	appPath                string
+	chart                  string
 	env                    string
 	revision               string
 	destServer             string
 	destNamespace          string
 	parameters             []string
 	valuesFiles            []string
+	valuesRepos            []string
+	valuesLiteralFile      string
+	helmSets               []string
+	helmSetStrings         []string
+	helmSetFiles           []string
+	helmVersion            string
+	kustomizeVersion       string
+	kustomizeComponents    []string
+	kustomizeReplicas      []string
+	kustomizePatchFiles    []string
 	releaseName            string
 	project                string
 	syncPolicy             string
 	autoPrune              bool
 	namePrefix             string
 	directoryRecurse       bool
+	directoryInclude       []string
+	directoryExclude       []string
+	directorySubstitute    bool
 	configManagementPlugin string
+	pluginParameters       []string
 	jsonnetTlaParameters   []string
+	jsonnetTlaStrFiles     []string
+	jsonnetTlaCodeFiles    []string
+	jsonnetExtVarStrs      []string
+	jsonnetExtVarCodes     []string
+	jsonnetExtVarStrFiles  []string
+	jsonnetExtVarCodeFiles []string
+	jsonnetLibs            []string
 }
 
 func addAppFlags(command *cobra.Command, opts *appOptions) {
 	command.Flags().StringVar(&opts.repoURL, "repo", "", "Repository URL, ignored if a file is set")
 	command.Flags().StringVar(&opts.appPath, "path", "", "Path in repository to the ksonnet app directory, ignored if a file is set")
+	command.Flags().StringVar(&opts.chart, "helm-chart", "", "Helm chart name, if the repository is a Helm chart repository or an OCI registry")
 	command.Flags().StringVar(&opts.env, "env", "", "Application environment to monitor")
-	command.Flags().StringVar(&opts.revision, "revision", "HEAD", "The tracking source branch, tag, or commit the application will sync to")
+	command.Flags().StringVar(&opts.revision, "revision", "HEAD", "The tracking source branch, tag, commit, Helm chart version, or tag or digest of a chart of an OCI registry the application will sync to")
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (overrides the server URL specified in the ksonnet app.yaml)")
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace (overrides the namespace specified in the ksonnet app.yaml)")
 	command.Flags().StringArrayVarP(&opts.parameters, "parameter", "p", []string{}, "set a parameter override (e.g. -p guestbook=image=example/guestbook:latest)")
 	command.Flags().StringArrayVar(&opts.valuesFiles, "values", []string{}, "Helm values file(s) to use")
+	command.Flags().StringVar(&opts.valuesLiteralFile, "values-literal-file", "", "Path to a YAML file of Helm values, which are stored in the application and override the values files, or - to read them from stdin")
+	command.Flags().StringArrayVar(&opts.valuesRepos, "values-repo", []string{}, "Repository of Helm values files, e.g. ref=values,repo=https://github.com/example/config.git,revision=master, whose files are used as values files with --values $values/prod.yaml")
 	command.Flags().StringVar(&opts.releaseName, "release-name", "", "Helm release-name")
+	command.Flags().StringArrayVar(&opts.helmSets, "helm-set", []string{}, "Helm set values on the command line (can be repeated to set several values: --helm-set key1=val1 --helm-set key2=val2)")
+	command.Flags().StringArrayVar(&opts.helmSetStrings, "helm-set-string", []string{}, "Helm set STRING values on the command line, which are not interpreted as booleans or numbers (can be repeated to set several values: --helm-set-string key1=val1 --helm-set-string key2=val2)")
+	command.Flags().StringArrayVar(&opts.helmSetFiles, "helm-set-file", []string{}, "Helm set values from a file of the repository, relative to the application path (can be repeated to set several values: --helm-set-file key1=path1 --helm-set-file key2=path2)")
+	command.Flags().StringVar(&opts.helmVersion, "helm-version", "", "Helm version registered in the settings, which renders the chart")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: automated, none)")
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
 	command.Flags().StringVar(&opts.namePrefix, "nameprefix", "", "Kustomize nameprefix")
+	command.Flags().StringVar(&opts.kustomizeVersion, "kustomize-version", "", "Kustomize version registered in the settings, which builds the kustomization")
+	command.Flags().StringArrayVar(&opts.kustomizeComponents, "kustomize-component", []string{}, "Kustomize component to add to the kustomization, relative to the application path (can be repeated)")
+	command.Flags().StringArrayVar(&opts.kustomizeReplicas, "kustomize-replica", []string{}, "Kustomize replica count override of the form name=count (can be repeated)")
+	command.Flags().StringArrayVar(&opts.kustomizePatchFiles, "kustomize-patch-file", []string{}, "Path to a file of a Kustomize strategic merge patch, or of a patch with a target, to add to the kustomization (can be repeated)")
 	command.Flags().BoolVar(&opts.directoryRecurse, "directory-recurse", false, "Recurse directory")
+	command.Flags().StringArrayVar(&opts.directoryInclude, "directory-include", []string{}, "Glob pattern of the files of the directory whose manifests are included, e.g. '*.yaml' or 'manifests/*.json' (can be repeated)")
+	command.Flags().StringArrayVar(&opts.directoryExclude, "directory-exclude", []string{}, "Glob pattern of the files and directories of the directory which are excluded from the manifests, e.g. 'docs' or 'config/*.yaml' (can be repeated)")
+	command.Flags().BoolVar(&opts.directorySubstitute, "directory-substitute", false, "Substitute the variables of the build environment, e.g. ${ARGOCD_APP_NAMESPACE}, in the YAML and JSON manifests of the directory")
 	command.Flags().StringVar(&opts.configManagementPlugin, "config-management-plugin", "", "Config management plugin name")
+	command.Flags().StringArrayVar(&opts.pluginParameters, "plugin-param", []string{}, "Config management plugin parameter of the form name=value (can be repeated to set several parameters: --plugin-param name1=val1 --plugin-param name2=val2)")
 	command.Flags().StringArrayVar(&opts.jsonnetTlaParameters, "jsonnet-tlas", []string{}, "Jsonnet top level arguments")
+	command.Flags().StringArrayVar(&opts.jsonnetTlaStrFiles, "jsonnet-tla-str-file", []string{}, "Jsonnet top level string argument read from a file of the repository, relative to the application path (e.g. --jsonnet-tla-str-file name=path)")
+	command.Flags().StringArrayVar(&opts.jsonnetTlaCodeFiles, "jsonnet-tla-code-file", []string{}, "Jsonnet top level code argument read from a file of the repository, relative to the application path (e.g. --jsonnet-tla-code-file name=path)")
+	command.Flags().StringArrayVar(&opts.jsonnetExtVarStrs, "jsonnet-ext-var-str", []string{}, "Jsonnet external string variable (e.g. --jsonnet-ext-var-str name=value)")
+	command.Flags().StringArrayVar(&opts.jsonnetExtVarCodes, "jsonnet-ext-var-code", []string{}, "Jsonnet external code variable (e.g. --jsonnet-ext-var-code name=code)")
+	command.Flags().StringArrayVar(&opts.jsonnetExtVarStrFiles, "jsonnet-ext-var-str-file", []string{}, "Jsonnet external string variable read from a file of the repository, relative to the application path (e.g. --jsonnet-ext-var-str-file name=path)")
+	command.Flags().StringArrayVar(&opts.jsonnetExtVarCodeFiles, "jsonnet-ext-var-code-file", []string{}, "Jsonnet external code variable read from a file of the repository, relative to the application path (e.g. --jsonnet-ext-var-code-file name=path)")
+	command.Flags().StringArrayVar(&opts.jsonnetLibs, "jsonnet-libs", []string{}, "Additional jsonnet library search dirs, relative to the root of the repository")
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
 func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		parameters  []string
-		valuesFiles []string
+		parameters    []string
+		valuesFiles   []string
+		valuesLiteral bool
 	)
 	var command = &cobra.Command{
 		Use:   "unset APPNAME -p COMPONENT=PARAM",
 		Short: "Unset application parameters",
 		Run: func(c *cobra.Command, args []string) {
-			if len(args) != 1 || (len(parameters) == 0 && len(valuesFiles) == 0) {
+			if len(args) != 1 || (len(parameters) == 0 && len(valuesFiles) == 0 && !valuesLiteral) {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
@@ -595,6 +1044,14 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 							break
 						}
 					}
+					fileParams := app.Spec.Source.Helm.FileParameters
+					for i, p := range fileParams {
+						if p.Name == paramStr {
+							app.Spec.Source.Helm.FileParameters = append(fileParams[0:i], fileParams[i+1:]...)
+							updated = true
+							break
+						}
+					}
 				}
 				specValueFiles := app.Spec.Source.Helm.ValueFiles
 				for _, valuesFile := range valuesFiles {
@@ -607,6 +1064,10 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 					}
 				}
 				setHelmOpt(&app.Spec.Source, specValueFiles, nil)
+				if valuesLiteral && app.Spec.Source.Helm != nil && app.Spec.Source.Helm.Values != "" {
+					setHelmValuesLiteral(&app.Spec.Source, "")
+					updated = true
+				}
 				if !updated {
 					return
 				}
@@ -621,6 +1082,7 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	}
 	command.Flags().StringArrayVarP(&parameters, "parameter", "p", []string{}, "unset a parameter override (e.g. -p guestbook=image)")
 	command.Flags().StringArrayVar(&valuesFiles, "values", []string{}, "unset one or more helm values files")
+	command.Flags().BoolVar(&valuesLiteral, "values-literal", false, "unset the inline helm values")
 	return command
 }
 
@@ -668,6 +1130,7 @@ func getLocalObjectsString(app *argoappv1.Application, local string, appLabelKey
 		AppLabelKey:       appLabelKey,
 		AppLabelValue:     app.Name,
 		Namespace:         app.Spec.Destination.Namespace,
+		DestServer:        app.Spec.Destination.Server,
 	})
 	errors.CheckError(err)
 
@@ -717,6 +1180,12 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		Use:   "diff APPNAME",
 		Short: shortDesc,
 		Long:  shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found",
+		Example: `  # Compare the live state with the manifests the server renders from the target revision
+  argocd app diff guestbook
+
+  # Compare the live state with the manifests rendered from a local directory, e.g. to check
+  # the effect of uncommitted changes before merging them
+  argocd app diff guestbook --local ./guestbook`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) == 0 {
 				c.HelpFunc()(c, args)
@@ -731,13 +1200,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			errors.CheckError(err)
 			resources, err := appIf.ManagedResources(context.Background(), &applicationpkg.ResourcesQuery{ApplicationName: &appName})
 			errors.CheckError(err)
-			liveObjs, err := liveObjects(resources.Items)
-			errors.CheckError(err)
-			items := make([]struct {
-				key    kube.ResourceKey
-				live   *unstructured.Unstructured
-				target *unstructured.Unstructured
-			}, 0)
+			var items []objKeyLiveTarget
 
 			conn, settingsIf := clientset.NewSettingsClientOrDie()
 			defer util.Close(conn)
@@ -745,55 +1208,11 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			errors.CheckError(err)
 
 			if local != "" {
+				checkLocalToolVersion(clientset, app, local)
+				liveObjs, err := liveObjects(resources.Items)
+				errors.CheckError(err)
 				localObjs := groupLocalObjs(getLocalObjects(app, local, argoSettings.AppLabelKey), liveObjs, app.Spec.Destination.Namespace)
-				for _, res := range resources.Items {
-					var live = &unstructured.Unstructured{}
-					err := json.Unmarshal([]byte(res.LiveState), &live)
-					errors.CheckError(err)
-
-					var key kube.ResourceKey
-					if live != nil {
-						key = kube.GetResourceKey(live)
-					} else {
-						var target = &unstructured.Unstructured{}
-						err = json.Unmarshal([]byte(res.TargetState), &target)
-						errors.CheckError(err)
-						key = kube.GetResourceKey(target)
-					}
-					if key.Kind == kube.SecretKind && key.Group == "" {
-						// Don't bother comparing secrets, argo-cd doesn't have access to k8s secret data
-						delete(localObjs, key)
-						continue
-					}
-					if local, ok := localObjs[key]; ok || live != nil {
-						if local != nil && !kube.IsCRD(local) {
-							err = kube.SetAppInstanceLabel(local, argoSettings.AppLabelKey, appName)
-							errors.CheckError(err)
-						}
-
-						items = append(items, struct {
-							key    kube.ResourceKey
-							live   *unstructured.Unstructured
-							target *unstructured.Unstructured
-						}{
-							live:   live,
-							target: local,
-							key:    key,
-						})
-						delete(localObjs, key)
-					}
-				}
-				for key, local := range localObjs {
-					items = append(items, struct {
-						key    kube.ResourceKey
-						live   *unstructured.Unstructured
-						target *unstructured.Unstructured
-					}{
-						live:   nil,
-						target: local,
-						key:    key,
-					})
-				}
+				items = groupObjsForDiff(resources, localObjs, appName, argoSettings.AppLabelKey)
 			} else {
 				for i := range resources.Items {
 					res := resources.Items[i]
@@ -805,11 +1224,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					err = json.Unmarshal([]byte(res.TargetState), &target)
 					errors.CheckError(err)
 
-					items = append(items, struct {
-						key    kube.ResourceKey
-						live   *unstructured.Unstructured
-						target *unstructured.Unstructured
-					}{
+					items = append(items, objKeyLiveTarget{
 						live:   live,
 						target: target,
 						key:    kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name),
@@ -817,34 +1232,11 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
-			foundDiffs := false
-			for i := range items {
-				item := items[i]
-				overrides := make(map[string]argoappv1.ResourceOverride)
-				for k := range argoSettings.ResourceOverrides {
-					val := argoSettings.ResourceOverrides[k]
-					overrides[k] = *val
-				}
-				normalizer, err := argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, overrides)
-				errors.CheckError(err)
-				// Diff is already available in ResourceDiff Diff field but we have to recalculate diff again due to https://github.com/yudai/gojsondiff/issues/31
-				diffRes := diff.Diff(item.target, item.live, normalizer)
-				if diffRes.Modified || item.target == nil || item.live == nil {
-					fmt.Printf("===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
-					var live *unstructured.Unstructured
-					var target *unstructured.Unstructured
-					if item.target != nil && item.live != nil {
-						target = item.live
-						live = item.live.DeepCopy()
-						gojsondiff.New().ApplyPatch(live.Object, diffRes.Diff)
-					} else {
-						live = item.live
-						target = item.target
-					}
-
-					foundDiffs = true
-					printDiff(item.key.Name, target, live)
-				}
+			syncPreview := printResourceDiffs(items, app, argoSettings)
+			foundDiffs := len(syncPreview) > 0
+			// A sync from the local directory performs the actions shown by the diff
+			if local != "" && len(syncPreview) > 0 {
+				printSyncPreview(syncPreview)
 			}
 			if foundDiffs {
 				os.Exit(1)
@@ -854,10 +1246,147 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	}
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
 	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
-	command.Flags().StringVar(&local, "local", "", "Compare live app to a local ksonnet app")
+	command.Flags().StringVar(&local, "local", "", "Compare live app to the manifests rendered from a local directory")
 	return command
 }
 
+type objKeyLiveTarget struct {
+	key    kube.ResourceKey
+	live   *unstructured.Unstructured
+	target *unstructured.Unstructured
+}
+
+// groupObjsForDiff pairs the live objects of the managed resources with the given target objects, which were
+// rendered from another source than the one the server compares the live state with
+func groupObjsForDiff(resources *applicationpkg.ManagedResourcesResponse, objs map[kube.ResourceKey]*unstructured.Unstructured, appName string, appLabelKey string) []objKeyLiveTarget {
+	items := make([]objKeyLiveTarget, 0)
+	for _, res := range resources.Items {
+		var live = &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(res.LiveState), &live)
+		errors.CheckError(err)
+
+		var key kube.ResourceKey
+		if live != nil {
+			key = kube.GetResourceKey(live)
+		} else {
+			var target = &unstructured.Unstructured{}
+			err = json.Unmarshal([]byte(res.TargetState), &target)
+			errors.CheckError(err)
+			key = kube.GetResourceKey(target)
+		}
+		if key.Kind == kube.SecretKind && key.Group == "" {
+			// Don't bother comparing secrets, argo-cd doesn't have access to k8s secret data
+			delete(objs, key)
+			continue
+		}
+		if local, ok := objs[key]; ok || live != nil {
+			if local != nil && !kube.IsCRD(local) {
+				err = kube.SetAppInstanceLabel(local, appLabelKey, appName)
+				errors.CheckError(err)
+			}
+
+			items = append(items, objKeyLiveTarget{
+				live:   live,
+				target: local,
+				key:    key,
+			})
+			delete(objs, key)
+		}
+	}
+	for key, local := range objs {
+		items = append(items, objKeyLiveTarget{
+			live:   nil,
+			target: local,
+			key:    key,
+		})
+	}
+	return items
+}
+
+// printResourceDiffs prints the differences between the target and live objects, and returns the actions which a
+// sync to the target objects performs
+func printResourceDiffs(items []objKeyLiveTarget, app *argoappv1.Application, argoSettings *settingspkg.Settings) []string {
+	syncPreview := make([]string, 0)
+	for i := range items {
+		item := items[i]
+		overrides := make(map[string]argoappv1.ResourceOverride)
+		for k := range argoSettings.ResourceOverrides {
+			val := argoSettings.ResourceOverrides[k]
+			overrides[k] = *val
+		}
+		normalizer, err := argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, overrides)
+		errors.CheckError(err)
+		// Diff is already available in ResourceDiff Diff field but we have to recalculate diff again due to https://github.com/yudai/gojsondiff/issues/31
+		diffRes := diff.Diff(item.target, item.live, normalizer)
+		if diffRes.Modified || item.target == nil || item.live == nil {
+			fmt.Printf("===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
+			var live *unstructured.Unstructured
+			var target *unstructured.Unstructured
+			if item.target != nil && item.live != nil {
+				target = item.live
+				live = item.live.DeepCopy()
+				gojsondiff.New().ApplyPatch(live.Object, diffRes.Diff)
+			} else {
+				live = item.live
+				target = item.target
+			}
+
+			printDiff(item.key.Name, target, live)
+
+			action := "update"
+			if item.live == nil {
+				action = "create"
+			} else if item.target == nil {
+				action = "prune"
+			}
+			syncPreview = append(syncPreview, fmt.Sprintf("%s\t%s\t%s\t%s\t%s", action, item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name))
+		}
+	}
+	return syncPreview
+}
+
+// printSyncPreview prints the actions returned by printResourceDiffs as a table
+func printSyncPreview(syncPreview []string) {
+	fmt.Println("===== Sync preview ======")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ACTION\tGROUP\tKIND\tNAMESPACE\tNAME\n")
+	for _, line := range syncPreview {
+		fmt.Fprintln(w, line)
+	}
+	_ = w.Flush()
+}
+
+// checkLocalToolVersion warns if the tool rendering the manifests of a local directory has another
+// version than the one used by the server, since the rendered manifests may differ then
+func checkLocalToolVersion(clientset argocdclient.Client, app *argoappv1.Application, local string) {
+	sourceType, err := repository.GetAppSourceType(&app.Spec.Source, local)
+	errors.CheckError(err)
+	conn, versionIf := clientset.NewVersionClientOrDie()
+	defer util.Close(conn)
+	serverVersion, err := versionIf.Version(context.Background(), &empty.Empty{})
+	errors.CheckError(err)
+
+	var tool, localVersion, serverToolVersion string
+	switch sourceType {
+	case argoappv1.ApplicationSourceTypeHelm:
+		tool, serverToolVersion = "helm", serverVersion.HelmVersion
+		localVersion, err = helm.Version()
+	case argoappv1.ApplicationSourceTypeKustomize:
+		tool, serverToolVersion = "kustomize", serverVersion.KustomizeVersion
+		localVersion, err = kustomize.Version()
+	case argoappv1.ApplicationSourceTypeKsonnet:
+		tool, serverToolVersion = "ksonnet", serverVersion.KsonnetVersion
+		localVersion, err = ksutil.KsonnetVersion()
+	default:
+		return
+	}
+	errors.CheckError(err)
+	// Servers which don't report the version of the tool are not checked
+	if serverToolVersion != "" && localVersion != serverToolVersion {
+		log.Warnf("Local %s version %s differs from the server's version %s, the manifests rendered locally may differ from the server's", tool, localVersion, serverToolVersion)
+	}
+}
+
 func printDiff(name string, live *unstructured.Unstructured, target *unstructured.Unstructured) {
 	tempDir, err := ioutil.TempDir("", "argocd-diff")
 	errors.CheckError(err)
@@ -1025,9 +1554,10 @@ func formatConditionsSummary(app argoappv1.Application) string {
 }
 
 const (
-	resourceFieldDelimiter = ":"
-	resourceFieldCount     = 3
-	labelFieldDelimiter    = "="
+	resourceFieldDelimiter  = ":"
+	resourceFieldCount      = 3
+	resourceExclusionPrefix = "!"
+	labelFieldDelimiter     = "="
 )
 
 func parseSelectedResources(resources []string) []argoappv1.SyncOperationResource {
@@ -1035,14 +1565,16 @@ func parseSelectedResources(resources []string) []argoappv1.SyncOperationResourc
 	if resources != nil {
 		selectedResources = []argoappv1.SyncOperationResource{}
 		for _, r := range resources {
-			fields := strings.Split(r, resourceFieldDelimiter)
+			exclude := strings.HasPrefix(r, resourceExclusionPrefix)
+			fields := strings.Split(strings.TrimPrefix(r, resourceExclusionPrefix), resourceFieldDelimiter)
 			if len(fields) != resourceFieldCount {
 				log.Fatalf("Resource should have GROUP%sKIND%sNAME, but instead got: %s", resourceFieldDelimiter, resourceFieldDelimiter, r)
 			}
 			rsrc := argoappv1.SyncOperationResource{
-				Group: fields[0],
-				Kind:  fields[1],
-				Name:  fields[2],
+				Group:   fields[0],
+				Kind:    fields[1],
+				Name:    fields[2],
+				Exclude: exclude,
 			}
 			selectedResources = append(selectedResources, rsrc)
 		}
@@ -1072,27 +1604,48 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		watchHealth     bool
 		watchSuspended  bool
 		watchOperations bool
+		watchDegraded   bool
+		jsonPath        string
 		timeout         uint
+		timeoutExitCode int
 		resources       []string
 	)
 	var command = &cobra.Command{
 		Use:   "wait APPNAME",
 		Short: "Wait for an application to reach a synced and healthy state",
+		Example: `  # Wait for the application to be synced and healthy, and for its operation to complete
+  argocd app wait guestbook
+
+  # Wait for the guestbook-ui deployment to be healthy, but fail as soon as it is degraded
+  argocd app wait guestbook --health --degraded --resource apps:Deployment:guestbook-ui
+
+  # Wait for the sync of a revision to succeed, and exit with 124 when timing out
+  argocd app wait guestbook --jsonpath '{.status.operationState.syncResult.revision} {.status.operationState.phase}=53e28ff0 Succeeded' --timeout 300 --timeout-exit-code 124`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
-			if !watchSync && !watchHealth && !watchOperations && !watchSuspended {
+			if !watchSync && !watchHealth && !watchOperations && !watchSuspended && jsonPath == "" {
 				watchSync = true
 				watchHealth = true
 				watchOperations = true
 				watchSuspended = false
 			}
+			var jsonPathCond *jsonPathCondition
+			if jsonPath != "" {
+				var err error
+				jsonPathCond, err = parseJSONPathCondition(jsonPath)
+				errors.CheckError(err)
+			}
 			selectedResources := parseSelectedResources(resources)
 			appName := args[0]
 			acdClient := argocdclient.NewClientOrDie(clientOpts)
-			_, err := waitOnApplicationStatus(acdClient, appName, timeout, watchSync, watchHealth, watchOperations, watchSuspended, selectedResources)
+			_, err := waitOnApplicationStatus(acdClient, appName, timeout, watchSync, watchHealth, watchOperations, watchSuspended, watchDegraded, jsonPathCond, selectedResources)
+			if _, ok := err.(*waitTimeoutError); ok && timeoutExitCode != 0 {
+				log.Error(err)
+				os.Exit(timeoutExitCode)
+			}
 			errors.CheckError(err)
 		},
 	}
@@ -1101,10 +1654,56 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&watchSuspended, "suspended", false, "Wait for suspended")
 	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%sKIND%sNAME. Fields may be blank. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
 	command.Flags().BoolVar(&watchOperations, "operation", false, "Wait for pending operations")
+	command.Flags().BoolVar(&watchDegraded, "degraded", false, "Fail as soon as the application, or one of the selected resources, is degraded")
+	command.Flags().StringVar(&jsonPath, "jsonpath", "", "Wait for a JSONPath template evaluated for the application to result in a value, e.g. '{.status.sync.status}=Synced', or in any value if the value is omitted")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	command.Flags().IntVar(&timeoutExitCode, "timeout-exit-code", 0, "Exit with this code instead of 1 when timing out")
 	return command
 }
 
+// jsonPathCondition is satisfied if a JSONPath template evaluated for an application results in the value, or in any
+// value if there is none
+type jsonPathCondition struct {
+	parser *jsonpath.JSONPath
+	value  *string
+}
+
+// parseJSONPathCondition parses a condition of the form TEMPLATE[=VALUE], e.g. '{.status.sync.status}=Synced'
+func parseJSONPathCondition(condition string) (*jsonPathCondition, error) {
+	template := condition
+	var value *string
+	if i := strings.LastIndex(condition, "}="); i >= 0 {
+		template = condition[:i+1]
+		v := condition[i+2:]
+		value = &v
+	}
+	parser := jsonpath.New("condition").AllowMissingKeys(true)
+	if err := parser.Parse(template); err != nil {
+		return nil, fmt.Errorf("Invalid JSONPath template '%s': %v", template, err)
+	}
+	return &jsonPathCondition{parser: parser, value: value}, nil
+}
+
+// matches returns whether the application satisfies the condition
+func (c *jsonPathCondition) matches(app *argoappv1.Application) (bool, error) {
+	data, err := json.Marshal(app)
+	if err != nil {
+		return false, err
+	}
+	var obj interface{}
+	if err = json.Unmarshal(data, &obj); err != nil {
+		return false, err
+	}
+	var result bytes.Buffer
+	if err = c.parser.Execute(&result, obj); err != nil {
+		return false, err
+	}
+	if c.value == nil {
+		return result.Len() > 0, nil
+	}
+	return result.String() == *c.value, nil
+}
+
 // printAppResources prints the resources of an application in a tabwriter table
 func printAppResources(w io.Writer, app *argoappv1.Application) {
 	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tSTATUS\tHEALTH\tHOOK\tMESSAGE\n")
@@ -1157,6 +1756,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		revision  string
 		resources []string
 		labels    []string
+		selector  string
 		prune     bool
 		dryRun    bool
 		timeout   uint
@@ -1164,10 +1764,29 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		force     bool
 		async     bool
 		local     string
+
+		retryLimit              int64
+		retryBackoffDuration    string
+		retryBackoffMaxDuration string
+		retryBackoffFactor      int64
 	)
 	var command = &cobra.Command{
 		Use:   "sync APPNAME",
 		Short: "Sync an application to its target state",
+		Example: `  # Sync an app
+  argocd app sync my-app
+
+  # Sync only the deployments of an app whose names start with "guestbook"
+  argocd app sync my-app --resource 'apps:Deployment:guestbook*'
+
+  # Sync all resources of an app except its secrets
+  argocd app sync my-app --resource '!:Secret:*'
+
+  # Sync only the resources of an app which match a label selector
+  argocd app sync my-app --selector 'tier=frontend,environment!=dev'
+
+  # Sync an app and retry failed syncs up to 5 times, waiting 10s, 20s, 40s, 80s and 2m between the attempts
+  argocd app sync my-app --retry-limit 5 --retry-backoff-duration 10s --retry-backoff-max-duration 2m`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
@@ -1241,12 +1860,13 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			}
 
 			syncReq := applicationpkg.ApplicationSyncRequest{
-				Name:      &appName,
-				DryRun:    dryRun,
-				Revision:  revision,
-				Resources: selectedResources,
-				Prune:     prune,
-				Manifests: localObjsStrings,
+				Name:          &appName,
+				DryRun:        dryRun,
+				Revision:      revision,
+				Resources:     selectedResources,
+				LabelSelector: selector,
+				Prune:         prune,
+				Manifests:     localObjsStrings,
 			}
 			switch strategy {
 			case "apply":
@@ -1258,16 +1878,26 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			default:
 				log.Fatalf("Unknown sync strategy: '%s'", strategy)
 			}
+			if retryLimit > 0 {
+				syncReq.RetryStrategy = &argoappv1.RetryStrategy{
+					Limit: retryLimit,
+					Backoff: &argoappv1.Backoff{
+						Duration:    retryBackoffDuration,
+						MaxDuration: retryBackoffMaxDuration,
+						Factor:      &retryBackoffFactor,
+					},
+				}
+			}
 			ctx := context.Background()
 			_, err := appIf.Sync(ctx, &syncReq)
 			errors.CheckError(err)
 
 			if !async {
-				app, err := waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, selectedResources)
+				app, err := waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, false, nil, selectedResources)
 				errors.CheckError(err)
 
 				// Only get resources to be pruned if sync was application-wide
-				if len(selectedResources) == 0 {
+				if len(selectedResources) == 0 && selector == "" {
 					pruningRequired := app.Status.OperationState.SyncResult.Resources.PruningRequired()
 					if pruningRequired > 0 {
 						log.Fatalf("%d resources require pruning", pruningRequired)
@@ -1283,12 +1913,17 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
-	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%sKIND%sNAME. Fields may be blank. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
+	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%sKIND%sNAME. Fields may be blank and may contain glob patterns. Prefix with %s to exclude the matching resources instead. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter, resourceExclusionPrefix))
 	command.Flags().StringArrayVar(&labels, "label", []string{}, fmt.Sprintf("Sync only specific resources with a label. This option may be specified repeatedly."))
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Sync only resources matching the label selector (e.g. tier=frontend,environment!=dev)")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&async, "async", false, "Do not wait for application to sync before continuing")
+	command.Flags().Int64Var(&retryLimit, "retry-limit", 0, "Max number of allowed sync retries")
+	command.Flags().StringVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration.String(), "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
+	command.Flags().StringVar(&retryBackoffMaxDuration, "retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration.String(), "Max retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
+	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
 	command.Flags().StringVar(&local, "local", "", "Path to a local directory. When this flag is present no git queries will be made")
 	return command
 }
@@ -1421,7 +2056,16 @@ func checkResourceStatus(watchSync bool, watchHealth bool, watchOperation bool,
 
 const waitFormatString = "%s\t%5s\t%10s\t%10s\t%20s\t%8s\t%7s\t%10s\t%s\n"
 
-func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout uint, watchSync bool, watchHealth bool, watchOperation bool, watchSuspended bool, selectedResources []argoappv1.SyncOperationResource) (*argoappv1.Application, error) {
+// waitTimeoutError is returned if the application did not reach the desired state in time
+type waitTimeoutError struct {
+	message string
+}
+
+func (e *waitTimeoutError) Error() string {
+	return e.message
+}
+
+func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout uint, watchSync bool, watchHealth bool, watchOperation bool, watchSuspended bool, watchDegraded bool, jsonPath *jsonPathCondition, selectedResources []argoappv1.SyncOperationResource) (*argoappv1.Application, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -1477,6 +2121,20 @@ func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout
 			refresh = true
 		}
 
+		if watchDegraded {
+			if len(selectedResources) > 0 {
+				for _, state := range getResourceStates(app, selectedResources) {
+					if state.Health == argoappv1.HealthStatusDegraded {
+						printFinalStatus(app)
+						return nil, fmt.Errorf("Resource %s/%s %s of application '%s' is degraded", state.Group, state.Kind, state.Name, appName)
+					}
+				}
+			} else if app.Status.Health.Status == argoappv1.HealthStatusDegraded {
+				printFinalStatus(app)
+				return nil, fmt.Errorf("Application '%s' is degraded", appName)
+			}
+		}
+
 		var selectedResourcesAreReady bool
 
 		// If selected resources are included, wait only on those resources, otherwise wait on the application as a whole.
@@ -1494,6 +2152,11 @@ func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout
 			selectedResourcesAreReady = checkResourceStatus(watchSync, watchHealth, watchOperation, watchSuspended, app.Status.Health.Status, string(app.Status.Sync.Status), appEvent.Application.Operation)
 		}
 
+		if selectedResourcesAreReady && jsonPath != nil {
+			selectedResourcesAreReady, err = jsonPath.matches(app)
+			errors.CheckError(err)
+		}
+
 		if selectedResourcesAreReady {
 			printFinalStatus(app)
 			return app, nil
@@ -1520,7 +2183,7 @@ func waitOnApplicationStatus(acdClient apiclient.Client, appName string, timeout
 		_ = w.Flush()
 	}
 	printFinalStatus(app)
-	return nil, fmt.Errorf("Timed out (%ds) waiting for app %q match desired state", timeout, appName)
+	return nil, &waitTimeoutError{message: fmt.Sprintf("Timed out (%ds) waiting for app %q match desired state", timeout, appName)}
 }
 
 // setParameterOverrides updates an existing or appends a new parameter override in the application
@@ -1577,34 +2240,94 @@ func setParameterOverrides(app *argoappv1.Application, parameters []string) {
 			}
 		}
 	case argoappv1.ApplicationSourceTypeHelm:
-		if app.Spec.Source.Helm == nil {
-			app.Spec.Source.Helm = &argoappv1.ApplicationSourceHelm{}
+		errors.CheckError(setHelmParameters(&app.Spec.Source, parameters, false))
+	default:
+		log.Fatalf("Parameters can only be set against Ksonnet or Helm applications")
+	}
+}
+
+// setHelmParameters updates existing or appends new Helm parameters of the form param=value. Parameters with
+// forceString are passed to Helm with --set-string, so their values are not interpreted as booleans or numbers.
+func setHelmParameters(src *argoappv1.ApplicationSource, parameters []string, forceString bool) error {
+	if src.Helm == nil {
+		src.Helm = &argoappv1.ApplicationSourceHelm{}
+	}
+	re := regexp.MustCompile(`([^\\]),`)
+	for _, paramStr := range parameters {
+		parts := strings.SplitN(paramStr, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("Expected helm parameter of the form: param=value. Received: %s", paramStr)
 		}
-		re := regexp.MustCompile(`([^\\]),`)
-		for _, paramStr := range parameters {
-			parts := strings.SplitN(paramStr, "=", 2)
-			if len(parts) != 2 {
-				log.Fatalf("Expected helm parameter of the form: param=value. Received: %s", paramStr)
-			}
-			newParam := argoappv1.HelmParameter{
-				Name:  parts[0],
-				Value: re.ReplaceAllString(parts[1], `$1\,`),
+		newParam := argoappv1.HelmParameter{
+			Name:        parts[0],
+			Value:       re.ReplaceAllString(parts[1], `$1\,`),
+			ForceString: forceString,
+		}
+		found := false
+		for i, cp := range src.Helm.Parameters {
+			if cp.Name == newParam.Name {
+				found = true
+				src.Helm.Parameters[i] = newParam
+				break
 			}
-			found := false
-			for i, cp := range app.Spec.Source.Helm.Parameters {
-				if cp.Name == newParam.Name {
-					found = true
-					app.Spec.Source.Helm.Parameters[i] = newParam
-					break
-				}
+		}
+		if !found {
+			src.Helm.Parameters = append(src.Helm.Parameters, newParam)
+		}
+	}
+	return nil
+}
+
+// setPluginParameters updates existing or appends new config management plugin parameters of the form name=value
+func setPluginParameters(src *argoappv1.ApplicationSource, parameters []string) error {
+	if src.Plugin == nil {
+		src.Plugin = &argoappv1.ApplicationSourcePlugin{}
+	}
+	for _, paramStr := range parameters {
+		parts := strings.SplitN(paramStr, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("Expected plugin parameter of the form: name=value. Received: %s", paramStr)
+		}
+		newParam := argoappv1.PluginParameter{Name: parts[0], Value: parts[1]}
+		found := false
+		for i, cp := range src.Plugin.Parameters {
+			if cp.Name == newParam.Name {
+				found = true
+				src.Plugin.Parameters[i] = newParam
+				break
 			}
-			if !found {
-				app.Spec.Source.Helm.Parameters = append(app.Spec.Source.Helm.Parameters, newParam)
+		}
+		if !found {
+			src.Plugin.Parameters = append(src.Plugin.Parameters, newParam)
+		}
+	}
+	return nil
+}
+
+// setHelmFileParameters updates existing or appends new Helm file parameters of the form param=path
+func setHelmFileParameters(src *argoappv1.ApplicationSource, parameters []string) error {
+	if src.Helm == nil {
+		src.Helm = &argoappv1.ApplicationSourceHelm{}
+	}
+	for _, paramStr := range parameters {
+		parts := strings.SplitN(paramStr, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return fmt.Errorf("Expected helm file parameter of the form: param=path. Received: %s", paramStr)
+		}
+		newParam := argoappv1.HelmFileParameter{Name: parts[0], Path: parts[1]}
+		found := false
+		for i, cp := range src.Helm.FileParameters {
+			if cp.Name == newParam.Name {
+				found = true
+				src.Helm.FileParameters[i] = newParam
+				break
 			}
 		}
-	default:
-		log.Fatalf("Parameters can only be set against Ksonnet or Helm applications")
+		if !found {
+			src.Helm.FileParameters = append(src.Helm.FileParameters, newParam)
+		}
 	}
+	return nil
 }
 
 // Print list of history ID's for an application.
@@ -1617,25 +2340,87 @@ func printApplicationHistoryIds(revHistory []argoappv1.RevisionHistory) {
 // Print a history table for an application.
 func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "ID\tDATE\tREVISION\n")
+	fmt.Fprintf(w, "ID\tDATE\tREVISION\tOPERATION\tINITIATOR\tPHASE\tOPTIONS\n")
 	for _, depInfo := range revHistory {
 		rev := depInfo.Source.TargetRevision
-		if len(depInfo.Revision) >= 7 {
+		if len(depInfo.Sources) > 0 {
+			// the revisions of the sources of an application with multiple sources
+			rev = strings.Join(depInfo.Revisions, ",")
+		} else if depInfo.Source.IsHelmChart() {
+			if depInfo.Revision != "" && depInfo.Revision != rev {
+				rev = fmt.Sprintf("%s (%s)", rev, depInfo.Revision)
+			}
+		} else if len(depInfo.Revision) >= 7 {
 			rev = fmt.Sprintf("%s (%s)", rev, depInfo.Revision[0:7])
 		}
-		fmt.Fprintf(w, "%d\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev)
+		operation := "Sync"
+		if depInfo.Rollback {
+			operation = "Rollback"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev, operation, formatOperationInitiator(depInfo.InitiatedBy), depInfo.Phase, strings.Join(depInfo.SyncOptions, ","))
 	}
 	_ = w.Flush()
 }
 
+// formatOperationInitiator returns the user who initiated an operation, or "automated" for automated syncs
+func formatOperationInitiator(initiator argoappv1.OperationInitiator) string {
+	if initiator.Automated {
+		return "automated"
+	}
+	return initiator.Username
+}
+
+// filterApplicationHistory returns the history entries which were deployed since the given time and whose revision
+// or target revision starts with the given revision, limited to the given number of the most recent entries
+func filterApplicationHistory(revHistory []argoappv1.RevisionHistory, revision string, since time.Time, maxResults int) []argoappv1.RevisionHistory {
+	filtered := make([]argoappv1.RevisionHistory, 0)
+	for _, depInfo := range revHistory {
+		if revision != "" && !strings.HasPrefix(depInfo.Revision, revision) && depInfo.Source.TargetRevision != revision && !hasSourceRevision(depInfo, revision) {
+			continue
+		}
+		if !since.IsZero() && depInfo.DeployedAt.Time.Before(since) {
+			continue
+		}
+		filtered = append(filtered, depInfo)
+	}
+	if maxResults > 0 && len(filtered) > maxResults {
+		filtered = filtered[len(filtered)-maxResults:]
+	}
+	return filtered
+}
+
+// hasSourceRevision returns whether the revision or target revision of any of the multiple sources of a history
+// entry starts with the given revision
+func hasSourceRevision(depInfo argoappv1.RevisionHistory, revision string) bool {
+	for _, rev := range depInfo.Revisions {
+		if strings.HasPrefix(rev, revision) {
+			return true
+		}
+	}
+	for _, source := range depInfo.Sources {
+		if source.TargetRevision == revision {
+			return true
+		}
+	}
+	return false
+}
+
 // NewApplicationHistoryCommand returns a new instance of an `argocd app history` command
 func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output string
+		output     string
+		maxResults int
+		since      time.Duration
+		revision   string
 	)
 	var command = &cobra.Command{
 		Use:   "history APPNAME",
 		Short: "Show application deployment history",
+		Example: `  # Show the deployments of the last week as JSON
+  argocd app history guestbook --since 168h -o json
+
+  # Show the last 5 deployments of a revision
+  argocd app history guestbook --revision 53e28ff --max-results 5`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
@@ -1646,14 +2431,33 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			appName := args[0]
 			app, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
-			if output == "id" {
-				printApplicationHistoryIds(app.Status.History)
-			} else {
-				printApplicationHistoryTable(app.Status.History)
+			var sinceTime time.Time
+			if since > 0 {
+				sinceTime = time.Now().Add(-since)
+			}
+			revHistory := filterApplicationHistory(app.Status.History, revision, sinceTime, maxResults)
+			switch output {
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(revHistory)
+				errors.CheckError(err)
+				fmt.Println(string(yamlBytes))
+			case "json":
+				jsonBytes, err := json.MarshalIndent(revHistory, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "id":
+				printApplicationHistoryIds(revHistory)
+			case "wide", "":
+				printApplicationHistoryTable(revHistory)
+			default:
+				errors.CheckError(fmt.Errorf("Unknown output format: %s", output))
 			}
 		},
 	}
-	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id|json|yaml")
+	command.Flags().IntVar(&maxResults, "max-results", 0, "Only show this many of the most recent deployments")
+	command.Flags().DurationVar(&since, "since", 0, "Only show the deployments newer than a duration, e.g. 2h or 168h")
+	command.Flags().StringVar(&revision, "revision", "", "Only show the deployments of this revision, i.e. the deployments whose revision starts with it or whose target revision equals it")
 	return command
 }
 
@@ -1662,51 +2466,113 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	var (
 		prune   bool
 		timeout uint
+		yes     bool
 	)
 	var command = &cobra.Command{
-		Use:   "rollback APPNAME ID",
-		Short: "Rollback application to a previous deployed version by History ID",
+		Use:   "rollback APPNAME ID|REVISION",
+		Short: "Rollback application to a previous deployed version by History ID, or to a revision of its source",
+		Long:  "Rollback application to a previous deployed version by History ID, or to a revision of its source.\nShows the differences between the live state and the manifests of the version to roll back to, and asks for confirmation before proceeding.",
+		Example: `  # Rollback to the version with the History ID 3, as shown by "argocd app history"
+  argocd app rollback guestbook 3
+
+  # Rollback to a git revision of the application source without confirmation
+  argocd app rollback guestbook 53e28ff --yes`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 2 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 			appName := args[0]
-			depID, err := strconv.Atoi(args[1])
-			errors.CheckError(err)
 			acdClient := argocdclient.NewClientOrDie(clientOpts)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 			ctx := context.Background()
 			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
-			var depInfo *argoappv1.RevisionHistory
-			for _, di := range app.Status.History {
-				if di.ID == int64(depID) {
-					depInfo = &di
-					break
-				}
+
+			rollbackReq := applicationpkg.ApplicationRollbackRequest{Name: &appName, Prune: prune}
+			manifestQuery := applicationpkg.ApplicationManifestQuery{Name: &appName}
+			target := fmt.Sprintf("revision %s", args[1])
+			// an argument which is the ID of a history entry is preferred to a revision of the same name
+			if depInfo := findRevisionHistory(app, args[1]); depInfo != nil {
+				rollbackReq.ID = depInfo.ID
+				manifestQuery.HistoryID = &depInfo.ID
+				target = fmt.Sprintf("deployment id %d", depInfo.ID)
+			} else {
+				rollbackReq.Revision = args[1]
+				manifestQuery.Revision = args[1]
 			}
-			if depInfo == nil {
-				log.Fatalf("Application '%s' does not have deployment id '%d' in history\n", app.ObjectMeta.Name, depID)
+
+			if !yes {
+				conn, settingsIf := acdClient.NewSettingsClientOrDie()
+				defer util.Close(conn)
+				argoSettings, err := settingsIf.Get(ctx, &settingspkg.SettingsQuery{})
+				errors.CheckError(err)
+				resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
+				errors.CheckError(err)
+				liveObjs, err := liveObjects(resources.Items)
+				errors.CheckError(err)
+				manifests, err := appIf.GetManifests(ctx, &manifestQuery)
+				errors.CheckError(err)
+				targetObjs := make([]*unstructured.Unstructured, len(manifests.Manifests))
+				for i := range manifests.Manifests {
+					targetObjs[i], err = argoappv1.UnmarshalToUnstructured(manifests.Manifests[i])
+					errors.CheckError(err)
+				}
+				items := groupObjsForDiff(resources, groupLocalObjs(targetObjs, liveObjs, app.Spec.Destination.Namespace), appName, argoSettings.AppLabelKey)
+				syncPreview := printResourceDiffs(items, app, argoSettings)
+				if !prune {
+					syncPreview = skipPrunes(syncPreview)
+				}
+				if len(syncPreview) == 0 {
+					fmt.Printf("The live state of application '%s' does not differ from %s\n", appName, target)
+				} else {
+					printSyncPreview(syncPreview)
+				}
+				if !cli.AskToProceed(fmt.Sprintf("Rollback application '%s' to %s (y/n)? ", appName, target)) {
+					fmt.Println("Aborted, the application has not been rolled back")
+					os.Exit(1)
+				}
 			}
 
-			_, err = appIf.Rollback(ctx, &applicationpkg.ApplicationRollbackRequest{
-				Name:  &appName,
-				ID:    int64(depID),
-				Prune: prune,
-			})
+			_, err = appIf.Rollback(ctx, &rollbackReq)
 			errors.CheckError(err)
 
-			_, err = waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, nil)
+			_, err = waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, false, nil, nil)
 			errors.CheckError(err)
 		},
 	}
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Rollback without showing the differences and asking for confirmation")
 	return command
 }
 
+// findRevisionHistory returns the history entry of the application whose ID is given by the argument, nil if there is none
+func findRevisionHistory(app *argoappv1.Application, arg string) *argoappv1.RevisionHistory {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return nil
+	}
+	for i := range app.Status.History {
+		if app.Status.History[i].ID == id {
+			return &app.Status.History[i]
+		}
+	}
+	return nil
+}
+
+// skipPrunes removes the prune actions from a sync preview, since they are not performed without pruning
+func skipPrunes(syncPreview []string) []string {
+	result := make([]string, 0)
+	for _, line := range syncPreview {
+		if !strings.HasPrefix(line, "prune\t") {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
 const printOpFmtStr = "%-20s%s\n"
 const defaultCheckTimeoutSeconds = 0
 
@@ -1715,7 +2581,11 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		return
 	}
 	if opState.SyncResult != nil {
-		fmt.Printf(printOpFmtStr, "Operation:", "Sync")
+		if opState.Operation.Sync != nil && opState.Operation.Sync.Rollback {
+			fmt.Printf(printOpFmtStr, "Operation:", "Rollback")
+		} else {
+			fmt.Printf(printOpFmtStr, "Operation:", "Sync")
+		}
 		fmt.Printf(printOpFmtStr, "Sync Revision:", opState.SyncResult.Revision)
 	}
 	fmt.Printf(printOpFmtStr, "Phase:", opState.Phase)
@@ -1733,6 +2603,148 @@ func printOperationResult(opState *argoappv1.OperationState) {
 	}
 }
 
+// NewApplicationLogsCommand returns a new instance of an `argocd app logs` command
+func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		group        string
+		kind         string
+		namespace    string
+		resourceName string
+		container    string
+		follow       bool
+		since        time.Duration
+		tail         int64
+	)
+	var command = &cobra.Command{
+		Use:   "logs APPNAME",
+		Short: "Print the logs of the pods of an application, or of one of its resources",
+		Example: `  # Print the logs of all pods of the application
+  argocd app logs guestbook
+
+  # Follow the logs of the pods of a deployment, starting with the last 10 lines of each pod
+  argocd app logs guestbook --group apps --kind Deployment --name guestbook-ui --follow --tail 10
+
+  # Print the logs of the last hour of a container of a pod
+  argocd app logs guestbook --kind Pod --name guestbook-ui-85c9c9b5d-x7lkq --container nginx --since 1h`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if kind != "" && resourceName == "" {
+				errors.CheckError(fmt.Errorf("--name is required when --kind is given"))
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			stream, err := appIf.ResourceLogs(context.Background(), &applicationpkg.ApplicationResourceLogsQuery{
+				Name:         &appName,
+				Group:        group,
+				Kind:         kind,
+				Namespace:    namespace,
+				ResourceName: resourceName,
+				Container:    container,
+				SinceSeconds: int64(since.Seconds()),
+				TailLines:    tail,
+				Follow:       follow,
+			})
+			errors.CheckError(err)
+			// the pod is only omitted if the logs of a single pod were requested
+			singlePod := group == "" && kind == kube.PodKind
+			for {
+				entry, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				errors.CheckError(err)
+				if singlePod {
+					fmt.Println(entry.Content)
+				} else {
+					fmt.Printf("[%s] %s\n", entry.PodName, entry.Content)
+				}
+			}
+		},
+	}
+	command.Flags().StringVar(&group, "group", "", "Group of the resource whose pods the logs are printed of")
+	command.Flags().StringVar(&kind, "kind", "", "Kind of the resource whose pods the logs are printed of, Pod for the logs of a single pod")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace of the resource")
+	command.Flags().StringVar(&resourceName, "name", "", "Name of the resource")
+	command.Flags().StringVarP(&container, "container", "c", "", "Container of the pods, required for pods with multiple containers")
+	command.Flags().BoolVarP(&follow, "follow", "f", false, "Follow the logs as they are written")
+	command.Flags().DurationVar(&since, "since", 0, "Only print the logs newer than a duration, e.g. 5s, 2m or 3h")
+	command.Flags().Int64Var(&tail, "tail", 0, "Only print this many of the most recent lines of each pod")
+	return command
+}
+
+// NewApplicationResourcesCommand returns a new instance of an `argocd app resources` command
+func NewApplicationResourcesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		kind     string
+		health   string
+		name     string
+		orphaned bool
+		pageSize int64
+	)
+	var command = &cobra.Command{
+		Use:   "resources APPNAME",
+		Short: "List the resources of an application",
+		Example: `  # List all resources of the application
+  argocd app resources guestbook
+
+  # List the degraded pods of the application whose name starts with guestbook-ui
+  argocd app resources guestbook --kind Pod --health Degraded --name 'guestbook-ui*'`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if pageSize < 0 {
+				errors.CheckError(fmt.Errorf("--page-size must not be negative"))
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tHEALTH\tORPHANED\n")
+			for offset := int64(0); ; offset += pageSize {
+				tree, err := appIf.ResourceTree(context.Background(), &applicationpkg.ResourcesQuery{
+					ApplicationName: &appName,
+					Kind:            kind,
+					Health:          health,
+					Name:            name,
+					Limit:           pageSize,
+					Offset:          offset,
+				})
+				errors.CheckError(err)
+				printResourceNodes(w, tree.Nodes, false)
+				if orphaned {
+					printResourceNodes(w, tree.OrphanedNodes, true)
+				}
+				if pageSize == 0 || int64(len(tree.Nodes)+len(tree.OrphanedNodes)) < pageSize {
+					break
+				}
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringVar(&kind, "kind", "", "Only list resources of this kind")
+	command.Flags().StringVar(&health, "health", "", "Only list resources with this health status, e.g. Degraded")
+	command.Flags().StringVar(&name, "name", "", "Only list resources whose name matches this glob pattern")
+	command.Flags().BoolVar(&orphaned, "orphaned", false, "Also list the orphaned resources in the namespace of the application")
+	command.Flags().Int64Var(&pageSize, "page-size", 500, "Number of resources to request from the server at once, 0 to request all of them at once")
+	return command
+}
+
+func printResourceNodes(w io.Writer, nodes []argoappv1.ResourceNode, orphaned bool) {
+	for _, node := range nodes {
+		healthStatus := ""
+		if node.Health != nil {
+			healthStatus = node.Health.Status
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", node.Group, node.Kind, node.Namespace, node.Name, healthStatus, strconv.FormatBool(orphaned))
+	}
+}
+
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
 func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -76,12 +77,12 @@ func NewApplicationResourceActionsListCommand(clientOpts *argocdclient.ClientOpt
 		sort.Strings(keys)
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(w, "RESOURCE\tACTION\n")
+		fmt.Fprintf(w, "RESOURCE\tACTION\tPARAMS\n")
 		fmt.Println()
 		for key := range availableActions {
 			for i := range availableActions[key] {
 				action := availableActions[key][i]
-				fmt.Fprintf(w, "%s\t%s\n", key, action.Name)
+				fmt.Fprintf(w, "%s\t%s\t%s\n", key, action.Name, formatActionParams(action.Params))
 
 			}
 		}
@@ -105,9 +106,12 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	var group string
 	var resourceName string
 	var all bool
+	var params []string
 	var command = &cobra.Command{
 		Use:   "run APPNAME ACTION",
 		Short: "Runs an available action on resource(s)",
+		Example: `  # Run the scale action of a deployment with the replicas parameter
+  argocd app actions run my-app scale --kind Deployment --param replicas=3`,
 	}
 
 	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
@@ -117,6 +121,7 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	command.Flags().StringVar(&group, "group", "", "Group")
 	command.Flags().StringVar(&namespace, "namespace", "", "Namespace")
 	command.Flags().BoolVar(&all, "all", false, "Indicates whether to run the action on multiple matching resources")
+	command.Flags().StringArrayVarP(&params, "param", "p", []string{}, "Set a parameter of the action, e.g. --param replicas=3")
 
 	command.Run = func(c *cobra.Command, args []string) {
 		if len(args) != 2 {
@@ -125,6 +130,8 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 		}
 		appName := args[0]
 		actionName := args[1]
+		actionParams, err := parseActionParams(params)
+		errors.CheckError(err)
 		conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 		defer util.Close(conn)
 		ctx := context.Background()
@@ -142,9 +149,39 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 				Group:        gvk.Group,
 				Kind:         gvk.Kind,
 				Action:       actionName,
+				Params:       actionParams,
 			})
 			errors.CheckError(err)
 		}
 	}
 	return command
 }
+
+// parseActionParams parses action parameters of the form name=value
+func parseActionParams(params []string) ([]argoappv1.ResourceActionParam, error) {
+	var actionParams []argoappv1.ResourceActionParam
+	for _, paramStr := range params {
+		parts := strings.SplitN(paramStr, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("Expected parameter of the form: param=value. Received: %s", paramStr)
+		}
+		actionParams = append(actionParams, argoappv1.ResourceActionParam{Name: parts[0], Value: parts[1]})
+	}
+	return actionParams, nil
+}
+
+// formatActionParams formats the parameters of an action, e.g. "replicas:integer=1"
+func formatActionParams(params []argoappv1.ResourceActionParam) string {
+	var formatted []string
+	for _, param := range params {
+		paramStr := param.Name
+		if param.Type != "" {
+			paramStr += ":" + param.Type
+		}
+		if param.Default != "" {
+			paramStr += "=" + param.Default
+		}
+		formatted = append(formatted, paramStr)
+	}
+	return strings.Join(formatted, ",")
+}
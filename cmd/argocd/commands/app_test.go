@@ -1,9 +1,15 @@
 package commands
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 )
 
 func TestParseLabels(t *testing.T) {
@@ -23,3 +29,245 @@ func TestParseLabels(t *testing.T) {
 	assert.Len(t, result, 0)
 
 }
+
+func TestFindRevisionHistory(t *testing.T) {
+	app := &argoappv1.Application{Status: argoappv1.ApplicationStatus{History: []argoappv1.RevisionHistory{
+		{ID: 0, Revision: "abc"},
+		{ID: 1, Revision: "def"},
+	}}}
+
+	if depInfo := findRevisionHistory(app, "1"); assert.NotNil(t, depInfo) {
+		assert.Equal(t, "def", depInfo.Revision)
+	}
+	if depInfo := findRevisionHistory(app, "0"); assert.NotNil(t, depInfo) {
+		assert.Equal(t, "abc", depInfo.Revision)
+	}
+	assert.Nil(t, findRevisionHistory(app, "2"))
+	assert.Nil(t, findRevisionHistory(app, "53e28ff"))
+}
+
+func TestFilterApplicationHistory(t *testing.T) {
+	now := time.Now()
+	revHistory := []argoappv1.RevisionHistory{
+		{ID: 0, Revision: "abc123", DeployedAt: metav1.NewTime(now.Add(-3 * time.Hour))},
+		{ID: 1, Revision: "def456", DeployedAt: metav1.NewTime(now.Add(-2 * time.Hour)), Source: argoappv1.ApplicationSource{TargetRevision: "v1.0"}},
+		{ID: 2, Revision: "abc123", DeployedAt: metav1.NewTime(now.Add(-time.Hour))},
+	}
+	ids := func(revHistory []argoappv1.RevisionHistory) []int64 {
+		result := make([]int64, 0)
+		for _, depInfo := range revHistory {
+			result = append(result, depInfo.ID)
+		}
+		return result
+	}
+
+	assert.Equal(t, []int64{0, 1, 2}, ids(filterApplicationHistory(revHistory, "", time.Time{}, 0)))
+	assert.Equal(t, []int64{1, 2}, ids(filterApplicationHistory(revHistory, "", time.Time{}, 2)))
+	assert.Equal(t, []int64{2}, ids(filterApplicationHistory(revHistory, "", now.Add(-90*time.Minute), 0)))
+	assert.Equal(t, []int64{0, 2}, ids(filterApplicationHistory(revHistory, "abc", time.Time{}, 0)))
+	assert.Equal(t, []int64{1}, ids(filterApplicationHistory(revHistory, "v1.0", time.Time{}, 0)))
+	assert.Equal(t, []int64{}, ids(filterApplicationHistory(revHistory, "abc", now, 0)))
+
+	// entries of applications with multiple sources match the revisions of any of their sources
+	revHistory = append(revHistory, argoappv1.RevisionHistory{ID: 3, Revisions: []string{"fed987", "abc456"}, DeployedAt: metav1.NewTime(now),
+		Sources: argoappv1.ApplicationSources{{TargetRevision: "master"}, {TargetRevision: "v2.0"}}})
+	assert.Equal(t, []int64{0, 2, 3}, ids(filterApplicationHistory(revHistory, "abc", time.Time{}, 0)))
+	assert.Equal(t, []int64{3}, ids(filterApplicationHistory(revHistory, "v2.0", time.Time{}, 0)))
+}
+
+func TestParseApplicationSource(t *testing.T) {
+	source, err := parseApplicationSource("repo=https://github.com/argoproj/argocd-example-apps.git,ref=values")
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"}, source)
+
+	sourceStr := "repo=https://charts.example.com,chart=guestbook,revision=1.2.0,values=$values/common.yaml,values=$values/prod.yaml"
+	source, err = parseApplicationSource(sourceStr)
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.ApplicationSource{
+		RepoURL:        "https://charts.example.com",
+		Chart:          "guestbook",
+		TargetRevision: "1.2.0",
+		Helm:           &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"$values/common.yaml", "$values/prod.yaml"}},
+	}, source)
+	assert.Equal(t, sourceStr, formatApplicationSource(source))
+
+	_, err = parseApplicationSource("path=guestbook")
+	assert.EqualError(t, err, "Source 'path=guestbook' does not have a repo")
+	_, err = parseApplicationSource("repo=https://github.com/argoproj/argocd-example-apps.git,path")
+	assert.EqualError(t, err, "Expected source option of the form key=value. Received: path")
+	_, err = parseApplicationSource("repo=https://github.com/argoproj/argocd-example-apps.git,branch=master")
+	assert.EqualError(t, err, "Unknown source option 'branch', must be one of: repo, path, chart, revision, ref, values")
+}
+
+func TestParseHelmValueFilesRepos(t *testing.T) {
+	valuesRepos, err := parseHelmValueFilesRepos([]string{
+		"ref=values,repo=https://github.com/argoproj/argocd-example-apps.git,revision=master",
+		"ref=common,repo=https://github.com/argoproj/argo-cd.git",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.HelmValueFilesRepo{
+		{Ref: "values", RepoURL: "https://github.com/argoproj/argocd-example-apps.git", TargetRevision: "master"},
+		{Ref: "common", RepoURL: "https://github.com/argoproj/argo-cd.git"},
+	}, valuesRepos)
+
+	_, err = parseHelmValueFilesRepos([]string{"repo=https://github.com/argoproj/argo-cd.git"})
+	assert.EqualError(t, err, "Values repo 'repo=https://github.com/argoproj/argo-cd.git' requires a ref and a repo")
+	_, err = parseHelmValueFilesRepos([]string{"ref=values,path=values"})
+	assert.EqualError(t, err, "Unknown values repo option 'path', must be one of: ref, repo, revision")
+}
+
+func TestReadHelmValuesLiteral(t *testing.T) {
+	file, err := ioutil.TempFile("", "values")
+	assert.NoError(t, err)
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	_, err = file.WriteString("replicaCount: 2\n")
+	assert.NoError(t, err)
+	values, err := readHelmValuesLiteral(file.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, "replicaCount: 2\n", values)
+
+	assert.NoError(t, ioutil.WriteFile(file.Name(), []byte("- replicaCount\n"), 0644))
+	_, err = readHelmValuesLiteral(file.Name())
+	assert.Error(t, err)
+}
+
+func TestSetHelmParameters(t *testing.T) {
+	src := argoappv1.ApplicationSource{Helm: &argoappv1.ApplicationSourceHelm{
+		Parameters: []argoappv1.HelmParameter{{Name: "image.tag", Value: "1.0"}},
+	}}
+	assert.NoError(t, setHelmParameters(&src, []string{"image.tag=20191014", "ingress.hosts=a,b"}, true))
+	assert.NoError(t, setHelmParameters(&src, []string{"replicaCount=2"}, false))
+	assert.Equal(t, []argoappv1.HelmParameter{
+		{Name: "image.tag", Value: "20191014", ForceString: true},
+		{Name: "ingress.hosts", Value: "a\\,b", ForceString: true},
+		{Name: "replicaCount", Value: "2"},
+	}, src.Helm.Parameters)
+	assert.EqualError(t, setHelmParameters(&src, []string{"replicaCount"}, false), "Expected helm parameter of the form: param=value. Received: replicaCount")
+
+	assert.NoError(t, setHelmFileParameters(&src, []string{"tls.crt=certs/tls.crt", "tls.key=certs/tls.key"}))
+	assert.NoError(t, setHelmFileParameters(&src, []string{"tls.crt=certs/other.crt"}))
+	assert.Equal(t, []argoappv1.HelmFileParameter{
+		{Name: "tls.crt", Path: "certs/other.crt"},
+		{Name: "tls.key", Path: "certs/tls.key"},
+	}, src.Helm.FileParameters)
+	assert.EqualError(t, setHelmFileParameters(&src, []string{"tls.crt="}), "Expected helm file parameter of the form: param=path. Received: tls.crt=")
+}
+
+func TestSetPluginParameters(t *testing.T) {
+	src := argoappv1.ApplicationSource{Plugin: &argoappv1.ApplicationSourcePlugin{Name: "cue"}}
+	assert.NoError(t, setPluginParameters(&src, []string{"replicas=2", "image-tag=v1"}))
+	assert.NoError(t, setPluginParameters(&src, []string{"replicas=3"}))
+	assert.Equal(t, &argoappv1.ApplicationSourcePlugin{
+		Name:       "cue",
+		Parameters: []argoappv1.PluginParameter{{Name: "replicas", Value: "3"}, {Name: "image-tag", Value: "v1"}},
+	}, src.Plugin)
+	assert.EqualError(t, setPluginParameters(&src, []string{"replicas"}), "Expected plugin parameter of the form: name=value. Received: replicas")
+}
+
+func TestSetKustomizeOverrides(t *testing.T) {
+	var src argoappv1.ApplicationSource
+	setKustomizeComponents(&src, []string{"../components/ha", "../components/ha", "../components/tls"})
+	assert.Equal(t, []string{"../components/ha", "../components/tls"}, src.Kustomize.Components)
+
+	assert.NoError(t, setKustomizeReplicas(&src, []string{"guestbook=2", "redis=1"}))
+	assert.NoError(t, setKustomizeReplicas(&src, []string{"guestbook=3"}))
+	assert.Equal(t, []argoappv1.KustomizeReplica{{Name: "guestbook", Count: 3}, {Name: "redis", Count: 1}}, src.Kustomize.Replicas)
+	assert.EqualError(t, setKustomizeReplicas(&src, []string{"guestbook"}), "Expected replicas of the form: name=count. Received: guestbook")
+	assert.EqualError(t, setKustomizeReplicas(&src, []string{"guestbook=many"}), "Invalid replica count 'many' of guestbook")
+
+	dir, err := ioutil.TempDir("", "patches")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	strategicMergePatch := "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: guestbook\n"
+	assert.NoError(t, ioutil.WriteFile(dir+"/smp.yaml", []byte(strategicMergePatch), 0644))
+	assert.NoError(t, ioutil.WriteFile(dir+"/json6902.yaml", []byte(`patch: |
+  - op: remove
+    path: /spec/replicas
+target:
+  kind: Deployment
+  name: guestbook
+`), 0644))
+	assert.NoError(t, addKustomizePatches(&src, []string{dir + "/smp.yaml", dir + "/json6902.yaml"}))
+	assert.Equal(t, []argoappv1.KustomizePatch{
+		{Patch: strategicMergePatch},
+		{Patch: "- op: remove\n  path: /spec/replicas\n", Target: &argoappv1.KustomizeSelector{Kind: "Deployment", Name: "guestbook"}},
+	}, src.Kustomize.Patches)
+}
+
+func TestSkipPrunes(t *testing.T) {
+	assert.Equal(t, []string{"update\tapps\tDeployment\tdefault\tguestbook"}, skipPrunes([]string{
+		"prune\t\tService\tdefault\tguestbook",
+		"update\tapps\tDeployment\tdefault\tguestbook",
+	}))
+}
+
+func TestJSONPathCondition(t *testing.T) {
+	app := &argoappv1.Application{Status: argoappv1.ApplicationStatus{
+		Sync:           argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced, Revision: "abc"},
+		OperationState: &argoappv1.OperationState{Phase: argoappv1.OperationSucceeded},
+	}}
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{"{.status.sync.status}=Synced", true},
+		{"{.status.sync.status}=OutOfSync", false},
+		{"{.status.sync.revision} {.status.operationState.phase}=abc Succeeded", true},
+		{"{.status.operationState.phase}", true},
+		{"{.status.operationState.message}", false},
+		{"{.status.operationState.message}=", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			condition, err := parseJSONPathCondition(tt.condition)
+			assert.NoError(t, err)
+			matches, err := condition.matches(app)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, matches)
+		})
+	}
+
+	_, err := parseJSONPathCondition("{.status.sync.status")
+	assert.Error(t, err)
+}
+
+func TestSetDirectoryOpts(t *testing.T) {
+	src := argoappv1.ApplicationSource{Directory: &argoappv1.ApplicationSourceDirectory{Jsonnet: argoappv1.ApplicationSourceJsonnet{Libs: []string{"vendor"}}}}
+	setDirectoryOpts(&src, func(directory *argoappv1.ApplicationSourceDirectory) {
+		directory.Exclude = []string{"docs"}
+	})
+	setDirectoryOpts(&src, func(directory *argoappv1.ApplicationSourceDirectory) {
+		directory.Recurse = true
+	})
+	assert.Equal(t, &argoappv1.ApplicationSourceDirectory{
+		Recurse: true,
+		Exclude: []string{"docs"},
+		Jsonnet: argoappv1.ApplicationSourceJsonnet{Libs: []string{"vendor"}},
+	}, src.Directory)
+
+	src = argoappv1.ApplicationSource{Directory: &argoappv1.ApplicationSourceDirectory{Include: []string{"*.yaml"}}}
+	setDirectoryOpts(&src, func(directory *argoappv1.ApplicationSourceDirectory) {
+		directory.Include = nil
+	})
+	assert.Nil(t, src.Directory)
+}
+
+func TestSetJsonnetVars(t *testing.T) {
+	var src argoappv1.ApplicationSource
+	assert.NoError(t, setJsonnetExtVars(&src, []string{"env=prod", "replicas=2"}, false, false))
+	assert.NoError(t, setJsonnetExtVars(&src, []string{"replicas=3"}, true, false))
+	assert.NoError(t, setJsonnetExtVars(&src, []string{"config=config/prod.libsonnet"}, true, true))
+	assert.Equal(t, []argoappv1.JsonnetVar{
+		{Name: "env", Value: "prod"},
+		{Name: "replicas", Value: "3", Code: true},
+		{Name: "config", Value: "config/prod.libsonnet", Code: true, File: true},
+	}, src.Directory.Jsonnet.ExtVars)
+	assert.EqualError(t, setJsonnetExtVars(&src, []string{"env"}, false, false), "Expected jsonnet variable of the form: name=value. Received: env")
+
+	assert.NoError(t, setJsonnetTLAs(&src, []string{"name=name.txt"}, false, true))
+	assert.Equal(t, []argoappv1.JsonnetVar{{Name: "name", Value: "name.txt", File: true}}, src.Directory.Jsonnet.TLAs)
+
+	setJsonnetLibs(&src, []string{"vendor"})
+	assert.Equal(t, []string{"vendor"}, src.Directory.Jsonnet.Libs)
+}
@@ -1,30 +1,62 @@
 package commands
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"text/tabwriter"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/errors"
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
 	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
+	repositorypkg "github.com/argoproj/argo-cd/pkg/apiclient/repository"
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
 	certutil "github.com/argoproj/argo-cd/util/cert"
+	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/config"
+	"github.com/argoproj/argo-cd/util/git"
 
 	"crypto/x509"
 )
 
 // NewCertCommand returns a new instance of an `argocd repo` command
 func NewCertCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		localTelemetry bool
+	)
 	var command = &cobra.Command{
 		Use:   "cert",
 		Short: "Manage repository certificates and SSH known hosts entries",
+		PersistentPreRun: func(c *cobra.Command, args []string) {
+			if localTelemetry {
+				enableCertTelemetry(newCertTelemetry(clientOpts.ConfigPath), c.Name())
+			}
+		},
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
 			os.Exit(1)
@@ -35,98 +67,446 @@ func NewCertCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewCertAddTLSCommand(clientOpts))
 	command.AddCommand(NewCertListCommand(clientOpts))
 	command.AddCommand(NewCertRemoveCommand(clientOpts))
+	command.AddCommand(NewCertDiffCommand(clientOpts))
+	command.AddCommand(NewCertExportCommand(clientOpts))
+	command.AddCommand(NewCertImportCommand(clientOpts))
+	command.AddCommand(NewCertRekeyCommand(clientOpts))
+	command.AddCommand(NewCertRekeyFinishCommand(clientOpts))
+	command.AddCommand(NewCertStatsCommand(clientOpts))
+	command.AddCommand(NewCertReplayCommand(clientOpts))
+	command.AddCommand(NewCertSupportedTypesCommand())
+	command.PersistentFlags().StringVar(&clientOpts.ImpersonateUser, "as", "", "Username to impersonate for the operation")
+	command.PersistentFlags().BoolVar(&localTelemetry, "local-telemetry", config.GetBoolFlag("local-telemetry"), "Count invocations and errors of cert commands in a local file next to the Argo CD config, which is never sent anywhere")
+	command.PersistentFlags().StringArrayVar(&clientOpts.ImpersonateGroups, "as-group", []string{}, "Group to impersonate for the operation, can be repeated to specify multiple groups")
 	return command
 }
 
 func NewCertAddTLSCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		fromFile string
-		upsert   bool
+		fromFile        string
+		upsert          bool
+		rotateBy        string
+		chainFromSystem bool
+		chainTimeout    time.Duration
+		stdinTimeout    time.Duration
+		inlineServer    string
+		inlineCertData  string
+		maxChainDepth   int
+		base64DER       bool
+		failIfExists    bool
+		dumpRequest     string
+		mirrorOpts      certMirrorOptions
+		servers         []string
+		batch           bool
+		dryRun          bool
 	)
 	var command = &cobra.Command{
-		Use:   "add-tls SERVERNAME",
+		Use:   "add-tls SERVERNAME [SERVERNAME...]",
 		Short: "Add TLS certificate data for connecting to repository server SERVERNAME",
 		Run: func(c *cobra.Command, args []string) {
 			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
 			defer util.Close(conn)
 
-			if len(args) != 1 {
+			if inlineServer != "" {
+				if len(args) > 0 {
+					errors.CheckError(fmt.Errorf("--server-name cannot be combined with SERVERNAME arguments"))
+				}
+				args = []string{inlineServer}
+			}
+			args = append(args, servers...)
+			if dryRun && dumpRequest != "" {
+				errors.CheckError(fmt.Errorf("--dry-run cannot be combined with --dump-request"))
+			}
+
+			// In batch mode, the server names and their certificates are
+			// read from a manifest instead.
+			var batchCertificates map[string][]string
+			if batch {
+				if len(args) > 0 || inlineCertData != "" || base64DER || chainFromSystem {
+					errors.CheckError(fmt.Errorf("--batch cannot be combined with SERVERNAME arguments, --servers, --server-name, --cert-data, --base64-der or --chain-from-system"))
+				}
+				var data []byte
+				var err error
+				if fromFile != "" {
+					fmt.Printf("Reading manifest of TLS certificates from '%s'\n", fromFile)
+					data, err = ioutil.ReadFile(fromFile)
+				} else {
+					fmt.Println("Enter manifest of TLS certificates in YAML or JSON format. Press CTRL-D when finished.")
+					data, err = ioutil.ReadAll(os.Stdin)
+				}
+				errors.CheckError(err)
+				args, batchCertificates, err = parseTLSBatchManifest(data)
+				if err == certutil.ErrPrivateKeyData {
+					printPrivateKeyWarning(os.Stderr)
+					os.Exit(1)
+				}
+				errors.CheckError(err)
+			}
+
+			if len(args) < 1 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 
-			var certificateArray []string
-			var err error
+			for _, serverName := range args {
+				if !certutil.IsValidTLSServerName(serverName) {
+					fmt.Printf("Invalid server name '%s'\n", sanitizeForDisplay(serverName))
+					os.Exit(1)
+				}
+			}
 
-			if fromFile != "" {
-				fmt.Printf("Reading TLS certificate data in PEM format from '%s'\n", fromFile)
-				certificateArray, err = certutil.ParseTLSCertificatesFromPath(fromFile)
-			} else {
-				fmt.Println("Enter TLS certificate data in PEM format. Press CTRL-D when finished.")
-				certificateArray, err = certutil.ParseTLSCertificatesFromStream(os.Stdin)
+			if failIfExists {
+				if upsert {
+					errors.CheckError(fmt.Errorf("--fail-if-exists cannot be combined with --upsert"))
+				}
+				pinned, err := tlsCertificatesPinnedFor(context.Background(), certIf, args)
+				errors.CheckError(err)
+				if len(pinned) > 0 {
+					for _, serverName := range pinned {
+						fmt.Printf("ERROR: TLS certificates for repository server %s already exist\n", sanitizeForDisplay(serverName))
+					}
+					os.Exit(1)
+				}
 			}
 
+			annotations, err := rotateByAnnotations(rotateBy)
 			errors.CheckError(err)
 
-			certificateList := make([]appsv1.RepositoryCertificate, 0)
-
-			subjectMap := make(map[string]*x509.Certificate)
+			if batch {
+				var entries []appsv1.RepositoryCertificate
+				for _, serverName := range args {
+					errors.CheckError(checkCertificateChainDepth(batchCertificates[serverName], maxChainDepth))
+					entries = append(entries, tlsCertificatesForServerNames([]string{serverName}, batchCertificates[serverName], annotations)...)
+				}
+				var mirror *certMirror
+				if !dryRun {
+					mirror = mirrorOpts.newMirror(clientOpts)
+				}
+				if mirror != nil {
+					defer util.Close(mirror.conn)
+				}
+				if !createTLSCertificateEntries(certIf, mirror, entries, upsert, dumpRequest, dryRun) {
+					os.Exit(1)
+				}
+				return
+			}
 
-			for _, entry := range certificateArray {
-				// We want to make sure to only send valid certificate data to the
-				// server, so we decode the certificate into X509 structure before
-				// further processing it.
-				x509cert, err := certutil.DecodePEMCertificateToX509(entry)
-				errors.CheckError(err)
+			var certificateArray []string
+			x509Cache := certutil.NewX509CertificateCache()
 
-				// TODO: We need a better way to detect duplicates sent in the stream,
-				// maybe by using fingerprints? For now, no two certs with the same
-				// subject may be sent.
-				if subjectMap[x509cert.Subject.String()] != nil {
-					fmt.Printf("ERROR: Cert with subject '%s' already seen in the input stream.\n", x509cert.Subject.String())
-					continue
+			if inlineCertData != "" {
+				if fromFile != "" {
+					errors.CheckError(fmt.Errorf("--cert-data cannot be combined with --from"))
+				}
+				if base64DER {
+					certificateArray, err = parseInlineBase64DERCertificate(inlineCertData)
+				} else {
+					certificateArray, err = parseInlineTLSCertificates(inlineCertData)
+				}
+			} else if fromFile != "" {
+				if base64DER {
+					fmt.Printf("Reading TLS certificate data in base64 encoded DER format from '%s'\n", fromFile)
+					certificateArray, err = parseBase64DERCertificateFromPath(fromFile)
 				} else {
-					subjectMap[x509cert.Subject.String()] = x509cert
+					fmt.Printf("Reading TLS certificate data in PEM or DER format from '%s'\n", fromFile)
+					certificateArray, err = certutil.ParseTLSCertificateInputFromPath(fromFile)
 				}
+			} else if base64DER {
+				fmt.Println("Enter TLS certificate data in base64 encoded DER format. Press CTRL-D when finished.")
+				certificateArray, err = parseStreamWithTimeout(os.Stdin, stdinTimeout, parseBase64DERCertificateFromStream)
+			} else {
+				fmt.Println("Enter TLS certificate data in PEM format. Press CTRL-D when finished.")
+				certificateArray, err = parseTLSCertificatesFromStreamWithTimeout(os.Stdin, stdinTimeout)
+			}
+
+			if err == certutil.ErrPrivateKeyData {
+				printPrivateKeyWarning(os.Stderr)
+				os.Exit(1)
 			}
+			errors.CheckError(err)
 
-			serverName := args[0]
-
-			if len(certificateArray) > 0 {
-				certificateList = append(certificateList, appsv1.RepositoryCertificate{
-					ServerName: serverName,
-					CertType:   "https",
-					CertData:   []byte(strings.Join(certificateArray, "\n")),
-				})
-				certificates, err := certIf.CreateCertificate(context.Background(), &certificatepkg.RepositoryCertificateCreateRequest{
-					Certificates: &appsv1.RepositoryCertificateList{
-						Items: certificateList,
-					},
-					Upsert: upsert,
-				})
+			errors.CheckError(checkCertificateChainDepth(certificateArray, maxChainDepth))
+			if chainFromSystem && len(certificateArray) > 0 {
+				certificateArray, err = completeCertificateChain(x509Cache, certificateArray, chainTimeout)
 				errors.CheckError(err)
-				fmt.Printf("Created entry with %d PEM certificates for repository server %s\n", len(certificates.Items), serverName)
-			} else {
+				errors.CheckError(checkCertificateChainDepth(certificateArray, maxChainDepth))
+			}
+
+			// We want to make sure to only send valid certificate data to the
+			// server, so every certificate is decoded into X509 structure here.
+			// Duplicates in the stream are detected by their fingerprint, so
+			// a renewed certificate may be sent along with the one it replaces.
+			certificateArray, duplicates, err := certutil.DedupeTLSCertificates(certificateArray)
+			errors.CheckError(err)
+			for _, duplicate := range duplicates {
+				fmt.Printf("Skipping duplicate of cert with subject '%s' (fingerprint SHA256:%s) in the input stream\n", sanitizeForDisplay(duplicate.Subject.String()), certutil.TLSCertificateFingerprintSHA256(duplicate))
+			}
+
+			if len(certificateArray) == 0 {
 				fmt.Printf("No valid certificates have been detected in the stream.\n")
+				return
+			}
+
+			var mirror *certMirror
+			if !dryRun {
+				mirror = mirrorOpts.newMirror(clientOpts)
+			}
+			if mirror != nil {
+				defer util.Close(mirror.conn)
+			}
+
+			entries := tlsCertificatesForServerNames(args, certificateArray, annotations)
+			if !createTLSCertificateEntries(certIf, mirror, entries, upsert, dumpRequest, dryRun) {
+				os.Exit(1)
 			}
 		},
 	}
-	command.Flags().StringVar(&fromFile, "from", "", "read TLS certificate data from file (default is to read from stdin)")
+	command.Flags().StringVar(&fromFile, "from", "", "read TLS certificate data in PEM or DER format from file (default is to read from stdin)")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Replace existing TLS certificate if certificate is different in input")
+	command.Flags().StringVar(&rotateBy, "rotate-by", "", "Date (YYYY-MM-DD or RFC3339) the certificate should be rotated by")
+	command.Flags().BoolVar(&chainFromSystem, "chain-from-system", false, "Complete the chain of the leaf certificate using the system trust store, fetching missing intermediates from the issuer URLs of the certificates")
+	command.Flags().DurationVar(&chainTimeout, "chain-timeout", 10*time.Second, "Timeout for fetching a single intermediate certificate when using --chain-from-system")
+	command.Flags().StringVar(&inlineServer, "server-name", "", "Name of the repository server, as alternative to the SERVERNAME argument")
+	command.Flags().StringVar(&inlineCertData, "cert-data", "", "TLS certificate data in PEM format, or @FILE to read it from FILE")
+	addCertDumpRequestFlag(command, &dumpRequest)
+	command.Flags().BoolVar(&failIfExists, "fail-if-exists", false, "Abort without any changes if TLS certificates are already configured for any of the given server names")
+	command.Flags().BoolVar(&base64DER, "base64-der", false, "Read the certificate as a single base64 encoded DER string without PEM armor, instead of PEM data")
+	command.Flags().IntVar(&maxChainDepth, "max-chain-depth", 10, "Maximum number of certificates to accept for a single server")
+	addCertMirrorFlags(command, &mirrorOpts)
+	command.Flags().DurationVar(&stdinTimeout, "stdin-timeout", 0, "Abort if no certificate data arrives on stdin within this duration (default is to wait forever)")
+	command.Flags().StringSliceVar(&servers, "servers", []string{}, "Comma separated list of repository servers to add the same TLS certificate data for, in addition to any SERVERNAME arguments")
+	command.Flags().BoolVar(&batch, "batch", false, "Read a YAML or JSON manifest mapping server names to PEM certificate data, from --from or stdin, and add the certificates for all of them")
+	addCertDryRunFlag(command, &dryRun)
 	return command
 }
 
+// Creates the given TLS certificate entries on the server, and mirrors them if
+// requested. Each server name gets its own request, so we can report the
+// result for every single one of them. On a dry run, only the changes are
+// printed. Returns false if any of them failed.
+func createTLSCertificateEntries(certIf certificatepkg.CertificateServiceClient, mirror *certMirror, entries []appsv1.RepositoryCertificate, upsert bool, dumpRequest string, dryRun bool) bool {
+	requests := make([]*certificatepkg.RepositoryCertificateCreateRequest, 0, len(entries))
+	for _, entry := range entries {
+		requests = append(requests, &certificatepkg.RepositoryCertificateCreateRequest{
+			Certificates: &appsv1.RepositoryCertificateList{
+				Items: []appsv1.RepositoryCertificate{entry},
+			},
+			Upsert: upsert,
+		})
+	}
+	if dryRun {
+		results, err := dryRunCertificateRequests(context.Background(), certIf, requests)
+		errors.CheckError(err)
+		return printCertDryRunResults(os.Stdout, results)
+	}
+	if dumpRequest != "" {
+		errors.CheckError(dumpCertCreateRequests(dumpRequest, requests...))
+	}
+
+	failed := false
+	for _, request := range requests {
+		entry := request.Certificates.Items[0]
+		snapshot, err := mirror.snapshot(context.Background(), certIf)
+		errors.CheckError(err)
+		certificates, err := certIf.CreateCertificate(context.Background(), request)
+		if err != nil {
+			fmt.Printf("ERROR: Could not create entry for repository server %s: %v\n", entry.ServerName, err)
+			failed = true
+			continue
+		}
+		if len(certificates.Items) == 0 {
+			fmt.Printf("Entry for repository server %s already present, unchanged\n", entry.ServerName)
+		} else {
+			fmt.Printf("Created entry with %d PEM certificates for repository server %s\n", len(certificates.Items), entry.ServerName)
+		}
+		if err := mirror.createCertificates(context.Background(), certIf, request, snapshot); err != nil {
+			failed = true
+		}
+	}
+	return !failed
+}
+
+// Parses a manifest as read by add-tls --batch, which maps server names to
+// PEM encoded certificate data. Returns the sorted server names, and the
+// certificates for each of them.
+func parseTLSBatchManifest(data []byte) ([]string, map[string][]string, error) {
+	var manifest map[string]string
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("Could not parse manifest: %v", err)
+	}
+	if len(manifest) == 0 {
+		return nil, nil, fmt.Errorf("Manifest does not contain any server names")
+	}
+	serverNames := make([]string, 0, len(manifest))
+	certificates := make(map[string][]string, len(manifest))
+	for serverName, pemData := range manifest {
+		certificateArray, err := certutil.ParseTLSCertificateInputFromData(pemData)
+		if err == certutil.ErrPrivateKeyData {
+			return nil, nil, err
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("Invalid certificate data for '%s': %v", serverName, err)
+		}
+		if len(certificateArray) == 0 {
+			return nil, nil, fmt.Errorf("No certificates found for '%s'", serverName)
+		}
+		certificateArray, _, err = certutil.DedupeTLSCertificates(certificateArray)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Invalid certificate data for '%s': %v", serverName, err)
+		}
+		serverNames = append(serverNames, serverName)
+		certificates[serverName] = certificateArray
+	}
+	sort.Strings(serverNames)
+	return serverNames, certificates, nil
+}
+
+// Returns an error if there are more certificates than maxDepth. A maxDepth
+// of zero or less disables the check.
+func checkCertificateChainDepth(certificateArray []string, maxDepth int) error {
+	if maxDepth > 0 && len(certificateArray) > maxDepth {
+		return fmt.Errorf("Input contains %d certificates, which exceeds the maximum chain depth of %d (see --max-chain-depth)", len(certificateArray), maxDepth)
+	}
+	return nil
+}
+
+// Print a warning about a private key found in the input. The input itself
+// must never be printed, so no part of the key can end up in logs.
+func printPrivateKeyWarning(w io.Writer) {
+	fmt.Fprintln(w, "SECURITY WARNING: this looks like a private key; do not paste keys here.")
+	fmt.Fprintln(w, "Only the certificates of the server are required. Nothing has been stored.")
+	fmt.Fprintln(w, "If the key has been exposed elsewhere, consider it compromised and rotate it.")
+}
+
+// A reader which closes its channel once the first read on it has returned
+type firstReadNotifier struct {
+	reader io.Reader
+	once   sync.Once
+	read   chan struct{}
+}
+
+func (r *firstReadNotifier) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.once.Do(func() { close(r.read) })
+	return n, err
+}
+
+// Parse TLS certificates from stream, giving up with an error if no data at
+// all arrives within timeout. A timeout of zero waits forever. Once the first
+// data has arrived, the stream is read to its end without a timeout.
+func parseTLSCertificatesFromStreamWithTimeout(stream io.Reader, timeout time.Duration) ([]string, error) {
+	return parseStreamWithTimeout(stream, timeout, certutil.ParseTLSCertificateInputFromStream)
+}
+
+// Parse stream using parse, giving up with an error if no data at all arrives
+// within timeout. A timeout of zero waits forever.
+func parseStreamWithTimeout(stream io.Reader, timeout time.Duration, parse func(io.Reader) ([]string, error)) ([]string, error) {
+	if timeout <= 0 {
+		return parse(stream)
+	}
+	type parseResult struct {
+		certificates []string
+		err          error
+	}
+	notifier := &firstReadNotifier{reader: stream, read: make(chan struct{})}
+	resultCh := make(chan parseResult, 1)
+	go func() {
+		certificates, err := parse(notifier)
+		resultCh <- parseResult{certificates: certificates, err: err}
+	}()
+	select {
+	case <-notifier.read:
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("No input received within %s, aborting", timeout)
+	}
+	res := <-resultCh
+	return res.certificates, res.err
+}
+
+// Returns those of the given server names which already have TLS certificates
+// configured
+func tlsCertificatesPinnedFor(ctx context.Context, certIf certificatepkg.CertificateServiceClient, serverNames []string) ([]string, error) {
+	pinned := make([]string, 0)
+	for _, serverName := range serverNames {
+		certificates, err := certIf.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{HostNamePattern: certutil.EscapeHostNamePattern(serverName), CertType: "https"})
+		if err != nil {
+			return nil, err
+		}
+		if len(certificates.Items) > 0 {
+			pinned = append(pinned, serverName)
+		}
+	}
+	return pinned, nil
+}
+
+// Returns one TLS certificate entry for each of the server names, all of them
+// holding the same PEM encoded certificates.
+func tlsCertificatesForServerNames(serverNames []string, certificateArray []string, annotations map[string]string) []appsv1.RepositoryCertificate {
+	certData := []byte(strings.Join(certificateArray, "\n"))
+	entries := make([]appsv1.RepositoryCertificate, 0, len(serverNames))
+	for _, serverName := range serverNames {
+		entries = append(entries, appsv1.RepositoryCertificate{
+			ServerName:  serverName,
+			CertType:    "https",
+			CertData:    certData,
+			Annotations: annotations,
+		})
+	}
+	return entries
+}
+
+// Complete the chain of the leaf certificate, which must be the first one of
+// the PEM encoded certificates, and return the PEM encoded full chain.
+func completeCertificateChain(x509Cache *certutil.X509CertificateCache, certificateArray []string, timeout time.Duration) ([]string, error) {
+	certs := make([]*x509.Certificate, 0, len(certificateArray))
+	for _, entry := range certificateArray {
+		x509Cert, err := x509Cache.DecodePEMCertificateToX509(entry)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, x509Cert)
+	}
+	chain, fetched, err := certutil.CompleteTLSCertificateChain(certs, nil, timeout)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fetched {
+		fmt.Printf("Fetched intermediate certificate '%s' from %s\n", sanitizeForDisplay(f.Certificate.Subject.String()), f.URL)
+	}
+	if len(fetched) == 0 {
+		fmt.Println("Certificate chain is already complete, no intermediates had to be fetched")
+	}
+	chainPEM := make([]string, 0, len(chain))
+	for _, x509Cert := range chain {
+		chainPEM = append(chainPEM, certutil.EncodeX509CertificateToPEM(x509Cert))
+	}
+	return chainPEM, nil
+}
+
 // NewCertAddCommand returns a new instance of an `argocd cert add` command
 func NewCertAddSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		fromFile     string
-		batchProcess bool
-		upsert       bool
-		certificates []appsv1.RepositoryCertificate
+		fromFile       string
+		hostsFile      string
+		batchProcess   bool
+		upsert         bool
+		scanRateLimit  float64
+		scanTimeout    time.Duration
+		rotateBy       string
+		dedupe         bool
+		inlineHost     string
+		inlineType     string
+		inlineKey      string
+		dumpRequest    string
+		mirrorOpts     certMirrorOptions
+		dryRun         bool
+		scanHosts      []string
+		scanFromServer bool
+		yes            bool
+		certificates   []appsv1.RepositoryCertificate
 	)
 
 	var command = &cobra.Command{
-		Use:   "add-ssh --batch",
+		Use:   "add-ssh [--batch|--hosts-file FILE|--host HOST --type TYPE --key DATA|--scan HOST[:PORT]]",
 		Short: "Add SSH known host entries for repository servers",
 		Run: func(c *cobra.Command, args []string) {
 
@@ -134,10 +514,49 @@ func NewCertAddSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			defer util.Close(conn)
 
 			var sshKnownHostsLists []string
-			var err error
 
-			// --batch is a flag, but it is mandatory for now.
-			if batchProcess {
+			if dryRun && dumpRequest != "" {
+				errors.CheckError(fmt.Errorf("--dry-run cannot be combined with --dump-request"))
+			}
+
+			annotations, err := rotateByAnnotations(rotateBy)
+			errors.CheckError(err)
+
+			// --batch is a flag, but it is mandatory for now unless the host keys
+			// are retrieved by scanning the hosts given in --hosts-file
+			if len(scanHosts) > 0 {
+				if batchProcess || fromFile != "" || hostsFile != "" || inlineHost != "" {
+					err = fmt.Errorf("--scan cannot be combined with --batch, --from, --hosts-file or --host")
+				} else {
+					sshKnownHostsLists, err = scanSSHHosts(context.Background(), certIf, scanHosts, scanFromServer, scanTimeout)
+					if err == nil {
+						// The keys are trusted on first use, so the user has
+						// to verify them before we add them.
+						err = printSSHHostKeyFingerprints(os.Stdout, sshKnownHostsLists)
+					}
+					if err == nil && !yes && !dryRun && !cli.AskToProceed("Add these SSH host keys (y/n)? ") {
+						fmt.Println("Aborted, no SSH host keys have been added")
+						os.Exit(1)
+					}
+				}
+			} else if scanFromServer {
+				err = fmt.Errorf("--scan-from-server requires --scan")
+			} else if inlineHost != "" {
+				if batchProcess || fromFile != "" || hostsFile != "" {
+					err = fmt.Errorf("--host cannot be combined with --batch, --from or --hosts-file")
+				} else {
+					var entry string
+					entry, err = inlineSSHKnownHostsEntry(inlineHost, inlineType, inlineKey)
+					sshKnownHostsLists = []string{entry}
+				}
+			} else if hostsFile != "" {
+				if batchProcess || fromFile != "" {
+					err = fmt.Errorf("--hosts-file cannot be combined with --batch or --from")
+				} else {
+					limiter := rate.NewLimiter(rate.Limit(scanRateLimit), 1)
+					sshKnownHostsLists, err = scanSSHHostsFromFile(hostsFile, limiter, scanTimeout)
+				}
+			} else if batchProcess {
 				if fromFile != "" {
 					fmt.Printf("Reading SSH known hosts entries from file '%s'\n", fromFile)
 					sshKnownHostsLists, err = certutil.ParseSSHKnownHostsFromPath(fromFile)
@@ -146,7 +565,7 @@ func NewCertAddSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					sshKnownHostsLists, err = certutil.ParseSSHKnownHostsFromStream(os.Stdin)
 				}
 			} else {
-				err = fmt.Errorf("You need to specify --batch or specify --help for usage instructions")
+				err = fmt.Errorf("You need to specify --batch, --hosts-file, --host or --scan, or specify --help for usage instructions")
 			}
 
 			errors.CheckError(err)
@@ -165,31 +584,307 @@ func NewCertAddSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					CertType:    "ssh",
 					CertSubType: certSubType,
 					CertData:    certData,
+					Annotations: annotations,
 				}
 
 				certificates = append(certificates, certificate)
 			}
 
+			if dedupe {
+				existing, err := certIf.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{CertType: "ssh"})
+				errors.CheckError(err)
+				var numKnown int
+				certificates, numKnown = dedupeSSHKnownHostsAgainstStore(certificates, existing.Items)
+				fmt.Printf("Skipping %d SSH known host entries already present in the store\n", numKnown)
+				if len(certificates) == 0 {
+					fmt.Println("No new SSH known host entries to create")
+					return
+				}
+			}
+
 			certList := &appsv1.RepositoryCertificateList{Items: certificates}
-			response, err := certIf.CreateCertificate(context.Background(), &certificatepkg.RepositoryCertificateCreateRequest{
+			request := &certificatepkg.RepositoryCertificateCreateRequest{
 				Certificates: certList,
 				Upsert:       upsert,
-			})
+			}
+			if dryRun {
+				results, err := dryRunCertificateRequests(context.Background(), certIf, []*certificatepkg.RepositoryCertificateCreateRequest{request})
+				errors.CheckError(err)
+				if !printCertDryRunResults(os.Stdout, results) {
+					os.Exit(1)
+				}
+				return
+			}
+
+			mirror := mirrorOpts.newMirror(clientOpts)
+			if mirror != nil {
+				defer util.Close(mirror.conn)
+			}
+			snapshot, err := mirror.snapshot(context.Background(), certIf)
+			errors.CheckError(err)
+			if dumpRequest != "" {
+				errors.CheckError(dumpCertCreateRequests(dumpRequest, request))
+			}
+			response, err := certIf.CreateCertificate(context.Background(), request)
 			errors.CheckError(err)
-			fmt.Printf("Successfully created %d SSH known host entries\n", len(response.Items))
+			fmt.Println(formatCreatedCertificatesSummary(len(certList.Items), len(response.Items)))
+			if err := mirror.createCertificates(context.Background(), certIf, request, snapshot); err != nil {
+				os.Exit(1)
+			}
 		},
 	}
 	command.Flags().StringVar(&fromFile, "from", "", "Read SSH known hosts data from file (default is to read from stdin)")
 	command.Flags().BoolVar(&batchProcess, "batch", false, "Perform batch processing by reading in SSH known hosts data (mandatory flag)")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Replace existing SSH server public host keys if key is different in input")
+	command.Flags().StringVar(&hostsFile, "hosts-file", "", "Scan the hosts listed in file (one host[:port] per line) for their SSH public host keys and add them")
+	command.Flags().Float64Var(&scanRateLimit, "scan-rate-limit", 5, "Maximum number of hosts to scan per second when using --hosts-file")
+	command.Flags().DurationVar(&scanTimeout, "scan-timeout", certutil.SSHScanDefaultTimeout, "Timeout for connecting to a single host when using --hosts-file or --scan")
+	command.Flags().StringVar(&rotateBy, "rotate-by", "", "Date (YYYY-MM-DD or RFC3339) the SSH known host entries should be rotated by")
+	command.Flags().StringVar(&inlineHost, "host", "", "Add a single SSH known hosts entry for HOST, given by --type and --key")
+	command.Flags().StringVar(&inlineType, "type", "", "Type of the SSH public host key given by --key, e.g. ssh-ed25519")
+	command.Flags().StringVar(&inlineKey, "key", "", "Base64 encoded SSH public host key data, or @FILE to read it from FILE")
+	addCertDumpRequestFlag(command, &dumpRequest)
+	command.Flags().BoolVar(&dedupe, "dedupe-against-store", false, "Skip entries whose host, key type and fingerprint are already present in the store")
+	addCertMirrorFlags(command, &mirrorOpts)
+	addCertDryRunFlag(command, &dryRun)
+	command.Flags().StringSliceVar(&scanHosts, "scan", []string{}, "Scan the given hosts (host[:port], comma separated) for their SSH public host keys, and add them after confirmation")
+	command.Flags().BoolVar(&scanFromServer, "scan-from-server", false, "Let the API server perform the scan requested by --scan, from within the cluster network")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Do not ask for confirmation before adding the host keys found by --scan")
 	return command
 }
 
+// Scans hosts for their SSH host keys and returns them as known hosts
+// entries. With fromServer, the API server performs the scan, so hosts only
+// reachable from within the cluster can be scanned as well.
+func scanSSHHosts(ctx context.Context, certIf certificatepkg.CertificateServiceClient, hosts []string, fromServer bool, timeout time.Duration) ([]string, error) {
+	sshKnownHostsLists := make([]string, 0)
+	for _, host := range hosts {
+		if fromServer {
+			fmt.Printf("Scanning SSH host keys of '%s' from the API server\n", host)
+			certList, err := certIf.ScanSSHHostKeys(ctx, &certificatepkg.SSHHostKeyScanRequest{Address: host})
+			if err != nil {
+				return nil, fmt.Errorf("Could not scan SSH host keys of '%s': %v", host, err)
+			}
+			for _, c := range certList.Items {
+				sshKnownHostsLists = append(sshKnownHostsLists, fmt.Sprintf("%s %s %s", c.ServerName, c.CertSubType, string(c.CertData)))
+			}
+		} else {
+			fmt.Printf("Scanning SSH host keys of '%s'\n", host)
+			entries, err := certutil.ScanSSHHostKeys(host, timeout)
+			if err != nil {
+				return nil, fmt.Errorf("Could not scan SSH host keys of '%s': %v", host, err)
+			}
+			sshKnownHostsLists = append(sshKnownHostsLists, entries...)
+		}
+	}
+	return sshKnownHostsLists, nil
+}
+
+// Prints a table of the fingerprints of the given known hosts entries
+func printSSHHostKeyFingerprints(w io.Writer, knownHostsEntries []string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "HOSTNAME\tTYPE\tFINGERPRINT\n")
+	for _, entry := range knownHostsEntries {
+		hostname, subType, _, err := certutil.TokenizeSSHKnownHostsEntry(entry)
+		if err != nil {
+			return err
+		}
+		_, key, err := certutil.KnownHostsLineToPublicKey(entry)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(tw, "%s\t%s\tSHA256:%s\n", sanitizeForDisplay(hostname), subType, certutil.SSHFingerprintSHA256(key))
+	}
+	return tw.Flush()
+}
+
+// Returns a summary of how many of the requested certificates have been
+// created by the server. Entries identical to existing ones are not created
+// again and are reported as already present.
+func formatCreatedCertificatesSummary(requested int, created int) string {
+	present := requested - created
+	if present < 0 {
+		present = 0
+	}
+	return fmt.Sprintf("%d created, %d already present", created, present)
+}
+
+// Removes SSH known hosts entries from certs which are already present in
+// existing with the same host name, key type and fingerprint. Returns the
+// remaining entries and the number of entries that were removed.
+func dedupeSSHKnownHostsAgainstStore(certs []appsv1.RepositoryCertificate, existing []appsv1.RepositoryCertificate) ([]appsv1.RepositoryCertificate, int) {
+	key := func(c appsv1.RepositoryCertificate) (string, bool) {
+		_, pubKey, err := certutil.TokenizedDataToPublicKey(c.ServerName, c.CertSubType, string(c.CertData))
+		if err != nil {
+			return "", false
+		}
+		return c.ServerName + " " + c.CertSubType + " " + certutil.SSHFingerprintSHA256(pubKey), true
+	}
+	known := make(map[string]bool)
+	for _, c := range existing {
+		if c.CertType != "ssh" {
+			continue
+		}
+		if k, ok := key(c); ok {
+			known[k] = true
+		}
+	}
+	remaining := make([]appsv1.RepositoryCertificate, 0, len(certs))
+	for _, c := range certs {
+		if k, ok := key(c); ok && known[k] {
+			continue
+		}
+		remaining = append(remaining, c)
+	}
+	return remaining, len(certs) - len(remaining)
+}
+
+// Returns the value of a flag carrying inline data. Values starting with @ are
+// taken as name of a file to read the data from.
+func readInlineData(value string) (string, error) {
+	if !strings.HasPrefix(value, "@") {
+		return value, nil
+	}
+	data, err := ioutil.ReadFile(strings.TrimPrefix(value, "@"))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Returns a SSH known hosts entry built from the given host, key type and
+// key data, making sure the key can be decoded.
+func inlineSSHKnownHostsEntry(host, keyType, key string) (string, error) {
+	if keyType == "" || key == "" {
+		return "", fmt.Errorf("--host requires --type and --key to be given")
+	}
+	keyData, err := readInlineData(key)
+	if err != nil {
+		return "", err
+	}
+	entry := fmt.Sprintf("%s %s %s", host, keyType, strings.TrimSpace(keyData))
+	if _, _, err := certutil.KnownHostsLineToPublicKey(entry); err != nil {
+		return "", fmt.Errorf("Invalid SSH public host key given: %v", err)
+	}
+	return entry, nil
+}
+
+// Returns the PEM encoded certificates from the given inline certificate
+// data, making sure all of them can be decoded.
+func parseInlineTLSCertificates(certData string) ([]string, error) {
+	data, err := readInlineData(certData)
+	if err != nil {
+		return nil, err
+	}
+	certificateArray, err := certutil.ParseTLSCertificateInputFromData(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(certificateArray) == 0 {
+		return nil, fmt.Errorf("No valid PEM certificate data given in --cert-data")
+	}
+	for _, entry := range certificateArray {
+		if _, err := certutil.DecodePEMCertificateToX509(entry); err != nil {
+			return nil, err
+		}
+	}
+	return certificateArray, nil
+}
+
+// Returns the PEM encoded certificate from the given inline base64 encoded DER
+// data.
+func parseInlineBase64DERCertificate(certData string) ([]string, error) {
+	data, err := readInlineData(certData)
+	if err != nil {
+		return nil, err
+	}
+	return parseBase64DERCertificateFromStream(strings.NewReader(data))
+}
+
+// Parse a single certificate in base64 encoded DER format from a file and
+// return it PEM encoded.
+func parseBase64DERCertificateFromPath(sourceFile string) ([]string, error) {
+	fileHandle, err := os.Open(sourceFile)
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(fileHandle)
+	return parseBase64DERCertificateFromStream(fileHandle)
+}
+
+// Parse a single certificate in base64 encoded DER format from stream and
+// return it PEM encoded.
+func parseBase64DERCertificateFromStream(stream io.Reader) ([]string, error) {
+	data, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return nil, err
+	}
+	pemData, err := certutil.ParseBase64DERCertificateFromData(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return []string{pemData}, nil
+}
+
+// Returns the annotations for a certificate that should be rotated by the
+// given date, or nil if no date was given.
+func rotateByAnnotations(rotateBy string) (map[string]string, error) {
+	if rotateBy == "" {
+		return nil, nil
+	}
+	if _, err := certutil.ParseRotateByDate(rotateBy); err != nil {
+		return nil, err
+	}
+	return map[string]string{certutil.CertificateAnnotationRotateBy: rotateBy}, nil
+}
+
+// Scan each host listed in hostsFile for its SSH public host keys and return
+// the keys as list of known hosts entries. Hosts that cannot be scanned are
+// reported, but do not abort the scan of the remaining hosts. Connections to
+// the hosts are throttled by limiter.
+func scanSSHHostsFromFile(hostsFile string, limiter *rate.Limiter, timeout time.Duration) ([]string, error) {
+	fmt.Printf("Reading hosts to scan from file '%s'\n", hostsFile)
+	hosts, err := certutil.ParseSSHScanHostsFromPath(hostsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	sshKnownHostsLists := make([]string, 0)
+	failedHosts := make([]string, 0)
+
+	for i, host := range hosts {
+		if err := limiter.Wait(context.Background()); err != nil {
+			return nil, err
+		}
+		fmt.Printf("[%d/%d] Scanning SSH host keys of '%s': ", i+1, len(hosts), host)
+		entries, err := certutil.ScanSSHHostKeys(host, timeout)
+		if err != nil {
+			fmt.Printf("FAILED (%v)\n", err)
+			failedHosts = append(failedHosts, host)
+			continue
+		}
+		fmt.Printf("found %d keys\n", len(entries))
+		sshKnownHostsLists = append(sshKnownHostsLists, entries...)
+	}
+
+	fmt.Printf("Scanned %d hosts: %d succeeded, %d failed\n", len(hosts), len(hosts)-len(failedHosts), len(failedHosts))
+	if len(failedHosts) > 0 {
+		fmt.Printf("Failed hosts: %s\n", strings.Join(failedHosts, ", "))
+	}
+
+	return sshKnownHostsLists, nil
+}
+
 // NewCertRemoveCommand returns a new instance of an `argocd cert rm` command
 func NewCertRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		certType    string
 		certSubType string
+		fingerprint string
+		yes         bool
+		force       bool
+		dumpRequest string
+		mirrorOpts  certMirrorOptions
 		certQuery   certificatepkg.RepositoryCertificateQuery
 	)
 	var command = &cobra.Command{
@@ -212,10 +907,63 @@ func NewCertRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 				err := fmt.Errorf("A single wildcard is not allowed as REPOSERVER name.")
 				errors.CheckError(err)
 			}
+			// The rollback of an atomic mirror restores the removed entries
+			// only, which would drop the remaining certificates of a server
+			// when removing a single one of them.
+			if fingerprint != "" && mirrorOpts.atomic {
+				errors.CheckError(fmt.Errorf("--fingerprint cannot be combined with --atomic"))
+			}
 			certQuery = certificatepkg.RepositoryCertificateQuery{
 				HostNamePattern: hostNamePattern,
 				CertType:        certType,
 				CertSubType:     certSubType,
+				Fingerprint:     fingerprint,
+			}
+
+			// A host can have certificates of both types configured, so we
+			// make sure the user really wants to remove all of them.
+			if certType == "" && !yes {
+				matching, err := certIf.ListCertificates(context.Background(), &certQuery)
+				errors.CheckError(err)
+				if types := certificateTypes(matching.Items); len(types) > 1 {
+					msg := fmt.Sprintf("Certificates of types %s match '%s'. Remove all of them (y/n)? ", strings.Join(types, " and "), hostNamePattern)
+					if !cli.AskToProceed(msg) {
+						fmt.Println("Aborted, use --cert-type to remove certificates of a single type only")
+						os.Exit(1)
+					}
+				}
+			}
+
+			// Removing the only pinned certificate for a host breaks every
+			// repository on that host which verifies it, so we refuse unless
+			// the user knows what they are doing.
+			if !force {
+				matching, err := certIf.ListCertificates(context.Background(), &certQuery)
+				errors.CheckError(err)
+				all, err := certIf.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{})
+				errors.CheckError(err)
+				repoConn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
+				repos, err := repoIf.List(context.Background(), &repositorypkg.RepoQuery{})
+				util.Close(repoConn)
+				if err != nil {
+					errors.CheckError(fmt.Errorf("Could not check which repositories depend on the certificates, use --force to skip this check: %v", err))
+				}
+				if blockers := findCertRemovalBlockers(matching.Items, all.Items, repos.Items); len(blockers) > 0 {
+					for _, blocker := range blockers {
+						fmt.Printf("Repository '%s' depends on the only %s certificate pinned for '%s'\n", blocker.repoURL, blocker.certType, blocker.serverName)
+					}
+					fmt.Println("Aborted, removing these certificates would make Argo CD reject the repositories above. Use --force to remove them anyway")
+					os.Exit(1)
+				}
+			}
+
+			mirror := mirrorOpts.newMirror(clientOpts)
+			if mirror != nil {
+				defer util.Close(mirror.conn)
+			}
+
+			if dumpRequest != "" {
+				errors.CheckError(dumpCertRequests(dumpRequest, []certDumpedRequest{{Delete: &certQuery}}))
 			}
 			removed, err := certIf.DeleteCertificate(context.Background(), &certQuery)
 			errors.CheckError(err)
@@ -226,80 +974,1722 @@ func NewCertRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			} else {
 				fmt.Println("No certificates were removed (none matched the given patterns)")
 			}
+			if err := mirror.deleteCertificates(context.Background(), certIf, &certQuery, removed.Items); err != nil {
+				os.Exit(1)
+			}
 		},
 	}
 	command.Flags().StringVar(&certType, "cert-type", "", "Only remove certs of given type (ssh, https)")
 	command.Flags().StringVar(&certSubType, "cert-sub-type", "", "Only remove certs of given sub-type (only for ssh)")
+	command.Flags().StringVar(&fingerprint, "fingerprint", "", "Only remove the cert with the given SHA256 fingerprint, as shown by 'argocd cert list'")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Do not ask for confirmation when removing certs of both types")
+	command.Flags().BoolVar(&force, "force", false, "Remove certs even if they are the only ones pinned for a host a configured repository depends on")
+	addCertDumpRequestFlag(command, &dumpRequest)
+	addCertMirrorFlags(command, &mirrorOpts)
 	return command
 }
 
-// NewCertListCommand returns a new instance of an `argocd cert rm` command
-func NewCertListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var (
-		certType        string
-		hostNamePattern string
-		sortOrder       string
-	)
-	var command = &cobra.Command{
-		Use:   "list",
-		Short: "List configured certificates",
-		Run: func(c *cobra.Command, args []string) {
-			if certType != "" {
-				switch certType {
-				case "ssh":
-				case "https":
-				default:
-					fmt.Println("cert-type must be either ssh or https")
-					os.Exit(1)
-				}
-			}
+// A repository which would no longer be verifiable after removing certificates
+type certRemovalBlocker struct {
+	repoURL    string
+	certType   string
+	serverName string
+}
 
-			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
-			defer util.Close(conn)
-			certificates, err := certIf.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{HostNamePattern: hostNamePattern, CertType: certType})
-			errors.CheckError(err)
-			printCertTable(certificates.Items, sortOrder)
-		},
+// Returns the server name and the type of the certificates a repository is
+// verified against, or an empty server name if the repository does not use
+// pinned certificates.
+func repositoryCertServerName(repo appsv1.Repository) (string, string) {
+	if repo.IsInsecure() {
+		return "", ""
 	}
-
-	command.Flags().StringVar(&sortOrder, "sort", "", "set display sort order, valid: 'hostname', 'type'")
-	command.Flags().StringVar(&certType, "cert-type", "", "only list certificates of given type, valid: 'ssh','https'")
+	if git.IsHTTPSURL(repo.Repo) {
+		repoURL, err := url.Parse(repo.Repo)
+		if err != nil {
+			return "", ""
+		}
+		return repoURL.Hostname(), "https"
+	}
+	if ok, _ := git.IsSSHURL(repo.Repo); !ok {
+		return "", ""
+	}
+	if strings.HasPrefix(repo.Repo, "ssh://") {
+		repoURL, err := url.Parse(repo.Repo)
+		if err != nil {
+			return "", ""
+		}
+		if port := repoURL.Port(); port != "" && port != "22" {
+			return fmt.Sprintf("[%s]:%s", repoURL.Hostname(), port), "ssh"
+		}
+		return repoURL.Hostname(), "ssh"
+	}
+	// SCP-like syntax, i.e. user@host:path
+	hostAndPath := repo.Repo[strings.Index(repo.Repo, "@")+1:]
+	return strings.SplitN(hostAndPath, ":", 2)[0], "ssh"
+}
+
+// Returns the repositories which depend on certificates of a host, all of
+// which are about to be removed. Removing only some of the certificates of a
+// host, e.g. a single SSH key type, is fine as long as one is left.
+func findCertRemovalBlockers(removed []appsv1.RepositoryCertificate, all []appsv1.RepositoryCertificate, repos []appsv1.Repository) []certRemovalBlocker {
+	countPins := func(certs []appsv1.RepositoryCertificate, serverName string, certType string) int {
+		count := 0
+		for _, c := range certs {
+			if c.ServerName == serverName && c.CertType == certType {
+				count++
+			}
+		}
+		return count
+	}
+	var blockers []certRemovalBlocker
+	for _, repo := range repos {
+		serverName, certType := repositoryCertServerName(repo)
+		if serverName == "" {
+			continue
+		}
+		numRemoved := countPins(removed, serverName, certType)
+		if numRemoved > 0 && numRemoved == countPins(all, serverName, certType) {
+			blockers = append(blockers, certRemovalBlocker{repoURL: repo.Repo, certType: certType, serverName: serverName})
+		}
+	}
+	return blockers
+}
+
+// Options for mirroring certificate changes to a second Argo CD instance
+type certMirrorOptions struct {
+	server    string
+	authToken string
+	context   string
+	atomic    bool
+}
+
+func addCertMirrorFlags(command *cobra.Command, opts *certMirrorOptions) {
+	command.Flags().StringVar(&opts.server, "mirror-to", "", "Address of a second Argo CD server to apply the same change to")
+	command.Flags().StringVar(&opts.authToken, "mirror-auth-token", "", "Authentication token for the server given by --mirror-to")
+	command.Flags().StringVar(&opts.context, "mirror-context", "", "Context from the Argo CD config to use for the mirror server")
+	command.Flags().BoolVar(&opts.atomic, "atomic", false, "Undo the change on the primary server if it could not be mirrored")
+}
+
+// A second Argo CD instance certificate changes are mirrored to
+type certMirror struct {
+	name   string
+	conn   io.Closer
+	client certificatepkg.CertificateServiceClient
+	atomic bool
+}
+
+// Returns a mirror for the configured instance, or nil if mirroring is not
+// enabled. The mirror client uses the TLS settings of the primary client.
+func (o *certMirrorOptions) newMirror(clientOpts *argocdclient.ClientOptions) *certMirror {
+	if o.server == "" && o.context == "" {
+		return nil
+	}
+	mirrorOpts := *clientOpts
+	mirrorOpts.ServerAddr = o.server
+	mirrorOpts.AuthToken = o.authToken
+	mirrorOpts.Context = o.context
+	conn, client := argocdclient.NewClientOrDie(&mirrorOpts).NewCertClientOrDie()
+	name := o.server
+	if name == "" {
+		name = o.context
+	}
+	return &certMirror{name: name, conn: conn, client: client, atomic: o.atomic}
+}
+
+// Returns the certificates currently configured on the primary instance, so
+// a change can be undone if mirroring fails. Returns nil unless the mirror
+// is atomic.
+func (m *certMirror) snapshot(ctx context.Context, primary certificatepkg.CertificateServiceClient) ([]appsv1.RepositoryCertificate, error) {
+	if m == nil || !m.atomic {
+		return nil, nil
+	}
+	certificates, err := primary.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{})
+	if err != nil {
+		return nil, err
+	}
+	return certificates.Items, nil
+}
+
+// Applies request, which has already been applied to primary, to the mirror.
+// If that fails and the mirror is atomic, the entries of the request are
+// restored on primary to their state in snapshot.
+func (m *certMirror) createCertificates(ctx context.Context, primary certificatepkg.CertificateServiceClient, request *certificatepkg.RepositoryCertificateCreateRequest, snapshot []appsv1.RepositoryCertificate) error {
+	if m == nil {
+		return nil
+	}
+	created, err := m.client.CreateCertificate(ctx, request)
+	if err == nil {
+		fmt.Printf("Mirror %s: created %d entries\n", m.name, len(created.Items))
+		return nil
+	}
+	fmt.Printf("ERROR: Mirror %s: could not create entries: %v\n", m.name, err)
+	if m.atomic {
+		if rollbackErr := rollbackCreatedCertificates(ctx, primary, request.Certificates.Items, snapshot); rollbackErr != nil {
+			fmt.Printf("ERROR: Could not undo the change on the primary server: %v\n", rollbackErr)
+		} else {
+			fmt.Println("Undid the change on the primary server")
+		}
+	}
+	return err
+}
+
+// Applies query, which has already been used to remove the certificates in
+// removed from primary, to the mirror. If that fails and the mirror is
+// atomic, the removed certificates are restored on primary.
+func (m *certMirror) deleteCertificates(ctx context.Context, primary certificatepkg.CertificateServiceClient, query *certificatepkg.RepositoryCertificateQuery, removed []appsv1.RepositoryCertificate) error {
+	if m == nil {
+		return nil
+	}
+	mirrorRemoved, err := m.client.DeleteCertificate(ctx, query)
+	if err == nil {
+		fmt.Printf("Mirror %s: removed %d entries\n", m.name, len(mirrorRemoved.Items))
+		return nil
+	}
+	fmt.Printf("ERROR: Mirror %s: could not remove entries: %v\n", m.name, err)
+	if m.atomic && len(removed) > 0 {
+		_, rollbackErr := primary.CreateCertificate(ctx, &certificatepkg.RepositoryCertificateCreateRequest{
+			Certificates: &appsv1.RepositoryCertificateList{Items: newCertBundle(removed).repositoryCertificates()},
+			Upsert:       true,
+		})
+		if rollbackErr != nil {
+			fmt.Printf("ERROR: Could not undo the change on the primary server: %v\n", rollbackErr)
+		} else {
+			fmt.Println("Undid the change on the primary server")
+		}
+	}
+	return err
+}
+
+// Restores the entries touched by created on primary to their state in
+// snapshot, i.e. entries that did not exist before are removed and entries
+// that were replaced get their previous data back.
+func rollbackCreatedCertificates(ctx context.Context, primary certificatepkg.CertificateServiceClient, created []appsv1.RepositoryCertificate, snapshot []appsv1.RepositoryCertificate) error {
+	type certKey struct{ serverName, certType, certSubType string }
+	keyOf := func(c appsv1.RepositoryCertificate) certKey {
+		if c.CertType == "https" {
+			return certKey{c.ServerName, c.CertType, ""}
+		}
+		return certKey{c.ServerName, c.CertType, c.CertSubType}
+	}
+	touched := make(map[certKey]bool)
+	for _, c := range created {
+		key := keyOf(c)
+		if touched[key] {
+			continue
+		}
+		touched[key] = true
+		_, err := primary.DeleteCertificate(ctx, &certificatepkg.RepositoryCertificateQuery{
+			HostNamePattern: certutil.EscapeHostNamePattern(key.serverName),
+			CertType:        key.certType,
+			CertSubType:     key.certSubType,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	previous := make([]appsv1.RepositoryCertificate, 0)
+	for _, c := range snapshot {
+		if touched[keyOf(c)] {
+			previous = append(previous, c)
+		}
+	}
+	if len(previous) == 0 {
+		return nil
+	}
+	_, err := primary.CreateCertificate(ctx, &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{Items: newCertBundle(previous).repositoryCertificates()},
+		Upsert:       true,
+	})
+	return err
+}
+
+// Returns the sorted, distinct types of the given certificates
+func certificateTypes(certs []appsv1.RepositoryCertificate) []string {
+	seen := make(map[string]bool)
+	types := make([]string, 0)
+	for _, c := range certs {
+		if !seen[c.CertType] {
+			seen[c.CertType] = true
+			types = append(types, c.CertType)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Result of comparing the pinned certificates of a server to the certificates
+// the server actually presents
+type certDiffResult struct {
+	ServerName         string
+	Status             string
+	PinnedFingerprints []string
+	LiveFingerprint    string
+	Error              error
+}
+
+const (
+	certDiffStatusOK       = "OK"
+	certDiffStatusMismatch = "MISMATCH"
+	certDiffStatusUnknown  = "UNKNOWN"
+)
+
+// NewCertDiffCommand returns a new instance of an `argocd cert diff` command
+func NewCertDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		againstLive     bool
+		hostNamePattern string
+		port            string
+		timeout         time.Duration
+		nonzeroUnknown  bool
+		hostsFile       string
+		output          string
+	)
+	var command = &cobra.Command{
+		Use:   "diff --against-live",
+		Short: "Compare configured certificates to the certificates presented by the servers",
+		Run: func(c *cobra.Command, args []string) {
+			if !againstLive {
+				errors.CheckError(fmt.Errorf("You need to specify --against-live, or specify --help for usage instructions"))
+			}
+			errors.CheckError(validateCertOutputFormat(c, output))
+
+			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
+			defer util.Close(conn)
+			certificates, err := certIf.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{HostNamePattern: hostNamePattern, CertType: "https"})
+			errors.CheckError(err)
+
+			certs := certificates.Items
+			var unpinned []certDiffResult
+			if hostsFile != "" {
+				hosts, err := readCertDiffHostsFile(hostsFile)
+				errors.CheckError(err)
+				certs, unpinned = certificatesForCertDiffHosts(certs, hosts)
+			}
+			results := diffCertificatesAgainstLive(certs, port, timeout)
+			if len(unpinned) > 0 {
+				results = append(results, unpinned...)
+				sort.SliceStable(results, func(i, j int) bool { return results[i].ServerName < results[j].ServerName })
+			}
+			switch output {
+			case "json":
+				data, err := json.MarshalIndent(newCertDiffReport(results), "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(data))
+			default:
+				printCertDiffTable(os.Stdout, results)
+			}
+			if code := certDiffExitCode(results, nonzeroUnknown); code != 0 {
+				os.Exit(code)
+			}
+		},
+	}
+	command.Flags().BoolVar(&againstLive, "against-live", false, "Compare the configured TLS certificates to the certificates presented by each server")
+	command.Flags().StringVar(&hostNamePattern, "hostname-pattern", "", "only compare certificates for hosts matching given glob-pattern")
+	command.Flags().StringVar(&port, "port", certutil.TLSFetchDefaultPort, "Port to connect to on servers whose name does not include a port")
+	command.Flags().DurationVar(&timeout, "timeout", 10*time.Second, "Timeout for connecting to a single server")
+	command.Flags().StringVar(&hostsFile, "hosts-file", "", "only compare certificates for the hosts listed in given file, one per line")
+	addCertOutputFlag(command, &output)
+	command.Flags().BoolVar(&nonzeroUnknown, "exit-nonzero-on-unknown", false, "Exit with a non-zero code if the certificates of a server could not be compared, e.g. because it was unreachable")
+	return command
+}
+
+// Returns the exit code for the results of a diff. A mismatch always fails,
+// unknown results only fail if failOnUnknown is set.
+func certDiffExitCode(results []certDiffResult, failOnUnknown bool) int {
+	for _, res := range results {
+		if res.Status == certDiffStatusMismatch || (failOnUnknown && res.Status == certDiffStatusUnknown) {
+			return 1
+		}
+	}
+	return 0
+}
+
+// For each server with pinned TLS certificates, fetch the certificates that
+// server presents and check whether they still match the pins. A server
+// matches if any certificate in its presented chain was pinned, or if its
+// leaf certificate was issued by a pinned certificate. Servers that cannot be
+// reached are reported with an unknown status.
+func diffCertificatesAgainstLive(certs []appsv1.RepositoryCertificate, port string, timeout time.Duration) []certDiffResult {
+	pinned := make(map[string][]*x509.Certificate)
+	serverNames := make([]string, 0)
+	for _, c := range certs {
+		if c.CertType != "https" {
+			continue
+		}
+		x509Cert, err := certutil.DecodePEMCertificateToX509(string(c.CertData))
+		if err != nil {
+			continue
+		}
+		if _, ok := pinned[c.ServerName]; !ok {
+			serverNames = append(serverNames, c.ServerName)
+		}
+		pinned[c.ServerName] = append(pinned[c.ServerName], x509Cert)
+	}
+	sort.Strings(serverNames)
+
+	results := make([]certDiffResult, 0, len(serverNames))
+	for _, serverName := range serverNames {
+		res := certDiffResult{ServerName: serverName}
+		pool := x509.NewCertPool()
+		pinnedFingerprints := make(map[string]bool)
+		for _, x509Cert := range pinned[serverName] {
+			fp := certutil.TLSCertificateFingerprintSHA256(x509Cert)
+			res.PinnedFingerprints = append(res.PinnedFingerprints, fp)
+			pinnedFingerprints[fp] = true
+			pool.AddCert(x509Cert)
+		}
+
+		address := serverName
+		if _, _, err := net.SplitHostPort(serverName); err != nil {
+			address = net.JoinHostPort(serverName, port)
+		}
+		live, err := certutil.FetchTLSCertificates(address, timeout)
+		if err != nil {
+			res.Status = certDiffStatusUnknown
+			res.Error = err
+			results = append(results, res)
+			continue
+		}
+		res.LiveFingerprint = certutil.TLSCertificateFingerprintSHA256(live[0])
+
+		res.Status = certDiffStatusMismatch
+		for _, liveCert := range live {
+			if pinnedFingerprints[certutil.TLSCertificateFingerprintSHA256(liveCert)] {
+				res.Status = certDiffStatusOK
+				break
+			}
+		}
+		if res.Status != certDiffStatusOK {
+			intermediates := x509.NewCertPool()
+			for _, liveCert := range live[1:] {
+				intermediates.AddCert(liveCert)
+			}
+			if _, err := live[0].Verify(x509.VerifyOptions{Roots: pool, Intermediates: intermediates}); err == nil {
+				res.Status = certDiffStatusOK
+			}
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// Reads the host names to compare from a file. Each line holds one host name,
+// empty lines and lines starting with # are ignored.
+func readCertDiffHostsFile(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	hosts := make([]string, 0)
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hosts = append(hosts, line)
+	}
+	return hosts, nil
+}
+
+// Returns the certificates pinned for any of the given hosts, along with an
+// unknown result for each host that has no certificates pinned at all.
+func certificatesForCertDiffHosts(certs []appsv1.RepositoryCertificate, hosts []string) ([]appsv1.RepositoryCertificate, []certDiffResult) {
+	wanted := make(map[string]bool)
+	for _, host := range hosts {
+		wanted[host] = true
+	}
+	pinned := make(map[string]bool)
+	selected := make([]appsv1.RepositoryCertificate, 0)
+	for _, c := range certs {
+		if wanted[c.ServerName] {
+			selected = append(selected, c)
+			pinned[c.ServerName] = true
+		}
+	}
+	unpinned := make([]certDiffResult, 0)
+	for _, host := range hosts {
+		if !pinned[host] {
+			unpinned = append(unpinned, certDiffResult{ServerName: host, Status: certDiffStatusUnknown, Error: fmt.Errorf("no certificates pinned")})
+			pinned[host] = true
+		}
+	}
+	return selected, unpinned
+}
+
+// Machine readable report of certificate diff results
+type certDiffReport struct {
+	Results []certDiffReportEntry `json:"results"`
+	Summary certDiffSummary       `json:"summary"`
+}
+
+type certDiffReportEntry struct {
+	Host               string   `json:"host"`
+	Status             string   `json:"status"`
+	PinnedFingerprints []string `json:"pinnedFingerprints"`
+	LiveFingerprint    string   `json:"liveFingerprint,omitempty"`
+	Error              string   `json:"error,omitempty"`
+}
+
+// Number of hosts per diff result status
+type certDiffSummary struct {
+	OK         int `json:"ok"`
+	Mismatched int `json:"mismatched"`
+	Unknown    int `json:"unknown"`
+}
+
+func (s certDiffSummary) String() string {
+	return fmt.Sprintf("%d ok, %d mismatched, %d unknown", s.OK, s.Mismatched, s.Unknown)
+}
+
+// Returns the number of hosts per status in results
+func summarizeCertDiffResults(results []certDiffResult) certDiffSummary {
+	var summary certDiffSummary
+	for _, res := range results {
+		switch res.Status {
+		case certDiffStatusOK:
+			summary.OK++
+		case certDiffStatusMismatch:
+			summary.Mismatched++
+		default:
+			summary.Unknown++
+		}
+	}
+	return summary
+}
+
+// Returns the machine readable report for results
+func newCertDiffReport(results []certDiffResult) certDiffReport {
+	report := certDiffReport{Results: make([]certDiffReportEntry, 0, len(results)), Summary: summarizeCertDiffResults(results)}
+	for _, res := range results {
+		entry := certDiffReportEntry{Host: res.ServerName, Status: res.Status, PinnedFingerprints: make([]string, 0, len(res.PinnedFingerprints))}
+		for _, fp := range res.PinnedFingerprints {
+			entry.PinnedFingerprints = append(entry.PinnedFingerprints, "SHA256:"+fp)
+		}
+		if res.LiveFingerprint != "" {
+			entry.LiveFingerprint = "SHA256:" + res.LiveFingerprint
+		}
+		if res.Error != nil {
+			entry.Error = res.Error.Error()
+		}
+		report.Results = append(report.Results, entry)
+	}
+	return report
+}
+
+// Print table of certificate diff results, followed by the number of hosts
+// per status
+func printCertDiffTable(out io.Writer, results []certDiffResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "HOSTNAME\tSTATUS\tPINNED\tLIVE\n")
+	for _, res := range results {
+		live := "-"
+		if res.Error != nil {
+			live = fmt.Sprintf("(%v)", res.Error)
+		} else if res.LiveFingerprint != "" {
+			live = "SHA256:" + res.LiveFingerprint
+		}
+		pinned := make([]string, 0, len(res.PinnedFingerprints))
+		for _, fp := range res.PinnedFingerprints {
+			pinned = append(pinned, "SHA256:"+fp)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", sanitizeForDisplay(res.ServerName), res.Status, strings.Join(pinned, ","), live)
+	}
+	_ = w.Flush()
+	fmt.Fprintln(out, summarizeCertDiffResults(results))
+}
+
+// NewCertListCommand returns a new instance of an `argocd cert rm` command
+func NewCertListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		certType        string
+		hostNamePattern string
+		sortOrder       string
+		showHashed      bool
+		count           bool
+		sinceReconcile  bool
+		staleThreshold  time.Duration
+		columnSpec      string
+		issuerOrg       string
+		expiringWithin  time.Duration
+		checkName       bool
+		fingerprintOnly bool
+		output          string
+		includeMetadata bool
+		outputFile      string
+		findDuplicates  bool
+		noPager         bool
+		templateText    string
+	)
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "List configured certificates",
+		Run: func(c *cobra.Command, args []string) {
+			if certType != "" {
+				switch certType {
+				case "ssh":
+				case "https":
+				default:
+					fmt.Println("cert-type must be either ssh or https")
+					os.Exit(1)
+				}
+			}
+			errors.CheckError(validateCertOutputFormat(c, output))
+			if (output == "template") != (templateText != "") {
+				fmt.Println("--template must be given together with --output template")
+				os.Exit(1)
+			}
+			columns, err := parseCertListColumns(columnSpec)
+			errors.CheckError(err)
+			if checkName {
+				columns = appendCertListColumn(columns, "valid-for-host")
+			}
+
+			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
+			defer util.Close(conn)
+			query := &certificatepkg.RepositoryCertificateQuery{HostNamePattern: hostNamePattern, CertType: certType}
+			if count {
+				counts, err := certIf.CountCertificates(context.Background(), query)
+				if status.Code(err) == codes.Unimplemented {
+					// Older servers do not know about counting, so we need to
+					// fetch the whole list and count on the client side.
+					certificates, err := certIf.ListCertificates(context.Background(), query)
+					errors.CheckError(err)
+					counts = certificatepkg.CountCertificates(certificates.Items)
+				} else {
+					errors.CheckError(err)
+				}
+				printCertCounts(counts)
+				return
+			}
+			certificates, err := certIf.ListCertificates(context.Background(), query)
+			errors.CheckError(err)
+			items, numHashed := filterHashedCertificates(certificates.Items, showHashed)
+			// The certificates are looked at by several of the steps below,
+			// but each of them is only decoded once.
+			x509Cache := certutil.NewX509CertificateCache()
+			if issuerOrg != "" {
+				items = filterCertificatesByIssuerOrg(x509Cache, items, issuerOrg)
+			}
+			if expiringWithin > 0 {
+				items = filterCertificatesExpiringWithin(x509Cache, items, expiringWithin, time.Now())
+			}
+			if findDuplicates {
+				errors.CheckError(writeCertOutput(outputFile, func(w io.Writer) error {
+					printCertFingerprintGroups(w, findDuplicateCertFingerprints(x509Cache, items))
+					return nil
+				}))
+				return
+			}
+			if fingerprintOnly {
+				errors.CheckError(writeCertOutput(outputFile, func(w io.Writer) error {
+					printCertFingerprints(w, x509Cache, items, sortOrder)
+					return nil
+				}))
+				return
+			}
+			switch output {
+			case "json", "yaml":
+				errors.CheckError(writeCertOutput(outputFile, func(w io.Writer) error {
+					return printCertList(w, x509Cache, items, sortOrder, output, includeMetadata)
+				}))
+			case "template":
+				errors.CheckError(writeCertOutput(outputFile, func(w io.Writer) error {
+					return printCertTemplate(w, x509Cache, items, sortOrder, templateText, time.Now())
+				}))
+			default:
+				render := func(w io.Writer) error {
+					printCertTable(w, x509Cache, items, sortOrder, columns)
+					return nil
+				}
+				if outputFile != "" {
+					errors.CheckError(writeCertOutput(outputFile, render))
+				} else {
+					pager := certListPager(noPager, terminal.IsTerminal(int(os.Stdout.Fd())), os.Getenv)
+					errors.CheckError(writeThroughPager(pager, os.Stdout, render))
+				}
+			}
+			// Notices must not end up in machine readable output, so they go
+			// to stderr unless the table is printed.
+			notices := os.Stdout
+			if output != "" {
+				notices = os.Stderr
+			}
+			if numHashed > 0 {
+				fmt.Fprintf(notices, "(+%d hashed entries, use --show-hashed)\n", numHashed)
+			}
+			if output == "" {
+				printCertExpiryWarning(os.Stdout, x509Cache, items, time.Now())
+			}
+			if sinceReconcile {
+				storeStatus, err := certIf.GetCertificateStoreStatus(context.Background(), &certificatepkg.RepositoryCertificateStoreStatusQuery{})
+				if status.Code(err) == codes.Unimplemented {
+					fmt.Fprintln(notices, "Server does not report last reconcile time of the certificate store")
+					return
+				}
+				errors.CheckError(err)
+				var lastReconciled time.Time
+				if storeStatus.LastReconciledAt != nil {
+					lastReconciled = storeStatus.LastReconciledAt.Time
+				}
+				printReconcileStatus(notices, lastReconciled, time.Now(), staleThreshold)
+			}
+		},
+	}
+
+	command.Flags().StringVar(&sortOrder, "sort", "", "set display sort order, valid: 'hostname', 'type'")
+	command.Flags().StringVar(&certType, "cert-type", "", "only list certificates of given type, valid: 'ssh','https'")
 	command.Flags().StringVar(&hostNamePattern, "hostname-pattern", "", "only list certificates for hosts matching given glob-pattern")
+	command.Flags().BoolVar(&showHashed, "show-hashed", true, "list SSH known hosts entries with hashed host names")
+	command.Flags().BoolVar(&count, "count", false, "only print the number of matching certificates per type")
+	addCertOutputFlag(command, &output)
+	addCertOutputFileFlag(command, &outputFile)
+	command.Flags().BoolVar(&noPager, "no-pager", false, "do not page the table output through $PAGER when writing to a terminal")
+	command.Flags().StringVar(&templateText, "template", "", "go template to render the certificates with when using --output template, see the docs for the available functions")
+	command.Flags().BoolVar(&includeMetadata, "include-metadata", false, "include all stored fields of the certificates in json or yaml output, instead of a summary")
+	command.Flags().BoolVar(&fingerprintOnly, "fingerprint-only", false, "only print the fingerprint of each matching certificate, one per line")
+	command.Flags().BoolVar(&findDuplicates, "find-duplicates", false, "only report certificates pinned in more than one entry, grouped by fingerprint")
+	command.Flags().BoolVar(&checkName, "check-name", false, "show whether each https certificate is valid for the server name it is configured for")
+	command.Flags().StringVar(&issuerOrg, "issuer-org", "", "only list https certificates whose issuer organization matches given glob-pattern")
+	command.Flags().DurationVar(&expiringWithin, "expiring-within", 0, "only list https certificates which have expired or expire within given duration, e.g. 720h")
+	command.Flags().StringVar(&columnSpec, "columns", "", "comma separated list of columns to display in the given order, valid: 'host','type','subtype','info','fingerprint','subject','issuer','expiry','san','rotate-by','valid-for-host' (default 'host,type,subtype,info,rotate-by')")
+	command.Flags().BoolVar(&sinceReconcile, "since-reconcile", false, "print when the certificate store was last reconciled from its configuration")
+	command.Flags().DurationVar(&staleThreshold, "stale-threshold", 10*time.Minute, "warn if the last reconcile of the certificate store is older than this")
 	return command
 }
 
-// Print table of certificate info
-func printCertTable(certs []appsv1.RepositoryCertificate, sortOrder string) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "HOSTNAME\tTYPE\tSUBTYPE\tFINGERPRINT/SUBJECT\n")
-
+// Print summary of the last reconcile of the certificate store, including a
+// warning if it happened longer than threshold ago.
+func printReconcileStatus(w io.Writer, lastReconciled time.Time, now time.Time, threshold time.Duration) {
+	if lastReconciled.IsZero() {
+		fmt.Fprintf(w, "Last reconciled: unknown\n")
+		fmt.Fprintf(w, "WARNING: certificate store has not been reconciled yet\n")
+		return
+	}
+	age := now.Sub(lastReconciled).Round(time.Second)
+	fmt.Fprintf(w, "Last reconciled: %s (%s ago)\n", lastReconciled.UTC().Format(time.RFC3339), age)
+	if threshold > 0 && age > threshold {
+		fmt.Fprintf(w, "WARNING: certificate store reconciliation appears stalled, last reconcile is older than %s\n", threshold)
+	}
+}
+
+// Print table of certificate counts
+func printCertCounts(counts *certificatepkg.RepositoryCertificateCountResponse) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "TYPE\tCOUNT\n")
+	fmt.Fprintf(w, "ssh\t%d\n", counts.Ssh)
+	fmt.Fprintf(w, "https\t%d\n", counts.Https)
+	fmt.Fprintf(w, "total\t%d\n", counts.Total)
+	_ = w.Flush()
+}
+
+// Removes SSH known hosts entries with hashed host names from certs, unless
+// showHashed is set. Returns the remaining certificates and the number of
+// entries that were removed.
+func filterHashedCertificates(certs []appsv1.RepositoryCertificate, showHashed bool) ([]appsv1.RepositoryCertificate, int) {
+	if showHashed {
+		return certs, 0
+	}
+	filtered := make([]appsv1.RepositoryCertificate, 0, len(certs))
+	for _, c := range certs {
+		if c.CertType == "ssh" && certutil.IsHashedSSHKnownHostsName(c.ServerName) {
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+	return filtered, len(certs) - len(filtered)
+}
+
+// Columns which can be displayed by cert list, and the default set of columns
+var (
+	certListColumns = map[string]certListColumn{
+		"host":           {header: "HOSTNAME", value: func(r certTableRow) string { return sanitizeForDisplay(r.cert.ServerName) }},
+		"type":           {header: "TYPE", value: func(r certTableRow) string { return r.cert.CertType }},
+		"subtype":        {header: "SUBTYPE", value: func(r certTableRow) string { return r.subType }},
+		"info":           {header: "FINGERPRINT/SUBJECT", value: certTableRowInfo},
+		"fingerprint":    {header: "FINGERPRINT", value: func(r certTableRow) string { return r.fingerprint }},
+		"subject":        {header: "SUBJECT", value: func(r certTableRow) string { return r.subject }},
+		"issuer":         {header: "ISSUER", value: func(r certTableRow) string { return r.issuer }},
+		"expiry":         {header: "EXPIRY", value: formatCertExpiry},
+		"san":            {header: "SAN", value: func(r certTableRow) string { return r.san }},
+		"rotate-by":      {header: "ROTATE-BY", value: func(r certTableRow) string { return r.rotateBy }},
+		"valid-for-host": {header: "VALID-FOR-HOST", value: func(r certTableRow) string { return r.validForHost }},
+	}
+	certListDefaultColumns = []string{"host", "type", "subtype", "info", "rotate-by"}
+)
+
+type certListColumn struct {
+	header string
+	value  func(r certTableRow) string
+}
+
+// Values of a single certificate prepared for display
+type certTableRow struct {
+	cert        appsv1.RepositoryCertificate
+	subType     string
+	fingerprint string
+	subject     string
+	issuer      string
+	expiry      string
+	// "expired" or "expiring" for https certificates which have expired or
+	// expire within certExpiryWarningPeriod
+	expiryStatus string
+	san          string
+	rotateBy     string
+	// whether a https certificate verifies for the server name it is pinned for
+	validForHost string
+}
+
+func certTableRowInfo(r certTableRow) string {
+	if r.cert.CertType == "ssh" {
+		return r.fingerprint
+	}
+	return r.subject
+}
+
+// Parses a comma separated list of column names for cert list, returning the
+// default columns if spec is empty.
+func parseCertListColumns(spec string) ([]string, error) {
+	if spec == "" {
+		return certListDefaultColumns, nil
+	}
+	var columns []string
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := certListColumns[name]; !ok {
+			valid := make([]string, 0, len(certListColumns))
+			for n := range certListColumns {
+				valid = append(valid, n)
+			}
+			sort.Strings(valid)
+			return nil, fmt.Errorf("Unknown column '%s', valid columns are: %s", name, strings.Join(valid, ","))
+		}
+		columns = append(columns, name)
+	}
+	return columns, nil
+}
+
+// Appends column to columns, unless it is already contained
+func appendCertListColumn(columns []string, column string) []string {
+	for _, c := range columns {
+		if c == column {
+			return columns
+		}
+	}
+	return append(append([]string{}, columns...), column)
+}
+
+func newCertTableRow(x509Cache *certutil.X509CertificateCache, c appsv1.RepositoryCertificate, now time.Time) certTableRow {
+	r := certTableRow{cert: c, subType: c.CertSubType, fingerprint: "-", subject: "-", issuer: "-", expiry: "-", san: "-", rotateBy: formatRotateBy(c, now), validForHost: "-"}
+	if c.CertType == "ssh" {
+		_, pubKey, err := certutil.TokenizedDataToPublicKey(c.ServerName, c.CertSubType, string(c.CertData))
+		errors.CheckError(err)
+		r.fingerprint = "SHA256:" + certutil.SSHFingerprintSHA256(pubKey)
+	} else if c.CertType == "https" {
+		x509Data, err := x509Cache.DecodePEMCertificateToX509(string(c.CertData))
+		r.subType = "-?-"
+		if err != nil {
+			r.subject = sanitizeForDisplay(err.Error())
+		} else {
+			r.subType = strings.ToLower(x509Data.PublicKeyAlgorithm.String())
+			r.fingerprint = "SHA256:" + certutil.TLSCertificateFingerprintSHA256(x509Data)
+			r.subject = sanitizeForDisplay(x509Data.Subject.String())
+			r.issuer = sanitizeForDisplay(x509Data.Issuer.String())
+			r.expiry = x509Data.NotAfter.UTC().Format(time.RFC3339)
+			r.expiryStatus = certExpiryStatus(x509Data.NotAfter, now)
+			r.validForHost = strconv.FormatBool(certutil.IsTLSCertificateValidForHost(x509Data, c.ServerName, now))
+			var sans []string
+			sans = append(sans, x509Data.DNSNames...)
+			for _, ip := range x509Data.IPAddresses {
+				sans = append(sans, ip.String())
+			}
+			if len(sans) > 0 {
+				r.san = sanitizeForDisplay(strings.Join(sans, ","))
+			}
+		}
+	}
+	return r
+}
+
+// https certificates expiring within this period are flagged by cert list
+const certExpiryWarningPeriod = 30 * 24 * time.Hour
+
+// Returns the end of the validity period of an https certificate. Older
+// servers do not report it, so it is taken from the certificate data then.
+func certNotAfter(x509Cache *certutil.X509CertificateCache, c appsv1.RepositoryCertificate) (time.Time, bool) {
+	if c.CertType != "https" {
+		return time.Time{}, false
+	}
+	if c.NotAfter != nil {
+		return c.NotAfter.Time, true
+	}
+	x509Data, err := x509Cache.DecodePEMCertificateToX509(string(c.CertData))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return x509Data.NotAfter, true
+}
+
+// Returns "expired" or "expiring" if a certificate valid until notAfter has
+// expired or expires within certExpiryWarningPeriod, or an empty string
+func certExpiryStatus(notAfter time.Time, now time.Time) string {
+	if now.After(notAfter) {
+		return "expired"
+	} else if notAfter.Before(now.Add(certExpiryWarningPeriod)) {
+		return "expiring"
+	}
+	return ""
+}
+
+func formatCertExpiry(r certTableRow) string {
+	if r.expiryStatus == "" {
+		return r.expiry
+	}
+	return fmt.Sprintf("%s (%s)", r.expiry, r.expiryStatus)
+}
+
+// Returns the https certificates from certs which have expired or expire
+// within the given duration. SSH known hosts entries never expire and are
+// never returned.
+func filterCertificatesExpiringWithin(x509Cache *certutil.X509CertificateCache, certs []appsv1.RepositoryCertificate, within time.Duration, now time.Time) []appsv1.RepositoryCertificate {
+	filtered := make([]appsv1.RepositoryCertificate, 0, len(certs))
+	for _, c := range certs {
+		if notAfter, ok := certNotAfter(x509Cache, c); ok && notAfter.Before(now.Add(within)) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// Prints a warning if any of the https certificates in certs have expired or
+// expire within certExpiryWarningPeriod
+func printCertExpiryWarning(w io.Writer, x509Cache *certutil.X509CertificateCache, certs []appsv1.RepositoryCertificate, now time.Time) {
+	var expired, expiring int
+	for _, c := range certs {
+		notAfter, ok := certNotAfter(x509Cache, c)
+		if !ok {
+			continue
+		}
+		switch certExpiryStatus(notAfter, now) {
+		case "expired":
+			expired++
+		case "expiring":
+			expiring++
+		}
+	}
+	if expired > 0 || expiring > 0 {
+		fmt.Fprintf(w, "WARNING: %d https certificates have expired and %d expire within %d days, use --expiring-within to list them\n", expired, expiring, int(certExpiryWarningPeriod.Hours()/24))
+	}
+}
+
+// Returns the https certificates from certs whose issuer has an organization
+// matching the given glob pattern. SSH known hosts entries have no issuer and
+// are never returned.
+func filterCertificatesByIssuerOrg(x509Cache *certutil.X509CertificateCache, certs []appsv1.RepositoryCertificate, pattern string) []appsv1.RepositoryCertificate {
+	filtered := make([]appsv1.RepositoryCertificate, 0, len(certs))
+	for _, c := range certs {
+		if c.CertType != "https" {
+			continue
+		}
+		x509Cert, err := x509Cache.DecodePEMCertificateToX509(string(c.CertData))
+		if err != nil {
+			continue
+		}
+		for _, org := range x509Cert.Issuer.Organization {
+			if certutil.MatchHostName(org, pattern) {
+				filtered = append(filtered, c)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// Sort certs for display in the given order. The sort is stable, so entries
+// with the same sort key keep the order they were received in.
+func sortCertificates(certs []appsv1.RepositoryCertificate, sortOrder string) {
 	if sortOrder == "hostname" || sortOrder == "" {
-		sort.Slice(certs, func(i, j int) bool {
+		sort.SliceStable(certs, func(i, j int) bool {
 			return certs[i].ServerName < certs[j].ServerName
 		})
 	} else if sortOrder == "type" {
-		sort.Slice(certs, func(i, j int) bool {
+		sort.SliceStable(certs, func(i, j int) bool {
 			return certs[i].CertType < certs[j].CertType
 		})
 	}
+}
+
+// Output formats supported by the cert commands, keyed by command name. Every
+// command also supports the empty format, which prints a table.
+var certOutputFormats = map[string][]string{
+	"diff":            {"json"},
+	"list":            {"json", "yaml", "template"},
+	"supported-types": {"json"},
+}
+
+// Adds the --output flag advertising the output formats supported by command
+func addCertOutputFlag(command *cobra.Command, output *string) {
+	formats := certOutputFormats[command.Name()]
+	command.Flags().StringVarP(output, "output", "o", "", fmt.Sprintf("Output format. One of: %s (default is a table)", strings.Join(formats, ", ")))
+}
+
+// Returns an error listing the supported formats if command does not support
+// the output format
+func validateCertOutputFormat(command *cobra.Command, output string) error {
+	formats := certOutputFormats[command.Name()]
+	if output == "" {
+		return nil
+	}
+	for _, format := range formats {
+		if format == output {
+			return nil
+		}
+	}
+	return fmt.Errorf("Unknown output format: %s, supported: %s", output, strings.Join(formats, ", "))
+}
+
+// Returns the pager to page the table output of cert list through, or an
+// empty string if the output should not be paged. Like git, output is only
+// paged when written to a terminal, using $PAGER or less if available.
+func certListPager(noPager bool, isTerminal bool, getenv func(string) string) string {
+	if noPager || !isTerminal {
+		return ""
+	}
+	if pager := getenv("PAGER"); pager != "" {
+		return pager
+	}
+	if _, err := exec.LookPath("less"); err != nil {
+		return ""
+	}
+	return "less"
+}
+
+// Pages the output produced by render through the shell command pager, which
+// writes to out. If pager is empty or cannot be started, the output is
+// written to out directly.
+func writeThroughPager(pager string, out io.Writer, render func(w io.Writer) error) error {
+	if pager == "" {
+		return render(out)
+	}
+	cmd := exec.Command("sh", "-c", pager)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+	if os.Getenv("LESS") == "" {
+		// Quit if the output fits on one screen, keep colors and do not clear
+		// the screen on exit.
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return render(out)
+	}
+	if err := cmd.Start(); err != nil {
+		return render(out)
+	}
+	err = render(in)
+	_ = in.Close()
+	if waitErr := cmd.Wait(); err == nil {
+		err = waitErr
+	}
+	return err
+}
+
+// Adds the --output-file flag shared by the cert commands producing output
+func addCertOutputFileFlag(command *cobra.Command, outputFile *string) {
+	command.Flags().StringVar(outputFile, "output-file", "", "write output to given file instead of stdout, the file is replaced atomically")
+}
+
+// Writes the output produced by render to path, or to stdout if path is
+// empty. The output is written to a temporary file in the same directory
+// first, which is then renamed to path, so that path never contains partial
+// output. The file keeps the mode of the file it replaces, new files are
+// created with mode 0644.
+func writeCertOutput(path string, render func(w io.Writer) error) error {
+	if path == "" {
+		return render(os.Stdout)
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	tmpPath := f.Name()
+	mode := os.FileMode(0644)
+	if info, statErr := os.Stat(path); statErr == nil {
+		mode = info.Mode().Perm()
+	}
+	err = render(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, mode)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// Summary of a certificate for json and yaml output
+type certListSummary struct {
+	ServerName      string `json:"servername"`
+	CertType        string `json:"type"`
+	CertSubType     string `json:"cipher"`
+	CertFingerprint string `json:"certfingerprint"`
+	// End of the validity period of https certificates in RFC3339 format,
+	// SSH known hosts entries do not expire
+	Expiry string `json:"expiry,omitempty"`
+}
+
+// Print certs as list in json or yaml format. Unless includeMetadata is set,
+// only a summary of each certificate is printed.
+func printCertList(w io.Writer, x509Cache *certutil.X509CertificateCache, certs []appsv1.RepositoryCertificate, sortOrder string, output string, includeMetadata bool) error {
+	sortCertificates(certs, sortOrder)
+	var list interface{} = certs
+	if !includeMetadata {
+		now := time.Now()
+		summaries := make([]certListSummary, 0, len(certs))
+		for _, c := range certs {
+			if c.CertType != "ssh" && c.CertType != "https" {
+				continue
+			}
+			r := newCertTableRow(x509Cache, c, now)
+			summary := certListSummary{ServerName: c.ServerName, CertType: c.CertType, CertSubType: r.subType, CertFingerprint: r.fingerprint}
+			if r.expiry != "-" {
+				summary.Expiry = r.expiry
+			}
+			summaries = append(summaries, summary)
+		}
+		list = summaries
+	}
+	var data []byte
+	var err error
+	switch output {
+	case "yaml":
+		data, err = yaml.Marshal(list)
+	case "json":
+		data, err = json.MarshalIndent(list, "", "  ")
+	default:
+		err = fmt.Errorf("Unknown output format: %s", output)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// Print the fingerprint of each certificate on a line of its own. Entries
+// whose data cannot be decoded are left out.
+func printCertFingerprints(w io.Writer, x509Cache *certutil.X509CertificateCache, certs []appsv1.RepositoryCertificate, sortOrder string) {
+	sortCertificates(certs, sortOrder)
+	now := time.Now()
+	for _, c := range certs {
+		if c.CertType != "ssh" && c.CertType != "https" {
+			continue
+		}
+		if r := newCertTableRow(x509Cache, c, now); r.fingerprint != "-" {
+			fmt.Fprintln(w, r.fingerprint)
+		}
+	}
+}
+
+// Entries of the certificate store sharing the same fingerprint
+type certFingerprintGroup struct {
+	fingerprint string
+	certs       []appsv1.RepositoryCertificate
+}
+
+// Groups certs by their fingerprint and returns all groups with more than one
+// entry, ordered by fingerprint.
+func findDuplicateCertFingerprints(x509Cache *certutil.X509CertificateCache, certs []appsv1.RepositoryCertificate) []certFingerprintGroup {
+	now := time.Now()
+	byFingerprint := make(map[string][]appsv1.RepositoryCertificate)
+	for _, c := range certs {
+		if c.CertType != "ssh" && c.CertType != "https" {
+			continue
+		}
+		if r := newCertTableRow(x509Cache, c, now); r.fingerprint != "-" {
+			byFingerprint[r.fingerprint] = append(byFingerprint[r.fingerprint], c)
+		}
+	}
+	groups := make([]certFingerprintGroup, 0)
+	for fingerprint, entries := range byFingerprint {
+		if len(entries) > 1 {
+			sortCertificates(entries, "hostname")
+			groups = append(groups, certFingerprintGroup{fingerprint: fingerprint, certs: entries})
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].fingerprint < groups[j].fingerprint })
+	return groups
+}
+
+// Print table of duplicate fingerprints along with the entries they are
+// pinned in
+func printCertFingerprintGroups(w io.Writer, groups []certFingerprintGroup) {
+	if len(groups) == 0 {
+		fmt.Fprintln(w, "No duplicate fingerprints found")
+		return
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "FINGERPRINT\tENTRIES\tHOSTS\n")
+	for _, group := range groups {
+		hosts := make([]string, 0, len(group.certs))
+		for _, c := range group.certs {
+			hosts = append(hosts, fmt.Sprintf("%s (%s)", sanitizeForDisplay(c.ServerName), c.CertType))
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", group.fingerprint, len(group.certs), strings.Join(hosts, ", "))
+	}
+	_ = tw.Flush()
+}
+
+// Print table of certificate info, using the given columns in order
+func printCertTable(w io.Writer, x509Cache *certutil.X509CertificateCache, certs []appsv1.RepositoryCertificate, sortOrder string, columns []string) {
+	if len(columns) == 0 {
+		columns = certListDefaultColumns
+	}
+	headers := make([]string, len(columns))
+	for i, name := range columns {
+		headers[i] = certListColumns[name].header
+	}
+	rows := [][]string{headers}
+	now := time.Now()
+
+	sortCertificates(certs, sortOrder)
+
+	for _, c := range certs {
+		if c.CertType != "ssh" && c.CertType != "https" {
+			continue
+		}
+		r := newCertTableRow(x509Cache, c, now)
+		values := make([]string, len(columns))
+		for i, name := range columns {
+			values[i] = certListColumns[name].value(r)
+		}
+		rows = append(rows, values)
+	}
+	printAlignedTable(w, rows)
+}
+
+// Prints rows as a table whose columns are separated by two spaces, like a
+// tabwriter would. Unlike a tabwriter, which counts runes, the columns are
+// padded by display width, so wide characters, e.g. CJK ideographs in the
+// subject of a certificate, don't break the alignment.
+func printAlignedTable(w io.Writer, rows [][]string) {
+	widths := make([]int, 0)
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			if cellWidth := displayWidth(cell); cellWidth > widths[i] {
+				widths[i] = cellWidth
+			}
+		}
+	}
+	for _, row := range rows {
+		var sb strings.Builder
+		for i, cell := range row {
+			sb.WriteString(cell)
+			if i < len(row)-1 {
+				sb.WriteString(strings.Repeat(" ", widths[i]-displayWidth(cell)+2))
+			}
+		}
+		fmt.Fprintln(w, sb.String())
+	}
+}
+
+// Returns the number of terminal columns str takes up. East Asian wide and
+// fullwidth characters take up two columns, combining marks none.
+func displayWidth(str string) int {
+	n := 0
+	for _, r := range str {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case width.LookupRune(r).Kind() == width.EastAsianWide || width.LookupRune(r).Kind() == width.EastAsianFullwidth:
+			n += 2
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// Returns the rotate-by date of the certificate for display. Certificates that
+// are past their rotate-by date are flagged as overdue, regardless of whether
+// they are still valid.
+func formatRotateBy(c appsv1.RepositoryCertificate, now time.Time) string {
+	value, ok := c.Annotations[certutil.CertificateAnnotationRotateBy]
+	if !ok {
+		return "-"
+	}
+	rotateBy, err := certutil.ParseRotateByDate(value)
+	if err != nil {
+		return fmt.Sprintf("%s (invalid)", value)
+	}
+	if now.After(rotateBy) {
+		return fmt.Sprintf("%s (overdue)", value)
+	}
+	return value
+}
+
+// Makes a string taken from certificate data safe for display in a table.
+// The string is normalized to NFC and fullwidth characters are folded to
+// their narrow form. Tables are padded by display width, which covers the
+// wide characters which cannot be folded. Control
+// characters, bidirectional text controls and invalid UTF-8 sequences are
+// escaped, so they cannot corrupt the terminal.
+func sanitizeForDisplay(str string) string {
+	str = width.Fold.String(norm.NFC.String(str))
+	var sb strings.Builder
+	for i := 0; i < len(str); {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&sb, "\\x%02x", str[i])
+		case unicode.IsControl(r) || unicode.Is(unicode.Bidi_Control, r):
+			if r < 0x100 {
+				fmt.Fprintf(&sb, "\\x%02x", r)
+			} else {
+				fmt.Fprintf(&sb, "\\u%04x", r)
+			}
+		default:
+			sb.WriteRune(r)
+		}
+		i += size
+	}
+	return sb.String()
+}
+
+const (
+	// API group of the certificate bundle format used by export and import
+	certBundleAPIGroup = "argocd.argoproj.io/cert-bundle"
+	// Current version of the certificate bundle format, written by export
+	certBundleAPIVersion = certBundleAPIGroup + "/v1"
+	// Kind of a certificate bundle document
+	certBundleKind = "CertificateBundle"
+)
+
+// Versions of the certificate bundle format import is able to read. When the
+// format changes, the new version has to be added here, and bundles of older
+// versions have to be converted in parseCertBundle.
+var certBundleSupportedVersions = []string{certBundleAPIVersion}
+
+// A document holding certificates, as written by export and read by import
+type certBundle struct {
+	APIVersion   string            `json:"apiVersion"`
+	Kind         string            `json:"kind"`
+	Certificates []certBundleEntry `json:"certificates"`
+}
+
+// A single entry of a certificate bundle. For https, the entry holds all the
+// PEM encoded certificates of a server.
+type certBundleEntry struct {
+	ServerName  string            `json:"serverName"`
+	CertType    string            `json:"certType"`
+	CertSubType string            `json:"certSubType,omitempty"`
+	CertData    string            `json:"certData"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
 
+// Builds a bundle of the current version from certs. The certificates of a
+// https server are merged into a single entry, as they are held in the store.
+func newCertBundle(certs []appsv1.RepositoryCertificate) *certBundle {
+	bundle := &certBundle{APIVersion: certBundleAPIVersion, Kind: certBundleKind, Certificates: make([]certBundleEntry, 0)}
+	tlsEntries := make(map[string]int)
 	for _, c := range certs {
-		if c.CertType == "ssh" {
-			_, pubKey, err := certutil.TokenizedDataToPublicKey(c.ServerName, c.CertSubType, string(c.CertData))
+		if c.CertType == "https" {
+			if idx, ok := tlsEntries[c.ServerName]; ok {
+				bundle.Certificates[idx].CertData += string(c.CertData)
+				continue
+			}
+			tlsEntries[c.ServerName] = len(bundle.Certificates)
+		}
+		bundle.Certificates = append(bundle.Certificates, certBundleEntry{
+			ServerName:  c.ServerName,
+			CertType:    c.CertType,
+			CertSubType: c.CertSubType,
+			CertData:    string(c.CertData),
+			Annotations: c.Annotations,
+		})
+	}
+	return bundle
+}
+
+// Parses a certificate bundle, making sure it is of a version we understand
+func parseCertBundle(data []byte) (*certBundle, error) {
+	var header struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	if err := yaml.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("Could not parse certificate bundle: %v", err)
+	}
+	if header.APIVersion == "" {
+		return nil, fmt.Errorf("Certificate bundle has no apiVersion, expected '%s'", certBundleAPIVersion)
+	}
+	supported := false
+	for _, version := range certBundleSupportedVersions {
+		if header.APIVersion == version {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		if strings.HasPrefix(header.APIVersion, certBundleAPIGroup+"/") {
+			return nil, fmt.Errorf("Certificate bundle version '%s' is not supported by this version of argocd, which supports %s. Please upgrade the argocd CLI to import it.", header.APIVersion, strings.Join(certBundleSupportedVersions, ", "))
+		}
+		return nil, fmt.Errorf("Unknown certificate bundle apiVersion '%s', expected '%s'", header.APIVersion, certBundleAPIVersion)
+	}
+	if header.Kind != certBundleKind {
+		return nil, fmt.Errorf("Unknown certificate bundle kind '%s', expected '%s'", header.Kind, certBundleKind)
+	}
+
+	var bundle certBundle
+	if err := yaml.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("Could not parse certificate bundle: %v", err)
+	}
+	return &bundle, nil
+}
+
+// Returns the entries of the bundle as certificates to be created
+func (b *certBundle) repositoryCertificates() []appsv1.RepositoryCertificate {
+	certs := make([]appsv1.RepositoryCertificate, 0, len(b.Certificates))
+	for _, entry := range b.Certificates {
+		certs = append(certs, appsv1.RepositoryCertificate{
+			ServerName:  entry.ServerName,
+			CertType:    entry.CertType,
+			CertSubType: entry.CertSubType,
+			CertData:    []byte(entry.CertData),
+			Annotations: entry.Annotations,
+		})
+	}
+	return certs
+}
+
+// NewCertExportCommand returns a new instance of an `argocd cert export` command
+func NewCertExportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		certType        string
+		hostNamePattern string
+		outputFile      string
+		format          string
+	)
+	var command = &cobra.Command{
+		Use:   "export",
+		Short: "Export configured certificates as a bundle, which can be imported using `argocd cert import`",
+		Run: func(c *cobra.Command, args []string) {
+			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
+			defer util.Close(conn)
+			certificates, err := certIf.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{HostNamePattern: hostNamePattern, CertType: certType})
 			errors.CheckError(err)
-			fmt.Fprintf(w, "%s\t%s\t%s\tSHA256:%s\n", c.ServerName, c.CertType, c.CertSubType, certutil.SSHFingerprintSHA256(pubKey))
-		} else if c.CertType == "https" {
-			x509Data, err := certutil.DecodePEMCertificateToX509(string(c.CertData))
-			var subject string
-			keyType := "-?-"
-			if err != nil {
-				subject = err.Error()
+			sortCertificates(certificates.Items, "hostname")
+			// Render before writing, so an unknown format does not leave
+			// an empty file behind
+			var out bytes.Buffer
+			errors.CheckError(writeCertExport(&out, certificates.Items, format))
+			errors.CheckError(writeCertOutput(outputFile, func(w io.Writer) error {
+				_, err := w.Write(out.Bytes())
+				return err
+			}))
+		},
+	}
+	addCertOutputFileFlag(command, &outputFile)
+	command.Flags().StringVar(&format, "format", "bundle", fmt.Sprintf("Format to export certificates in. One of: %s", strings.Join(certExportFormats, ", ")))
+	command.Flags().StringVar(&certType, "cert-type", "", "only export certificates of given type, valid: 'ssh','https'")
+	command.Flags().StringVar(&hostNamePattern, "hostname-pattern", "", "only export certificates for hosts matching given glob-pattern")
+	return command
+}
+
+// NewCertImportCommand returns a new instance of an `argocd cert import` command
+func NewCertImportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		upsert bool
+	)
+	var command = &cobra.Command{
+		Use:   "import FILE",
+		Short: "Import certificates from a bundle created by `argocd cert export`, use - to read from stdin",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var data []byte
+			var err error
+			if args[0] == "-" {
+				data, err = ioutil.ReadAll(os.Stdin)
 			} else {
-				subject = x509Data.Subject.String()
-				keyType = x509Data.PublicKeyAlgorithm.String()
+				data, err = ioutil.ReadFile(args[0])
+			}
+			errors.CheckError(err)
+			bundle, err := parseCertBundle(data)
+			errors.CheckError(err)
+
+			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
+			defer util.Close(conn)
+			created, err := certIf.CreateCertificate(context.Background(), &certificatepkg.RepositoryCertificateCreateRequest{
+				Certificates: &appsv1.RepositoryCertificateList{Items: bundle.repositoryCertificates()},
+				Upsert:       upsert,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Imported %d certificates from bundle with %d entries\n", len(created.Items), len(bundle.Certificates))
+		},
+	}
+	command.Flags().BoolVar(&upsert, "upsert", false, "Replace existing certificates if the data in the bundle is different")
+	return command
+}
+
+// NewCertRekeyCommand returns a new instance of an `argocd cert rekey` command
+func NewCertRekeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		newFromFile string
+	)
+	var command = &cobra.Command{
+		Use:   "rekey HOST --new-from FILE",
+		Short: "Start rotation of the SSH host keys of HOST by pinning the new keys alongside the old ones",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if newFromFile == "" {
+				errors.CheckError(fmt.Errorf("You need to specify --new-from, or specify --help for usage instructions"))
+			}
+			host := args[0]
+			knownHostsEntries, err := certutil.ParseSSHKnownHostsFromPath(newFromFile)
+			errors.CheckError(err)
+
+			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
+			defer util.Close(conn)
+			created, err := startSSHRekey(context.Background(), certIf, host, knownHostsEntries, time.Now())
+			errors.CheckError(err)
+			for _, c := range created {
+				fmt.Printf("Pinned new %s host key for %s alongside the old keys\n", c.CertSubType, sanitizeForDisplay(host))
+			}
+			fmt.Printf("Run 'argocd cert rekey-finish %s' once the rotation on the server is complete\n", sanitizeForDisplay(host))
+		},
+	}
+	command.Flags().StringVar(&newFromFile, "new-from", "", "Read the new SSH host keys of HOST from file in SSH known hosts format")
+	return command
+}
+
+// NewCertRekeyFinishCommand returns a new instance of an `argocd cert rekey-finish` command
+func NewCertRekeyFinishCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "rekey-finish HOST",
+		Short: "Finish rotation of the SSH host keys of HOST by removing the old keys",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
 			}
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.ServerName, c.CertType, strings.ToLower(keyType), subject)
+			host := args[0]
+			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
+			defer util.Close(conn)
+			removed, err := finishSSHRekey(context.Background(), certIf, host)
+			errors.CheckError(err)
+			for _, c := range removed {
+				fmt.Printf("Removed old %s host key for %s\n", c.CertSubType, sanitizeForDisplay(host))
+			}
+			fmt.Printf("Finished host key rotation for %s\n", sanitizeForDisplay(host))
+		},
+	}
+	return command
+}
+
+// Pins the SSH host keys for host found in knownHostsEntries in addition to
+// the keys currently pinned for host, so connections succeed with either of
+// them while the server rotates its keys. The new entries are annotated with
+// the fingerprints of the keys they replace, for finishSSHRekey to remove them
+// later on. The new keys may be of the same type as the old ones.
+func startSSHRekey(ctx context.Context, certIf certificatepkg.CertificateServiceClient, host string, knownHostsEntries []string, now time.Time) ([]appsv1.RepositoryCertificate, error) {
+	existing, err := certIf.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{HostNamePattern: certutil.EscapeHostNamePattern(host), CertType: "ssh"})
+	if err != nil {
+		return nil, err
+	}
+	if len(existing.Items) == 0 {
+		return nil, fmt.Errorf("No SSH host keys are pinned for '%s', use 'argocd cert add-ssh' instead", host)
+	}
+	oldFingerprints := make([]string, 0, len(existing.Items))
+	for _, c := range existing.Items {
+		if _, ok := c.Annotations[certutil.CertificateAnnotationRekeyReplaces]; ok {
+			return nil, fmt.Errorf("A host key rotation for '%s' is already in progress, finish it using 'argocd cert rekey-finish' first", host)
+		}
+		_, key, err := certutil.TokenizedDataToPublicKey(c.ServerName, c.CertSubType, string(c.CertData))
+		if err != nil {
+			return nil, err
 		}
+		oldFingerprints = append(oldFingerprints, "SHA256:"+certutil.SSHFingerprintSHA256(key))
 	}
-	_ = w.Flush()
+	sort.Strings(oldFingerprints)
+
+	annotations := map[string]string{
+		certutil.CertificateAnnotationRekeyReplaces:  strings.Join(oldFingerprints, ","),
+		certutil.CertificateAnnotationRekeyStartedAt: now.UTC().Format(time.RFC3339),
+	}
+	newCerts := make([]appsv1.RepositoryCertificate, 0)
+	for _, entry := range knownHostsEntries {
+		hostnames, certSubType, certData, err := certutil.TokenizeSSHKnownHostsEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		if !knownHostsEntryIsForHost(hostnames, host) {
+			continue
+		}
+		_, key, err := certutil.KnownHostsLineToPublicKey(entry)
+		if err != nil {
+			return nil, err
+		}
+		fingerprint := "SHA256:" + certutil.SSHFingerprintSHA256(key)
+		for _, old := range oldFingerprints {
+			if old == fingerprint {
+				return nil, fmt.Errorf("The %s host key %s is already pinned for '%s'", certSubType, fingerprint, host)
+			}
+		}
+		newCerts = append(newCerts, appsv1.RepositoryCertificate{
+			ServerName:  host,
+			CertType:    "ssh",
+			CertSubType: certSubType,
+			CertData:    certData,
+			Annotations: annotations,
+		})
+	}
+	if len(newCerts) == 0 {
+		return nil, fmt.Errorf("No SSH host keys for '%s' found in input", host)
+	}
+	created, err := certIf.CreateCertificate(ctx, &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{Items: newCerts},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created.Items, nil
+}
+
+// Removes the old SSH host keys of host replaced by a rotation started with
+// startSSHRekey by their fingerprint, and clears the rotation from the
+// annotations of the new keys. Returns the removed keys.
+func finishSSHRekey(ctx context.Context, certIf certificatepkg.CertificateServiceClient, host string) ([]appsv1.RepositoryCertificate, error) {
+	existing, err := certIf.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{HostNamePattern: certutil.EscapeHostNamePattern(host), CertType: "ssh"})
+	if err != nil {
+		return nil, err
+	}
+	replaced := make(map[string]bool)
+	newCerts := make([]appsv1.RepositoryCertificate, 0)
+	for _, c := range existing.Items {
+		replaces, ok := c.Annotations[certutil.CertificateAnnotationRekeyReplaces]
+		if !ok {
+			continue
+		}
+		for _, fingerprint := range strings.Split(replaces, ",") {
+			replaced[fingerprint] = true
+		}
+		annotations := make(map[string]string)
+		for k, v := range c.Annotations {
+			if k != certutil.CertificateAnnotationRekeyReplaces && k != certutil.CertificateAnnotationRekeyStartedAt {
+				annotations[k] = v
+			}
+		}
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		c.Annotations = annotations
+		newCerts = append(newCerts, c)
+	}
+	if len(newCerts) == 0 {
+		return nil, fmt.Errorf("No host key rotation for '%s' is in progress", host)
+	}
+
+	removed := make([]appsv1.RepositoryCertificate, 0)
+	for _, c := range existing.Items {
+		if _, ok := c.Annotations[certutil.CertificateAnnotationRekeyReplaces]; ok {
+			continue
+		}
+		_, key, err := certutil.TokenizedDataToPublicKey(c.ServerName, c.CertSubType, string(c.CertData))
+		if err != nil {
+			return removed, err
+		}
+		fingerprint := "SHA256:" + certutil.SSHFingerprintSHA256(key)
+		if !replaced[fingerprint] {
+			continue
+		}
+		deleted, err := certIf.DeleteCertificate(ctx, &certificatepkg.RepositoryCertificateQuery{HostNamePattern: certutil.EscapeHostNamePattern(host), CertType: "ssh", CertSubType: c.CertSubType, Fingerprint: fingerprint})
+		if err != nil {
+			return removed, err
+		}
+		removed = append(removed, deleted.Items...)
+	}
+	_, err = certIf.CreateCertificate(ctx, &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{Items: newCerts},
+		Upsert:       true,
+	})
+	return removed, err
+}
+
+// Returns true if the comma separated host names of a SSH known hosts entry
+// include host
+func knownHostsEntryIsForHost(hostnames string, host string) bool {
+	for _, name := range strings.Split(hostnames, ",") {
+		if name == host {
+			return true
+		}
+	}
+	return false
+}
+
+// NewCertSupportedTypesCommand returns a new instance of an `argocd cert supported-types` command
+func NewCertSupportedTypesCommand() *cobra.Command {
+	var (
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "supported-types",
+		Short: "List the key algorithms supported for SSH known hosts entries and TLS certificates",
+		Run: func(c *cobra.Command, args []string) {
+			errors.CheckError(validateCertOutputFormat(c, output))
+			errors.CheckError(printSupportedCertTypes(os.Stdout, output))
+		},
+	}
+	addCertOutputFlag(command, &output)
+	return command
+}
+
+// Key algorithms supported per certificate type
+type supportedCertTypes struct {
+	SSH   []string `json:"ssh"`
+	HTTPS []string `json:"https"`
+}
+
+// Returns the key algorithms supported per certificate type
+func getSupportedCertTypes() supportedCertTypes {
+	types := supportedCertTypes{SSH: certutil.SupportedSSHKeyAlgorithms, HTTPS: make([]string, 0, len(certutil.SupportedTLSPublicKeyAlgorithms))}
+	for _, algorithm := range certutil.SupportedTLSPublicKeyAlgorithms {
+		types.HTTPS = append(types.HTTPS, algorithm.String())
+	}
+	return types
+}
+
+// Print the supported key algorithms as table, or in given output format
+func printSupportedCertTypes(w io.Writer, output string) error {
+	types := getSupportedCertTypes()
+	if output == "json" {
+		data, err := json.MarshalIndent(types, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "TYPE\tALGORITHM\n")
+	for _, algorithm := range types.SSH {
+		fmt.Fprintf(tw, "ssh\t%s\n", algorithm)
+	}
+	for _, algorithm := range types.HTTPS {
+		fmt.Fprintf(tw, "https\t%s\n", algorithm)
+	}
+	return tw.Flush()
 }
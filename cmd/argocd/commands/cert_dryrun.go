@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// Outcome of a create request sent with --dry-run for a single entry
+type certDryRunResult struct {
+	action      string
+	certificate appsv1.RepositoryCertificate
+	err         error
+}
+
+// Adds the --dry-run flag shared by the cert commands adding certificates
+func addCertDryRunFlag(command *cobra.Command, dryRun *bool) {
+	command.Flags().BoolVar(dryRun, "dry-run", false, "Validate the certificates on the server and print what would change, without changing anything")
+}
+
+// Returns the key identifying the store entry a certificate is kept in. TLS
+// certificates are stored per server, SSH known hosts entries per server and
+// key type.
+func certStoreEntryKey(c appsv1.RepositoryCertificate) string {
+	if c.CertType == "ssh" {
+		return fmt.Sprintf("ssh %s %s", c.ServerName, c.CertSubType)
+	}
+	return fmt.Sprintf("%s %s", c.CertType, c.ServerName)
+}
+
+// Sends requests to the server as dry run, and returns what each entry of
+// the requests would change in the store. A request refused by the server
+// yields an error result for all of its entries.
+func dryRunCertificateRequests(ctx context.Context, certIf certificatepkg.CertificateServiceClient, requests []*certificatepkg.RepositoryCertificateCreateRequest) ([]certDryRunResult, error) {
+	existing, err := certIf.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{})
+	if err != nil {
+		return nil, err
+	}
+	var results []certDryRunResult
+	for _, request := range requests {
+		dryRunRequest := *request
+		dryRunRequest.DryRun = true
+		wouldCreate, err := certIf.CreateCertificate(ctx, &dryRunRequest)
+		if err != nil {
+			for _, c := range request.Certificates.Items {
+				results = append(results, certDryRunResult{action: "error", certificate: c, err: err})
+			}
+			continue
+		}
+		results = append(results, classifyCertDryRun(request.Certificates.Items, wouldCreate.Items, existing.Items)...)
+	}
+	return results, nil
+}
+
+// Returns whether each of the requested entries would be created, would
+// update an existing entry, or would leave the store unchanged, based on the
+// entries the server reported it would create.
+func classifyCertDryRun(requested []appsv1.RepositoryCertificate, wouldCreate []appsv1.RepositoryCertificate, existing []appsv1.RepositoryCertificate) []certDryRunResult {
+	changed := make(map[string]bool)
+	for _, c := range wouldCreate {
+		changed[certStoreEntryKey(c)] = true
+	}
+	present := make(map[string]bool)
+	for _, c := range existing {
+		present[certStoreEntryKey(c)] = true
+	}
+	results := make([]certDryRunResult, 0, len(requested))
+	for _, c := range requested {
+		key := certStoreEntryKey(c)
+		action := "unchanged"
+		if changed[key] {
+			if present[key] {
+				action = "update"
+			} else {
+				action = "create"
+			}
+		}
+		results = append(results, certDryRunResult{action: action, certificate: c})
+	}
+	return results
+}
+
+// Prints a table of the results of a dry run, followed by a summary. Returns
+// false if the server refused any of the entries.
+func printCertDryRunResults(w io.Writer, results []certDryRunResult) bool {
+	counts := make(map[string]int)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "ACTION\tTYPE\tSUBTYPE\tSERVERNAME\tERROR\n")
+	for _, r := range results {
+		counts[r.action]++
+		errMsg := ""
+		if r.err != nil {
+			errMsg = r.err.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", r.action, r.certificate.CertType, r.certificate.CertSubType, sanitizeForDisplay(r.certificate.ServerName), errMsg)
+	}
+	_ = tw.Flush()
+	fmt.Fprintf(w, "\nDry run: %d to create, %d to update, %d unchanged, %d refused. Nothing has been changed.\n", counts["create"], counts["update"], counts["unchanged"], counts["error"])
+	return counts["error"] == 0
+}
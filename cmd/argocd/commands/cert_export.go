@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/common"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/util/cert"
+)
+
+// Formats certificates can be exported in. Only bundles can be imported using
+// `argocd cert import`, the other formats are meant for use by other tools or
+// for declarative setups.
+var certExportFormats = []string{"bundle", "pem", "known_hosts", "configmap"}
+
+// Writes certs to w in the given export format
+func writeCertExport(w io.Writer, certs []appsv1.RepositoryCertificate, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case "bundle":
+		data, err = yaml.Marshal(newCertBundle(certs))
+	case "pem":
+		data = []byte(certsToPEMBundle(certs))
+	case "known_hosts":
+		data = []byte(certsToKnownHosts(certs))
+	case "configmap":
+		data, err = certsToConfigMaps(certs)
+	default:
+		err = fmt.Errorf("Unknown export format: %s, supported: %s", format, strings.Join(certExportFormats, ", "))
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Returns the PEM encoded TLS certificates of certs, each preceded by a
+// comment naming the server it is configured for. SSH known hosts entries are
+// left out.
+func certsToPEMBundle(certs []appsv1.RepositoryCertificate) string {
+	var sb strings.Builder
+	for _, c := range certs {
+		if c.CertType != "https" {
+			continue
+		}
+		fmt.Fprintf(&sb, "# %s\n%s\n", c.ServerName, strings.TrimSpace(string(c.CertData)))
+	}
+	return sb.String()
+}
+
+// Returns the SSH known hosts entries of certs in known_hosts format. TLS
+// certificates are left out.
+func certsToKnownHosts(certs []appsv1.RepositoryCertificate) string {
+	var sb strings.Builder
+	for _, c := range certs {
+		if c.CertType != "ssh" {
+			continue
+		}
+		fmt.Fprintf(&sb, "%s %s %s\n", c.ServerName, c.CertSubType, string(c.CertData))
+	}
+	return sb.String()
+}
+
+// Returns the certificate ConfigMaps holding certs as YAML documents, as used
+// in a declarative setup. A ConfigMap is only returned for the types of
+// certificates present in certs.
+func certsToConfigMaps(certs []appsv1.RepositoryCertificate) ([]byte, error) {
+	tlsData := make(map[string]string)
+	tlsAnnotations := make(map[string]map[string]string)
+	var knownHosts []string
+	knownHostsAnnotations := make(map[string]map[string]string)
+	knownHostsCount := make(map[string]int)
+	for _, c := range certs {
+		switch c.CertType {
+		case "https":
+			if existing, ok := tlsData[c.ServerName]; ok {
+				tlsData[c.ServerName] = existing + "\n" + strings.TrimSpace(string(c.CertData))
+			} else {
+				tlsData[c.ServerName] = strings.TrimSpace(string(c.CertData))
+			}
+			if len(c.Annotations) > 0 {
+				tlsAnnotations[c.ServerName] = c.Annotations
+			}
+		case "ssh":
+			knownHosts = append(knownHosts, fmt.Sprintf("%s %s %s", c.ServerName, c.CertSubType, string(c.CertData)))
+			knownHostsCount[certutil.SSHKnownHostsAnnotationsKey(c.ServerName, c.CertSubType)]++
+		}
+	}
+	// Several entries of the same host and key type, as pinned during a host
+	// key rotation, keep their annotations by fingerprint.
+	for _, c := range certs {
+		if c.CertType != "ssh" || len(c.Annotations) == 0 {
+			continue
+		}
+		key := certutil.SSHKnownHostsAnnotationsKey(c.ServerName, c.CertSubType)
+		if knownHostsCount[key] > 1 {
+			key = certutil.SSHKnownHostsFingerprintAnnotationsKey(c.ServerName, c.CertSubType, c.CertFingerprint)
+		}
+		knownHostsAnnotations[key] = c.Annotations
+	}
+
+	var configMaps []*corev1.ConfigMap
+	if len(knownHosts) > 0 {
+		sort.Strings(knownHosts)
+		cm, err := newCertConfigMap(common.ArgoCDKnownHostsConfigMapName, map[string]string{"ssh_known_hosts": strings.Join(knownHosts, "\n") + "\n"}, knownHostsAnnotations)
+		if err != nil {
+			return nil, err
+		}
+		configMaps = append(configMaps, cm)
+	}
+	if len(tlsData) > 0 {
+		for serverName, data := range tlsData {
+			tlsData[serverName] = data + "\n"
+		}
+		cm, err := newCertConfigMap(common.ArgoCDTLSCertsConfigMapName, tlsData, tlsAnnotations)
+		if err != nil {
+			return nil, err
+		}
+		configMaps = append(configMaps, cm)
+	}
+
+	documents := make([]string, 0, len(configMaps))
+	for _, cm := range configMaps {
+		data, err := yaml.Marshal(cm)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, string(data))
+	}
+	return []byte(strings.Join(documents, "---\n")), nil
+}
+
+// Returns a certificate ConfigMap with the given name and data, labeled like
+// the one installed by the Argo CD manifests
+func newCertConfigMap(name string, data map[string]string, certAnnotations map[string]map[string]string) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":    name,
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: data,
+	}
+	if len(certAnnotations) > 0 {
+		annotations, err := json.Marshal(certAnnotations)
+		if err != nil {
+			return nil, err
+		}
+		cm.Annotations = map[string]string{common.AnnotationKeyCertificateAnnotations: string(annotations)}
+	}
+	return cm, nil
+}
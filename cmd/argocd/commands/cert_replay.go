@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/errors"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// Version of the format requests are dumped in
+const certRequestDumpVersion = "argocd.argoproj.io/cert-requests/v1"
+
+// NewCertReplayCommand returns a new instance of an `argocd cert replay` command
+func NewCertReplayCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "replay PATH",
+		Short: "Send the requests dumped to PATH by a cert command using --dump-request",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			requests, err := loadCertRequests(args[0])
+			errors.CheckError(err)
+
+			conn, certIf := argocdclient.NewClientOrDie(clientOpts).NewCertClientOrDie()
+			defer util.Close(conn)
+			for _, request := range requests {
+				certificates, err := replayCertRequest(context.Background(), certIf, request)
+				errors.CheckError(err)
+				if request.Create != nil {
+					fmt.Printf("Created %d certificates\n", len(certificates.Items))
+				} else {
+					fmt.Printf("Removed %d certificates\n", len(certificates.Items))
+				}
+			}
+		},
+	}
+	return command
+}
+
+// Requests to the certificate service dumped by --dump-request
+type certRequestDump struct {
+	APIVersion string              `json:"apiVersion"`
+	Requests   []certDumpedRequest `json:"requests"`
+}
+
+// A single dumped request, of which exactly one field is set
+type certDumpedRequest struct {
+	Create *certificatepkg.RepositoryCertificateCreateRequest `json:"create,omitempty"`
+	Delete *certificatepkg.RepositoryCertificateQuery         `json:"delete,omitempty"`
+}
+
+// Adds the --dump-request flag shared by the cert commands changing the store
+func addCertDumpRequestFlag(command *cobra.Command, dumpRequest *string) {
+	command.Flags().StringVar(dumpRequest, "dump-request", "", "Write the requests sent to the server as JSON to given file before sending them, for use with 'argocd cert replay'")
+}
+
+// Writes the given requests to path, replacing any existing file
+func dumpCertRequests(path string, requests []certDumpedRequest) error {
+	data, err := json.MarshalIndent(certRequestDump{APIVersion: certRequestDumpVersion, Requests: requests}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeCertOutput(path, func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, string(data))
+		return err
+	})
+}
+
+// Writes the given create requests to path, replacing any existing file
+func dumpCertCreateRequests(path string, requests ...*certificatepkg.RepositoryCertificateCreateRequest) error {
+	dumped := make([]certDumpedRequest, 0, len(requests))
+	for _, request := range requests {
+		dumped = append(dumped, certDumpedRequest{Create: request})
+	}
+	return dumpCertRequests(path, dumped)
+}
+
+// Reads the requests dumped to path
+func loadCertRequests(path string) ([]certDumpedRequest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var dump certRequestDump
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return nil, fmt.Errorf("Could not parse dumped requests in '%s': %v", path, err)
+	}
+	if dump.APIVersion != certRequestDumpVersion {
+		return nil, fmt.Errorf("Unsupported version '%s' of dumped requests in '%s', supported: %s", dump.APIVersion, path, certRequestDumpVersion)
+	}
+	for i, request := range dump.Requests {
+		if (request.Create == nil) == (request.Delete == nil) {
+			return nil, fmt.Errorf("Request %d in '%s' must either be a create or a delete request", i+1, path)
+		}
+	}
+	return dump.Requests, nil
+}
+
+// Sends a dumped request to the server
+func replayCertRequest(ctx context.Context, certIf certificatepkg.CertificateServiceClient, request certDumpedRequest) (*appsv1.RepositoryCertificateList, error) {
+	if request.Create != nil {
+		return certIf.CreateCertificate(ctx, request.Create)
+	}
+	return certIf.DeleteCertificate(ctx, request.Delete)
+}
@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/errors"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+)
+
+// Name of the file holding the local telemetry of the cert commands, which is
+// stored in the same directory as the Argo CD config
+const certTelemetryFileName = "cert-stats.json"
+
+// Matches the status code of an error returned by a gRPC call
+var grpcErrorCodeRegexp = regexp.MustCompile(`rpc error: code = (\w+)`)
+
+// NewCertStatsCommand returns a new instance of an `argocd cert stats` command
+func NewCertStatsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		local bool
+	)
+	var command = &cobra.Command{
+		Use:   "stats --local",
+		Short: "Show the usage of cert commands recorded with --local-telemetry",
+		Run: func(c *cobra.Command, args []string) {
+			if !local {
+				errors.CheckError(fmt.Errorf("You need to specify --local, or specify --help for usage instructions"))
+			}
+			telemetry := newCertTelemetry(clientOpts.ConfigPath)
+			stats, err := telemetry.load()
+			errors.CheckError(err)
+			if len(stats.Invocations) == 0 && len(stats.Errors) == 0 {
+				fmt.Println("No usage has been recorded yet, enable recording using --local-telemetry")
+				return
+			}
+			fmt.Printf("Usage recorded in '%s':\n\n", telemetry.path)
+			printCertTelemetryStats(os.Stdout, stats)
+		},
+	}
+	command.Flags().BoolVar(&local, "local", false, "Show the usage recorded on this machine (mandatory flag)")
+	return command
+}
+
+// Counts of cert command invocations and of errors by category. Neither
+// arguments nor any data of the certificates are recorded.
+type certTelemetryStats struct {
+	Invocations map[string]int64 `json:"invocations"`
+	Errors      map[string]int64 `json:"errors"`
+}
+
+// Local store for the telemetry of the cert commands
+type certTelemetry struct {
+	path string
+}
+
+// Returns the telemetry store next to the Argo CD config at configPath
+func newCertTelemetry(configPath string) *certTelemetry {
+	return &certTelemetry{path: filepath.Join(filepath.Dir(configPath), certTelemetryFileName)}
+}
+
+// Loads the recorded stats. If nothing has been recorded yet, empty stats are
+// returned.
+func (t *certTelemetry) load() (*certTelemetryStats, error) {
+	stats := &certTelemetryStats{Invocations: make(map[string]int64), Errors: make(map[string]int64)}
+	data, err := ioutil.ReadFile(t.path)
+	if os.IsNotExist(err) {
+		return stats, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, stats); err != nil {
+		return nil, fmt.Errorf("Could not parse local telemetry in '%s': %v", t.path, err)
+	}
+	if stats.Invocations == nil {
+		stats.Invocations = make(map[string]int64)
+	}
+	if stats.Errors == nil {
+		stats.Errors = make(map[string]int64)
+	}
+	return stats, nil
+}
+
+// Loads the recorded stats, applies update to them and saves them again
+func (t *certTelemetry) update(update func(stats *certTelemetryStats)) error {
+	stats, err := t.load()
+	if err != nil {
+		return err
+	}
+	update(stats)
+	data, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.path), 0700); err != nil {
+		return err
+	}
+	return writeCertOutput(t.path, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// Records an invocation of the cert command with the given name
+func (t *certTelemetry) recordInvocation(command string) error {
+	return t.update(func(stats *certTelemetryStats) {
+		stats.Invocations[command]++
+	})
+}
+
+// Records an error of the given category
+func (t *certTelemetry) recordError(category string) error {
+	return t.update(func(stats *certTelemetryStats) {
+		stats.Errors[category]++
+	})
+}
+
+// Records the invocation of command, and registers a hook recording the
+// category of the error the command might fail with.
+func enableCertTelemetry(telemetry *certTelemetry, command string) {
+	if err := telemetry.recordInvocation(command); err != nil {
+		log.Warnf("Could not record local telemetry: %v", err)
+		return
+	}
+	log.AddHook(&certTelemetryHook{telemetry: telemetry})
+}
+
+// Returns the category of an error message for the telemetry, which is the
+// gRPC status code for errors returned by the server. The message itself is
+// never recorded, since it might contain host names or certificate data.
+func certErrorCategory(message string) string {
+	if match := grpcErrorCodeRegexp.FindStringSubmatch(message); match != nil {
+		return match[1]
+	}
+	return "Other"
+}
+
+// Hook recording the category of fatal errors, which are logged by
+// errors.CheckError right before the command exits
+type certTelemetryHook struct {
+	telemetry *certTelemetry
+}
+
+func (h *certTelemetryHook) Levels() []log.Level {
+	return []log.Level{log.FatalLevel}
+}
+
+func (h *certTelemetryHook) Fire(entry *log.Entry) error {
+	return h.telemetry.recordError(certErrorCategory(entry.Message))
+}
+
+// Print tables of the recorded invocations and errors
+func printCertTelemetryStats(w io.Writer, stats *certTelemetryStats) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "COMMAND\tINVOCATIONS\n")
+	for _, name := range sortedCertTelemetryKeys(stats.Invocations) {
+		fmt.Fprintf(tw, "cert %s\t%d\n", name, stats.Invocations[name])
+	}
+	fmt.Fprintf(tw, "\nERROR\tCOUNT\n")
+	for _, name := range sortedCertTelemetryKeys(stats.Errors) {
+		fmt.Fprintf(tw, "%s\t%d\n", name, stats.Errors[name])
+	}
+	_ = tw.Flush()
+}
+
+func sortedCertTelemetryKeys(counts map[string]int64) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
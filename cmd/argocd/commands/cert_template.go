@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+	"time"
+
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/util/cert"
+)
+
+// Returns the functions available to templates given to `cert list -o
+// template`, with now being the time expiry is computed against
+func certTemplateFuncs(x509Cache *certutil.X509CertificateCache, now time.Time) template.FuncMap {
+	return template.FuncMap{
+		// Time left until the https certificate expires, which is negative
+		// for expired certificates
+		"expiresIn": func(c appsv1.RepositoryCertificate) (time.Duration, error) {
+			notAfter, err := certTemplateNotAfter(x509Cache, c)
+			if err != nil {
+				return 0, err
+			}
+			return notAfter.Sub(now), nil
+		},
+		// Whether the https certificate has expired. SSH known hosts entries
+		// never expire.
+		"isExpired": func(c appsv1.RepositoryCertificate) (bool, error) {
+			if c.CertType == "ssh" {
+				return false, nil
+			}
+			notAfter, err := certTemplateNotAfter(x509Cache, c)
+			if err != nil {
+				return false, err
+			}
+			return now.After(notAfter), nil
+		},
+		// Fingerprint of the certificate in the same format as printed by
+		// `cert list --fingerprint-only`
+		"fingerprint": func(c appsv1.RepositoryCertificate) string {
+			return newCertTableRow(x509Cache, c, now).fingerprint
+		},
+	}
+}
+
+// Returns the end of the validity period of an https certificate
+func certTemplateNotAfter(x509Cache *certutil.X509CertificateCache, c appsv1.RepositoryCertificate) (time.Time, error) {
+	if c.CertType != "https" {
+		return time.Time{}, fmt.Errorf("Entry for '%s' of type %s has no expiry, only https certificates expire", c.ServerName, c.CertType)
+	}
+	x509Data, err := x509Cache.DecodePEMCertificateToX509(string(c.CertData))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Could not decode certificate for '%s': %v", c.ServerName, err)
+	}
+	return x509Data.NotAfter, nil
+}
+
+// Renders certs using the go template text, which is executed once against
+// the whole sorted list of certificates
+func printCertTemplate(w io.Writer, x509Cache *certutil.X509CertificateCache, certs []appsv1.RepositoryCertificate, sortOrder string, text string, now time.Time) error {
+	tmpl, err := template.New("cert list").Funcs(certTemplateFuncs(x509Cache, now)).Parse(text)
+	if err != nil {
+		return fmt.Errorf("Could not parse template: %v", err)
+	}
+	sortCertificates(certs, sortOrder)
+	return tmpl.Execute(w, certs)
+}
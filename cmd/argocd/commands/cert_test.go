@@ -0,0 +1,1718 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/common"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/util/cert"
+)
+
+// Starts a minimal SSH server on a random local port, which presents signer as
+// its host key. Returns the server's address and the fingerprint of the key.
+func startTestSSHServer(t *testing.T) (string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	assert.NoError(t, err)
+
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _, _, _ = ssh.NewServerConn(conn, config)
+				_ = conn.Close()
+			}()
+		}
+	}()
+	return listener.Addr().String(), certutil.SSHFingerprintSHA256(signer.PublicKey())
+}
+
+func TestScanSSHHostsFromFile(t *testing.T) {
+	addr1, fp1 := startTestSSHServer(t)
+	addr2, fp2 := startTestSSHServer(t)
+
+	hostsFile, err := ioutil.TempFile("", "argocd-hosts")
+	assert.NoError(t, err)
+	defer os.Remove(hostsFile.Name())
+	_, err = fmt.Fprintf(hostsFile, "# test servers\n%s\n\n%s\n", addr1, addr2)
+	assert.NoError(t, err)
+	assert.NoError(t, hostsFile.Close())
+
+	entries, err := scanSSHHostsFromFile(hostsFile.Name(), rate.NewLimiter(rate.Inf, 1), 5*time.Second)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	fingerprints := make([]string, 0)
+	for _, entry := range entries {
+		hostname, subType, certData, err := certutil.TokenizeSSHKnownHostsEntry(entry)
+		assert.NoError(t, err)
+		assert.Equal(t, ssh.KeyAlgoECDSA256, subType)
+		_, pubKey, err := certutil.TokenizedDataToPublicKey(hostname, subType, string(certData))
+		assert.NoError(t, err)
+		fingerprints = append(fingerprints, certutil.SSHFingerprintSHA256(pubKey))
+	}
+	assert.ElementsMatch(t, []string{fp1, fp2}, fingerprints)
+}
+
+func TestScanSSHHostsFromFile_UnreachableHost(t *testing.T) {
+	addr, _ := startTestSSHServer(t)
+
+	// Grab a free port and close the listener again so nothing is listening
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	unreachable := listener.Addr().String()
+	assert.NoError(t, listener.Close())
+
+	hostsFile, err := ioutil.TempFile("", "argocd-hosts")
+	assert.NoError(t, err)
+	defer os.Remove(hostsFile.Name())
+	_, err = fmt.Fprintf(hostsFile, "%s\n%s\n", unreachable, addr)
+	assert.NoError(t, err)
+	assert.NoError(t, hostsFile.Close())
+
+	entries, err := scanSSHHostsFromFile(hostsFile.Name(), rate.NewLimiter(rate.Inf, 1), 5*time.Second)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestScanSSHHosts(t *testing.T) {
+	addr, fp := startTestSSHServer(t)
+
+	t.Run("Local", func(t *testing.T) {
+		entries, err := scanSSHHosts(context.Background(), nil, []string{addr}, false, 5*time.Second)
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 1) {
+			var out bytes.Buffer
+			assert.NoError(t, printSSHHostKeyFingerprints(&out, entries))
+			assert.Contains(t, out.String(), "SHA256:"+fp)
+			assert.Contains(t, out.String(), ssh.KeyAlgoECDSA256)
+		}
+	})
+
+	t.Run("FromServer", func(t *testing.T) {
+		certIf := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{
+			{ServerName: "git.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+		}}
+		entries, err := scanSSHHosts(context.Background(), certIf, []string{"git.example.com"}, true, 5*time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"git.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"}, entries)
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		unreachable := listener.Addr().String()
+		assert.NoError(t, listener.Close())
+		_, err = scanSSHHosts(context.Background(), nil, []string{addr, unreachable}, false, 5*time.Second)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), unreachable)
+		}
+	})
+}
+
+func TestFilterHashedCertificates(t *testing.T) {
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "github.com", CertType: "ssh", CertSubType: "ssh-rsa"},
+		{ServerName: "|1|/s6tHGtkI4wQPtnAobD85v/i1xM=|f3YsaG5ySaRrKA7ocOxmhkX4vbY=", CertType: "ssh", CertSubType: "ssh-rsa"},
+		{ServerName: "|1|2Zbg3Xvs2nLJoF8VGmVn4yjQfgE=|z2ZLrkZ2jNmBJ0UDYTmUUCa2tBk=", CertType: "ssh", CertSubType: "ecdsa-sha2-nistp256"},
+		{ServerName: "gitlab.com", CertType: "https", CertSubType: "rsa"},
+	}
+
+	// Hashed entries are summarized when hidden
+	filtered, numHashed := filterHashedCertificates(certs, false)
+	assert.Equal(t, 2, numHashed)
+	if assert.Len(t, filtered, 2) {
+		assert.Equal(t, "github.com", filtered[0].ServerName)
+		assert.Equal(t, "gitlab.com", filtered[1].ServerName)
+	}
+
+	// All entries are shown when flag is set
+	filtered, numHashed = filterHashedCertificates(certs, true)
+	assert.Equal(t, 0, numHashed)
+	assert.Len(t, filtered, 4)
+}
+
+func TestFormatRotateBy(t *testing.T) {
+	certData, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	now := time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	// The certificate itself is still valid
+	x509Cert, err := certutil.DecodePEMCertificateToX509(string(certData))
+	assert.NoError(t, err)
+	assert.True(t, x509Cert.NotAfter.After(now))
+
+	cert := appsv1.RepositoryCertificate{ServerName: "localhost", CertType: "https", CertData: certData}
+	assert.Equal(t, "-", formatRotateBy(cert, now))
+
+	cert.Annotations = map[string]string{certutil.CertificateAnnotationRotateBy: "2020-01-01"}
+	assert.Equal(t, "2020-01-01 (overdue)", formatRotateBy(cert, now))
+
+	cert.Annotations = map[string]string{certutil.CertificateAnnotationRotateBy: "2020-02-01"}
+	assert.Equal(t, "2020-02-01", formatRotateBy(cert, now))
+
+	cert.Annotations = map[string]string{certutil.CertificateAnnotationRotateBy: "soon"}
+	assert.Equal(t, "soon (invalid)", formatRotateBy(cert, now))
+}
+
+func TestSanitizeForDisplay(t *testing.T) {
+	// Printable unicode is kept, decomposed characters are normalized
+	assert.Equal(t, "CN=Müller GmbH,O=株式会社", sanitizeForDisplay("CN=Mu\u0308ller GmbH,O=株式会社"))
+	// Fullwidth characters are folded to their narrow form
+	assert.Equal(t, "CN=ACME", sanitizeForDisplay("CN=ＡＣＭＥ"))
+	// Control characters, bidi controls and invalid UTF-8 are escaped
+	assert.Equal(t, `CN=evil\x1b[2J\x0a\x09\u202e\xff`, sanitizeForDisplay("CN=evil\x1b[2J\n\t\u202e\xff"))
+
+	// Wide characters which cannot be folded keep the columns of a table
+	// aligned, since cells are padded by display width
+	assert.Equal(t, 14, displayWidth("株式会社テスト"))
+	var out bytes.Buffer
+	printAlignedTable(&out, [][]string{
+		{"SUBJECT", "ISSUER"},
+		{sanitizeForDisplay("O=株式会社テスト"), "O=ACME"},
+		{sanitizeForDisplay("O=Mu\u0308ller GmbH"), "O=ACME"},
+	})
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if assert.Len(t, lines, 3) {
+		for _, line := range lines {
+			issuer := strings.LastIndex(line, " ") + 1
+			assert.Equal(t, 18, displayWidth(line[:issuer]), line)
+		}
+	}
+}
+
+func TestDiffCertificatesAgainstLive(t *testing.T) {
+	server := httptest.NewTLSServer(nil)
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+	host, port, err := net.SplitHostPort(serverURL.Host)
+	assert.NoError(t, err)
+
+	otherCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	liveCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	liveFingerprint := certutil.TLSCertificateFingerprintSHA256(server.Certificate())
+
+	t.Run("Mismatch", func(t *testing.T) {
+		results := diffCertificatesAgainstLive([]appsv1.RepositoryCertificate{
+			{ServerName: host, CertType: "https", CertData: otherCert},
+		}, port, 5*time.Second)
+		if assert.Len(t, results, 1) {
+			x509Cert, err := certutil.DecodePEMCertificateToX509(string(otherCert))
+			assert.NoError(t, err)
+			assert.Equal(t, certDiffStatusMismatch, results[0].Status)
+			assert.Equal(t, []string{certutil.TLSCertificateFingerprintSHA256(x509Cert)}, results[0].PinnedFingerprints)
+			assert.Equal(t, liveFingerprint, results[0].LiveFingerprint)
+		}
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		results := diffCertificatesAgainstLive([]appsv1.RepositoryCertificate{
+			{ServerName: host, CertType: "https", CertData: otherCert},
+			{ServerName: host, CertType: "https", CertData: liveCert},
+		}, port, 5*time.Second)
+		if assert.Len(t, results, 1) {
+			assert.Equal(t, certDiffStatusOK, results[0].Status)
+			assert.Len(t, results[0].PinnedFingerprints, 2)
+		}
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		_, closedPort, _ := net.SplitHostPort(listener.Addr().String())
+		_ = listener.Close()
+		results := diffCertificatesAgainstLive([]appsv1.RepositoryCertificate{
+			{ServerName: "127.0.0.1:" + closedPort, CertType: "https", CertData: liveCert},
+		}, port, 5*time.Second)
+		if assert.Len(t, results, 1) {
+			assert.Equal(t, certDiffStatusUnknown, results[0].Status)
+			assert.Error(t, results[0].Error)
+		}
+		assert.Equal(t, 0, certDiffExitCode(results, false))
+		assert.Equal(t, 1, certDiffExitCode(results, true))
+	})
+}
+
+func TestCertDiffExitCode(t *testing.T) {
+	ok := certDiffResult{ServerName: "ok.example.com", Status: certDiffStatusOK}
+	mismatch := certDiffResult{ServerName: "mismatch.example.com", Status: certDiffStatusMismatch}
+	unknown := certDiffResult{ServerName: "unknown.example.com", Status: certDiffStatusUnknown}
+
+	assert.Equal(t, 0, certDiffExitCode([]certDiffResult{ok}, false))
+	assert.Equal(t, 0, certDiffExitCode([]certDiffResult{ok}, true))
+	assert.Equal(t, 1, certDiffExitCode([]certDiffResult{ok, mismatch}, false))
+	assert.Equal(t, 1, certDiffExitCode([]certDiffResult{ok, mismatch}, true))
+	assert.Equal(t, 0, certDiffExitCode([]certDiffResult{ok, unknown}, false))
+	assert.Equal(t, 1, certDiffExitCode([]certDiffResult{ok, unknown}, true))
+}
+
+func TestPrintCertTable(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}
+
+	var out bytes.Buffer
+	printCertTable(&out, certutil.NewX509CertificateCache(), certs, "", nil)
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if assert.Len(t, lines, 3) {
+		assert.Equal(t, []string{"HOSTNAME", "TYPE", "SUBTYPE", "FINGERPRINT/SUBJECT", "ROTATE-BY"}, strings.Fields(lines[0]))
+		assert.Equal(t, []string{"gitlab.com", "ssh", "ssh-ed25519", "SHA256:eUXGGm1YGsMAS7vkcx6JOJdOGHPem5gQp4taiCfCLB8", "-"}, strings.Fields(lines[1]))
+		assert.Equal(t, []string{"localhost", "https", "rsa", "CN=localhost", "-"}, strings.Fields(lines[2]))
+	}
+
+	out.Reset()
+	printCertTable(&out, certutil.NewX509CertificateCache(), certs, "type", nil)
+	lines = strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if assert.Len(t, lines, 3) {
+		assert.True(t, strings.HasPrefix(lines[1], "localhost"))
+		assert.True(t, strings.HasPrefix(lines[2], "gitlab.com"))
+	}
+}
+
+func TestPrintCertTableColumns(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}
+
+	columns, err := parseCertListColumns("subject, host,fingerprint,type")
+	assert.NoError(t, err)
+	var out bytes.Buffer
+	printCertTable(&out, certutil.NewX509CertificateCache(), certs, "", columns)
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if assert.Len(t, lines, 3) {
+		assert.Equal(t, []string{"SUBJECT", "HOSTNAME", "FINGERPRINT", "TYPE"}, strings.Fields(lines[0]))
+		assert.Equal(t, []string{"-", "gitlab.com", "SHA256:eUXGGm1YGsMAS7vkcx6JOJdOGHPem5gQp4taiCfCLB8", "ssh"}, strings.Fields(lines[1]))
+		fields := strings.Fields(lines[2])
+		if assert.Len(t, fields, 4) {
+			assert.Equal(t, "CN=localhost", fields[0])
+			assert.Equal(t, "localhost", fields[1])
+			assert.True(t, strings.HasPrefix(fields[2], "SHA256:"))
+			assert.Equal(t, "https", fields[3])
+		}
+	}
+
+	columns, err = parseCertListColumns("")
+	assert.NoError(t, err)
+	assert.Equal(t, certListDefaultColumns, columns)
+
+	_, err = parseCertListColumns("host,owner")
+	assert.Error(t, err)
+}
+
+func TestPrintReconcileStatus(t *testing.T) {
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Fresh", func(t *testing.T) {
+		var buf bytes.Buffer
+		printReconcileStatus(&buf, now.Add(-2*time.Minute), now, 10*time.Minute)
+		assert.Equal(t, "Last reconciled: 2019-10-01T11:58:00Z (2m0s ago)\n", buf.String())
+	})
+
+	t.Run("Stale", func(t *testing.T) {
+		var buf bytes.Buffer
+		printReconcileStatus(&buf, now.Add(-time.Hour), now, 10*time.Minute)
+		assert.Contains(t, buf.String(), "Last reconciled: 2019-10-01T11:00:00Z (1h0m0s ago)")
+		assert.Contains(t, buf.String(), "WARNING: certificate store reconciliation appears stalled")
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		var buf bytes.Buffer
+		printReconcileStatus(&buf, time.Time{}, now, 10*time.Minute)
+		assert.Contains(t, buf.String(), "Last reconciled: unknown")
+		assert.Contains(t, buf.String(), "WARNING")
+	})
+}
+
+func TestTLSCertificatesForServerNames(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	certificateArray, err := certutil.ParseTLSCertificatesFromData(string(tlsCert))
+	assert.NoError(t, err)
+
+	entries := tlsCertificatesForServerNames([]string{"git.example.com", "git-mirror.example.com"}, certificateArray, nil)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, "git.example.com", entries[0].ServerName)
+		assert.Equal(t, "git-mirror.example.com", entries[1].ServerName)
+		for _, entry := range entries {
+			assert.Equal(t, "https", entry.CertType)
+		}
+		assert.Equal(t, entries[0].CertData, entries[1].CertData)
+		assert.NotEmpty(t, entries[0].CertData)
+	}
+}
+
+func TestDedupeSSHKnownHostsAgainstStore(t *testing.T) {
+	const ed25519Key = "AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"
+	const ecdsaKey = "AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBFSMqzJeV9rUzU4kWitGjeR4PWSa29SPqJ1fVkhtj3Hw9xjLVXVYrU9QlYWrOLXBpQ6KWjbjTDTdDkoohFzgbEY="
+	store := []appsv1.RepositoryCertificate{
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(ed25519Key)},
+	}
+	certs := []appsv1.RepositoryCertificate{
+		// Already present in the store
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(ed25519Key)},
+		// Same host, different key type
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ecdsa-sha2-nistp256", CertData: []byte(ecdsaKey)},
+		// Same key, different host
+		{ServerName: "gitlab.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(ed25519Key)},
+	}
+
+	remaining, numKnown := dedupeSSHKnownHostsAgainstStore(certs, store)
+	assert.Equal(t, 1, numKnown)
+	if assert.Len(t, remaining, 2) {
+		assert.Equal(t, "ecdsa-sha2-nistp256", remaining[0].CertSubType)
+		assert.Equal(t, "gitlab.example.com", remaining[1].ServerName)
+	}
+
+	remaining, numKnown = dedupeSSHKnownHostsAgainstStore(certs, nil)
+	assert.Equal(t, 0, numKnown)
+	assert.Len(t, remaining, 3)
+}
+
+// Creates a self-signed certificate for commonName, issued by organization
+// org, and returns it in PEM format.
+func createTestCertificatePEM(t testing.TB, commonName string, org string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{org}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestFilterCertificatesByIssuerOrg(t *testing.T) {
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "a.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "a.example.com", "DigiCert Inc")},
+		{ServerName: "b.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "b.example.com", "Let's Encrypt")},
+		{ServerName: "c.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "c.example.com", "DigiCert Inc")},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}
+
+	filtered := filterCertificatesByIssuerOrg(certutil.NewX509CertificateCache(), certs, "DigiCert Inc")
+	if assert.Len(t, filtered, 2) {
+		assert.Equal(t, "a.example.com", filtered[0].ServerName)
+		assert.Equal(t, "c.example.com", filtered[1].ServerName)
+	}
+
+	filtered = filterCertificatesByIssuerOrg(certutil.NewX509CertificateCache(), certs, "Let*")
+	if assert.Len(t, filtered, 1) {
+		assert.Equal(t, "b.example.com", filtered[0].ServerName)
+	}
+
+	assert.Empty(t, filterCertificatesByIssuerOrg(certutil.NewX509CertificateCache(), certs, "Unknown CA"))
+}
+
+func TestParseTLSCertificatesFromStreamWithTimeout(t *testing.T) {
+	t.Run("NoInput", func(t *testing.T) {
+		reader, writer := io.Pipe()
+		defer func() { _ = writer.Close() }()
+		start := time.Now()
+		_, err := parseTLSCertificatesFromStreamWithTimeout(reader, 100*time.Millisecond)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "No input received within 100ms")
+		assert.True(t, time.Since(start) < 5*time.Second)
+	})
+
+	t.Run("Input", func(t *testing.T) {
+		tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+		assert.NoError(t, err)
+		certificates, err := parseTLSCertificatesFromStreamWithTimeout(bytes.NewReader(tlsCert), 5*time.Second)
+		assert.NoError(t, err)
+		assert.Len(t, certificates, 1)
+	})
+}
+
+func TestPrintCertTableCheckName(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	certs := []appsv1.RepositoryCertificate{
+		// The certificate is only valid for localhost and 127.0.0.1
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert},
+		{ServerName: "git.example.com", CertType: "https", CertData: tlsCert},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}
+
+	columns := appendCertListColumn([]string{"host", "type"}, "valid-for-host")
+	var out bytes.Buffer
+	printCertTable(&out, certutil.NewX509CertificateCache(), certs, "", columns)
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if assert.Len(t, lines, 4) {
+		assert.Equal(t, []string{"HOSTNAME", "TYPE", "VALID-FOR-HOST"}, strings.Fields(lines[0]))
+		assert.Equal(t, []string{"git.example.com", "https", "false"}, strings.Fields(lines[1]))
+		assert.Equal(t, []string{"gitlab.com", "ssh", "-"}, strings.Fields(lines[2]))
+		assert.Equal(t, []string{"localhost", "https", "true"}, strings.Fields(lines[3]))
+	}
+
+	assert.Equal(t, columns, appendCertListColumn(columns, "valid-for-host"))
+}
+
+func TestPrivateKeyInputRejected(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+
+	for _, blockType := range []string{"EC PRIVATE KEY", "PRIVATE KEY", "RSA PRIVATE KEY"} {
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+		keyBody := strings.Split(string(keyPEM), "\n")[1]
+		input := append(append([]byte{}, tlsCert...), keyPEM...)
+
+		certificates, err := parseTLSCertificatesFromStreamWithTimeout(bytes.NewReader(input), 0)
+		assert.Equal(t, certutil.ErrPrivateKeyData, err, blockType)
+		assert.Nil(t, certificates)
+
+		var out bytes.Buffer
+		printPrivateKeyWarning(&out)
+		assert.Contains(t, out.String(), "this looks like a private key")
+		assert.NotContains(t, out.String(), keyBody)
+		assert.NotContains(t, err.Error(), keyBody)
+	}
+}
+
+func TestInlineSSHKnownHostsEntry(t *testing.T) {
+	const ed25519Key = "AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"
+
+	entry, err := inlineSSHKnownHostsEntry("gitlab.com", "ssh-ed25519", ed25519Key)
+	assert.NoError(t, err)
+	assert.Equal(t, "gitlab.com ssh-ed25519 "+ed25519Key, entry)
+
+	keyFile, err := ioutil.TempFile("", "argocd-ssh-key")
+	assert.NoError(t, err)
+	defer os.Remove(keyFile.Name())
+	_, err = keyFile.WriteString(ed25519Key + "\n")
+	assert.NoError(t, err)
+	_ = keyFile.Close()
+	entry, err = inlineSSHKnownHostsEntry("gitlab.com", "ssh-ed25519", "@"+keyFile.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, "gitlab.com ssh-ed25519 "+ed25519Key, entry)
+
+	_, err = inlineSSHKnownHostsEntry("gitlab.com", "ssh-ed25519", "invalid")
+	assert.Error(t, err)
+	_, err = inlineSSHKnownHostsEntry("gitlab.com", "", ed25519Key)
+	assert.Error(t, err)
+}
+
+func TestParseInlineTLSCertificates(t *testing.T) {
+	certFile := "../../../test/fixture/certs/argocd-test-server.crt"
+	tlsCert, err := ioutil.ReadFile(certFile)
+	assert.NoError(t, err)
+
+	certificates, err := parseInlineTLSCertificates(string(tlsCert))
+	assert.NoError(t, err)
+	assert.Len(t, certificates, 1)
+
+	certificates, err = parseInlineTLSCertificates("@" + certFile)
+	assert.NoError(t, err)
+	assert.Len(t, certificates, 1)
+
+	_, err = parseInlineTLSCertificates("not a certificate")
+	assert.Error(t, err)
+	_, err = parseInlineTLSCertificates("-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n")
+	assert.Error(t, err)
+}
+
+func TestPrintCertFingerprints(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	x509Cert, err := certutil.DecodePEMCertificateToX509(string(tlsCert))
+	assert.NoError(t, err)
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}
+
+	var out bytes.Buffer
+	printCertFingerprints(&out, certutil.NewX509CertificateCache(), certs, "")
+	assert.Equal(t, "SHA256:eUXGGm1YGsMAS7vkcx6JOJdOGHPem5gQp4taiCfCLB8\nSHA256:"+certutil.TLSCertificateFingerprintSHA256(x509Cert)+"\n", out.String())
+}
+
+func TestCertificateTypes(t *testing.T) {
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519"},
+		{ServerName: "gitlab.com", CertType: "https"},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-rsa"},
+	}
+	assert.Equal(t, []string{"https", "ssh"}, certificateTypes(certs))
+	assert.Equal(t, []string{"ssh"}, certificateTypes(certs[2:]))
+	assert.Empty(t, certificateTypes(nil))
+}
+
+func TestCheckCertificateChainDepth(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	chain := func(length int) []string {
+		certificateArray, err := certutil.ParseTLSCertificatesFromData(strings.Repeat(string(tlsCert), length))
+		assert.NoError(t, err)
+		assert.Len(t, certificateArray, length)
+		return certificateArray
+	}
+
+	assert.NoError(t, checkCertificateChainDepth(chain(3), 3))
+	assert.NoError(t, checkCertificateChainDepth(chain(10), 10))
+	err = checkCertificateChainDepth(chain(11), 10)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "exceeds the maximum chain depth of 10")
+	}
+	assert.NoError(t, checkCertificateChainDepth(chain(11), 0))
+}
+
+func TestCertBundle(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert},
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"), Annotations: map[string]string{"rotate-by": "2020-01-01"}},
+	}
+
+	t.Run("CurrentVersion", func(t *testing.T) {
+		data, err := yaml.Marshal(newCertBundle(certs))
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "apiVersion: "+certBundleAPIVersion)
+
+		bundle, err := parseCertBundle(data)
+		assert.NoError(t, err)
+		imported := bundle.repositoryCertificates()
+		if assert.Len(t, imported, 2) {
+			assert.Equal(t, "localhost", imported[0].ServerName)
+			assert.Equal(t, string(tlsCert)+string(tlsCert), string(imported[0].CertData))
+			assert.Equal(t, certs[2], imported[1])
+		}
+	})
+
+	t.Run("FutureVersion", func(t *testing.T) {
+		_, err := parseCertBundle([]byte("apiVersion: " + certBundleAPIGroup + "/v99\nkind: CertificateBundle\ncertificates: []\n"))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "Please upgrade")
+		}
+	})
+
+	t.Run("BogusVersion", func(t *testing.T) {
+		_, err := parseCertBundle([]byte("apiVersion: bogus/v1\nkind: CertificateBundle\ncertificates: []\n"))
+		assert.Error(t, err)
+		_, err = parseCertBundle([]byte("certificates: []\n"))
+		assert.Error(t, err)
+		_, err = parseCertBundle([]byte("apiVersion: " + certBundleAPIVersion + "\nkind: Other\n"))
+		assert.Error(t, err)
+	})
+}
+
+// An in-memory certificate service, standing in for an Argo CD instance
+type fakeCertServiceClient struct {
+	certs     []appsv1.RepositoryCertificate
+	failWrite bool
+}
+
+func fakeCertKey(c appsv1.RepositoryCertificate) string {
+	if c.CertType == "https" {
+		return c.ServerName + " https"
+	}
+	return c.ServerName + " " + c.CertType + " " + c.CertSubType
+}
+
+func (f *fakeCertServiceClient) matches(c appsv1.RepositoryCertificate, q *certificatepkg.RepositoryCertificateQuery) bool {
+	return certutil.MatchHostName(c.ServerName, q.HostNamePattern) &&
+		(q.CertType == "" || q.CertType == c.CertType) &&
+		(q.CertSubType == "" || q.CertSubType == c.CertSubType) &&
+		(q.Fingerprint == "" || q.Fingerprint == newCertTableRow(certutil.NewX509CertificateCache(), c, time.Now()).fingerprint)
+}
+
+func (f *fakeCertServiceClient) ListCertificates(ctx context.Context, q *certificatepkg.RepositoryCertificateQuery, opts ...grpc.CallOption) (*appsv1.RepositoryCertificateList, error) {
+	list := &appsv1.RepositoryCertificateList{}
+	for _, c := range f.certs {
+		if f.matches(c, q) {
+			list.Items = append(list.Items, c)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeCertServiceClient) CountCertificates(ctx context.Context, q *certificatepkg.RepositoryCertificateQuery, opts ...grpc.CallOption) (*certificatepkg.RepositoryCertificateCountResponse, error) {
+	list, _ := f.ListCertificates(ctx, q)
+	return certificatepkg.CountCertificates(list.Items), nil
+}
+
+func (f *fakeCertServiceClient) GetCertificateStoreStatus(ctx context.Context, q *certificatepkg.RepositoryCertificateStoreStatusQuery, opts ...grpc.CallOption) (*certificatepkg.RepositoryCertificateStoreStatus, error) {
+	return &certificatepkg.RepositoryCertificateStoreStatus{}, nil
+}
+
+func (f *fakeCertServiceClient) ScanSSHHostKeys(ctx context.Context, q *certificatepkg.SSHHostKeyScanRequest, opts ...grpc.CallOption) (*appsv1.RepositoryCertificateList, error) {
+	list := &appsv1.RepositoryCertificateList{}
+	for _, c := range f.certs {
+		if c.CertType == "ssh" && c.ServerName == q.Address {
+			list.Items = append(list.Items, c)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeCertServiceClient) CreateCertificate(ctx context.Context, req *certificatepkg.RepositoryCertificateCreateRequest, opts ...grpc.CallOption) (*appsv1.RepositoryCertificateList, error) {
+	if f.failWrite {
+		return nil, fmt.Errorf("connection refused")
+	}
+	if req.DryRun {
+		saved := append([]appsv1.RepositoryCertificate{}, f.certs...)
+		defer func() { f.certs = saved }()
+	}
+	for _, item := range req.Certificates.Items {
+		replaced := false
+		for i := range f.certs {
+			// Like the server, the new key of a host key rotation is pinned
+			// alongside the old key of the same type
+			if _, ok := item.Annotations[certutil.CertificateAnnotationRekeyReplaces]; ok && string(f.certs[i].CertData) != string(item.CertData) {
+				continue
+			}
+			if fakeCertKey(f.certs[i]) == fakeCertKey(item) {
+				if !req.Upsert {
+					return nil, fmt.Errorf("%s already exists", fakeCertKey(item))
+				}
+				f.certs[i] = item
+				replaced = true
+			}
+		}
+		if !replaced {
+			f.certs = append(f.certs, item)
+		}
+	}
+	return req.Certificates, nil
+}
+
+func (f *fakeCertServiceClient) DeleteCertificate(ctx context.Context, q *certificatepkg.RepositoryCertificateQuery, opts ...grpc.CallOption) (*appsv1.RepositoryCertificateList, error) {
+	if f.failWrite {
+		return nil, fmt.Errorf("connection refused")
+	}
+	removed := &appsv1.RepositoryCertificateList{}
+	remaining := make([]appsv1.RepositoryCertificate, 0)
+	for _, c := range f.certs {
+		if f.matches(c, q) {
+			removed.Items = append(removed.Items, c)
+		} else {
+			remaining = append(remaining, c)
+		}
+	}
+	f.certs = remaining
+	return removed, nil
+}
+
+func TestCertMirror(t *testing.T) {
+	ctx := context.Background()
+	existing := appsv1.RepositoryCertificate{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("old")}
+	replacement := appsv1.RepositoryCertificate{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("new")}
+	added := appsv1.RepositoryCertificate{ServerName: "github.com", CertType: "ssh", CertSubType: "ssh-rsa", CertData: []byte("added")}
+	request := &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{Items: []appsv1.RepositoryCertificate{replacement, added}},
+		Upsert:       true,
+	}
+
+	// Runs the create request against primary and mirrors it
+	apply := func(primary *fakeCertServiceClient, mirror *certMirror) error {
+		snapshot, err := mirror.snapshot(ctx, primary)
+		assert.NoError(t, err)
+		_, err = primary.CreateCertificate(ctx, request)
+		assert.NoError(t, err)
+		return mirror.createCertificates(ctx, primary, request, snapshot)
+	}
+
+	t.Run("CreateLandsOnBoth", func(t *testing.T) {
+		primary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{existing}}
+		secondary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{existing}}
+		assert.NoError(t, apply(primary, &certMirror{name: "standby", client: secondary}))
+		assert.ElementsMatch(t, []appsv1.RepositoryCertificate{replacement, added}, primary.certs)
+		assert.ElementsMatch(t, []appsv1.RepositoryCertificate{replacement, added}, secondary.certs)
+	})
+
+	t.Run("MirrorFailureKeepsPrimaryChange", func(t *testing.T) {
+		primary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{existing}}
+		secondary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{existing}, failWrite: true}
+		assert.Error(t, apply(primary, &certMirror{name: "standby", client: secondary}))
+		assert.ElementsMatch(t, []appsv1.RepositoryCertificate{replacement, added}, primary.certs)
+	})
+
+	t.Run("AtomicMirrorFailureRestoresPrimary", func(t *testing.T) {
+		primary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{existing}}
+		secondary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{existing}, failWrite: true}
+		assert.Error(t, apply(primary, &certMirror{name: "standby", client: secondary, atomic: true}))
+		assert.Equal(t, []appsv1.RepositoryCertificate{existing}, primary.certs)
+	})
+
+	t.Run("DeleteLandsOnBoth", func(t *testing.T) {
+		primary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{existing, added}}
+		secondary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{existing, added}}
+		query := &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "gitlab.com"}
+		removed, err := primary.DeleteCertificate(ctx, query)
+		assert.NoError(t, err)
+		assert.NoError(t, (&certMirror{name: "standby", client: secondary}).deleteCertificates(ctx, primary, query, removed.Items))
+		assert.Equal(t, []appsv1.RepositoryCertificate{added}, primary.certs)
+		assert.Equal(t, []appsv1.RepositoryCertificate{added}, secondary.certs)
+	})
+
+	t.Run("AtomicDeleteFailureRestoresPrimary", func(t *testing.T) {
+		// the annotations of the removed entries are restored along with them
+		annotated := existing
+		annotated.Annotations = map[string]string{certutil.CertificateAnnotationRotateBy: "2020-01-01", certutil.CertificateAnnotationRekeyReplaces: "SHA256:foo"}
+		primary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{annotated, added}}
+		secondary := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{annotated, added}, failWrite: true}
+		query := &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "gitlab.com"}
+		removed, err := primary.DeleteCertificate(ctx, query)
+		assert.NoError(t, err)
+		assert.Error(t, (&certMirror{name: "standby", client: secondary, atomic: true}).deleteCertificates(ctx, primary, query, removed.Items))
+		assert.ElementsMatch(t, []appsv1.RepositoryCertificate{annotated, added}, primary.certs)
+	})
+
+	t.Run("NoMirror", func(t *testing.T) {
+		var mirror *certMirror
+		snapshot, err := mirror.snapshot(ctx, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, snapshot)
+		assert.NoError(t, mirror.createCertificates(ctx, nil, request, nil))
+	})
+}
+
+func TestPrintCertList(t *testing.T) {
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"), Annotations: map[string]string{"rotate-by": "2020-01-01"}},
+	}
+
+	t.Run("Summary", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, printCertList(&out, certutil.NewX509CertificateCache(), certs, "", "json", false))
+		var summaries []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(out.Bytes(), &summaries))
+		if assert.Len(t, summaries, 1) {
+			assert.Equal(t, "gitlab.com", summaries[0]["servername"])
+			assert.Equal(t, "SHA256:eUXGGm1YGsMAS7vkcx6JOJdOGHPem5gQp4taiCfCLB8", summaries[0]["certfingerprint"])
+			assert.NotContains(t, summaries[0], "annotations")
+			assert.NotContains(t, summaries[0], "certdata")
+			assert.NotContains(t, summaries[0], "expiry")
+		}
+	})
+
+	t.Run("SummaryWithExpiry", func(t *testing.T) {
+		tlsCerts := []appsv1.RepositoryCertificate{
+			{ServerName: "git.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "git.example.com", "Test")},
+		}
+		x509Data, err := certutil.DecodePEMCertificateToX509(string(tlsCerts[0].CertData))
+		assert.NoError(t, err)
+		var out bytes.Buffer
+		assert.NoError(t, printCertList(&out, certutil.NewX509CertificateCache(), tlsCerts, "", "yaml", false))
+		var summaries []certListSummary
+		assert.NoError(t, yaml.Unmarshal(out.Bytes(), &summaries))
+		if assert.Len(t, summaries, 1) {
+			assert.Equal(t, "SHA256:"+certutil.TLSCertificateFingerprintSHA256(x509Data), summaries[0].CertFingerprint)
+			assert.Equal(t, x509Data.NotAfter.UTC().Format(time.RFC3339), summaries[0].Expiry)
+		}
+	})
+
+	t.Run("IncludeMetadata", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, printCertList(&out, certutil.NewX509CertificateCache(), certs, "", "json", true))
+		var full []appsv1.RepositoryCertificate
+		assert.NoError(t, json.Unmarshal(out.Bytes(), &full))
+		assert.Equal(t, certs, full)
+
+		out.Reset()
+		assert.NoError(t, printCertList(&out, certutil.NewX509CertificateCache(), certs, "", "yaml", true))
+		assert.Contains(t, out.String(), "annotations:")
+		assert.Contains(t, out.String(), "rotate-by: \"2020-01-01\"")
+		assert.Contains(t, out.String(), "certdata:")
+	})
+
+	assert.Error(t, printCertList(&bytes.Buffer{}, certutil.NewX509CertificateCache(), certs, "", "xml", false))
+}
+
+func TestWriteCertOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cert-output")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "certs.txt")
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}
+
+	t.Run("WritesRendering", func(t *testing.T) {
+		assert.NoError(t, writeCertOutput(path, func(w io.Writer) error {
+			printCertTable(w, certutil.NewX509CertificateCache(), certs, "", nil)
+			return nil
+		}))
+		var expected bytes.Buffer
+		printCertTable(&expected, certutil.NewX509CertificateCache(), certs, "", nil)
+		data, err := ioutil.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, expected.String(), string(data))
+		info, err := os.Stat(path)
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+	})
+
+	t.Run("KeepsMode", func(t *testing.T) {
+		assert.NoError(t, os.Chmod(path, 0640))
+		assert.NoError(t, writeCertOutput(path, func(w io.Writer) error {
+			printCertTable(w, certutil.NewX509CertificateCache(), certs, "", nil)
+			return nil
+		}))
+		info, err := os.Stat(path)
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0640), info.Mode().Perm())
+	})
+
+	t.Run("RenderedAtomically", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(path, []byte("previous output\n"), 0644))
+		err := writeCertOutput(path, func(w io.Writer) error {
+			_, _ = fmt.Fprintln(w, "partial output")
+			// While rendering, the target must still hold the previous output
+			data, err := ioutil.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, "previous output\n", string(data))
+			return fmt.Errorf("rendering failed")
+		})
+		assert.EqualError(t, err, "rendering failed")
+		data, err := ioutil.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "previous output\n", string(data))
+
+		// No temporary files must be left behind
+		files, err := ioutil.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+	})
+}
+
+func TestParseBase64DERCertificate(t *testing.T) {
+	pemData := createTestCertificatePEM(t, "git.example.com", "Example Inc")
+	expected, err := certutil.DecodePEMCertificateToX509(string(pemData))
+	assert.NoError(t, err)
+	derData := base64.StdEncoding.EncodeToString(expected.Raw)
+
+	certificateArray, err := parseBase64DERCertificateFromStream(strings.NewReader(derData + "\n"))
+	assert.NoError(t, err)
+	entries := tlsCertificatesForServerNames([]string{"git.example.com"}, certificateArray, nil)
+	if assert.Len(t, entries, 1) {
+		assert.True(t, strings.HasPrefix(string(entries[0].CertData), "-----BEGIN CERTIFICATE-----"))
+		stored, err := certutil.DecodePEMCertificateToX509(string(entries[0].CertData))
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Raw, stored.Raw)
+	}
+
+	// PEM data must not be accepted as base64 DER, even though PEM is base64
+	// encoded DER with armor.
+	_, err = parseInlineBase64DERCertificate(string(pemData))
+	assert.Error(t, err)
+}
+
+func TestCertDiffReport(t *testing.T) {
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "a.example.com", CertType: "https"},
+		{ServerName: "b.example.com", CertType: "https"},
+		{ServerName: "c.example.com", CertType: "https"},
+	}
+	dir, err := ioutil.TempDir("", "cert-diff")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	hostsFile := filepath.Join(dir, "hosts")
+	assert.NoError(t, ioutil.WriteFile(hostsFile, []byte("# fleet\na.example.com\n\nb.example.com\nd.example.com\ne.example.com\n"), 0644))
+	hosts, err := readCertDiffHostsFile(hostsFile)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.example.com", "b.example.com", "d.example.com", "e.example.com"}, hosts)
+
+	selected, unpinned := certificatesForCertDiffHosts(certs, hosts)
+	assert.Equal(t, certs[:2], selected)
+	if assert.Len(t, unpinned, 2) {
+		assert.Equal(t, "d.example.com", unpinned[0].ServerName)
+		assert.Equal(t, certDiffStatusUnknown, unpinned[0].Status)
+	}
+
+	results := append([]certDiffResult{
+		{ServerName: "a.example.com", Status: certDiffStatusOK, PinnedFingerprints: []string{"fp-a"}, LiveFingerprint: "fp-a"},
+		{ServerName: "b.example.com", Status: certDiffStatusMismatch, PinnedFingerprints: []string{"fp-b"}, LiveFingerprint: "fp-other"},
+	}, unpinned...)
+	report := newCertDiffReport(results)
+	assert.Equal(t, certDiffSummary{OK: 1, Mismatched: 1, Unknown: 2}, report.Summary)
+	if assert.Len(t, report.Results, 4) {
+		assert.Equal(t, certDiffReportEntry{Host: "a.example.com", Status: "OK", PinnedFingerprints: []string{"SHA256:fp-a"}, LiveFingerprint: "SHA256:fp-a"}, report.Results[0])
+		assert.Equal(t, certDiffReportEntry{Host: "b.example.com", Status: "MISMATCH", PinnedFingerprints: []string{"SHA256:fp-b"}, LiveFingerprint: "SHA256:fp-other"}, report.Results[1])
+		assert.Equal(t, certDiffReportEntry{Host: "e.example.com", Status: "UNKNOWN", PinnedFingerprints: []string{}, Error: "no certificates pinned"}, report.Results[3])
+	}
+
+	data, err := json.Marshal(report)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"summary":{"ok":1,"mismatched":1,"unknown":2}`)
+
+	var out bytes.Buffer
+	printCertDiffTable(&out, results)
+	assert.True(t, strings.HasSuffix(out.String(), "1 ok, 1 mismatched, 2 unknown\n"))
+}
+
+func TestSSHRekey(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	oldKey := appsv1.RepositoryCertificate{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ecdsa-sha2-nistp256", CertData: []byte("AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBFSMqzJeV9rUzU4kWitGjeR4PWSa29SPqJ1fVkhtj3Hw9xjLVXVYrU9QlYWrOLXBpQ6KWjbjTDTdDkoohFzgbEY=")}
+	other := appsv1.RepositoryCertificate{ServerName: "github.com", CertType: "ssh", CertSubType: "ecdsa-sha2-nistp256", CertData: oldKey.CertData}
+	newEntries := []string{
+		"gitlab.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf",
+		"github.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf",
+	}
+	certIf := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{oldKey, other}}
+	oldFingerprint := newCertTableRow(certutil.NewX509CertificateCache(), oldKey, now).fingerprint
+
+	_, err := finishSSHRekey(ctx, certIf, "gitlab.com")
+	assert.EqualError(t, err, "No host key rotation for 'gitlab.com' is in progress")
+
+	// Both the old and the new key are pinned during the rotation
+	created, err := startSSHRekey(ctx, certIf, "gitlab.com", newEntries, now)
+	assert.NoError(t, err)
+	if assert.Len(t, created, 1) {
+		assert.Equal(t, "ssh-ed25519", created[0].CertSubType)
+		assert.Equal(t, map[string]string{"rekey-replaces": oldFingerprint, "rekey-started-at": "2019-10-01T12:00:00Z"}, created[0].Annotations)
+	}
+	pinned, err := certIf.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "gitlab.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ecdsa-sha2-nistp256", "ssh-ed25519"}, certificateSubTypes(pinned.Items))
+
+	_, err = startSSHRekey(ctx, certIf, "gitlab.com", newEntries, now)
+	assert.Error(t, err, "rotation already in progress")
+	_, err = startSSHRekey(ctx, certIf, "github.com", []string{"github.com " + oldKey.CertSubType + " " + string(oldKey.CertData)}, now)
+	assert.EqualError(t, err, "The ecdsa-sha2-nistp256 host key "+oldFingerprint+" is already pinned for 'github.com'")
+
+	// Only the new key remains after finishing the rotation
+	removed, err := finishSSHRekey(ctx, certIf, "gitlab.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []appsv1.RepositoryCertificate{oldKey}, removed)
+	pinned, err = certIf.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "gitlab.com"})
+	assert.NoError(t, err)
+	if assert.Len(t, pinned.Items, 1) {
+		assert.Equal(t, "ssh-ed25519", pinned.Items[0].CertSubType)
+		assert.Nil(t, pinned.Items[0].Annotations)
+	}
+
+	// Other hosts are not touched
+	pinned, err = certIf.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "github.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, []appsv1.RepositoryCertificate{other}, pinned.Items)
+}
+
+func TestSSHRekeySameType(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	oldKey := appsv1.RepositoryCertificate{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")}
+	newKey := appsv1.RepositoryCertificate{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAICalOEEnLR6jXHuLey9aSiFKJ4x4MAsugYpirpcAnpA2")}
+	certIf := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{oldKey}}
+
+	// The new key is pinned as a second entry of the same type
+	_, err := startSSHRekey(ctx, certIf, "gitlab.com", []string{"gitlab.com ssh-ed25519 " + string(newKey.CertData)}, now)
+	assert.NoError(t, err)
+	assert.Len(t, certIf.certs, 2)
+
+	// Only the old key is removed, by its fingerprint
+	removed, err := finishSSHRekey(ctx, certIf, "gitlab.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []appsv1.RepositoryCertificate{oldKey}, removed)
+	assert.Equal(t, []appsv1.RepositoryCertificate{newKey}, certIf.certs)
+}
+
+func certificateSubTypes(certs []appsv1.RepositoryCertificate) []string {
+	subTypes := make([]string, 0, len(certs))
+	for _, c := range certs {
+		subTypes = append(subTypes, c.CertSubType)
+	}
+	sort.Strings(subTypes)
+	return subTypes
+}
+
+func TestFindDuplicateCertFingerprints(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	sshKey := []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: sshKey},
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert},
+		{ServerName: "git.example.com", CertType: "https", CertData: tlsCert},
+		{ServerName: "gitlab-mirror.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: sshKey},
+		{ServerName: "unique.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "unique.example.com", "Example Inc")},
+	}
+
+	groups := findDuplicateCertFingerprints(certutil.NewX509CertificateCache(), certs)
+	if assert.Len(t, groups, 2) {
+		fingerprints := make(map[string][]string)
+		for _, group := range groups {
+			hosts := make([]string, 0)
+			for _, c := range group.certs {
+				hosts = append(hosts, c.ServerName)
+			}
+			fingerprints[group.fingerprint] = hosts
+		}
+		assert.Equal(t, []string{"gitlab-mirror.example.com", "gitlab.com"}, fingerprints["SHA256:eUXGGm1YGsMAS7vkcx6JOJdOGHPem5gQp4taiCfCLB8"])
+		x509Cert, err := certutil.DecodePEMCertificateToX509(string(tlsCert))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"git.example.com", "localhost"}, fingerprints["SHA256:"+certutil.TLSCertificateFingerprintSHA256(x509Cert)])
+	}
+
+	var out bytes.Buffer
+	printCertFingerprintGroups(&out, groups)
+	assert.Contains(t, out.String(), "gitlab-mirror.example.com (ssh), gitlab.com (ssh)")
+	assert.Contains(t, out.String(), "git.example.com (https), localhost (https)")
+	assert.NotContains(t, out.String(), "unique.example.com")
+
+	out.Reset()
+	printCertFingerprintGroups(&out, findDuplicateCertFingerprints(certutil.NewX509CertificateCache(), certs[:2]))
+	assert.Equal(t, "No duplicate fingerprints found\n", out.String())
+}
+
+func TestValidateCertOutputFormat(t *testing.T) {
+	certCommand := NewCertCommand(&argocdclient.ClientOptions{})
+	commandsWithOutput := make([]string, 0)
+	for _, command := range certCommand.Commands() {
+		flag := command.Flags().Lookup("output")
+		if flag == nil {
+			continue
+		}
+		commandsWithOutput = append(commandsWithOutput, command.Name())
+		formats, ok := certOutputFormats[command.Name()]
+		if !assert.True(t, ok, "output formats of %s are not registered", command.Name()) {
+			continue
+		}
+		assert.Contains(t, flag.Usage, strings.Join(formats, ", "))
+		assert.NoError(t, validateCertOutputFormat(command, ""))
+		for _, format := range formats {
+			assert.NoError(t, validateCertOutputFormat(command, format))
+		}
+		assert.EqualError(t, validateCertOutputFormat(command, "jsonpath"), "Unknown output format: jsonpath, supported: "+strings.Join(formats, ", "))
+	}
+	sort.Strings(commandsWithOutput)
+	assert.Equal(t, []string{"diff", "list", "supported-types"}, commandsWithOutput)
+
+	diff, _, err := certCommand.Find([]string{"diff"})
+	assert.NoError(t, err)
+	assert.EqualError(t, validateCertOutputFormat(diff, "yaml"), "Unknown output format: yaml, supported: json")
+}
+
+func TestCertTelemetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cert-telemetry")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	configPath := filepath.Join(dir, "config")
+	logger := log.StandardLogger()
+	hooks := logger.Hooks
+	logger.Hooks = make(log.LevelHooks)
+	defer func() { logger.Hooks = hooks }()
+
+	runCertCommand := func(args ...string) {
+		clientOpts := &argocdclient.ClientOptions{ConfigPath: configPath}
+		command := NewCertCommand(clientOpts)
+		assert.NoError(t, command.ParseFlags(args[1:]))
+		subCommand, _, err := command.Find(args[:1])
+		assert.NoError(t, err)
+		assert.NoError(t, subCommand.ParseFlags(args[1:]))
+		command.PersistentPreRun(subCommand, nil)
+	}
+
+	// Nothing is recorded unless enabled
+	runCertCommand("list")
+	_, err = os.Stat(filepath.Join(dir, certTelemetryFileName))
+	assert.True(t, os.IsNotExist(err))
+
+	runCertCommand("list", "--local-telemetry")
+	runCertCommand("list", "--local-telemetry")
+	runCertCommand("add-tls", "--local-telemetry")
+	telemetry := newCertTelemetry(configPath)
+	stats, err := telemetry.load()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"list": 2, "add-tls": 1}, stats.Invocations)
+
+	hook := &certTelemetryHook{telemetry: telemetry}
+	assert.NoError(t, hook.Fire(&log.Entry{Message: "rpc error: code = PermissionDenied desc = permission denied: certificates, create"}))
+	assert.NoError(t, hook.Fire(&log.Entry{Message: "open /tmp/does-not-exist.pem: no such file or directory"}))
+	stats, err = telemetry.load()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"PermissionDenied": 1, "Other": 1}, stats.Errors)
+
+	data, err := ioutil.ReadFile(telemetry.path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "does-not-exist")
+
+	var out bytes.Buffer
+	printCertTelemetryStats(&out, stats)
+	assert.Contains(t, out.String(), "cert list     2")
+}
+
+func TestTLSCertificatesPinnedFor(t *testing.T) {
+	ctx := context.Background()
+	certIf := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{
+		{ServerName: "git.example.com", CertType: "https", CertData: []byte("pinned")},
+		{ServerName: "ssh.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("key")},
+	}}
+
+	pinned, err := tlsCertificatesPinnedFor(ctx, certIf, []string{"new.example.com", "git.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"git.example.com"}, pinned)
+
+	// SSH known hosts entries are no TLS pins, and server names are matched
+	// literally rather than as patterns
+	pinned, err = tlsCertificatesPinnedFor(ctx, certIf, []string{"new.example.com", "ssh.example.com", "*.example.com"})
+	assert.NoError(t, err)
+	assert.Empty(t, pinned)
+}
+
+// A certificate service client recording all requests it receives
+type recordingCertServiceClient struct {
+	fakeCertServiceClient
+	creates []*certificatepkg.RepositoryCertificateCreateRequest
+	deletes []*certificatepkg.RepositoryCertificateQuery
+}
+
+func (r *recordingCertServiceClient) CreateCertificate(ctx context.Context, req *certificatepkg.RepositoryCertificateCreateRequest, opts ...grpc.CallOption) (*appsv1.RepositoryCertificateList, error) {
+	r.creates = append(r.creates, req)
+	return r.fakeCertServiceClient.CreateCertificate(ctx, req, opts...)
+}
+
+func (r *recordingCertServiceClient) DeleteCertificate(ctx context.Context, q *certificatepkg.RepositoryCertificateQuery, opts ...grpc.CallOption) (*appsv1.RepositoryCertificateList, error) {
+	r.deletes = append(r.deletes, q)
+	return r.fakeCertServiceClient.DeleteCertificate(ctx, q, opts...)
+}
+
+func TestCertRequestDumpReplay(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "cert-dump")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	create := &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{Items: []appsv1.RepositoryCertificate{
+			{ServerName: "git.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "git.example.com", "Example Inc"), Annotations: map[string]string{"rotate-by": "2020-01-01"}},
+		}},
+		Upsert: true,
+	}
+	remove := &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "gitlab.com", CertType: "ssh", CertSubType: "ssh-rsa"}
+	createPath := filepath.Join(dir, "create.json")
+	removePath := filepath.Join(dir, "remove.json")
+	assert.NoError(t, dumpCertCreateRequests(createPath, create))
+	assert.NoError(t, dumpCertRequests(removePath, []certDumpedRequest{{Delete: remove}}))
+
+	certIf := &recordingCertServiceClient{}
+	for _, path := range []string{createPath, removePath} {
+		requests, err := loadCertRequests(path)
+		assert.NoError(t, err)
+		for _, request := range requests {
+			_, err := replayCertRequest(ctx, certIf, request)
+			assert.NoError(t, err)
+		}
+	}
+	assert.Equal(t, []*certificatepkg.RepositoryCertificateCreateRequest{create}, certIf.creates)
+	assert.Equal(t, []*certificatepkg.RepositoryCertificateQuery{remove}, certIf.deletes)
+
+	t.Run("Invalid", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		assert.NoError(t, ioutil.WriteFile(path, []byte(`{"apiVersion":"argocd.argoproj.io/cert-requests/v2","requests":[]}`), 0644))
+		_, err := loadCertRequests(path)
+		assert.Error(t, err)
+		assert.NoError(t, ioutil.WriteFile(path, []byte(`{"apiVersion":"argocd.argoproj.io/cert-requests/v1","requests":[{}]}`), 0644))
+		_, err = loadCertRequests(path)
+		assert.Error(t, err)
+	})
+}
+
+func TestCertListPager(t *testing.T) {
+	getenv := func(pager string) func(string) string {
+		return func(key string) string {
+			if key == "PAGER" {
+				return pager
+			}
+			return ""
+		}
+	}
+	assert.Equal(t, "more", certListPager(false, true, getenv("more")))
+	if _, err := exec.LookPath("less"); err == nil {
+		assert.Equal(t, "less", certListPager(false, true, getenv("")))
+	} else {
+		assert.Equal(t, "", certListPager(false, true, getenv("")))
+	}
+	assert.Equal(t, "", certListPager(false, false, getenv("more")), "no pager when not writing to a terminal")
+	assert.Equal(t, "", certListPager(true, true, getenv("more")), "no pager with --no-pager")
+
+	render := func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, "HOSTNAME")
+		return err
+	}
+
+	t.Run("Paged", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, writeThroughPager("sed 's/^/paged: /'", &out, render))
+		assert.Equal(t, "paged: HOSTNAME\n", out.String())
+	})
+
+	t.Run("NotPaged", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, writeThroughPager("", &out, render))
+		assert.Equal(t, "HOSTNAME\n", out.String())
+	})
+}
+
+func TestPrintSupportedCertTypes(t *testing.T) {
+	var out bytes.Buffer
+	assert.NoError(t, printSupportedCertTypes(&out, ""))
+	for _, line := range []string{"ssh    ssh-rsa", "ssh    ssh-ed25519", "ssh    ecdsa-sha2-nistp256", "https  RSA", "https  ECDSA"} {
+		assert.Contains(t, out.String(), line+"\n")
+	}
+
+	out.Reset()
+	assert.NoError(t, printSupportedCertTypes(&out, "json"))
+	var types supportedCertTypes
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &types))
+	assert.Contains(t, types.SSH, "ssh-ed25519")
+	assert.Contains(t, types.SSH, "ssh-rsa")
+	assert.Contains(t, types.HTTPS, "RSA")
+	assert.Contains(t, types.HTTPS, "ECDSA")
+}
+
+func TestFormatCreatedCertificatesSummary(t *testing.T) {
+	assert.Equal(t, "2 created, 0 already present", formatCreatedCertificatesSummary(2, 2))
+	assert.Equal(t, "0 created, 2 already present", formatCreatedCertificatesSummary(2, 0))
+	assert.Equal(t, "1 created, 1 already present", formatCreatedCertificatesSummary(2, 1))
+}
+
+func TestCertBundleMetadataRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	source := &fakeCertServiceClient{certs: []appsv1.RepositoryCertificate{
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert, Annotations: map[string]string{
+			certutil.CertificateAnnotationRotateBy: "2020-01-01",
+			"comment":                              "internal git server, ask the infra team before rotating",
+		}},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"), Annotations: map[string]string{
+			certutil.CertificateAnnotationRekeyReplaces:  "ecdsa-sha2-nistp256",
+			certutil.CertificateAnnotationRekeyStartedAt: "2019-10-01T12:00:00Z",
+		}},
+		{ServerName: "github.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}}
+
+	exported, err := source.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{})
+	assert.NoError(t, err)
+	data, err := yaml.Marshal(newCertBundle(exported.Items))
+	assert.NoError(t, err)
+
+	bundle, err := parseCertBundle(data)
+	assert.NoError(t, err)
+	target := &fakeCertServiceClient{}
+	_, err = target.CreateCertificate(ctx, &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{Items: bundle.repositoryCertificates()},
+	})
+	assert.NoError(t, err)
+
+	// All annotations of the source arrive unchanged in the target store
+	assert.Equal(t, source.certs, target.certs)
+}
+
+func TestPrintCertTemplate(t *testing.T) {
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "b.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "b.example.com", "Test")},
+		{ServerName: "a.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "a.example.com", "Test")},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}
+	expired := `{{range .}}{{if isExpired .}}{{.ServerName}}{{"\n"}}{{end}}{{end}}`
+
+	t.Run("IsExpired", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, printCertTemplate(&out, certutil.NewX509CertificateCache(), certs, "hostname", expired, time.Now()))
+		assert.Empty(t, out.String())
+
+		out.Reset()
+		assert.NoError(t, printCertTemplate(&out, certutil.NewX509CertificateCache(), certs, "hostname", expired, time.Now().Add(2*time.Hour)))
+		assert.Equal(t, "a.example.com\nb.example.com\n", out.String())
+	})
+
+	t.Run("ExpiresInAndFingerprint", func(t *testing.T) {
+		var out bytes.Buffer
+		text := `{{range .}}{{if eq .CertType "https"}}{{if lt (expiresIn .).Hours 2.0}}{{.ServerName}} {{fingerprint .}}{{"\n"}}{{end}}{{end}}{{end}}`
+		cert := appsv1.RepositoryCertificate{ServerName: "a.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "a.example.com", "Test")}
+		assert.NoError(t, printCertTemplate(&out, certutil.NewX509CertificateCache(), []appsv1.RepositoryCertificate{cert}, "", text, time.Now()))
+		assert.Equal(t, "a.example.com "+newCertTableRow(certutil.NewX509CertificateCache(), cert, time.Now()).fingerprint+"\n", out.String())
+	})
+
+	t.Run("NoExpiryForSSH", func(t *testing.T) {
+		var out bytes.Buffer
+		ssh := []appsv1.RepositoryCertificate{{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")}}
+		err := printCertTemplate(&out, certutil.NewX509CertificateCache(), ssh, "", `{{range .}}{{expiresIn .}}{{end}}`, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		err := printCertTemplate(ioutil.Discard, certutil.NewX509CertificateCache(), certs, "", `{{range .}`, time.Now())
+		assert.Error(t, err)
+	})
+}
+
+func TestFindCertRemovalBlockers(t *testing.T) {
+	tlsCert := createTestCertificatePEM(t, "git.example.com", "Test")
+	all := []appsv1.RepositoryCertificate{
+		{ServerName: "git.example.com", CertType: "https", CertData: tlsCert},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519"},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ecdsa-sha2-nistp256"},
+		{ServerName: "[ssh.example.com]:2222", CertType: "ssh", CertSubType: "ssh-ed25519"},
+	}
+	repos := []appsv1.Repository{
+		{Repo: "https://git.example.com/org/repo.git"},
+		{Repo: "git@gitlab.com:org/repo.git"},
+		{Repo: "ssh://git@ssh.example.com:2222/org/repo.git"},
+	}
+
+	t.Run("OnlyPinOfRepoHost", func(t *testing.T) {
+		blockers := findCertRemovalBlockers(all[0:1], all, repos)
+		assert.Equal(t, []certRemovalBlocker{{repoURL: "https://git.example.com/org/repo.git", certType: "https", serverName: "git.example.com"}}, blockers)
+
+		blockers = findCertRemovalBlockers(all[3:4], all, repos)
+		assert.Equal(t, []certRemovalBlocker{{repoURL: "ssh://git@ssh.example.com:2222/org/repo.git", certType: "ssh", serverName: "[ssh.example.com]:2222"}}, blockers)
+	})
+
+	t.Run("AllPinsOfRepoHost", func(t *testing.T) {
+		blockers := findCertRemovalBlockers(all[1:3], all, repos)
+		assert.Equal(t, []certRemovalBlocker{{repoURL: "git@gitlab.com:org/repo.git", certType: "ssh", serverName: "gitlab.com"}}, blockers)
+	})
+
+	t.Run("OtherPinLeft", func(t *testing.T) {
+		assert.Empty(t, findCertRemovalBlockers(all[1:2], all, repos))
+	})
+
+	t.Run("InsecureRepo", func(t *testing.T) {
+		insecure := []appsv1.Repository{{Repo: "https://git.example.com/org/repo.git", Insecure: true}}
+		assert.Empty(t, findCertRemovalBlockers(all[0:1], all, insecure))
+	})
+
+	t.Run("NoRepoOnHost", func(t *testing.T) {
+		assert.Empty(t, findCertRemovalBlockers(all[0:1], all, repos[1:]))
+	})
+}
+
+func TestCertExpiry(t *testing.T) {
+	now := time.Now()
+	notAfter := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(d))
+		return &t
+	}
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "expired.example.com", CertType: "https", NotAfter: notAfter(-time.Hour)},
+		{ServerName: "expiring.example.com", CertType: "https", NotAfter: notAfter(24 * time.Hour)},
+		{ServerName: "valid.example.com", CertType: "https", NotAfter: notAfter(365 * 24 * time.Hour)},
+		// Older servers do not report the validity period
+		{ServerName: "legacy.example.com", CertType: "https", CertData: createTestCertificatePEM(t, "legacy.example.com", "Test")},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")},
+	}
+
+	t.Run("ExpiringWithin", func(t *testing.T) {
+		var names []string
+		for _, c := range filterCertificatesExpiringWithin(certutil.NewX509CertificateCache(), certs, 30*24*time.Hour, now) {
+			names = append(names, c.ServerName)
+		}
+		assert.Equal(t, []string{"expired.example.com", "expiring.example.com", "legacy.example.com"}, names)
+		assert.Len(t, filterCertificatesExpiringWithin(certutil.NewX509CertificateCache(), certs, time.Minute, now), 1)
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		assert.Equal(t, "expired", certExpiryStatus(now.Add(-time.Second), now))
+		assert.Equal(t, "expiring", certExpiryStatus(now.Add(time.Hour), now))
+		assert.Equal(t, "", certExpiryStatus(now.Add(certExpiryWarningPeriod+time.Hour), now))
+		assert.Equal(t, "2019-01-01T00:00:00Z (expired)", formatCertExpiry(certTableRow{expiry: "2019-01-01T00:00:00Z", expiryStatus: "expired"}))
+		assert.Equal(t, "-", formatCertExpiry(certTableRow{expiry: "-"}))
+	})
+
+	t.Run("Warning", func(t *testing.T) {
+		var out bytes.Buffer
+		printCertExpiryWarning(&out, certutil.NewX509CertificateCache(), certs, now)
+		assert.Equal(t, "WARNING: 1 https certificates have expired and 2 expire within 30 days, use --expiring-within to list them\n", out.String())
+
+		out.Reset()
+		printCertExpiryWarning(&out, certutil.NewX509CertificateCache(), certs[2:3], now)
+		assert.Empty(t, out.String())
+	})
+}
+
+// Runs the steps of cert list which look at the certificate data, taking the
+// cache for each step from cacheFor, and returns the number of decodes done.
+func runCertListPipeline(t testing.TB, certs []appsv1.RepositoryCertificate, now time.Time, cacheFor func() *certutil.X509CertificateCache) int {
+	columns, err := parseCertListColumns("host,type,fingerprint,issuer,expiry")
+	assert.NoError(t, err)
+	var caches []*certutil.X509CertificateCache
+	next := func() *certutil.X509CertificateCache {
+		c := cacheFor()
+		caches = append(caches, c)
+		return c
+	}
+	items := filterCertificatesByIssuerOrg(next(), certs, "Test")
+	items = filterCertificatesExpiringWithin(next(), items, 30*24*time.Hour, now)
+	printCertTable(ioutil.Discard, next(), items, "", columns)
+	printCertExpiryWarning(ioutil.Discard, next(), items, now)
+
+	decodes := 0
+	seen := map[*certutil.X509CertificateCache]bool{}
+	for _, c := range caches {
+		if !seen[c] {
+			seen[c] = true
+			decodes += c.Decodes()
+		}
+	}
+	return decodes
+}
+
+func createCertListPipelineCerts(t testing.TB) []appsv1.RepositoryCertificate {
+	var certs []appsv1.RepositoryCertificate
+	for i := 0; i < 10; i++ {
+		host := fmt.Sprintf("git%d.example.com", i)
+		certs = append(certs, appsv1.RepositoryCertificate{ServerName: host, CertType: "https", CertData: createTestCertificatePEM(t, host, "Test")})
+	}
+	return append(certs, appsv1.RepositoryCertificate{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")})
+}
+
+func TestCertListPipelineDecodes(t *testing.T) {
+	certs := createCertListPipelineCerts(t)
+	now := time.Now()
+
+	// A cache per step decodes every certificate once per step
+	perStep := runCertListPipeline(t, certs, now, certutil.NewX509CertificateCache)
+	assert.Equal(t, 10*4, perStep)
+
+	// The cache shared by cert list decodes every certificate once
+	shared := certutil.NewX509CertificateCache()
+	decodes := runCertListPipeline(t, certs, now, func() *certutil.X509CertificateCache { return shared })
+	assert.Equal(t, 10, decodes)
+}
+
+// Runs the cert list steps with the caches returned by newCacheFor for each
+// invocation, and logs the number of decodes done per invocation.
+func benchmarkCertListPipeline(b *testing.B, newCacheFor func() func() *certutil.X509CertificateCache) {
+	certs := createCertListPipelineCerts(b)
+	now := time.Now()
+	b.ResetTimer()
+	decodes := 0
+	for i := 0; i < b.N; i++ {
+		decodes += runCertListPipeline(b, certs, now, newCacheFor())
+	}
+	b.Logf("%.2f decodes/op", float64(decodes)/float64(b.N))
+}
+
+func BenchmarkCertListPipeline_CachePerStep(b *testing.B) {
+	benchmarkCertListPipeline(b, func() func() *certutil.X509CertificateCache {
+		return certutil.NewX509CertificateCache
+	})
+}
+
+func BenchmarkCertListPipeline_SharedCache(b *testing.B) {
+	benchmarkCertListPipeline(b, func() func() *certutil.X509CertificateCache {
+		shared := certutil.NewX509CertificateCache()
+		return func() *certutil.X509CertificateCache { return shared }
+	})
+}
+
+func TestTLSBatchManifest(t *testing.T) {
+	certA := string(createTestCertificatePEM(t, "a.example.com", "Test"))
+	certB := string(createTestCertificatePEM(t, "b.example.com", "Test"))
+
+	t.Run("YAML", func(t *testing.T) {
+		manifest, err := yaml.Marshal(map[string]string{"b.example.com": certB, "a.example.com": certA})
+		assert.NoError(t, err)
+		serverNames, certificates, err := parseTLSBatchManifest(manifest)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a.example.com", "b.example.com"}, serverNames)
+		if assert.Len(t, certificates["a.example.com"], 1) && assert.Len(t, certificates["b.example.com"], 1) {
+			assert.Equal(t, strings.TrimSpace(certA), strings.TrimSpace(certificates["a.example.com"][0]))
+			assert.Equal(t, strings.TrimSpace(certB), strings.TrimSpace(certificates["b.example.com"][0]))
+		}
+
+		certIf := &fakeCertServiceClient{}
+		var entries []appsv1.RepositoryCertificate
+		for _, serverName := range serverNames {
+			entries = append(entries, tlsCertificatesForServerNames([]string{serverName}, certificates[serverName], nil)...)
+		}
+		assert.True(t, createTLSCertificateEntries(certIf, nil, entries, false, "", false))
+		assert.Len(t, certIf.certs, 2)
+		// Adding them again without --upsert fails for every single entry
+		assert.False(t, createTLSCertificateEntries(certIf, nil, entries, false, "", false))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		manifest, err := json.Marshal(map[string]string{"a.example.com": certA + certB})
+		assert.NoError(t, err)
+		serverNames, certificates, err := parseTLSBatchManifest(manifest)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a.example.com"}, serverNames)
+		assert.Len(t, certificates["a.example.com"], 2)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, _, err := parseTLSBatchManifest([]byte("{}"))
+		assert.EqualError(t, err, "Manifest does not contain any server names")
+		_, _, err = parseTLSBatchManifest([]byte("a.example.com: no certificate here"))
+		assert.EqualError(t, err, "No certificates found for 'a.example.com'")
+		_, _, err = parseTLSBatchManifest([]byte("- a.example.com"))
+		assert.Error(t, err)
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(key)
+		assert.NoError(t, err)
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+		manifest, err := yaml.Marshal(map[string]string{"a.example.com": certA + string(keyPEM)})
+		assert.NoError(t, err)
+		_, _, err = parseTLSBatchManifest(manifest)
+		assert.Equal(t, certutil.ErrPrivateKeyData, err)
+	})
+}
+
+func TestWriteCertExport(t *testing.T) {
+	tlsCert, err := ioutil.ReadFile("../../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	sshKey := "AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"
+	certs := []appsv1.RepositoryCertificate{
+		{ServerName: "localhost", CertType: "https", CertData: tlsCert, Annotations: map[string]string{"rotate-by": "2020-01-01"}},
+		{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(sshKey), Annotations: map[string]string{"rotate-by": "2020-02-01"}},
+	}
+
+	t.Run("PEM", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, writeCertExport(&out, certs, "pem"))
+		assert.Equal(t, "# localhost\n"+strings.TrimSpace(string(tlsCert))+"\n", out.String())
+	})
+
+	t.Run("KnownHosts", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, writeCertExport(&out, certs, "known_hosts"))
+		assert.Equal(t, "gitlab.com ssh-ed25519 "+sshKey+"\n", out.String())
+	})
+
+	t.Run("ConfigMap", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, writeCertExport(&out, certs, "configmap"))
+		documents := strings.Split(out.String(), "\n---\n")
+		if !assert.Len(t, documents, 2) {
+			return
+		}
+		var knownHostsCM, tlsCM corev1.ConfigMap
+		assert.NoError(t, yaml.Unmarshal([]byte(documents[0]), &knownHostsCM))
+		assert.NoError(t, yaml.Unmarshal([]byte(documents[1]), &tlsCM))
+
+		assert.Equal(t, common.ArgoCDKnownHostsConfigMapName, knownHostsCM.Name)
+		assert.Equal(t, "argocd", knownHostsCM.Labels["app.kubernetes.io/part-of"])
+		assert.Equal(t, "gitlab.com ssh-ed25519 "+sshKey+"\n", knownHostsCM.Data["ssh_known_hosts"])
+		assert.JSONEq(t, `{"gitlab.com ssh-ed25519": {"rotate-by": "2020-02-01"}}`, knownHostsCM.Annotations[common.AnnotationKeyCertificateAnnotations])
+
+		assert.Equal(t, common.ArgoCDTLSCertsConfigMapName, tlsCM.Name)
+		assert.Equal(t, strings.TrimSpace(string(tlsCert))+"\n", tlsCM.Data["localhost"])
+		assert.JSONEq(t, `{"localhost": {"rotate-by": "2020-01-01"}}`, tlsCM.Annotations[common.AnnotationKeyCertificateAnnotations])
+	})
+
+	t.Run("ConfigMapOnlySelectedType", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, writeCertExport(&out, certs[:1], "configmap"))
+		assert.NotContains(t, out.String(), "\n---\n")
+		assert.Contains(t, out.String(), "name: "+common.ArgoCDTLSCertsConfigMapName)
+	})
+
+	t.Run("UnknownFormat", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.Error(t, writeCertExport(&out, certs, "bogus"))
+		assert.Empty(t, out.String())
+	})
+}
+
+func TestCertDryRun(t *testing.T) {
+	existing := []appsv1.RepositoryCertificate{
+		{ServerName: "a.example.com", CertType: "https", CertData: []byte("a")},
+		{ServerName: "a.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("key")},
+	}
+	requested := []appsv1.RepositoryCertificate{
+		{ServerName: "a.example.com", CertType: "https", CertData: []byte("a2")},
+		{ServerName: "a.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("key")},
+		{ServerName: "a.example.com", CertType: "ssh", CertSubType: "ssh-rsa", CertData: []byte("rsa")},
+	}
+
+	t.Run("Classify", func(t *testing.T) {
+		results := classifyCertDryRun(requested, []appsv1.RepositoryCertificate{requested[0], requested[2]}, existing)
+		if assert.Len(t, results, 3) {
+			assert.Equal(t, "update", results[0].action)
+			assert.Equal(t, "unchanged", results[1].action)
+			assert.Equal(t, "create", results[2].action)
+		}
+	})
+
+	t.Run("NothingPersisted", func(t *testing.T) {
+		certIf := &fakeCertServiceClient{certs: append([]appsv1.RepositoryCertificate{}, existing...)}
+		request := &certificatepkg.RepositoryCertificateCreateRequest{
+			Certificates: &appsv1.RepositoryCertificateList{Items: requested[2:]},
+		}
+		results, err := dryRunCertificateRequests(context.Background(), certIf, []*certificatepkg.RepositoryCertificateCreateRequest{request})
+		assert.NoError(t, err)
+		if assert.Len(t, results, 1) {
+			assert.Equal(t, "create", results[0].action)
+		}
+		assert.False(t, request.DryRun)
+		assert.Equal(t, existing, certIf.certs)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		certIf := &fakeCertServiceClient{certs: append([]appsv1.RepositoryCertificate{}, existing...)}
+		request := &certificatepkg.RepositoryCertificateCreateRequest{
+			Certificates: &appsv1.RepositoryCertificateList{Items: requested[:1]},
+		}
+		results, err := dryRunCertificateRequests(context.Background(), certIf, []*certificatepkg.RepositoryCertificateCreateRequest{request})
+		assert.NoError(t, err)
+		var out bytes.Buffer
+		assert.False(t, printCertDryRunResults(&out, results))
+		assert.Contains(t, out.String(), "already exists")
+		assert.Contains(t, out.String(), "Dry run: 0 to create, 0 to update, 0 unchanged, 1 refused. Nothing has been changed.")
+	})
+}
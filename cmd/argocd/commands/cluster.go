@@ -22,6 +22,7 @@ import (
 	clusterpkg "github.com/argoproj/argo-cd/pkg/apiclient/cluster"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/clusterauth"
 )
 
@@ -47,11 +48,17 @@ func NewClusterCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientc
 // NewClusterAddCommand returns a new instance of an `argocd cluster add` command
 func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientcmd.PathOptions) *cobra.Command {
 	var (
-		inCluster       bool
-		upsert          bool
-		awsRoleArn      string
-		awsClusterName  string
-		systemNamespace string
+		inCluster          bool
+		upsert             bool
+		awsRoleArn         string
+		awsClusterName     string
+		systemNamespace    string
+		shard              int64
+		namespaces         []string
+		serviceAccount     string
+		bearerToken        string
+		yes                bool
+		execProviderConfig argoappv1.ExecProviderConfig
 	)
 	var command = &cobra.Command{
 		Use:   "add",
@@ -77,6 +84,18 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			conf, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
 
+			if serviceAccount != "" && bearerToken != "" {
+				log.Fatal("Only one of --service-account and --bearer-token can be specified")
+			}
+			if awsClusterName != "" && execProviderConfig.Command != "" {
+				log.Fatal("Only one of --aws-cluster-name and --exec-command can be specified")
+			}
+			if (awsClusterName != "" || execProviderConfig.Command != "") && (serviceAccount != "" || bearerToken != "") {
+				log.Fatal("--aws-cluster-name and --exec-command cannot be combined with --service-account or --bearer-token")
+			}
+
+			// clusters which authenticate by AWS IAM or by an exec provider, e.g. `aws eks get-token`, need no resources
+			// in the cluster
 			managerBearerToken := ""
 			var awsAuthConf *argoappv1.AWSAuthConfig
 			if awsClusterName != "" {
@@ -84,12 +103,28 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 					ClusterName: awsClusterName,
 					RoleARN:     awsRoleArn,
 				}
-			} else {
-				// Install RBAC resources for managing the cluster
+			} else if bearerToken != "" {
+				managerBearerToken = bearerToken
+			} else if execProviderConfig.Command == "" {
 				clientset, err := kubernetes.NewForConfig(conf)
 				errors.CheckError(err)
-				managerBearerToken, err = clusterauth.InstallClusterManagerRBAC(clientset, systemNamespace)
-				errors.CheckError(err)
+				if serviceAccount != "" {
+					// Use the token of the existing service account, without changing the RBAC resources of the cluster
+					managerBearerToken, err = clusterauth.GetServiceAccountBearerToken(clientset, systemNamespace, serviceAccount)
+					errors.CheckError(err)
+				} else {
+					privileges := "full cluster level privileges"
+					if len(namespaces) > 0 {
+						privileges = fmt.Sprintf("full privileges in the namespaces %s", strings.Join(namespaces, ", "))
+					}
+					if !yes && !cli.AskToProceed(fmt.Sprintf("WARNING: This will create a service account `%s` in the namespace `%s` of the cluster of context `%s` with %s. Proceed (y/n)? ", clusterauth.ArgoCDManagerServiceAccount, systemNamespace, args[0], privileges)) {
+						fmt.Println("Aborted, the cluster has not been added")
+						os.Exit(1)
+					}
+					// Install RBAC resources for managing the cluster
+					managerBearerToken, err = clusterauth.InstallClusterManagerRBAC(clientset, systemNamespace, namespaces)
+					errors.CheckError(err)
+				}
 			}
 			conn, clusterIf := argocdclient.NewClientOrDie(clientOpts).NewClusterClientOrDie()
 			defer util.Close(conn)
@@ -97,6 +132,13 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if inCluster {
 				clst.Server = common.KubernetesInternalAPIServerAddr
 			}
+			if shard >= 0 {
+				clst.Shard = &shard
+			}
+			clst.Namespaces = namespaces
+			if execProviderConfig.Command != "" {
+				clst.Config.ExecProviderConfig = &execProviderConfig
+			}
 			clstCreateReq := clusterpkg.ClusterCreateRequest{
 				Cluster: clst,
 				Upsert:  upsert,
@@ -112,6 +154,15 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 	command.Flags().StringVar(&awsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws-iam-authenticator will be used to access cluster")
 	command.Flags().StringVar(&awsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assume a role to perform cluster operations instead of the default AWS credential provider chain.")
 	command.Flags().StringVar(&systemNamespace, "system-namespace", common.DefaultSystemNamespace, "Use different system namespace")
+	command.Flags().Int64Var(&shard, "shard", -1, "Shard of the application controller which processes the applications of the cluster. If omitted, the shard is derived from the server address.")
+	command.Flags().StringVar(&execProviderConfig.Command, "exec-command", "", "Command to run to provide client credentials to the cluster, e.g. aws. If set, no resources are created in the cluster.")
+	command.Flags().StringArrayVar(&execProviderConfig.Args, "exec-command-args", nil, "Arguments to supply to the --exec-command command, e.g. --exec-command-args eks --exec-command-args get-token")
+	command.Flags().StringToStringVar(&execProviderConfig.Env, "exec-command-env", nil, "Environment variables to pass to the --exec-command command")
+	command.Flags().StringVar(&execProviderConfig.APIVersion, "exec-command-api-version", "client.authentication.k8s.io/v1alpha1", "Preferred input version of the ExecInfo for the --exec-command command")
+	command.Flags().StringVar(&serviceAccount, "service-account", "", fmt.Sprintf("Existing service account of the system namespace whose token is used to manage the cluster. If set, the %s service account and its RBAC resources are not created.", clusterauth.ArgoCDManagerServiceAccount))
+	command.Flags().StringVar(&bearerToken, "bearer-token", "", "Bearer token used to manage the cluster. If set, no resources are created in the cluster.")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Do not ask for confirmation before creating the service account and its RBAC resources in the cluster")
+	command.Flags().StringArrayVar(&namespaces, "namespace", nil, "List of namespaces which are allowed to manage. If omitted, the access to the cluster is not restricted to namespaces.")
 	return command
 }
 
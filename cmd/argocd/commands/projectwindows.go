@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/errors"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	projectpkg "github.com/argoproj/argo-cd/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// NewProjectWindowsCommand returns a new instance of the `argocd proj windows` command
+func NewProjectWindowsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	windowsCommand := &cobra.Command{
+		Use:   "windows",
+		Short: "Manage a project's sync windows",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	windowsCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
+	windowsCommand.AddCommand(NewProjectWindowsAddCommand(clientOpts))
+	windowsCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
+	windowsCommand.AddCommand(NewProjectWindowsEnableCommand(clientOpts))
+	windowsCommand.AddCommand(NewProjectWindowsDisableCommand(clientOpts))
+	return windowsCommand
+}
+
+// NewProjectWindowsListCommand returns a new instance of an `argocd proj windows list` command
+func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "list PROJECT",
+		Short: "List the sync windows of a project",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			printSyncWindows(proj.Spec.SyncWindows)
+		},
+	}
+	return command
+}
+
+// NewProjectWindowsAddCommand returns a new instance of an `argocd proj windows add` command
+func NewProjectWindowsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		kind         string
+		schedule     string
+		duration     string
+		applications []string
+		namespaces   []string
+		clusters     []string
+	)
+	var command = &cobra.Command{
+		Use:   "add PROJECT",
+		Short: "Add a sync window to a project",
+		Example: `  # Deny syncs of all applications of the project between 10pm and 6am
+  argocd proj windows add my-project --kind deny --schedule "0 22 * * *" --duration 8h --applications "*"
+
+  # Allow syncs of the applications deployed to the production namespaces only on weekdays between 9am and 5pm
+  argocd proj windows add my-project --kind allow --schedule "0 9 * * 1-5" --duration 8h --namespaces "prod-*"`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			window := v1alpha1.SyncWindow{
+				Kind:         kind,
+				Schedule:     schedule,
+				Duration:     duration,
+				Applications: applications,
+				Namespaces:   namespaces,
+				Clusters:     clusters,
+			}
+			errors.CheckError(window.Validate())
+			if len(applications) == 0 && len(namespaces) == 0 && len(clusters) == 0 {
+				errors.CheckError(fmt.Errorf("At least one of --applications, --namespaces or --clusters is required"))
+			}
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			proj.Spec.SyncWindows = append(proj.Spec.SyncWindows, window)
+			_, err = projIf.Update(context.Background(), &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+			fmt.Printf("Sync window %d added to project '%s'\n", len(proj.Spec.SyncWindows)-1, projName)
+		},
+	}
+	command.Flags().StringVar(&kind, "kind", "", fmt.Sprintf("Kind of the window, one of: %s|%s", v1alpha1.SyncWindowKindAllow, v1alpha1.SyncWindowKindDeny))
+	command.Flags().StringVar(&schedule, "schedule", "", "Cron schedule of the start of the window (e.g. \"0 22 * * *\")")
+	command.Flags().StringVar(&duration, "duration", "", "Duration of the window (e.g. 1h, 30m)")
+	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Glob patterns of the names of the applications the window applies to")
+	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Glob patterns of the destination namespaces the window applies to")
+	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Glob patterns of the destination servers the window applies to")
+	return command
+}
+
+// NewProjectWindowsDeleteCommand returns a new instance of an `argocd proj windows rm` command
+func NewProjectWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "rm PROJECT ID",
+		Short: "Remove a sync window from a project",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			updateSyncWindow(clientOpts, args[0], args[1], func(proj *v1alpha1.AppProject, id int) {
+				proj.Spec.SyncWindows = append(proj.Spec.SyncWindows[:id], proj.Spec.SyncWindows[id+1:]...)
+			})
+		},
+	}
+	return command
+}
+
+// NewProjectWindowsEnableCommand returns a new instance of an `argocd proj windows enable` command
+func NewProjectWindowsEnableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "enable PROJECT ID",
+		Short: "Enable a sync window of a project",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			updateSyncWindow(clientOpts, args[0], args[1], func(proj *v1alpha1.AppProject, id int) {
+				proj.Spec.SyncWindows[id].Disabled = false
+			})
+		},
+	}
+	return command
+}
+
+// NewProjectWindowsDisableCommand returns a new instance of an `argocd proj windows disable` command
+func NewProjectWindowsDisableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "disable PROJECT ID",
+		Short: "Disable a sync window of a project",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			updateSyncWindow(clientOpts, args[0], args[1], func(proj *v1alpha1.AppProject, id int) {
+				proj.Spec.SyncWindows[id].Disabled = true
+			})
+		},
+	}
+	return command
+}
+
+// updateSyncWindow applies the update to the sync window with the given ID, i.e. its index in the list of windows,
+// and saves the project
+func updateSyncWindow(clientOpts *argocdclient.ClientOptions, projName string, windowID string, update func(proj *v1alpha1.AppProject, id int)) {
+	conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+	defer util.Close(conn)
+
+	proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
+	errors.CheckError(err)
+
+	id, err := strconv.Atoi(windowID)
+	if err != nil || id < 0 || id >= len(proj.Spec.SyncWindows) {
+		errors.CheckError(fmt.Errorf("Sync window '%s' does not exist in project '%s'", windowID, projName))
+	}
+	update(proj, id)
+	_, err = projIf.Update(context.Background(), &projectpkg.ProjectUpdateRequest{Project: proj})
+	errors.CheckError(err)
+}
+
+// printSyncWindows prints the sync windows of a project in a tabwriter table
+func printSyncWindows(windows v1alpha1.SyncWindows) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	now := time.Now()
+	fmt.Fprintf(w, "ID\tSTATUS\tKIND\tSCHEDULE\tDURATION\tAPPLICATIONS\tNAMESPACES\tCLUSTERS\n")
+	for i, window := range windows {
+		status := "Inactive"
+		if window.Disabled {
+			status = "Disabled"
+		} else if window.IsActive(now) {
+			status = "Active"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", i, status, window.Kind, window.Schedule, window.Duration,
+			formatSyncWindowPatterns(window.Applications), formatSyncWindowPatterns(window.Namespaces), formatSyncWindowPatterns(window.Clusters))
+	}
+	_ = w.Flush()
+}
+
+func formatSyncWindowPatterns(patterns []string) string {
+	if len(patterns) == 0 {
+		return "-"
+	}
+	return strings.Join(patterns, ",")
+}
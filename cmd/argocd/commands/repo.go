@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	log "github.com/sirupsen/logrus"
@@ -17,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/helm"
 )
 
 // NewRepoCommand returns a new instance of an `argocd repo` command
@@ -32,6 +35,7 @@ func NewRepoCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 
 	command.AddCommand(NewRepoAddCommand(clientOpts))
 	command.AddCommand(NewRepoListCommand(clientOpts))
+	command.AddCommand(NewRepoGetCommand(clientOpts))
 	command.AddCommand(NewRepoRemoveCommand(clientOpts))
 	return command
 }
@@ -47,6 +51,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		tlsClientCertPath              string
 		tlsClientCertKeyPath           string
 		enableLfs                      bool
+		enableOCI                      bool
 	)
 
 	// For better readability and easier formatting
@@ -57,6 +62,12 @@ Add a HTTPS repository using username/password and TLS client certificates:",
   $ argocd repo add https://git.example.com --username git --password secret --tls-client-cert-path ~/mycert.crt --tls-client-cert-key-path ~/mycert.key",
 Add a HTTPS repository using username/password without verifying the server's TLS certificate:",
   $ argocd repo add https://git.example.com --username git --password secret --insecure-skip-server-verification",
+Add a Git repository whose submodules are checked out recursively:",
+  $ argocd repo add https://git.example.com/repos/repo --username git --password secret --enable-submodules",
+Add a Helm chart repository, which is available by its name to charts depending on it:",
+  $ argocd repo add https://kubernetes-charts.storage.googleapis.com --type helm --name stable",
+Add Helm charts stored in an OCI registry using the registry's credentials:",
+  $ argocd repo add registry.example.com/charts --enable-oci --name example --username admin --password secret",
 `
 
 	var command = &cobra.Command{
@@ -72,6 +83,36 @@ Add a HTTPS repository using username/password without verifying the server's TL
 			// Repository URL
 			repo.Repo = args[0]
 
+			// OCI registries are Helm chart repositories using the oci:// scheme
+			if enableOCI {
+				repo.Type = appsv1.RepositoryTypeHelm
+				if !helm.IsOCIURL(repo.Repo) {
+					repo.Repo = "oci://" + repo.Repo
+				}
+			}
+
+			switch repo.Type {
+			case appsv1.RepositoryTypeGit:
+				// Git is the default type, which is not stored explicitly
+				repo.Type = ""
+			case appsv1.RepositoryTypeHelm:
+				if repo.Name == "" {
+					err := fmt.Errorf("--name is required for Helm chart repositories")
+					errors.CheckError(err)
+				}
+				if !strings.HasPrefix(repo.Repo, "https://") && !strings.HasPrefix(repo.Repo, "http://") && !helm.IsOCIURL(repo.Repo) {
+					err := fmt.Errorf("Helm chart repositories must be specified by an HTTP, HTTPS or OCI URL")
+					errors.CheckError(err)
+				}
+				if sshPrivateKeyPath != "" || enableLfs {
+					err := fmt.Errorf("--ssh-private-key-path and --enable-lfs are not supported for Helm chart repositories")
+					errors.CheckError(err)
+				}
+			default:
+				err := fmt.Errorf("Unknown repository type '%s', must be one of: %s, %s", repo.Type, appsv1.RepositoryTypeGit, appsv1.RepositoryTypeHelm)
+				errors.CheckError(err)
+			}
+
 			// Specifying ssh-private-key-path is only valid for SSH repositories
 			if sshPrivateKeyPath != "" {
 				if ok, _ := git.IsSSHURL(repo.Repo); ok {
@@ -93,9 +134,10 @@ Add a HTTPS repository using username/password without verifying the server's TL
 				errors.CheckError(err)
 			}
 
-			// Specifying tls-client-cert-path is only valid for HTTPS repositories
+			// Specifying tls-client-cert-path is only valid for HTTPS repositories, which OCI
+			// registries are accessed by
 			if tlsClientCertPath != "" {
-				if git.IsHTTPSURL(repo.Repo) {
+				if git.IsHTTPSURL(repo.Repo) || helm.IsOCIURL(repo.Repo) {
 					tlsCertData, err := ioutil.ReadFile(tlsClientCertPath)
 					errors.CheckError(err)
 					tlsCertKey, err := ioutil.ReadFile(tlsClientCertKeyPath)
@@ -108,6 +150,19 @@ Add a HTTPS repository using username/password without verifying the server's TL
 				}
 			}
 
+			// A proxy is only used for repositories accessed by HTTP(S)
+			if repo.Proxy != "" {
+				if ok, _ := git.IsSSHURL(repo.Repo); ok {
+					err := fmt.Errorf("--proxy is not supported for SSH repositories")
+					errors.CheckError(err)
+				}
+				proxyURL, err := url.Parse(repo.Proxy)
+				if err != nil || (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") || proxyURL.Host == "" {
+					err := fmt.Errorf("--proxy must be a HTTP or HTTPS URL, e.g. http://proxy.example.com:3128")
+					errors.CheckError(err)
+				}
+			}
+
 			// InsecureIgnoreHostKey is deprecated and only here for backwards compat
 			repo.InsecureIgnoreHostKey = insecureIgnoreHostKey
 			repo.Insecure = insecureSkipServerVerification
@@ -127,6 +182,9 @@ Add a HTTPS repository using username/password without verifying the server's TL
 			// that were supplied, we bail out.
 			repoAccessReq := repositorypkg.RepoAccessQuery{
 				Repo:              repo.Repo,
+				Type:              repo.Type,
+				Name:              repo.Name,
+				Proxy:             repo.Proxy,
 				Username:          repo.Username,
 				Password:          repo.Password,
 				SshPrivateKey:     repo.SSHPrivateKey,
@@ -146,6 +204,8 @@ Add a HTTPS repository using username/password without verifying the server's TL
 			fmt.Printf("repository '%s' added\n", createdRepo.Repo)
 		},
 	}
+	command.Flags().StringVar(&repo.Type, "type", appsv1.RepositoryTypeGit, "type of the repository, \"git\" or \"helm\"")
+	command.Flags().StringVar(&repo.Name, "name", "", "name of the repository, required for Helm chart repositories")
 	command.Flags().StringVar(&repo.Username, "username", "", "username to the repository")
 	command.Flags().StringVar(&repo.Password, "password", "", "password to the repository")
 	command.Flags().StringVar(&sshPrivateKeyPath, "ssh-private-key-path", "", "path to the private ssh key (e.g. ~/.ssh/id_rsa)")
@@ -154,6 +214,9 @@ Add a HTTPS repository using username/password without verifying the server's TL
 	command.Flags().BoolVar(&insecureIgnoreHostKey, "insecure-ignore-host-key", false, "disables SSH strict host key checking (deprecated, use --insecure-skip-server-validation instead)")
 	command.Flags().BoolVar(&insecureSkipServerVerification, "insecure-skip-server-verification", false, "disables server certificate and host key checks")
 	command.Flags().BoolVar(&enableLfs, "enable-lfs", false, "enable git-lfs (Large File Support) on this repository")
+	command.Flags().BoolVar(&repo.EnableSubmodules, "enable-submodules", false, "initialize the submodules of this repository recursively when checking out a revision")
+	command.Flags().StringVar(&repo.Proxy, "proxy", "", "use a HTTP(S) proxy to access the repository, instead of the proxy configured for the repo-server")
+	command.Flags().BoolVar(&enableOCI, "enable-oci", false, "the repository is an OCI registry of Helm charts, implies --type helm")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
 	return command
 }
@@ -182,7 +245,7 @@ func NewRepoRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 // Print table of repo info
 func printRepoTable(repos []appsv1.Repository) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "REPO\tINSECURE\tLFS\tUSER\tSTATUS\tMESSAGE\n")
+	fmt.Fprintf(w, "TYPE\tNAME\tREPO\tINSECURE\tLFS\tUSER\tSTATUS\tMESSAGE\n")
 	for _, r := range repos {
 		var username string
 		if r.Username == "" {
@@ -190,7 +253,15 @@ func printRepoTable(repos []appsv1.Repository) {
 		} else {
 			username = r.Username
 		}
-		fmt.Fprintf(w, "%s\t%v\t%v\t%s\t%s\t%s\n", r.Repo, r.IsInsecure(), r.EnableLFS, username, r.ConnectionState.Status, r.ConnectionState.Message)
+		repoType := r.Type
+		if repoType == "" {
+			repoType = appsv1.RepositoryTypeGit
+		}
+		name := r.Name
+		if name == "" {
+			name = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%v\t%v\t%s\t%s\t%s\n", repoType, name, r.Repo, r.IsInsecure(), r.EnableLFS, username, r.ConnectionState.Status, r.ConnectionState.Message)
 	}
 	_ = w.Flush()
 }
@@ -225,3 +296,70 @@ func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|url")
 	return command
 }
+
+// NewRepoGetCommand returns a new instance of an `argocd repo get` command
+func NewRepoGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "get REPOURL",
+		Short: "Get a configured repository and check the connection to it",
+		Long:  "Get a configured repository and check the connection to it stage by stage, i.e. DNS resolution, TCP connection, TLS handshake and authentication",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
+			defer util.Close(conn)
+			repo, err := repoIf.Get(context.Background(), &repositorypkg.RepoQuery{Repo: args[0]})
+			errors.CheckError(err)
+			diagnostics, err := repoIf.GetConnectionDiagnostics(context.Background(), &repositorypkg.RepoQuery{Repo: args[0]})
+			errors.CheckError(err)
+			printRepo(repo)
+			fmt.Println()
+			printRepoConnectionChecks(diagnostics.Checks)
+		},
+	}
+	return command
+}
+
+// Print details of a repo
+func printRepo(repo *appsv1.Repository) {
+	repoType := repo.Type
+	if repoType == "" {
+		repoType = appsv1.RepositoryTypeGit
+	}
+	fmt.Printf(printOpFmtStr, "Repo:", repo.Repo)
+	fmt.Printf(printOpFmtStr, "Type:", repoType)
+	if repo.Name != "" {
+		fmt.Printf(printOpFmtStr, "Name:", repo.Name)
+	}
+	if repo.Username != "" {
+		fmt.Printf(printOpFmtStr, "Username:", repo.Username)
+	}
+	fmt.Printf(printOpFmtStr, "Insecure:", fmt.Sprintf("%v", repo.IsInsecure()))
+	fmt.Printf(printOpFmtStr, "LFS:", fmt.Sprintf("%v", repo.EnableLFS))
+	fmt.Printf(printOpFmtStr, "Submodules:", fmt.Sprintf("%v", repo.EnableSubmodules))
+	if repo.Proxy != "" {
+		fmt.Printf(printOpFmtStr, "Proxy:", repo.Proxy)
+	}
+	fmt.Printf(printOpFmtStr, "Status:", repo.ConnectionState.Status)
+	if repo.ConnectionState.Message != "" {
+		fmt.Printf(printOpFmtStr, "Message:", repo.ConnectionState.Message)
+	}
+}
+
+// Print table of the stages of a connection check
+func printRepoConnectionChecks(checks []*repositorypkg.RepoConnectionCheck) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "STAGE\tSTATUS\tMESSAGE\n")
+	for _, check := range checks {
+		status := "Failed"
+		if check.Skipped {
+			status = "Skipped"
+		} else if check.Successful {
+			status = "Successful"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", check.Stage, status, check.Message)
+	}
+	_ = w.Flush()
+}
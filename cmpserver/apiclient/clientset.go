@@ -0,0 +1,69 @@
+package apiclient
+
+import (
+	"context"
+	"net"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+)
+
+const (
+	// dialTimeout is the timeout of connecting to the socket of a plugin
+	dialTimeout = 5 * time.Second
+	// probeTimeout is the timeout of checking whether a plugin accepts connections on its socket
+	probeTimeout = 100 * time.Millisecond
+	// MaxGRPCMessageSize is the maximum size of a manifest a plugin may generate
+	MaxGRPCMessageSize = 100 * 1024 * 1024
+)
+
+// Clientset represents config management plugin server api clients
+type Clientset interface {
+	NewConfigManagementPluginClient() (util.Closer, ConfigManagementPluginServiceClient, error)
+}
+
+type clientSet struct {
+	socketPath string
+}
+
+func (c *clientSet) NewConfigManagementPluginClient() (util.Closer, ConfigManagementPluginServiceClient, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+	conn, err := grpc_util.BlockingDial(ctx, "unix", c.socketPath, nil, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxGRPCMessageSize)))
+	if err != nil {
+		log.Errorf("Unable to connect to config management plugin service with socket %s", c.socketPath)
+		return nil, nil, err
+	}
+	return conn, NewConfigManagementPluginServiceClient(conn), nil
+}
+
+// NewConfigManagementPluginClientSet creates new instance of config management plugin server Clientset
+func NewConfigManagementPluginClientSet(socketPath string) Clientset {
+	return &clientSet{socketPath: socketPath}
+}
+
+// GetPluginSockFilePath returns the path where the config management plugin sidecars create their sockets. If the
+// ARGOCD_PLUGINSOCKFILEPATH environment variable is set, the path is taken from there, otherwise the default is returned.
+func GetPluginSockFilePath() string {
+	if envPath := os.Getenv(common.EnvVarPluginSockFilePath); envPath != "" {
+		return envPath
+	}
+	return common.DefaultPluginSockFilePath
+}
+
+// IsAcceptingConnections returns whether a plugin accepts connections on the socket at the given path. The sockets of
+// sidecars which are gone are left behind, and should be skipped rather than waited for.
+func IsAcceptingConnections(socketPath string) bool {
+	conn, err := net.DialTimeout("unix", socketPath, probeTimeout)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
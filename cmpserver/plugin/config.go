@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// ConfigManagementPluginKind is the kind of the manifest of a config management plugin
+const ConfigManagementPluginKind = "ConfigManagementPlugin"
+
+// PluginConfig is the manifest of a config management plugin, which runs in a sidecar of the repo server
+type PluginConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        metav1.ObjectMeta `json:"metadata"`
+	Spec            PluginConfigSpec  `json:"spec"`
+}
+
+// PluginConfigSpec holds the commands, the discovery rule and the parameters of a config management plugin
+type PluginConfigSpec struct {
+	// Init is a command which runs before the manifests are generated
+	Init *v1alpha1.Command `json:"init,omitempty"`
+	// Generate is the command which generates the manifests, and prints them as YAML or JSON
+	Generate v1alpha1.Command `json:"generate"`
+	// Discover is the rule which matches the applications the plugin supports, if they do not name a plugin
+	Discover *Discover `json:"discover,omitempty"`
+	// Parameters are the parameters applications may set, which are passed to the commands as environment variables
+	Parameters []ParameterSpec `json:"parameters,omitempty"`
+}
+
+// Discover is the discovery rule of a config management plugin. It matches an application if a file of the
+// application matches the file name, or if the find command succeeds and prints anything.
+type Discover struct {
+	// FileName is a glob, relative to the application path, e.g. "*.cue"
+	FileName string `json:"fileName,omitempty"`
+	// Find is a command which runs in the application path
+	Find *v1alpha1.Command `json:"find,omitempty"`
+}
+
+// ParameterType is the type of the value of a parameter
+type ParameterType string
+
+const (
+	ParameterTypeString  ParameterType = "string"
+	ParameterTypeBoolean ParameterType = "boolean"
+	ParameterTypeNumber  ParameterType = "number"
+)
+
+// ParameterSpec declares a parameter of a config management plugin
+type ParameterSpec struct {
+	Name string `json:"name"`
+	// Type is the type of the value, one of string (the default), boolean or number
+	Type ParameterType `json:"type,omitempty"`
+	// Required is whether applications must set the parameter
+	Required bool `json:"required,omitempty"`
+	// Default is the value of the parameter if an application does not set it
+	Default string `json:"default,omitempty"`
+}
+
+// ReadPluginConfig reads and validates the manifest of a config management plugin
+func ReadPluginConfig(path string) (*PluginConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config PluginConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse the plugin config %s: %v", path, err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid plugin config %s: %v", path, err)
+	}
+	return &config, nil
+}
+
+// Validate returns an error if the manifest of the plugin is incomplete, or declares invalid parameters
+func (c *PluginConfig) Validate() error {
+	if c.Kind != ConfigManagementPluginKind {
+		return fmt.Errorf("kind must be %s, but is '%s'", ConfigManagementPluginKind, c.Kind)
+	}
+	if c.Metadata.Name == "" {
+		return fmt.Errorf("metadata.name is required")
+	}
+	if len(c.Spec.Generate.Command) == 0 {
+		return fmt.Errorf("spec.generate.command is required")
+	}
+	names := map[string]bool{}
+	for _, p := range c.Spec.Parameters {
+		if p.Name == "" {
+			return fmt.Errorf("the name of a parameter is required")
+		}
+		if names[p.Name] {
+			return fmt.Errorf("parameter %s is declared more than once", p.Name)
+		}
+		names[p.Name] = true
+		switch p.Type {
+		case "", ParameterTypeString, ParameterTypeBoolean, ParameterTypeNumber:
+		default:
+			return fmt.Errorf("parameter %s has invalid type '%s', must be one of: string, boolean, number", p.Name, p.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	argoexec "github.com/argoproj/pkg/exec"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/cmpserver/apiclient"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/config"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+const (
+	// PluginEnvParameters is the environment variable of the values of all parameters, as a JSON object
+	PluginEnvParameters = "ARGOCD_APP_PARAMETERS"
+	// PluginEnvParameterPrefix is the prefix of the environment variables of the values of the parameters
+	PluginEnvParameterPrefix = "PARAM_"
+)
+
+var invalidEnvChars = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// Service implements ConfigManagementPluginService interface
+type Service struct {
+	config *PluginConfig
+}
+
+// NewService returns a new instance of the config management plugin service
+func NewService(config *PluginConfig) *Service {
+	return &Service{config: config}
+}
+
+// GenerateManifest runs the commands of the plugin in the application path, and streams the generated manifests
+func (s *Service) GenerateManifest(q *apiclient.ManifestRequest, stream apiclient.ConfigManagementPluginService_GenerateManifestServer) error {
+	if err := checkAppPath(q.RepoPath, q.AppPath); err != nil {
+		return err
+	}
+	env, err := s.environ(q)
+	if err != nil {
+		return err
+	}
+	if s.config.Spec.Init != nil {
+		if _, err := runCommand(*s.config.Spec.Init, q.AppPath, env); err != nil {
+			return err
+		}
+	}
+	out, err := runCommand(s.config.Spec.Generate, q.AppPath, env)
+	if err != nil {
+		return err
+	}
+	objs, err := kube.SplitYAML(out)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "failed to parse the manifests generated by plugin %s: %v", s.config.Metadata.Name, err)
+	}
+	for _, obj := range objs {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&apiclient.ManifestResponse{Manifest: string(data)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchRepository returns whether the discovery rule of the plugin matches the application
+func (s *Service) MatchRepository(ctx context.Context, q *apiclient.RepositoryRequest) (*apiclient.RepositoryResponse, error) {
+	discover := s.config.Spec.Discover
+	if discover == nil {
+		return &apiclient.RepositoryResponse{IsSupported: false}, nil
+	}
+	if discover.FileName != "" {
+		matches, err := filepath.Glob(filepath.Join(q.AppPath, discover.FileName))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid file name %s of the discovery rule: %v", discover.FileName, err)
+		}
+		if len(matches) > 0 {
+			return &apiclient.RepositoryResponse{IsSupported: true}, nil
+		}
+	}
+	if discover.Find != nil {
+		// a failing find command does not match
+		out, err := runCommand(*discover.Find, q.AppPath, os.Environ())
+		if err == nil && strings.TrimSpace(out) != "" {
+			return &apiclient.RepositoryResponse{IsSupported: true}, nil
+		}
+	}
+	return &apiclient.RepositoryResponse{IsSupported: false}, nil
+}
+
+// environ returns the environment of the commands, with the environment variables of the request and the values of
+// the parameters
+func (s *Service) environ(q *apiclient.ManifestRequest) ([]string, error) {
+	env := os.Environ()
+	for _, e := range q.Env {
+		env = append(env, fmt.Sprintf("%s=%s", e.Name, e.Value))
+	}
+	values := map[string]string{}
+	for _, p := range q.Parameters {
+		values[p.Name] = p.Value
+	}
+	params := map[string]interface{}{}
+	for _, spec := range s.config.Spec.Parameters {
+		value, ok := values[spec.Name]
+		delete(values, spec.Name)
+		if !ok {
+			if spec.Required {
+				return nil, status.Errorf(codes.InvalidArgument, "parameter %s of plugin %s is required", spec.Name, s.config.Metadata.Name)
+			}
+			value = spec.Default
+		}
+		typed, err := parseParameter(spec, value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parameter %s of plugin %s: %v", spec.Name, s.config.Metadata.Name, err)
+		}
+		params[spec.Name] = typed
+		env = append(env, fmt.Sprintf("%s%s=%s", PluginEnvParameterPrefix, invalidEnvChars.ReplaceAllString(strings.ToUpper(spec.Name), "_"), value))
+	}
+	for name := range values {
+		return nil, status.Errorf(codes.InvalidArgument, "plugin %s has no parameter %s", s.config.Metadata.Name, name)
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	return append(env, fmt.Sprintf("%s=%s", PluginEnvParameters, data)), nil
+}
+
+// parseParameter returns the value of a parameter as its type. Empty values of optional parameters are nil, unless
+// the parameter is a string.
+func parseParameter(spec ParameterSpec, value string) (interface{}, error) {
+	switch spec.Type {
+	case ParameterTypeBoolean:
+		if value == "" {
+			return nil, nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a boolean", value)
+		}
+		return b, nil
+	case ParameterTypeNumber:
+		if value == "" {
+			return nil, nil
+		}
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a number", value)
+		}
+		return f, nil
+	default:
+		return value, nil
+	}
+}
+
+// checkAppPath returns an error if the application path is not within the repository
+func checkAppPath(repoPath, appPath string) error {
+	relPath, err := filepath.Rel(repoPath, appPath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, "../") {
+		return status.Errorf(codes.InvalidArgument, "application path %s is outside of the repository %s", appPath, repoPath)
+	}
+	return nil
+}
+
+func runCommand(command v1alpha1.Command, path string, env []string) (string, error) {
+	if len(command.Command) == 0 {
+		return "", fmt.Errorf("Command is empty")
+	}
+	cmd := exec.Command(command.Command[0], append(command.Command[1:], command.Args...)...)
+	cmd.Env = env
+	cmd.Dir = path
+	return argoexec.RunCommandExt(cmd, config.CmdOpts())
+}
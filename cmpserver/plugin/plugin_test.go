@@ -0,0 +1,182 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/cmpserver/apiclient"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+type fakeStream struct {
+	grpc.ServerStream
+	manifests []string
+}
+
+func (s *fakeStream) Send(res *apiclient.ManifestResponse) error {
+	s.manifests = append(s.manifests, res.Manifest)
+	return nil
+}
+
+func newTestConfig() *PluginConfig {
+	return &PluginConfig{
+		TypeMeta: metav1.TypeMeta{Kind: ConfigManagementPluginKind, APIVersion: "argoproj.io/v1alpha1"},
+		Metadata: metav1.ObjectMeta{Name: "test"},
+		Spec: PluginConfigSpec{
+			Generate: v1alpha1.Command{
+				Command: []string{"sh", "-c"},
+				Args:    []string{`printf "kind: ConfigMap\napiVersion: v1\nmetadata:\n  name: $ARGOCD_APP_NAME\ndata:\n  replicas: '$PARAM_REPLICAS'\n  params: '$ARGOCD_APP_PARAMETERS'\n---\nkind: Secret\napiVersion: v1\nmetadata:\n  name: secret\n"`},
+			},
+			Parameters: []ParameterSpec{
+				{Name: "replicas", Type: ParameterTypeNumber, Default: "1"},
+				{Name: "debug", Type: ParameterTypeBoolean},
+				{Name: "image-tag", Required: true},
+			},
+		},
+	}
+}
+
+func TestReadPluginConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugin")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "plugin.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(`
+apiVersion: argoproj.io/v1alpha1
+kind: ConfigManagementPlugin
+metadata:
+  name: cue
+spec:
+  generate:
+    command: [cue, export]
+  discover:
+    fileName: "*.cue"
+  parameters:
+  - name: replicas
+    type: number
+`), 0644))
+	config, err := ReadPluginConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "cue", config.Metadata.Name)
+	assert.Equal(t, []string{"cue", "export"}, config.Spec.Generate.Command)
+	assert.Equal(t, "*.cue", config.Spec.Discover.FileName)
+	assert.Equal(t, []ParameterSpec{{Name: "replicas", Type: ParameterTypeNumber}}, config.Spec.Parameters)
+
+	_, err = ReadPluginConfig(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, newTestConfig().Validate())
+
+	config := newTestConfig()
+	config.Kind = "ConfigMap"
+	assert.EqualError(t, config.Validate(), "kind must be ConfigManagementPlugin, but is 'ConfigMap'")
+
+	config = newTestConfig()
+	config.Metadata.Name = ""
+	assert.EqualError(t, config.Validate(), "metadata.name is required")
+
+	config = newTestConfig()
+	config.Spec.Generate = v1alpha1.Command{}
+	assert.EqualError(t, config.Validate(), "spec.generate.command is required")
+
+	config = newTestConfig()
+	config.Spec.Parameters = append(config.Spec.Parameters, ParameterSpec{Name: "debug"})
+	assert.EqualError(t, config.Validate(), "parameter debug is declared more than once")
+
+	config = newTestConfig()
+	config.Spec.Parameters = []ParameterSpec{{Name: "replicas", Type: "int"}}
+	assert.EqualError(t, config.Validate(), "parameter replicas has invalid type 'int', must be one of: string, boolean, number")
+}
+
+func TestGenerateManifest(t *testing.T) {
+	service := NewService(newTestConfig())
+
+	t.Run("Parameters", func(t *testing.T) {
+		stream := &fakeStream{}
+		err := service.GenerateManifest(&apiclient.ManifestRequest{
+			AppPath:    "./testdata",
+			RepoPath:   ".",
+			Env:        []*apiclient.EnvEntry{{Name: "ARGOCD_APP_NAME", Value: "my-app"}},
+			Parameters: []*apiclient.Parameter{{Name: "image-tag", Value: "v1"}, {Name: "debug", Value: "true"}},
+		}, stream)
+		assert.NoError(t, err)
+		assert.Len(t, stream.manifests, 2)
+
+		var obj map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(stream.manifests[0]), &obj))
+		assert.Equal(t, "my-app", obj["metadata"].(map[string]interface{})["name"])
+		data := obj["data"].(map[string]interface{})
+		assert.Equal(t, "1", data["replicas"])
+		var params map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(data["params"].(string)), &params))
+		assert.Equal(t, map[string]interface{}{"replicas": float64(1), "debug": true, "image-tag": "v1"}, params)
+	})
+
+	t.Run("RequiredParameter", func(t *testing.T) {
+		err := service.GenerateManifest(&apiclient.ManifestRequest{AppPath: "./testdata", RepoPath: "."}, &fakeStream{})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = parameter image-tag of plugin test is required")
+	})
+
+	t.Run("UnknownParameter", func(t *testing.T) {
+		err := service.GenerateManifest(&apiclient.ManifestRequest{
+			AppPath:    "./testdata",
+			RepoPath:   ".",
+			Parameters: []*apiclient.Parameter{{Name: "image-tag", Value: "v1"}, {Name: "foo", Value: "bar"}},
+		}, &fakeStream{})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = plugin test has no parameter foo")
+	})
+
+	t.Run("InvalidParameter", func(t *testing.T) {
+		err := service.GenerateManifest(&apiclient.ManifestRequest{
+			AppPath:    "./testdata",
+			RepoPath:   ".",
+			Parameters: []*apiclient.Parameter{{Name: "image-tag", Value: "v1"}, {Name: "debug", Value: "maybe"}},
+		}, &fakeStream{})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = parameter debug of plugin test: 'maybe' is not a boolean")
+	})
+
+	t.Run("OutsideOfRepository", func(t *testing.T) {
+		err := service.GenerateManifest(&apiclient.ManifestRequest{AppPath: "..", RepoPath: "."}, &fakeStream{})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = application path .. is outside of the repository .")
+	})
+}
+
+func TestMatchRepository(t *testing.T) {
+	config := newTestConfig()
+	service := NewService(config)
+
+	res, err := service.MatchRepository(context.Background(), &apiclient.RepositoryRequest{AppPath: "./testdata"})
+	assert.NoError(t, err)
+	assert.False(t, res.IsSupported)
+
+	config.Spec.Discover = &Discover{FileName: "*.cue"}
+	res, err = service.MatchRepository(context.Background(), &apiclient.RepositoryRequest{AppPath: "./testdata"})
+	assert.NoError(t, err)
+	assert.True(t, res.IsSupported)
+
+	config.Spec.Discover = &Discover{FileName: "*.jsonnet"}
+	res, err = service.MatchRepository(context.Background(), &apiclient.RepositoryRequest{AppPath: "./testdata"})
+	assert.NoError(t, err)
+	assert.False(t, res.IsSupported)
+
+	config.Spec.Discover = &Discover{Find: &v1alpha1.Command{Command: []string{"sh", "-c", "find . -name '*.cue'"}}}
+	res, err = service.MatchRepository(context.Background(), &apiclient.RepositoryRequest{AppPath: "./testdata"})
+	assert.NoError(t, err)
+	assert.True(t, res.IsSupported)
+
+	config.Spec.Discover = &Discover{Find: &v1alpha1.Command{Command: []string{"sh", "-c", "exit 1"}}}
+	res, err = service.MatchRepository(context.Background(), &apiclient.RepositoryRequest{AppPath: "./testdata"})
+	assert.NoError(t, err)
+	assert.False(t, res.IsSupported)
+}
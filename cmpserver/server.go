@@ -0,0 +1,50 @@
+package cmpserver
+
+import (
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/argoproj/argo-cd/cmpserver/apiclient"
+	"github.com/argoproj/argo-cd/cmpserver/plugin"
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+)
+
+// ArgoCDCMPServer is the config management plugin server implementation
+type ArgoCDCMPServer struct {
+	log    *log.Entry
+	config *plugin.PluginConfig
+	opts   []grpc.ServerOption
+}
+
+// NewServer returns a new instance of the config management plugin server. The server listens on a unix socket,
+// which only the containers of the repo server pod share, so it does not use TLS.
+func NewServer(config *plugin.PluginConfig) *ArgoCDCMPServer {
+	serverLog := log.NewEntry(log.StandardLogger())
+	streamInterceptors := []grpc.StreamServerInterceptor{grpc_logrus.StreamServerInterceptor(serverLog), grpc_util.PanicLoggerStreamServerInterceptor(serverLog)}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_logrus.UnaryServerInterceptor(serverLog), grpc_util.PanicLoggerUnaryServerInterceptor(serverLog)}
+
+	return &ArgoCDCMPServer{
+		log:    serverLog,
+		config: config,
+		opts: []grpc.ServerOption{
+			grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
+			grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+			grpc.MaxSendMsgSize(apiclient.MaxGRPCMessageSize),
+		},
+	}
+}
+
+// CreateGRPC creates new configured grpc server
+func (a *ArgoCDCMPServer) CreateGRPC() *grpc.Server {
+	server := grpc.NewServer(a.opts...)
+	pluginService := plugin.NewService(a.config)
+	apiclient.RegisterConfigManagementPluginServiceServer(server, pluginService)
+
+	// Register reflection service on gRPC server.
+	reflection.Register(server)
+
+	return server
+}
@@ -19,6 +19,8 @@ const (
 	ArgoCDKnownHostsConfigMapName = "argocd-ssh-known-hosts-cm"
 	// Contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDTLSCertsConfigMapName = "argocd-tls-certs-cm"
+	// Contains GnuPG public keys for verifying the signatures of revisions
+	ArgoCDGPGKeysConfigMapName = "argocd-gpg-keys-cm"
 )
 
 // Default system namespace
@@ -45,6 +47,10 @@ const (
 	DefaultPathSSHConfig = "/app/config/ssh"
 	// Default name for the SSH known hosts file
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
+	// The default path where the config management plugin sidecars create their sockets
+	DefaultPluginSockFilePath = "/home/argocd/cmp-server/plugins"
+	// The default path of the config file of a config management plugin sidecar
+	DefaultPluginConfigFilePath = "/home/argocd/cmp-server/config/plugin.yaml"
 )
 
 // Argo CD application related constants
@@ -96,6 +102,9 @@ const (
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
+	// LabelValueSecretTypeEnv indicates a secret type of environment variables, which applications may source the
+	// environment variables of plugins, Helm and Kustomize from
+	LabelValueSecretTypeEnv = "env"
 
 	// AnnotationCompareOptions is a comma-separated list of options for comparison
 	AnnotationCompareOptions = "argocd.argoproj.io/compare-options"
@@ -107,6 +116,8 @@ const (
 	AnnotationKeyHook = "argocd.argoproj.io/hook"
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
 	AnnotationKeyHookDeletePolicy = "argocd.argoproj.io/hook-delete-policy"
+	// AnnotationKeyHookWeight orders the hooks within a phase and wave
+	AnnotationKeyHookWeight = "argocd.argoproj.io/hook-weight"
 	// AnnotationKeyRefresh is the annotation key which indicates that app needs to be refreshed. Removed by application controller after app is refreshed.
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh = "argocd.argoproj.io/refresh"
@@ -114,6 +125,11 @@ const (
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
 	AnnotationValueManagedByArgoCD = "argocd.argoproj.io"
+	// AnnotationKeyEnvProjects is the annotation on secrets of environment variables which contains a comma separated
+	// list of the projects whose applications may source variables from the secret, or '*' for all projects
+	AnnotationKeyEnvProjects = "argocd.argoproj.io/env-projects"
+	// AnnotationKeyCertificateAnnotations is the annotation on the certificate ConfigMaps which stores the annotations of each certificate as JSON
+	AnnotationKeyCertificateAnnotations = "argocd.argoproj.io/certificate-annotations"
 	// AnnotationKeyHelmHook is the helm hook annotation
 	AnnotationKeyHelmHook = "helm.sh/hook"
 	// AnnotationValueHelmHookCRDInstall is a value of crd helm hook
@@ -135,6 +151,8 @@ const (
 	EnvVarSSHDataPath = "ARGOCD_SSH_DATA_PATH"
 	// Overrides the location where TLS certificate for repo access data is stored
 	EnvVarTLSDataPath = "ARGOCD_TLS_DATA_PATH"
+	// Overrides the location where the config management plugin sidecars create their sockets
+	EnvVarPluginSockFilePath = "ARGOCD_PLUGINSOCKFILEPATH"
 )
 
 const (
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"os"
 	"reflect"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -26,6 +29,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	statecache "github.com/argoproj/argo-cd/controller/cache"
 	"github.com/argoproj/argo-cd/controller/metrics"
+	"github.com/argoproj/argo-cd/controller/sharding"
 	"github.com/argoproj/argo-cd/errors"
 	"github.com/argoproj/argo-cd/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -85,6 +89,10 @@ type ApplicationController struct {
 	refreshRequestedApps      map[string]CompareWith
 	refreshRequestedAppsMutex *sync.Mutex
 	metricsServer             *metrics.MetricsServer
+	// the number of shards the clusters are distributed over, and the shard of the controller, or -1 while it has no
+	// shard
+	shards int
+	shard  int64
 }
 
 type ApplicationControllerConfig struct {
@@ -103,6 +111,7 @@ func NewApplicationController(
 	appResyncPeriod time.Duration,
 	selfHealTimeout time.Duration,
 	metricsPort int,
+	shards int,
 ) (*ApplicationController, error) {
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
 	kubectlCmd := kube.KubectlCmd{}
@@ -122,16 +131,20 @@ func NewApplicationController(
 		auditLogger:               argo.NewAuditLogger(namespace, kubeClientset, "argocd-application-controller"),
 		settingsMgr:               settingsMgr,
 		selfHealTimeout:           selfHealTimeout,
+		shards:                    shards,
+	}
+	if shards > 1 {
+		ctrl.shard = -1
 	}
 	appInformer, appLister := ctrl.newApplicationInformerAndLister()
 	projInformer := v1alpha1.NewAppProjectInformer(applicationClientset, namespace, appResyncPeriod, cache.Indexers{})
 	metricsAddr := fmt.Sprintf("0.0.0.0:%d", metricsPort)
-	ctrl.metricsServer = metrics.NewMetricsServer(metricsAddr, appLister, func() error {
+	ctrl.metricsServer = metrics.NewMetricsServer(metricsAddr, appLister, ctrl.canProcessApp, func() error {
 		_, err := kubeClientset.Discovery().ServerVersion()
 		return err
 	})
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectlCmd, ctrl.metricsServer, ctrl.handleAppUpdated)
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectlCmd, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer)
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectlCmd, ctrl.metricsServer, ctrl.handleAppUpdated, ctrl.isClusterOwned)
+	appStateManager := NewAppStateManager(db, kubeClientset, applicationClientset, repoClientset, namespace, kubectlCmd, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -221,7 +234,67 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 
 		}
 	}
-	return &appv1.ApplicationTree{Nodes: nodes}, nil
+	orphanedNodes, err := ctrl.getOrphanedNodes(a, managedResources)
+	if err != nil {
+		return nil, err
+	}
+	return &appv1.ApplicationTree{Nodes: nodes, OrphanedNodes: orphanedNodes}, nil
+}
+
+// getOrphanedNodes returns the top level resources in the destination namespace of the application which are not managed
+// by any application, if orphaned resources monitoring is enabled in the project of the application
+func (ctrl *ApplicationController) getOrphanedNodes(a *appv1.Application, managedResources []*appv1.ResourceDiff) ([]appv1.ResourceNode, error) {
+	// an invalid project is reported by the application conditions
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+	if err != nil || proj.Spec.OrphanedResources == nil || a.Spec.Destination.Namespace == "" {
+		return nil, nil
+	}
+	nodesByKey, err := ctrl.stateCache.GetNamespaceTopLevelResources(a.Spec.Destination.Server, a.Spec.Destination.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range managedResources {
+		delete(nodesByKey, kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name))
+	}
+	keys := make([]kube.ResourceKey, 0)
+	for key := range nodesByKey {
+		if proj.IsResourcePermitted(metav1.GroupKind{Group: key.Group, Kind: key.Kind}, true) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	orphanedNodes := make([]appv1.ResourceNode, len(keys))
+	for i, key := range keys {
+		orphanedNodes[i] = nodesByKey[key]
+	}
+	return orphanedNodes, nil
+}
+
+// setOrphanedResourcesCondition sets the orphaned resource warning condition if the application has orphaned resources
+// and its project warns about them, and removes the condition otherwise
+func (ctrl *ApplicationController) setOrphanedResourcesCondition(app *appv1.Application, tree *appv1.ApplicationTree) {
+	warn := false
+	if len(tree.OrphanedNodes) > 0 {
+		proj, err := argo.GetAppProject(&app.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+		warn = err == nil && proj.Spec.OrphanedResources != nil && proj.Spec.OrphanedResources.IsWarn()
+	}
+	conditions := make([]appv1.ApplicationCondition, 0)
+	for _, condition := range app.Status.Conditions {
+		if condition.Type != appv1.ApplicationConditionOrphanedResourceWarning {
+			conditions = append(conditions, condition)
+		}
+	}
+	if warn {
+		conditions = append(conditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionOrphanedResourceWarning,
+			Message: fmt.Sprintf("Application has %d orphaned resources", len(tree.OrphanedNodes)),
+		})
+	}
+	if len(conditions) != len(app.Status.Conditions) || warn {
+		app.Status.Conditions = conditions
+	}
 }
 
 func (ctrl *ApplicationController) managedResources(comparisonResult *comparisonResult) ([]*appv1.ResourceDiff, error) {
@@ -277,11 +350,69 @@ func (ctrl *ApplicationController) managedResources(comparisonResult *comparison
 	return items, nil
 }
 
+// isClusterOwned returns whether the applications of a cluster are processed by the shard of the controller
+func (ctrl *ApplicationController) isClusterOwned(cluster *appv1.Cluster) bool {
+	return sharding.GetClusterShard(cluster, ctrl.shards) == int(atomic.LoadInt64(&ctrl.shard))
+}
+
+// canProcessApp returns whether the destination cluster of an application belongs to the shard of the controller
+func (ctrl *ApplicationController) canProcessApp(obj interface{}) bool {
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return false
+	}
+	if ctrl.shards <= 1 {
+		return true
+	}
+	cluster, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		// the applications of unknown clusters are processed by the shard of the server address, which reports the error
+		cluster = &appv1.Cluster{Server: app.Spec.Destination.Server}
+	}
+	return ctrl.isClusterOwned(cluster)
+}
+
+// acquireShard blocks until the controller claimed a shard, and keeps renewing the lease of the shard. The controller
+// exits once the lease is lost, since another replica takes over the shard.
+func (ctrl *ApplicationController) acquireShard(ctx context.Context) (int, error) {
+	identity, err := os.Hostname()
+	if err != nil {
+		return -1, err
+	}
+	elector := sharding.NewElector(ctrl.kubeClientset, ctrl.namespace, identity, ctrl.shards)
+	log.Infof("Waiting to claim one of %d shards", ctrl.shards)
+	shard, err := elector.Acquire(ctx)
+	if err != nil {
+		return -1, err
+	}
+	go func() {
+		if err := elector.Renew(ctx); err != nil {
+			log.Fatalf("Stopping since the lease of shard %d was lost: %v", shard, err)
+		}
+	}()
+	return shard, nil
+}
+
 // Run starts the Application CRD controller.
 func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int, operationProcessors int) {
 	defer runtime.HandleCrash()
 	defer ctrl.appRefreshQueue.ShutDown()
 
+	// the metrics server serves the health check while the controller stands by for a shard
+	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+
+	shard := 0
+	if ctrl.shards > 1 {
+		var err error
+		shard, err = ctrl.acquireShard(ctx)
+		if err != nil {
+			log.Errorf("Failed to claim a shard: %v", err)
+			return
+		}
+	}
+	atomic.StoreInt64(&ctrl.shard, int64(shard))
+	ctrl.metricsServer.SetShard(shard)
+
 	go ctrl.appInformer.Run(ctx.Done())
 	go ctrl.projInformer.Run(ctx.Done())
 
@@ -291,7 +422,6 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	}
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
-	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -354,6 +484,9 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	if !ctrl.canProcessApp(app) {
+		return
+	}
 	if app.Operation != nil {
 		ctrl.processRequestedAppOperation(app)
 	} else if app.DeletionTimestamp != nil && app.CascadedDeletion() {
@@ -507,15 +640,59 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		}
 		app = freshApp
 		state = app.Status.OperationState.DeepCopy()
-		logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
+		if state.Phase == appv1.OperationRunning && state.FinishedAt != nil && state.Operation.Retry != nil {
+			// the previous attempt of the operation failed and the operation is to be retried
+			retryAt, err := state.Operation.Retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount-1)
+			if err != nil {
+				state.Phase = appv1.OperationFailed
+				state.Message = fmt.Sprintf("Failed to retry operation: %v", err)
+				ctrl.setOperationState(app, state)
+				return
+			}
+			if retryAfter := time.Until(retryAt); retryAfter > 0 {
+				logCtx.Infof("Skipping retry of in-progress operation until %s", retryAt.Format(time.RFC3339))
+				if key, err := cache.MetaNamespaceKeyFunc(app); err == nil {
+					ctrl.appOperationQueue.AddAfter(key, retryAfter)
+				}
+				return
+			}
+			// the sync result of the failed attempt must not be resumed
+			state.FinishedAt = nil
+			state.SyncResult = nil
+			ctrl.setOperationState(app, state)
+			logCtx.Infof("Retrying operation. attempt: %d", state.RetryCount)
+		} else {
+			logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
+		}
 	} else {
 		state = &appv1.OperationState{Phase: appv1.OperationRunning, Operation: *app.Operation, StartedAt: metav1.Now()}
 		ctrl.setOperationState(app, state)
 		logCtx.Infof("Initialized new operation: %v", *app.Operation)
 	}
 
+	terminating := state.Phase == appv1.OperationTerminating
 	ctrl.appStateManager.SyncAppState(app, state)
 
+	if !terminating && (state.Phase == appv1.OperationFailed || state.Phase == appv1.OperationError) {
+		retry := state.Operation.Retry
+		if retry != nil && state.RetryCount < retry.Limit {
+			// keep the operation running, so that it is retried once the backoff elapsed. The time of the
+			// failure is remembered in the finishedAt field.
+			now := metav1.Now()
+			retryAt, err := retry.NextRetryAt(now.Time, state.RetryCount)
+			if err != nil {
+				state.Message = fmt.Sprintf("%s. Failed to retry operation: %v", state.Message, err)
+			} else {
+				state.Phase = appv1.OperationRunning
+				state.FinishedAt = &now
+				state.RetryCount++
+				state.Message = fmt.Sprintf("%s. Retrying attempt #%d at %s.", state.Message, state.RetryCount, retryAt.Format(time.Kitchen))
+			}
+		} else if state.RetryCount > 0 {
+			state.Message = fmt.Sprintf("%s (retried %d times).", state.Message, state.RetryCount)
+		}
+	}
+
 	if state.Phase == appv1.OperationRunning {
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
@@ -555,9 +732,25 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			now := metav1.Now()
 			state.FinishedAt = &now
 		}
+		stateJSON, err := json.Marshal(state)
+		if err != nil {
+			return err
+		}
+		var operationState map[string]interface{}
+		if err := json.Unmarshal(stateJSON, &operationState); err != nil {
+			return err
+		}
+		// A retried operation drops the finish time and the sync result of the failed attempt, which
+		// requires explicit nulls in the merge patch.
+		if state.FinishedAt == nil {
+			operationState["finishedAt"] = nil
+		}
+		if state.SyncResult == nil {
+			operationState["syncResult"] = nil
+		}
 		patch := map[string]interface{}{
 			"status": map[string]interface{}{
-				"operationState": state,
+				"operationState": operationState,
 			},
 		}
 		if state.Phase.Completed() {
@@ -636,6 +829,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	if !ctrl.canProcessApp(origApp) {
+		return
+	}
 	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout)
 
 	if !needRefresh {
@@ -660,6 +856,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 				app.Status.Conditions = []appv1.ApplicationCondition{{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()}}
 			} else {
 				app.Status.Summary = tree.GetSummary()
+				ctrl.setOrphanedResourcesCondition(app, tree)
 				if err = ctrl.cache.SetAppResourcesTree(app.Name, tree); err != nil {
 					logCtx.Errorf("Failed to cache resources tree: %v", err)
 					return
@@ -686,11 +883,15 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		localManifests = opState.Operation.Sync.Manifests
 	}
 
-	revision := app.Spec.Source.TargetRevision
+	var revisions []string
 	if comparisonLevel == CompareWithRecent {
-		revision = app.Status.Sync.Revision
+		if app.Spec.HasMultipleSources() {
+			revisions = app.Status.Sync.Revisions
+		} else {
+			revisions = []string{app.Status.Sync.Revision}
+		}
 	}
-	compareResult, err := ctrl.appStateManager.CompareAppState(app, revision, app.Spec.Source, refreshType == appv1.RefreshTypeHard, localManifests)
+	compareResult, err := ctrl.appStateManager.CompareAppState(app, revisions, app.Spec.GetSources(), refreshType == appv1.RefreshTypeHard, localManifests)
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()})
 	} else {
@@ -716,6 +917,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	app.Status.Resources = compareResult.resources
 	app.Status.Conditions = conditions
 	app.Status.SourceType = compareResult.appSourceType
+	if tree != nil {
+		ctrl.setOrphanedResourcesCondition(app, tree)
+	}
 	ctrl.persistAppStatus(origApp, &app.Status)
 	return
 }
@@ -738,8 +942,10 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 		reason = fmt.Sprintf("controller refresh requested")
 	} else if app.Status.Sync.Status == appv1.SyncStatusCodeUnknown && expired {
 		reason = "comparison status unknown"
-	} else if !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
+	} else if !app.Spec.HasMultipleSources() && !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
 		reason = "spec.source differs"
+	} else if app.Spec.HasMultipleSources() && !app.Spec.Sources.Equals(app.Status.Sync.ComparedTo.Sources) {
+		reason = "spec.sources differ"
 	} else if !app.Spec.Destination.Equals(app.Status.Sync.ComparedTo.Destination) {
 		reason = "spec.destination differs"
 	} else if expired {
@@ -788,6 +994,7 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 		appv1.ApplicationConditionSyncError:               true,
 		appv1.ApplicationConditionRepeatedResourceWarning: true,
 		appv1.ApplicationConditionExcludedResourceWarning: true,
+		appv1.ApplicationConditionPruneSkippedWarning:     true,
 	}
 	appConditions := make([]appv1.ApplicationCondition, 0)
 	for i := 0; i < len(app.Status.Conditions); i++ {
@@ -886,15 +1093,27 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: application status is %s", syncStatus.Status)
 		return nil
 	}
+	// an invalid project is reported by the sync operation itself
+	proj, err := argo.GetAppProject(&app.Spec, applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()), ctrl.namespace)
+	if err == nil && !proj.Spec.SyncWindows.Matches(app).CanSync(time.Now()) {
+		logCtx.Infof("Skipping auto-sync: blocked by sync window")
+		return nil
+	}
 
 	desiredCommitSHA := syncStatus.Revision
-	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA)
+	if app.Spec.HasMultipleSources() {
+		desiredCommitSHA = strings.Join(syncStatus.Revisions, ",")
+	}
+	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, syncStatus)
 	selfHeal := app.Spec.SyncPolicy.Automated.SelfHeal
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision: desiredCommitSHA,
-			Prune:    app.Spec.SyncPolicy.Automated.Prune,
+			Revision:  syncStatus.Revision,
+			Revisions: syncStatus.Revisions,
+			Prune:     app.Spec.SyncPolicy.Automated.Prune,
 		},
+		Retry:       app.Spec.SyncPolicy.Retry,
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
 	}
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
@@ -910,6 +1129,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil
 	} else if alreadyAttempted && selfHeal {
 		if shouldSelfHeal, retryAfter := ctrl.shouldSelfHeal(app); shouldSelfHeal {
+			op.Sync.SelfHeal = true
 			for _, resource := range resources {
 				if resource.Status != appv1.SyncStatusCodeSynced {
 					op.Sync.Resources = append(op.Sync.Resources, appv1.SyncOperationResource{
@@ -933,7 +1153,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	}
 
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
-	_, err := argo.SetAppOperation(appIf, app.Name, &op)
+	_, err = argo.SetAppOperation(appIf, app.Name, &op)
 	if err != nil {
 		logCtx.Errorf("Failed to initiate auto-sync to %s: %v", desiredCommitSHA, err)
 		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
@@ -945,12 +1165,19 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 }
 
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
-// commitSHA and with the same app source config which are currently set in the app
-func alreadyAttemptedSync(app *appv1.Application, commitSHA string) (bool, appv1.OperationPhase) {
+// commitSHAs of the sync status and with the same app source config which are currently set in the app
+func alreadyAttemptedSync(app *appv1.Application, syncStatus *appv1.SyncStatus) (bool, appv1.OperationPhase) {
 	if app.Status.OperationState == nil || app.Status.OperationState.Operation.Sync == nil || app.Status.OperationState.SyncResult == nil {
 		return false, ""
 	}
-	if app.Status.OperationState.SyncResult.Revision != commitSHA {
+	syncResult := app.Status.OperationState.SyncResult
+	if app.Spec.HasMultipleSources() {
+		if !reflect.DeepEqual(syncResult.Revisions, syncStatus.Revisions) {
+			return false, ""
+		}
+		return app.Spec.Sources.Equals(syncResult.Sources), app.Status.OperationState.Phase
+	}
+	if syncResult.Revision != syncStatus.Revision {
 		return false, ""
 	}
 	// Ignore differences in target revision, since we already just verified commitSHAs are equal,
@@ -988,6 +1215,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	informer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
+				if !ctrl.canProcessApp(obj) {
+					return
+				}
 				key, err := cache.MetaNamespaceKeyFunc(obj)
 				if err == nil {
 					ctrl.appRefreshQueue.Add(key)
@@ -995,6 +1225,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 			},
 			UpdateFunc: func(old, new interface{}) {
+				if !ctrl.canProcessApp(new) {
+					return
+				}
 				key, err := cache.MetaNamespaceKeyFunc(new)
 				if err != nil {
 					return
@@ -20,6 +20,7 @@ import (
 
 	"github.com/argoproj/argo-cd/common"
 	mockstatecache "github.com/argoproj/argo-cd/controller/cache/mocks"
+	"github.com/argoproj/argo-cd/controller/sharding"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
@@ -32,9 +33,10 @@ import (
 )
 
 type fakeData struct {
-	apps             []runtime.Object
-	manifestResponse *apiclient.ManifestResponse
-	managedLiveObjs  map[kube.ResourceKey]*unstructured.Unstructured
+	apps                []runtime.Object
+	manifestResponse    *apiclient.ManifestResponse
+	managedLiveObjs     map[kube.ResourceKey]*unstructured.Unstructured
+	namespacedResources map[kube.ResourceKey]argoappv1.ResourceNode
 }
 
 func newFakeController(data *fakeData) *ApplicationController {
@@ -82,6 +84,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 		time.Minute,
 		time.Minute,
 		common.DefaultPortArgoCDMetrics,
+		1,
 	)
 	if err != nil {
 		panic(err)
@@ -95,6 +98,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 		mockStateCache := mockstatecache.LiveStateCache{}
 		mockStateCache.On("GetManagedLiveObjs", mock.Anything, mock.Anything).Return(data.managedLiveObjs, nil)
 		mockStateCache.On("IsNamespaced", mock.Anything, mock.Anything).Return(true, nil)
+		mockStateCache.On("GetNamespaceTopLevelResources", mock.Anything, mock.Anything).Return(data.namespacedResources, nil)
 		ctrl.stateCache = &mockStateCache
 		ctrl.appStateManager.(*appStateManager).liveStateCache = &mockStateCache
 	}
@@ -189,6 +193,57 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncSelfHeal(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.SelfHeal = true
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+		Phase:      argoappv1.OperationSucceeded,
+		FinishedAt: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+		SyncResult: &argoappv1.SyncOperationResult{
+			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			Source:   app.Spec.Source,
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{
+		{Group: "apps", Kind: "Deployment", Name: "drifted", Status: argoappv1.SyncStatusCodeOutOfSync},
+		{Group: "apps", Kind: "Deployment", Name: "synced", Status: argoappv1.SyncStatusCodeSynced},
+	})
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.True(t, app.Operation.Sync.SelfHeal)
+	assert.Equal(t, []argoappv1.SyncOperationResource{{Group: "apps", Kind: "Deployment", Name: "drifted"}}, app.Operation.Sync.Resources)
+}
+
+func TestAutoSyncBlockedBySyncWindow(t *testing.T) {
+	app := newFakeApp()
+	proj := argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncWindows:  argoappv1.SyncWindows{{Kind: argoappv1.SyncWindowKindDeny, Schedule: "* * * * *", Duration: "1h", Applications: []string{"my-*"}}},
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &proj}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{})
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
 func TestSkipAutoSync(t *testing.T) {
 	// Verify we skip when we previously synced to it in our most recent history
 	// Set current to 'aaaaa', desired to 'aaaa' and mark system OutOfSync
@@ -475,6 +530,36 @@ func TestHandleAppUpdated(t *testing.T) {
 	assert.Equal(t, CompareWithRecent, level)
 }
 
+func TestCanProcessApp(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	assert.True(t, ctrl.canProcessApp(app))
+
+	ctrl.shards = 2
+	shard := sharding.GetClusterShard(&argoappv1.Cluster{Server: app.Spec.Destination.Server}, 2)
+	ctrl.shard = int64(shard)
+	assert.True(t, ctrl.canProcessApp(app))
+	ctrl.shard = int64(1 - shard)
+	assert.False(t, ctrl.canProcessApp(app))
+	ctrl.shard = -1
+	assert.False(t, ctrl.canProcessApp(app))
+
+	// the assigned shard of the cluster takes precedence over the shard of its server address
+	cluster, err := ctrl.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	assert.NoError(t, err)
+	assigned := int64(1 - shard)
+	cluster.Shard = &assigned
+	_, err = ctrl.db.UpdateCluster(context.Background(), cluster)
+	assert.NoError(t, err)
+	ctrl.shard = assigned
+	assert.True(t, ctrl.canProcessApp(app))
+
+	// the applications of unknown clusters are processed by the shard of the server address
+	app.Spec.Destination.Server = "https://unknown-cluster"
+	ctrl.shard = int64(sharding.GetClusterShard(&argoappv1.Cluster{Server: app.Spec.Destination.Server}, 2))
+	assert.True(t, ctrl.canProcessApp(app))
+}
+
 func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
 	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
@@ -488,6 +573,96 @@ func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	assert.True(t, patched)
 }
 
+func TestProcessRequestedAppOperationRetry(t *testing.T) {
+	app := newFakeApp()
+	// an operation without a sync fails immediately
+	app.Operation = &argoappv1.Operation{Retry: &argoappv1.RetryStrategy{Limit: 1}}
+	app.Status.OperationState = nil
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace)
+
+	ctrl.processRequestedAppOperation(app)
+	app, err := appIf.Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.OperationRunning, app.Status.OperationState.Phase)
+	assert.Equal(t, int64(1), app.Status.OperationState.RetryCount)
+	assert.NotNil(t, app.Status.OperationState.FinishedAt)
+	assert.Contains(t, app.Status.OperationState.Message, "Retrying attempt #1")
+	assert.NotNil(t, app.Operation)
+
+	// the retry is skipped until the backoff elapsed
+	ctrl.processRequestedAppOperation(app)
+	app, err = appIf.Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.OperationRunning, app.Status.OperationState.Phase)
+	assert.Equal(t, int64(1), app.Status.OperationState.RetryCount)
+
+	// the operation fails for good once the retries are exhausted
+	failedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	app.Status.OperationState.FinishedAt = &failedAt
+	app, err = appIf.Update(app)
+	assert.NoError(t, err)
+	ctrl.processRequestedAppOperation(app)
+	app, err = appIf.Get("my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.OperationFailed, app.Status.OperationState.Phase)
+	assert.Contains(t, app.Status.OperationState.Message, "(retried 1 times)")
+	assert.Nil(t, app.Operation)
+}
+
+func TestGetResourceTreeOrphanedNodes(t *testing.T) {
+	app := newFakeApp()
+	proj := argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:                []string{"*"},
+			Destinations:               []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Secret"}},
+		},
+	}
+	managedDeploy := kube.NewResourceKey("apps", "Deployment", test.FakeDestNamespace, "managed")
+	orphanedDeploy := kube.NewResourceKey("apps", "Deployment", test.FakeDestNamespace, "orphaned")
+	orphanedConfigMap := kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "orphaned")
+	blacklistedSecret := kube.NewResourceKey("", "Secret", test.FakeDestNamespace, "blacklisted")
+	namespacedResources := make(map[kube.ResourceKey]argoappv1.ResourceNode)
+	for _, key := range []kube.ResourceKey{managedDeploy, orphanedDeploy, orphanedConfigMap, blacklistedSecret} {
+		namespacedResources[key] = argoappv1.ResourceNode{ResourceRef: argoappv1.ResourceRef{Group: key.Group, Kind: key.Kind, Namespace: key.Namespace, Name: key.Name}}
+	}
+	newCtrl := func() *ApplicationController {
+		return newFakeController(&fakeData{
+			apps:                []runtime.Object{app, &proj},
+			managedLiveObjs:     make(map[kube.ResourceKey]*unstructured.Unstructured),
+			namespacedResources: namespacedResources,
+		})
+	}
+	managedResources := []*argoappv1.ResourceDiff{{Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "managed", LiveState: "null", TargetState: `{"apiVersion": "apps/v1", "kind": "Deployment"}`}}
+
+	// orphaned resources are not monitored by default
+	ctrl := newCtrl()
+	tree, err := ctrl.getResourceTree(app, managedResources)
+	assert.NoError(t, err)
+	assert.Empty(t, tree.OrphanedNodes)
+	ctrl.setOrphanedResourcesCondition(app, tree)
+	assert.Empty(t, app.Status.Conditions)
+
+	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{}
+	ctrl = newCtrl()
+	tree, err = ctrl.getResourceTree(app, managedResources)
+	assert.NoError(t, err)
+	assert.Equal(t, []argoappv1.ResourceNode{namespacedResources[orphanedConfigMap], namespacedResources[orphanedDeploy]}, tree.OrphanedNodes)
+	ctrl.setOrphanedResourcesCondition(app, tree)
+	assert.Equal(t, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionOrphanedResourceWarning, Message: "Application has 2 orphaned resources"}}, app.Status.Conditions)
+
+	warn := false
+	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{Warn: &warn}
+	ctrl = newCtrl()
+	tree, err = ctrl.getResourceTree(app, managedResources)
+	assert.NoError(t, err)
+	assert.Len(t, tree.OrphanedNodes, 2)
+	ctrl.setOrphanedResourcesCondition(app, tree)
+	assert.Empty(t, app.Status.Conditions)
+}
+
 func TestNeedRefreshAppStatus(t *testing.T) {
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
 
@@ -32,6 +32,8 @@ type LiveStateCache interface {
 	IterateHierarchy(server string, obj *unstructured.Unstructured, action func(child appv1.ResourceNode)) error
 	// Returns state of live nodes which correspond for target nodes of specified application.
 	GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error)
+	// Returns top level resources (resources without owner references) of a specified namespace, which do not belong to any application
+	GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error)
 	// Starts watching resources of each controlled cluster.
 	Run(ctx context.Context) error
 	// Invalidate invalidates the entire cluster state cache
@@ -57,9 +59,10 @@ func NewLiveStateCache(
 	settingsMgr *settings.SettingsManager,
 	kubectl kube.Kubectl,
 	metricsServer *metrics.MetricsServer,
-	onAppUpdated AppUpdatedHandler) LiveStateCache {
+	onAppUpdated AppUpdatedHandler,
+	clusterFilter func(cluster *appv1.Cluster) bool) LiveStateCache {
 
-	return &liveStateCache{
+	c := &liveStateCache{
 		appInformer:       appInformer,
 		db:                db,
 		clusters:          make(map[string]*clusterInfo),
@@ -69,7 +72,10 @@ func NewLiveStateCache(
 		settingsMgr:       settingsMgr,
 		metricsServer:     metricsServer,
 		cacheSettingsLock: &sync.Mutex{},
+		clusterFilter:     clusterFilter,
 	}
+	metricsServer.RegisterClustersInfoSource(c)
+	return c
 }
 
 type liveStateCache struct {
@@ -83,6 +89,8 @@ type liveStateCache struct {
 	metricsServer     *metrics.MetricsServer
 	cacheSettingsLock *sync.Mutex
 	cacheSettings     *cacheSettings
+	// clusterFilter returns whether the applications of a cluster are processed by the shard of the controller
+	clusterFilter func(cluster *appv1.Cluster) bool
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -141,6 +149,17 @@ func (c *liveStateCache) getSyncedCluster(server string) (*clusterInfo, error) {
 	return info, nil
 }
 
+// GetClustersInfo returns the cache sizes of the clusters
+func (c *liveStateCache) GetClustersInfo() []metrics.ClusterCacheInfo {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := make([]metrics.ClusterCacheInfo, 0, len(c.clusters))
+	for _, info := range c.clusters {
+		res = append(res, info.getClusterCacheInfo())
+	}
+	return res
+}
+
 func (c *liveStateCache) Invalidate() {
 	log.Info("invalidating live state cache")
 	c.lock.Lock()
@@ -178,6 +197,14 @@ func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*
 	return clusterInfo.getManagedLiveObjs(a, targetObjs, c.metricsServer)
 }
 
+func (c *liveStateCache) GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	return clusterInfo.getNamespaceTopLevelResources(namespace), nil
+}
+
 func isClusterHasApps(apps []interface{}, cluster *appv1.Cluster) bool {
 	for _, obj := range apps {
 		if app, ok := obj.(*appv1.Application); ok && app.Spec.Destination.Server == cluster.Server {
@@ -241,14 +268,15 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 			c.lock.Lock()
 			defer c.lock.Unlock()
 			if cluster, ok := c.clusters[event.Cluster.Server]; ok {
-				if event.Type == watch.Deleted {
+				// the cache of a cluster which was assigned to another shard is dropped, like the cache of a deleted cluster
+				if event.Type == watch.Deleted || !c.clusterFilter(event.Cluster) {
 					cluster.invalidate()
 					delete(c.clusters, event.Cluster.Server)
 				} else if event.Type == watch.Modified {
 					cluster.cluster = event.Cluster
 					cluster.invalidate()
 				}
-			} else if event.Type == watch.Added && isClusterHasApps(c.appInformer.GetStore().List(), event.Cluster) {
+			} else if event.Type == watch.Added && c.clusterFilter(event.Cluster) && isClusterHasApps(c.appInformer.GetStore().List(), event.Cluster) {
 				go func() {
 					// warm up cache for cluster with apps
 					_, _ = c.getSyncedCluster(event.Cluster.Server)
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -17,8 +18,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
@@ -33,9 +36,51 @@ const (
 )
 
 type apiMeta struct {
-	namespaced      bool
+	namespaced bool
+	// the watches of the resources by namespace, or by "" if the resources of all namespaces are watched
+	watches map[string]*watchMeta
+	// the namespaces whose resources are left out of the watch of all namespaces
+	excludedNamespaces []string
+	watchCancel        context.CancelFunc
+}
+
+type watchMeta struct {
 	resourceVersion string
-	watchCancel     context.CancelFunc
+}
+
+// watchesNamespace returns whether the resources of a namespace are watched
+func (m *apiMeta) watchesNamespace(namespace string) bool {
+	_, all := m.watches[""]
+	if !m.namespaced {
+		return all
+	}
+	if all {
+		return !containsNamespace(m.excludedNamespaces, namespace)
+	}
+	_, ok := m.watches[namespace]
+	return ok
+}
+
+// listOptions returns the options of the list or watch of the resources of a namespace. The excluded namespaces are
+// left out of the list and watch of all namespaces by the API server.
+func (m *apiMeta) listOptions(namespace string, resourceVersion string) metav1.ListOptions {
+	opts := metav1.ListOptions{ResourceVersion: resourceVersion}
+	if namespace == "" && len(m.excludedNamespaces) > 0 {
+		selectors := make([]fields.Selector, len(m.excludedNamespaces))
+		for i := range m.excludedNamespaces {
+			selectors[i] = fields.OneTermNotEqualSelector("metadata.namespace", m.excludedNamespaces[i])
+		}
+		opts.FieldSelector = fields.AndSelectors(selectors...).String()
+	}
+	return opts
+}
+
+// resourceInterface returns the interface of the resources of a namespace, or of all namespaces if the namespace is empty
+func resourceInterface(api kube.APIResourceInfo, namespace string) dynamic.ResourceInterface {
+	if namespaceableIf, ok := api.Interface.(dynamic.NamespaceableResourceInterface); ok && namespace != "" {
+		return namespaceableIf.Namespace(namespace)
+	}
+	return api.Interface
 }
 
 type clusterInfo struct {
@@ -43,6 +88,8 @@ type clusterInfo struct {
 	syncTime  *time.Time
 	syncError error
 	apisMeta  map[schema.GroupKind]*apiMeta
+	// the number of running watches of the resources
+	watchCount int64
 
 	lock    *sync.Mutex
 	nodes   map[kube.ResourceKey]*node
@@ -55,7 +102,48 @@ type clusterInfo struct {
 	cacheSettingsSrc func() *cacheSettings
 }
 
-func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion string, objs []unstructured.Unstructured) {
+// newAPIMeta returns the metadata of the watches of an API resource. The resources of namespaced APIs are watched in
+// the namespaces which are not excluded by the resources filter. If the credentials of the cluster are restricted to
+// some namespaces, only the resources of these namespaces are watched, and cluster level resources are not watched.
+func (c *clusterInfo) newAPIMeta(api kube.APIResourceInfo) *apiMeta {
+	info := &apiMeta{namespaced: api.Meta.Namespaced, watches: make(map[string]*watchMeta)}
+	var namespaces []string
+	if filter := c.cacheSettingsSrc().ResourcesFilter; filter != nil && api.Meta.Namespaced {
+		namespaces, info.excludedNamespaces = filter.GetNamespaces(api.GroupKind.Group, api.GroupKind.Kind, c.cluster.Server)
+	}
+	if len(c.cluster.Namespaces) > 0 {
+		allowed := make([]string, 0)
+		if api.Meta.Namespaced {
+			for _, namespace := range c.cluster.Namespaces {
+				included := namespaces == nil || containsNamespace(namespaces, namespace)
+				if included && !containsNamespace(info.excludedNamespaces, namespace) {
+					allowed = append(allowed, namespace)
+				}
+			}
+		}
+		namespaces, info.excludedNamespaces = allowed, nil
+	}
+	if namespaces == nil {
+		info.watches[""] = &watchMeta{}
+	}
+	for _, namespace := range namespaces {
+		info.watches[namespace] = &watchMeta{}
+	}
+	return info
+}
+
+func containsNamespace(namespaces []string, namespace string) bool {
+	for _, ns := range namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceResourceCache replaces the cached resources of a group kind in a namespace, or in all namespaces if the
+// namespace is empty
+func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, namespace string, resourceVersion string, objs []unstructured.Unstructured) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	info, ok := c.apisMeta[gk]
@@ -73,7 +161,7 @@ func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion
 		}
 
 		for key, existingNode := range c.nodes {
-			if key.Kind != gk.Kind || key.Group != gk.Group {
+			if key.Kind != gk.Kind || key.Group != gk.Group || (namespace != "" && key.Namespace != namespace) {
 				continue
 			}
 
@@ -81,7 +169,9 @@ func (c *clusterInfo) replaceResourceCache(gk schema.GroupKind, resourceVersion
 				c.onNodeRemoved(key, existingNode)
 			}
 		}
-		info.resourceVersion = resourceVersion
+		if nsWatch, ok := info.watches[namespace]; ok {
+			nsWatch.resourceVersion = resourceVersion
+		}
 	}
 }
 
@@ -139,6 +229,7 @@ func (c *clusterInfo) invalidate() {
 		c.apisMeta[i].watchCancel()
 	}
 	c.apisMeta = nil
+	atomic.StoreInt64(&c.watchCount, 0)
 }
 
 func (c *clusterInfo) synced() bool {
@@ -157,7 +248,8 @@ func (c *clusterInfo) stopWatching(gk schema.GroupKind) {
 	if info, ok := c.apisMeta[gk]; ok {
 		info.watchCancel()
 		delete(c.apisMeta, gk)
-		c.replaceResourceCache(gk, "", []unstructured.Unstructured{})
+		atomic.AddInt64(&c.watchCount, -int64(len(info.watches)))
+		c.replaceResourceCache(gk, "", "", []unstructured.Unstructured{})
 		log.Warnf("Stop watching %s not found on %s.", gk, c.cluster.Server)
 	}
 }
@@ -174,9 +266,13 @@ func (c *clusterInfo) startMissingWatches() error {
 		api := apis[i]
 		if _, ok := c.apisMeta[api.GroupKind]; !ok {
 			ctx, cancel := context.WithCancel(context.Background())
-			info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
+			info := c.newAPIMeta(api)
+			info.watchCancel = cancel
 			c.apisMeta[api.GroupKind] = info
-			go c.watchEvents(ctx, api, info)
+			for namespace, nsWatch := range info.watches {
+				go c.watchEvents(ctx, api, info, namespace, nsWatch)
+			}
+			atomic.AddInt64(&c.watchCount, int64(len(info.watches)))
 		}
 	}
 	return nil
@@ -188,7 +284,8 @@ func runSynced(lock *sync.Mutex, action func() error) error {
 	return action()
 }
 
-func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo, info *apiMeta) {
+func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo, info *apiMeta, namespace string, nsWatch *watchMeta) {
+	resIf := resourceInterface(api, namespace)
 	util.RetryUntilSucceed(func() (err error) {
 		defer func() {
 			if r := recover(); r != nil {
@@ -197,12 +294,12 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 		}()
 
 		err = runSynced(c.syncLock, func() error {
-			if info.resourceVersion == "" {
-				list, err := api.Interface.List(metav1.ListOptions{})
+			if nsWatch.resourceVersion == "" {
+				list, err := resIf.List(info.listOptions(namespace, ""))
 				if err != nil {
 					return err
 				}
-				c.replaceResourceCache(api.GroupKind, list.GetResourceVersion(), list.Items)
+				c.replaceResourceCache(api.GroupKind, namespace, list.GetResourceVersion(), list.Items)
 			}
 			return nil
 		})
@@ -211,7 +308,7 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 			return err
 		}
 
-		w, err := api.Interface.Watch(metav1.ListOptions{ResourceVersion: info.resourceVersion})
+		w, err := resIf.Watch(info.listOptions(namespace, nsWatch.resourceVersion))
 		if errors.IsNotFound(err) {
 			c.stopWatching(api.GroupKind)
 			return nil
@@ -219,7 +316,7 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 
 		err = runSynced(c.syncLock, func() error {
 			if errors.IsGone(err) {
-				info.resourceVersion = ""
+				nsWatch.resourceVersion = ""
 				log.Warnf("Resource version of %s on %s is too old.", api.GroupKind, c.cluster.Server)
 			}
 			return err
@@ -236,7 +333,7 @@ func (c *clusterInfo) watchEvents(ctx context.Context, api kube.APIResourceInfo,
 			case event, ok := <-w.ResultChan():
 				if ok {
 					obj := event.Object.(*unstructured.Unstructured)
-					info.resourceVersion = obj.GetResourceVersion()
+					nsWatch.resourceVersion = obj.GetResourceVersion()
 					c.processEvent(event.Type, obj)
 					if kube.IsCRD(obj) {
 						if event.Type == watch.Deleted {
@@ -274,6 +371,7 @@ func (c *clusterInfo) sync() (err error) {
 		c.apisMeta[i].watchCancel()
 	}
 	c.apisMeta = make(map[schema.GroupKind]*apiMeta)
+	atomic.StoreInt64(&c.watchCount, 0)
 	c.nodes = make(map[kube.ResourceKey]*node)
 
 	apis, err := c.kubectl.GetAPIResources(c.cluster.RESTConfig(), c.cacheSettingsSrc().ResourcesFilter)
@@ -283,16 +381,19 @@ func (c *clusterInfo) sync() (err error) {
 	lock := sync.Mutex{}
 	err = util.RunAllAsync(len(apis), func(i int) error {
 		api := apis[i]
-		list, err := api.Interface.List(metav1.ListOptions{})
-		if err != nil {
-			return err
-		}
+		info := c.newAPIMeta(api)
+		for namespace := range info.watches {
+			list, err := resourceInterface(api, namespace).List(info.listOptions(namespace, ""))
+			if err != nil {
+				return err
+			}
 
-		lock.Lock()
-		for i := range list.Items {
-			c.setNode(c.createObjInfo(&list.Items[i], c.cacheSettingsSrc().AppInstanceLabelKey))
+			lock.Lock()
+			for i := range list.Items {
+				c.setNode(c.createObjInfo(&list.Items[i], c.cacheSettingsSrc().AppInstanceLabelKey))
+			}
+			lock.Unlock()
 		}
-		lock.Unlock()
 		return nil
 	})
 
@@ -323,6 +424,28 @@ func (c *clusterInfo) ensureSynced() error {
 	return c.syncError
 }
 
+func (c *clusterInfo) getClusterCacheInfo() metrics.ClusterCacheInfo {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return metrics.ClusterCacheInfo{
+		Server:    c.cluster.Server,
+		Watches:   int(atomic.LoadInt64(&c.watchCount)),
+		Resources: len(c.nodes),
+	}
+}
+
+func (c *clusterInfo) getNamespaceTopLevelResources(namespace string) map[kube.ResourceKey]appv1.ResourceNode {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	nodes := make(map[kube.ResourceKey]appv1.ResourceNode)
+	for key, n := range c.nsIndex[namespace] {
+		if len(n.ownerRefs) == 0 && n.appName == "" {
+			nodes[key] = n.asResourceNode()
+		}
+	}
+	return nodes
+}
+
 func (c *clusterInfo) iterateHierarchy(obj *unstructured.Unstructured, action func(child appv1.ResourceNode)) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -399,7 +522,7 @@ func (c *clusterInfo) getManagedLiveObjs(a *appv1.Application, targetObjs []*uns
 						return err
 					}
 				}
-			} else if _, watched := c.apisMeta[key.GroupKind()]; !watched {
+			} else if info, watched := c.apisMeta[key.GroupKind()]; !watched || !info.watchesNamespace(key.Namespace) {
 				var err error
 				managedObj, err = c.kubectl.GetResource(config, targetObj.GroupVersionKind(), targetObj.GetName(), targetObj.GetNamespace())
 				if err != nil {
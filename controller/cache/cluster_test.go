@@ -17,12 +17,15 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/controller/metrics"
 	"github.com/argoproj/argo-cd/errors"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kube/kubetest"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 func strToUnstructured(jsonStr string) *unstructured.Unstructured {
@@ -248,6 +251,34 @@ metadata:
 	})
 }
 
+func TestGetNamespaceTopLevelResources(t *testing.T) {
+	orphanedDeploy := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    uid: "4"
+    name: orphaned-deploy
+    namespace: default
+    resourceVersion: "123"`)
+	otherNamespaceDeploy := strToUnstructured(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    uid: "5"
+    name: other-namespace-deploy
+    namespace: other
+    resourceVersion: "123"`)
+	cluster := newCluster(testPod, testRS, testDeploy, orphanedDeploy, otherNamespaceDeploy)
+	err := cluster.ensureSynced()
+	assert.Nil(t, err)
+
+	resources := cluster.getNamespaceTopLevelResources("default")
+	assert.Len(t, resources, 1)
+	node, ok := resources[kube.NewResourceKey("apps", "Deployment", "default", "orphaned-deploy")]
+	assert.True(t, ok)
+	assert.Equal(t, "4", node.UID)
+}
+
 func TestChildDeletedEvent(t *testing.T) {
 	cluster := newCluster(testPod, testRS, testDeploy)
 	err := cluster.ensureSynced()
@@ -421,7 +452,7 @@ func TestWatchCacheUpdated(t *testing.T) {
 
 	podGroupKind := testPod.GroupVersionKind().GroupKind()
 
-	cluster.replaceResourceCache(podGroupKind, "updated-list-version", []unstructured.Unstructured{*updated, *added})
+	cluster.replaceResourceCache(podGroupKind, "", "updated-list-version", []unstructured.Unstructured{*updated, *added})
 
 	_, ok := cluster.nodes[kube.GetResourceKey(removed)]
 	assert.False(t, ok)
@@ -451,3 +482,98 @@ func TestGetDuplicatedChildren(t *testing.T) {
 		assert.Equal(t, testRS.GetName(), children[0].Name)
 	}
 }
+
+func TestWatchNamespaces(t *testing.T) {
+	otherNamespacePod := testPod.DeepCopy()
+	otherNamespacePod.SetNamespace("kube-system")
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), testPod, otherNamespacePod, testRS)
+	var fieldSelectors []string
+	client.PrependReactor("list", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		fieldSelectors = append(fieldSelectors, action.(kubetesting.ListAction).GetListRestrictions().Fields.String())
+		return false, nil, nil
+	})
+	apiResources := []kube.APIResourceInfo{{
+		GroupKind: schema.GroupKind{Group: "", Kind: "Pod"},
+		Interface: client.Resource(schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}),
+		Meta:      metav1.APIResource{Namespaced: true},
+	}, {
+		GroupKind: schema.GroupKind{Group: "apps", Kind: "ReplicaSet"},
+		Interface: client.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}),
+		Meta:      metav1.APIResource{Namespaced: true},
+	}}
+	resourcesFilter := &settings.ResourcesFilter{}
+	cluster := newClusterExt(&kubetest.MockKubectlCmd{APIResources: apiResources})
+	cluster.cacheSettingsSrc = func() *cacheSettings {
+		return &cacheSettings{AppInstanceLabelKey: common.LabelKeyAppInstance, ResourcesFilter: resourcesFilter}
+	}
+
+	t.Run("IncludedNamespaces", func(t *testing.T) {
+		resourcesFilter.ResourceInclusions = []settings.FilteredResource{{APIGroups: []string{""}, Kinds: []string{"Pod"}, Namespaces: []string{"default"}}, {APIGroups: []string{"apps"}}}
+		cluster.invalidate()
+		assert.NoError(t, cluster.ensureSynced())
+
+		_, ok := cluster.nodes[kube.GetResourceKey(testPod)]
+		assert.True(t, ok)
+		_, ok = cluster.nodes[kube.GetResourceKey(otherNamespacePod)]
+		assert.False(t, ok)
+		_, ok = cluster.nodes[kube.GetResourceKey(testRS)]
+		assert.True(t, ok)
+
+		podsMeta := cluster.apisMeta[schema.GroupKind{Kind: "Pod"}]
+		assert.True(t, podsMeta.watchesNamespace("default"))
+		assert.False(t, podsMeta.watchesNamespace("kube-system"))
+		assert.Equal(t, metrics.ClusterCacheInfo{Watches: 2, Resources: 2}, cluster.getClusterCacheInfo())
+	})
+
+	t.Run("ExcludedNamespaces", func(t *testing.T) {
+		resourcesFilter.ResourceInclusions = nil
+		resourcesFilter.ResourceExclusions = []settings.FilteredResource{{Kinds: []string{"Pod"}, Namespaces: []string{"kube-system", "monitoring"}}}
+		fieldSelectors = nil
+		cluster.invalidate()
+		assert.NoError(t, cluster.ensureSynced())
+
+		assert.Contains(t, fieldSelectors, "metadata.namespace!=kube-system,metadata.namespace!=monitoring")
+		assert.Contains(t, fieldSelectors, "")
+		podsMeta := cluster.apisMeta[schema.GroupKind{Kind: "Pod"}]
+		assert.True(t, podsMeta.watchesNamespace("default"))
+		assert.False(t, podsMeta.watchesNamespace("kube-system"))
+		assert.True(t, cluster.apisMeta[schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}].watchesNamespace("kube-system"))
+		assert.Equal(t, 2, cluster.getClusterCacheInfo().Watches)
+	})
+}
+
+func TestClusterNamespaces(t *testing.T) {
+	otherNamespacePod := testPod.DeepCopy()
+	otherNamespacePod.SetNamespace("kube-system")
+	client := fake.NewSimpleDynamicClient(runtime.NewScheme(), testPod, otherNamespacePod)
+	apiResources := []kube.APIResourceInfo{{
+		GroupKind: schema.GroupKind{Group: "", Kind: "Pod"},
+		Interface: client.Resource(schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}),
+		Meta:      metav1.APIResource{Namespaced: true},
+	}, {
+		GroupKind: schema.GroupKind{Group: "", Kind: "Namespace"},
+		Interface: client.Resource(schema.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}),
+		Meta:      metav1.APIResource{Namespaced: false},
+	}}
+	cluster := newClusterExt(&kubetest.MockKubectlCmd{APIResources: apiResources})
+	cluster.cluster.Namespaces = []string{"default", "monitoring"}
+	cluster.cacheSettingsSrc = func() *cacheSettings {
+		return &cacheSettings{
+			AppInstanceLabelKey: common.LabelKeyAppInstance,
+			ResourcesFilter:     &settings.ResourcesFilter{ResourceExclusions: []settings.FilteredResource{{Kinds: []string{"Pod"}, Namespaces: []string{"monitoring"}}}},
+		}
+	}
+	assert.NoError(t, cluster.ensureSynced())
+
+	_, ok := cluster.nodes[kube.GetResourceKey(testPod)]
+	assert.True(t, ok)
+	_, ok = cluster.nodes[kube.GetResourceKey(otherNamespacePod)]
+	assert.False(t, ok)
+
+	podsMeta := cluster.apisMeta[schema.GroupKind{Kind: "Pod"}]
+	assert.True(t, podsMeta.watchesNamespace("default"))
+	assert.False(t, podsMeta.watchesNamespace("monitoring"))
+	assert.False(t, podsMeta.watchesNamespace("kube-system"))
+	assert.False(t, cluster.apisMeta[schema.GroupKind{Kind: "Namespace"}].watchesNamespace(""))
+	assert.Equal(t, metrics.ClusterCacheInfo{Watches: 1, Resources: 1}, cluster.getClusterCacheInfo())
+}
@@ -3,6 +3,7 @@ package metrics
 import (
 	"net/http"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -21,6 +22,23 @@ type MetricsServer struct {
 	syncCounter        *prometheus.CounterVec
 	k8sRequestCounter  *prometheus.CounterVec
 	reconcileHistogram *prometheus.HistogramVec
+	appCollector       *appCollector
+	registry           *prometheus.Registry
+}
+
+// ClusterCacheInfo holds the size of the cache of the resources of a cluster
+type ClusterCacheInfo struct {
+	// Server is the API server URL of the cluster
+	Server string
+	// Watches is the number of watches of the resources of the cluster
+	Watches int
+	// Resources is the number of cached resources of the cluster
+	Resources int
+}
+
+// HasClustersInfo is the source of the cache sizes of the clusters
+type HasClustersInfo interface {
+	GetClustersInfo() []ClusterCacheInfo
 }
 
 const (
@@ -57,12 +75,33 @@ var (
 		append(descAppDefaultLabels, "health_status"),
 		nil,
 	)
+	descClusterCacheWatches = prometheus.NewDesc(
+		"argocd_cluster_cache_watches",
+		"Number of watches of the resources of a cluster.",
+		[]string{"server"},
+		nil,
+	)
+	descClusterCacheResources = prometheus.NewDesc(
+		"argocd_cluster_cache_resources",
+		"Number of cached resources of a cluster.",
+		[]string{"server"},
+		nil,
+	)
+	descShardApps = prometheus.NewDesc(
+		"argocd_controller_shard_apps",
+		"Number of applications processed by the shard of the application controller.",
+		[]string{"shard"},
+		nil,
+	)
 )
 
-// NewMetricsServer returns a new prometheus server which collects application metrics
-func NewMetricsServer(addr string, appLister applister.ApplicationLister, healthCheck func() error) *MetricsServer {
+// NewMetricsServer returns a new prometheus server which collects application metrics of the applications accepted by
+// the filter
+func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFilter func(obj interface{}) bool, healthCheck func() error) *MetricsServer {
 	mux := http.NewServeMux()
-	appRegistry := NewAppRegistry(appLister)
+	appCollector := newAppCollector(appLister, appFilter)
+	appRegistry := prometheus.NewRegistry()
+	appRegistry.MustRegister(appCollector)
 	appRegistry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	appRegistry.MustRegister(prometheus.NewGoCollector())
 	mux.Handle(MetricsPath, promhttp.HandlerFor(appRegistry, promhttp.HandlerOpts{}))
@@ -105,9 +144,21 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, health
 		syncCounter:        syncCounter,
 		k8sRequestCounter:  k8sRequestCounter,
 		reconcileHistogram: reconcileHistogram,
+		appCollector:       appCollector,
+		registry:           appRegistry,
 	}
 }
 
+// RegisterClustersInfoSource registers the source of the cache sizes of the clusters
+func (m *MetricsServer) RegisterClustersInfoSource(source HasClustersInfo) {
+	m.registry.MustRegister(&clusterCollector{source: source})
+}
+
+// SetShard sets the shard of the controller, whose number of applications is collected
+func (m *MetricsServer) SetShard(shard int) {
+	atomic.StoreInt64(&m.appCollector.shard, int64(shard))
+}
+
 // IncSync increments the sync counter for an application
 func (m *MetricsServer) IncSync(app *argoappv1.Application, state *argoappv1.OperationState) {
 	if !state.Phase.Completed() {
@@ -127,20 +178,29 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 }
 
 type appCollector struct {
-	store applister.ApplicationLister
+	store     applister.ApplicationLister
+	appFilter func(obj interface{}) bool
+	// the shard of the controller, or -1 while it has no shard
+	shard int64
 }
 
 // NewAppCollector returns a prometheus collector for application metrics
-func NewAppCollector(appLister applister.ApplicationLister) prometheus.Collector {
+func NewAppCollector(appLister applister.ApplicationLister, appFilter func(obj interface{}) bool) prometheus.Collector {
+	return newAppCollector(appLister, appFilter)
+}
+
+func newAppCollector(appLister applister.ApplicationLister, appFilter func(obj interface{}) bool) *appCollector {
 	return &appCollector{
-		store: appLister,
+		store:     appLister,
+		appFilter: appFilter,
+		shard:     -1,
 	}
 }
 
 // NewAppRegistry creates a new prometheus registry that collects applications
-func NewAppRegistry(appLister applister.ApplicationLister) *prometheus.Registry {
+func NewAppRegistry(appLister applister.ApplicationLister, appFilter func(obj interface{}) bool) *prometheus.Registry {
 	registry := prometheus.NewRegistry()
-	registry.MustRegister(NewAppCollector(appLister))
+	registry.MustRegister(NewAppCollector(appLister, appFilter))
 	return registry
 }
 
@@ -150,6 +210,7 @@ func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descAppCreated
 	ch <- descAppSyncStatusCode
 	ch <- descAppHealthStatus
+	ch <- descShardApps
 }
 
 // Collect implements the prometheus.Collector interface
@@ -159,8 +220,33 @@ func (c *appCollector) Collect(ch chan<- prometheus.Metric) {
 		log.Warnf("Failed to collect applications: %v", err)
 		return
 	}
+	count := 0
 	for _, app := range apps {
-		collectApps(ch, app)
+		if c.appFilter(app) {
+			collectApps(ch, app)
+			count++
+		}
+	}
+	if shard := atomic.LoadInt64(&c.shard); shard >= 0 {
+		ch <- prometheus.MustNewConstMetric(descShardApps, prometheus.GaugeValue, float64(count), strconv.FormatInt(shard, 10))
+	}
+}
+
+type clusterCollector struct {
+	source HasClustersInfo
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *clusterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descClusterCacheWatches
+	ch <- descClusterCacheResources
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *clusterCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, info := range c.source.GetClustersInfo() {
+		ch <- prometheus.MustNewConstMetric(descClusterCacheWatches, prometheus.GaugeValue, float64(info.Watches), info.Server)
+		ch <- prometheus.MustNewConstMetric(descClusterCacheResources, prometheus.GaugeValue, float64(info.Resources), info.Server)
 	}
 }
 
@@ -181,7 +267,7 @@ func collectApps(ch chan<- prometheus.Metric, app *argoappv1.Application) {
 		addConstMetric(desc, prometheus.GaugeValue, v, lv...)
 	}
 
-	addGauge(descAppInfo, 1, git.NormalizeGitURL(app.Spec.Source.RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace)
+	addGauge(descAppInfo, 1, git.NormalizeGitURL(app.Spec.GetSource().RepoURL), app.Spec.Destination.Server, app.Spec.Destination.Namespace)
 
 	addGauge(descAppCreated, float64(app.CreationTimestamp.Unix()))
 
@@ -108,6 +108,10 @@ var noOpHealthCheck = func() error {
 	return nil
 }
 
+var noOpAppFilter = func(obj interface{}) bool {
+	return true
+}
+
 func newFakeApp(fakeApp string) *argoappv1.Application {
 	var app argoappv1.Application
 	err := yaml.Unmarshal([]byte(fakeApp), &app)
@@ -137,7 +141,7 @@ func newFakeLister(fakeApp ...string) (context.CancelFunc, applister.Application
 func testApp(t *testing.T, fakeApp string, expectedResponse string) {
 	cancel, appLister := newFakeLister(fakeApp)
 	defer cancel()
-	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpAppFilter, noOpHealthCheck)
 	req, err := http.NewRequest("GET", "/metrics", nil)
 	assert.NoError(t, err)
 	rr := httptest.NewRecorder()
@@ -170,6 +174,68 @@ func TestMetrics(t *testing.T) {
 	}
 }
 
+const shardApps = `# HELP argocd_controller_shard_apps Number of applications processed by the shard of the application controller.
+# TYPE argocd_controller_shard_apps gauge
+argocd_controller_shard_apps{shard="1"} 1
+`
+
+func TestMetricsShard(t *testing.T) {
+	cancel, appLister := newFakeLister(fakeApp, strings.Replace(fakeDefaultApp, "name: my-app", "name: other-app", 1))
+	defer cancel()
+	appFilter := func(obj interface{}) bool {
+		return obj.(*argoappv1.Application).Name == "my-app"
+	}
+	metricsServ := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.NotContains(t, body, "argocd_controller_shard_apps")
+
+	metricsServ.SetShard(1)
+	rr = httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body = rr.Body.String()
+	log.Println(body)
+	assertMetricsPrinted(t, shardApps, body)
+	assertMetricsPrinted(t, expectedResponse, body)
+	assert.NotContains(t, body, "other-app")
+}
+
+type fakeClustersInfo []ClusterCacheInfo
+
+func (f fakeClustersInfo) GetClustersInfo() []ClusterCacheInfo {
+	return f
+}
+
+const clusterCacheMetrics = `# HELP argocd_cluster_cache_resources Number of cached resources of a cluster.
+# TYPE argocd_cluster_cache_resources gauge
+argocd_cluster_cache_resources{server="https://localhost:6443"} 120
+# HELP argocd_cluster_cache_watches Number of watches of the resources of a cluster.
+# TYPE argocd_cluster_cache_watches gauge
+argocd_cluster_cache_watches{server="https://localhost:6443"} 42
+`
+
+func TestMetricsClusterCache(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpAppFilter, noOpHealthCheck)
+	metricsServ.RegisterClustersInfoSource(fakeClustersInfo{{Server: "https://localhost:6443", Watches: 42, Resources: 120}})
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	log.Println(body)
+	assertMetricsPrinted(t, clusterCacheMetrics, body)
+}
+
 const appSyncTotal = `# HELP argocd_app_sync_total Number of application syncs.
 # TYPE argocd_app_sync_total counter
 argocd_app_sync_total{name="my-app",namespace="argocd",phase="Error",project="important-project"} 1
@@ -180,7 +246,7 @@ argocd_app_sync_total{name="my-app",namespace="argocd",phase="Succeeded",project
 func TestMetricsSyncCounter(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
-	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpAppFilter, noOpHealthCheck)
 
 	fakeApp := newFakeApp(fakeApp)
 	metricsServ.IncSync(fakeApp, &argoappv1.OperationState{Phase: argoappv1.OperationRunning})
@@ -221,7 +287,7 @@ argocd_app_reconcile_count{name="my-app",namespace="argocd",project="important-p
 func TestReconcileMetrics(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
-	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpHealthCheck)
+	metricsServ := NewMetricsServer("localhost:8082", appLister, noOpAppFilter, noOpHealthCheck)
 
 	fakeApp := newFakeApp(fakeApp)
 	metricsServ.IncReconcile(fakeApp, 5*time.Second)
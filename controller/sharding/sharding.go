@@ -0,0 +1,196 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/hash"
+)
+
+const (
+	// leaseNamePrefix is the prefix of the names of the leases of the shards
+	leaseNamePrefix = "argocd-application-controller-shard-"
+	// defaultLeaseDuration is how long other replicas wait for the lease of a shard to be renewed, before they take
+	// over the shard
+	defaultLeaseDuration = 15 * time.Second
+	// defaultRenewDeadline is how long the replica which claimed a shard retries to renew its lease, before it gives
+	// up the shard
+	defaultRenewDeadline = 10 * time.Second
+	// defaultRetryPeriod is how often the lease of the claimed shard is renewed, and how often standby replicas try to
+	// claim a shard
+	defaultRetryPeriod = 2 * time.Second
+)
+
+// GetClusterShard returns the shard of the application controller which processes the applications of a cluster. The
+// shard is the shard assigned to the cluster, or else the hash of the server address of the cluster, modulo the number
+// of shards.
+func GetClusterShard(cluster *appv1.Cluster, shards int) int {
+	if shards <= 1 {
+		return 0
+	}
+	if cluster.Shard != nil && *cluster.Shard >= 0 {
+		return int(*cluster.Shard % int64(shards))
+	}
+	return int(hash.FNVa(cluster.Server) % uint32(shards))
+}
+
+// Elector claims a shard for a replica of the application controller, using a lease per shard, so each shard is
+// processed by a single replica. The replicas which did not claim a shard stand by, and take over the shard of a
+// replica which stopped renewing its lease.
+type Elector struct {
+	kubeclientset kubernetes.Interface
+	namespace     string
+	identity      string
+	shards        int
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+	shard         int
+	// the last observed holders and renew times of the leases, and when they were observed, by shard
+	observedRecords map[int]string
+	observedTimes   map[int]time.Time
+}
+
+// NewElector returns a new elector of the shard of the replica with the given identity, e.g. the name of its pod
+func NewElector(kubeclientset kubernetes.Interface, namespace string, identity string, shards int) *Elector {
+	return &Elector{
+		kubeclientset:   kubeclientset,
+		namespace:       namespace,
+		identity:        identity,
+		shards:          shards,
+		leaseDuration:   defaultLeaseDuration,
+		renewDeadline:   defaultRenewDeadline,
+		retryPeriod:     defaultRetryPeriod,
+		shard:           -1,
+		observedRecords: map[int]string{},
+		observedTimes:   map[int]time.Time{},
+	}
+}
+
+// Acquire blocks until the replica claimed a shard, and returns the shard. The shards are tried starting from the hash
+// of the identity of the replica, so replicas which start at the same time claim different shards.
+func (e *Elector) Acquire(ctx context.Context) (int, error) {
+	start := int(hash.FNVa(e.identity) % uint32(e.shards))
+	for {
+		for i := 0; i < e.shards; i++ {
+			shard := (start + i) % e.shards
+			acquired, err := e.tryAcquireOrRenew(shard)
+			if err != nil {
+				log.Warnf("Failed to claim shard %d: %v", shard, err)
+				continue
+			}
+			if acquired {
+				log.Infof("Claimed shard %d", shard)
+				e.shard = shard
+				return shard, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return -1, ctx.Err()
+		case <-time.After(e.retryPeriod):
+		}
+	}
+}
+
+// Renew renews the lease of the claimed shard until the context is done. It returns an error once the shard was taken
+// over by another replica, or once the lease could not be renewed within the renew deadline, since other replicas
+// take over the shard after the lease duration.
+func (e *Elector) Renew(ctx context.Context) error {
+	renewedAt := time.Now()
+	ticker := time.NewTicker(e.retryPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		renewed, err := e.tryAcquireOrRenew(e.shard)
+		if renewed {
+			renewedAt = time.Now()
+			continue
+		}
+		if err == nil {
+			return fmt.Errorf("shard %d was taken over by another replica", e.shard)
+		}
+		if time.Since(renewedAt) > e.renewDeadline {
+			return fmt.Errorf("failed to renew the lease of shard %d: %v", e.shard, err)
+		}
+		log.Warnf("Failed to renew the lease of shard %d: %v", e.shard, err)
+	}
+}
+
+// tryAcquireOrRenew claims the lease of a shard if it has no holder or if it expired, or renews it if the replica
+// holds it already. It returns whether the replica holds the lease.
+func (e *Elector) tryAcquireOrRenew(shard int) (bool, error) {
+	leases := e.kubeclientset.CoordinationV1().Leases(e.namespace)
+	name := leaseNamePrefix + strconv.Itoa(shard)
+	now := metav1.NewMicroTime(time.Now())
+	leaseDurationSeconds := int32(e.leaseDuration / time.Second)
+
+	lease, err := leases.Get(name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		_, err = leases.Create(&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &e.identity,
+				LeaseDurationSeconds: &leaseDurationSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		})
+		if apierr.IsAlreadyExists(err) {
+			return false, nil
+		}
+		return err == nil, err
+	} else if err != nil {
+		return false, err
+	}
+
+	holder := ""
+	if lease.Spec.HolderIdentity != nil {
+		holder = *lease.Spec.HolderIdentity
+	}
+	if holder != e.identity {
+		// the expiry of the lease is measured by the local clock since the lease was last observed to change, since
+		// the clocks of the replicas may differ
+		record := holder
+		if lease.Spec.RenewTime != nil {
+			record += "/" + lease.Spec.RenewTime.Format(time.RFC3339Nano)
+		}
+		if record != e.observedRecords[shard] {
+			e.observedRecords[shard] = record
+			e.observedTimes[shard] = time.Now()
+		}
+		if holder != "" && time.Since(e.observedTimes[shard]) < e.leaseDuration {
+			return false, nil
+		}
+		transitions := int32(1)
+		if lease.Spec.LeaseTransitions != nil {
+			transitions = *lease.Spec.LeaseTransitions + 1
+		}
+		lease.Spec.LeaseTransitions = &transitions
+		lease.Spec.AcquireTime = &now
+		lease.Spec.HolderIdentity = &e.identity
+	}
+	lease.Spec.LeaseDurationSeconds = &leaseDurationSeconds
+	lease.Spec.RenewTime = &now
+	_, err = leases.Update(lease)
+	if apierr.IsConflict(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,77 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestGetClusterShard(t *testing.T) {
+	cluster := &appv1.Cluster{Server: "https://kubernetes.default.svc"}
+	assert.Equal(t, 0, GetClusterShard(cluster, 1))
+
+	shard := GetClusterShard(cluster, 3)
+	assert.True(t, shard >= 0 && shard < 3)
+	assert.Equal(t, shard, GetClusterShard(&appv1.Cluster{Server: cluster.Server}, 3))
+
+	assigned := int64(2)
+	assert.Equal(t, 2, GetClusterShard(&appv1.Cluster{Server: cluster.Server, Shard: &assigned}, 3))
+	assert.Equal(t, 0, GetClusterShard(&appv1.Cluster{Server: cluster.Server, Shard: &assigned}, 2))
+
+	negative := int64(-1)
+	assert.Equal(t, shard, GetClusterShard(&appv1.Cluster{Server: cluster.Server, Shard: &negative}, 3))
+}
+
+func newTestElector(kubeclientset *fake.Clientset, identity string) *Elector {
+	elector := NewElector(kubeclientset, "argocd", identity, 2)
+	elector.leaseDuration = time.Second
+	elector.renewDeadline = 500 * time.Millisecond
+	elector.retryPeriod = 10 * time.Millisecond
+	return elector
+}
+
+func TestElector(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := newTestElector(kubeclientset, "controller-a")
+	firstShard, err := first.Acquire(ctx)
+	assert.NoError(t, err)
+	second := newTestElector(kubeclientset, "controller-b")
+	secondShard, err := second.Acquire(ctx)
+	assert.NoError(t, err)
+	assert.NotEqual(t, firstShard, secondShard)
+
+	lease, err := kubeclientset.CoordinationV1().Leases("argocd").Get(fmt.Sprintf("%s%d", leaseNamePrefix, firstShard), metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "controller-a", *lease.Spec.HolderIdentity)
+
+	// the standby replica does not claim a shard while its lease is renewed
+	go func() { _ = first.Renew(ctx) }()
+	standby := newTestElector(kubeclientset, "controller-c")
+	standbyCtx, standbyCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer standbyCancel()
+	_, err = standby.Acquire(standbyCtx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// the standby replica takes over the shard whose lease is not renewed anymore
+	standbyShard, err := standby.Acquire(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, secondShard, standbyShard)
+
+	lease, err = kubeclientset.CoordinationV1().Leases("argocd").Get(fmt.Sprintf("%s%d", leaseNamePrefix, standbyShard), metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "controller-c", *lease.Spec.HolderIdentity)
+	assert.Equal(t, int32(1), *lease.Spec.LeaseTransitions)
+
+	err = second.Renew(ctx)
+	assert.EqualError(t, err, fmt.Sprintf("shard %d was taken over by another replica", secondShard))
+}
@@ -7,9 +7,11 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/common"
@@ -18,6 +20,7 @@ import (
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	listersv1alpha1 "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
@@ -56,7 +59,7 @@ type ResourceInfoProvider interface {
 
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
-	CompareAppState(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, noCache bool, localObjects []string) (*comparisonResult, error)
+	CompareAppState(app *v1alpha1.Application, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, localObjects []string) (*comparisonResult, error)
 	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
 }
 
@@ -77,6 +80,7 @@ type appStateManager struct {
 	metricsServer  *metrics.MetricsServer
 	db             db.ArgoDB
 	settingsMgr    *settings.SettingsManager
+	kubeClientset  kubernetes.Interface
 	appclientset   appclientset.Interface
 	projInformer   cache.SharedIndexInformer
 	kubectl        kubeutil.Kubectl
@@ -85,25 +89,19 @@ type appStateManager struct {
 	namespace      string
 }
 
-func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1.ApplicationSource, appLabelKey, revision string, noCache bool) ([]*unstructured.Unstructured, []*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+// getRepoObjs generates the manifests of the sources of the application, at the given revisions or the target
+// revisions of the sources. Returns the manifest responses of the sources, in the order of the sources.
+func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache bool) ([]*unstructured.Unstructured, []*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
 	helmRepos, err := m.db.ListHelmRepos(context.Background())
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	repo, err := m.db.GetRepository(context.Background(), source.RepoURL)
-	if err != nil {
-		return nil, nil, nil, err
-	}
 	conn, repoClient, err := m.repoClientset.NewRepoServerClient()
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	defer util.Close(conn)
 
-	if revision == "" {
-		revision = source.TargetRevision
-	}
-
 	plugins, err := m.settingsMgr.GetConfigManagementPlugins()
 	if err != nil {
 		return nil, nil, nil, err
@@ -114,24 +112,132 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		tools[i] = &plugins[i]
 	}
 
-	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
-		Repo:              repo,
-		HelmRepos:         helmRepos,
-		Revision:          revision,
-		NoCache:           noCache,
-		AppLabelKey:       appLabelKey,
-		AppLabelValue:     app.Name,
-		Namespace:         app.Spec.Destination.Namespace,
-		ApplicationSource: &source,
-		Plugins:           tools,
-	})
+	kustomizeVersions, err := m.settingsMgr.GetKustomizeVersions()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	kustomizeVersionRefs := make([]*appv1.KustomizeVersion, len(kustomizeVersions))
+	for i := range kustomizeVersions {
+		kustomizeVersionRefs[i] = &kustomizeVersions[i]
+	}
+
+	helmVersions, err := m.settingsMgr.GetHelmVersions()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	helmVersionRefs := make([]*appv1.HelmVersion, len(helmVersions))
+	for i := range helmVersions {
+		helmVersionRefs[i] = &helmVersions[i]
+	}
+
+	jsonnetLibs, err := m.settingsMgr.GetJsonnetLibs()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	verifySignature, signatureKeys, err := m.getSignatureKeys(app)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	targetObjs, hooks, nil := unmarshalManifests(manifestInfo.Manifests)
-	return targetObjs, hooks, manifestInfo, nil
+	generateManifest := func(i int, refSources map[string]*apiclient.RefTarget) (*apiclient.ManifestResponse, *appv1.Repository, string, error) {
+		source := sources[i]
+		repo, err := m.db.GetRepository(context.Background(), source.RepoURL)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		enableSubmodules, submoduleCreds, err := m.db.GetSubmoduleCredentials(context.Background(), repo)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		repo.EnableSubmodules = enableSubmodules
+		revision := source.TargetRevision
+		if i < len(revisions) && revisions[i] != "" {
+			revision = revisions[i]
+		}
+		refSources, err = argo.GetRefSources(context.Background(), &source, refSources, m.db)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		envFromSecrets, err := argo.GetEnvFromSecrets(m.kubeClientset, m.namespace, app.Spec.GetProject(), &source)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:              repo,
+			HelmRepos:         helmRepos,
+			Revision:          revision,
+			NoCache:           noCache,
+			AppLabelKey:       appLabelKey,
+			AppLabelValue:     app.Name,
+			Namespace:         app.Spec.Destination.Namespace,
+			DestServer:        app.Spec.Destination.Server,
+			ApplicationSource: &source,
+			Plugins:           tools,
+			VerifySignature:   verifySignature,
+			SignatureKeys:     signatureKeys,
+			SubmoduleCreds:    submoduleCreds,
+			RefSources:        refSources,
+			KustomizeVersions: kustomizeVersionRefs,
+			HelmVersions:      helmVersionRefs,
+			JsonnetLibs:       jsonnetLibs,
+			EnvFromSecrets:    envFromSecrets,
+		})
+		return manifestInfo, repo, revision, err
+	}
+
+	manifestInfos := make([]*apiclient.ManifestResponse, len(sources))
+	// The revisions of the ref sources are resolved first, so that the other sources use the value files of the same
+	// revisions which are reported as compared to
+	refSources := make(map[string]*apiclient.RefTarget)
+	for i, source := range sources {
+		if !source.IsRef() {
+			continue
+		}
+		if _, ok := refSources[source.Ref]; ok {
+			return nil, nil, nil, fmt.Errorf("Multiple sources have the ref '%s'", source.Ref)
+		}
+		manifestInfo, repo, _, err := generateManifest(i, nil)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		manifestInfos[i] = manifestInfo
+		refSources[source.Ref] = &apiclient.RefTarget{Repo: repo, Revision: manifestInfo.Revision}
+	}
+
+	targetObjs := make([]*unstructured.Unstructured, 0)
+	hooks := make([]*unstructured.Unstructured, 0)
+	for i, source := range sources {
+		if source.IsRef() {
+			continue
+		}
+		manifestInfo, _, _, err := generateManifest(i, refSources)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		manifestInfos[i] = manifestInfo
+		sourceTargetObjs, sourceHooks, err := unmarshalManifests(manifestInfo.Manifests)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		targetObjs = append(targetObjs, sourceTargetObjs...)
+		hooks = append(hooks, sourceHooks...)
+	}
+	return targetObjs, hooks, manifestInfos, nil
+}
 
+// getSignatureKeys returns whether the project of the application requires the signature of revisions
+// to be verified, and the public keys the project trusts
+func (m *appStateManager) getSignatureKeys(app *v1alpha1.Application) (bool, []string, error) {
+	proj, err := argo.GetAppProject(&app.Spec, listersv1alpha1.NewAppProjectLister(m.projInformer.GetIndexer()), m.namespace)
+	if err != nil {
+		// Applications of missing projects are never synced, so their manifests may still be compared
+		if apierr.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return argo.GetSignatureKeys(context.Background(), proj, m.db)
 }
 
 func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
@@ -228,9 +334,31 @@ func dedupLiveResources(targetObjs []*unstructured.Unstructured, liveObjsByKey m
 }
 
 // CompareAppState compares application git state to the live app state, using the specified
-// revision and supplied source. If revision or overrides are empty, then compares against
-// revision and overrides in the app spec.
-func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, noCache bool, localManifests []string) (*comparisonResult, error) {
+// revisions and supplied sources, in the same order. If a revision is empty, then compares against
+// the target revision of its source.
+// isExcludedResource returns whether the group and kind of a resource are excluded, or whether it is a namespaced
+// resource whose namespace is excluded
+func isExcludedResource(resFilter *settings.ResourcesFilter, liveStateCache statecache.LiveStateCache, app *v1alpha1.Application, obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	server := app.Spec.Destination.Server
+	if resFilter.IsExcludedResource(gvk.Group, gvk.Kind, server) {
+		return true
+	}
+	if included, excluded := resFilter.GetNamespaces(gvk.Group, gvk.Kind, server); included == nil && len(excluded) == 0 {
+		return false
+	}
+	namespaced, err := liveStateCache.IsNamespaced(server, obj)
+	if err != nil || !namespaced {
+		return false
+	}
+	namespace := obj.GetNamespace()
+	if namespace == "" {
+		namespace = app.Spec.Destination.Namespace
+	}
+	return resFilter.IsExcludedNamespacedResource(gvk.Group, gvk.Kind, server, namespace)
+}
+
+func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, localManifests []string) (*comparisonResult, error) {
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return nil, err
@@ -251,23 +379,30 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 
 	var targetObjs []*unstructured.Unstructured
 	var hooks []*unstructured.Unstructured
-	var manifestInfo *apiclient.ManifestResponse
+	var manifestInfos []*apiclient.ManifestResponse
 
 	if len(localManifests) == 0 {
-		targetObjs, hooks, manifestInfo, err = m.getRepoObjs(app, source, appLabelKey, revision, noCache)
+		targetObjs, hooks, manifestInfos, err = m.getRepoObjs(app, sources, appLabelKey, revisions, noCache)
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
 			failedToLoadObjs = true
 		}
 	} else {
-		targetObjs, hooks, err = unmarshalManifests(localManifests)
+		// Local manifests cannot be verified, so they are refused if the project requires signed revisions
+		verifySignature, _, err := m.getSignatureKeys(app)
+		if err == nil && verifySignature {
+			err = fmt.Errorf("Cannot use local manifests, because project '%s' requires revisions to be signed", app.Spec.GetProject())
+		}
+		if err == nil {
+			targetObjs, hooks, err = unmarshalManifests(localManifests)
+		}
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
 			failedToLoadObjs = true
 		}
-		manifestInfo = nil
+		manifestInfos = nil
 	}
 
 	targetObjs, dedupConditions, err := DeduplicateTargetObjects(app.Spec.Destination.Server, app.Spec.Destination.Namespace, targetObjs, m.liveStateCache)
@@ -283,7 +418,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		for i := len(targetObjs) - 1; i >= 0; i-- {
 			targetObj := targetObjs[i]
 			gvk := targetObj.GroupVersionKind()
-			if resFilter.IsExcludedResource(gvk.Group, gvk.Kind, app.Spec.Destination.Server) {
+			if isExcludedResource(resFilter, m.liveStateCache, app, targetObj) {
 				targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
 				conditions = append(conditions, v1alpha1.ApplicationCondition{
 					Type:    v1alpha1.ApplicationConditionExcludedResourceWarning,
@@ -385,6 +520,13 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		} else {
 			resState.Status = v1alpha1.SyncStatusCodeSynced
 		}
+		// resources which opted out of pruning are reported, since they would be pruned otherwise
+		if resState.RequiresPruning && !resState.Hook && resource.HasAnnotationOption(liveObj, common.AnnotationSyncOptions, "Prune=false") {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:    v1alpha1.ApplicationConditionPruneSkippedWarning,
+				Message: fmt.Sprintf("Resource %s/%s %s requires pruning, but is not pruned because of the Prune=false sync option", resState.Group, resState.Kind, resState.Name),
+			})
+		}
 		managedResources[i] = managedResource{
 			Name:      resState.Name,
 			Namespace: resState.Namespace,
@@ -404,13 +546,22 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 	}
 	syncStatus := v1alpha1.SyncStatus{
 		ComparedTo: appv1.ComparedTo{
-			Source:      source,
 			Destination: app.Spec.Destination,
 		},
 		Status: syncCode,
 	}
-	if manifestInfo != nil {
-		syncStatus.Revision = manifestInfo.Revision
+	if app.Spec.HasMultipleSources() {
+		syncStatus.ComparedTo.Sources = sources
+		if manifestInfos != nil {
+			for _, manifestInfo := range manifestInfos {
+				syncStatus.Revisions = append(syncStatus.Revisions, manifestInfo.Revision)
+			}
+		}
+	} else {
+		syncStatus.ComparedTo.Source = sources[0]
+		if manifestInfos != nil {
+			syncStatus.Revision = manifestInfos[0].Revision
+		}
 	}
 
 	healthStatus, err := health.SetApplicationHealth(resourceSummaries, GetLiveObjs(managedResources), resourceOverrides, func(obj *unstructured.Unstructured) bool {
@@ -431,22 +582,33 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, revision st
 		hooks:            hooks,
 		diffNormalizer:   diffNormalizer,
 	}
-	if manifestInfo != nil {
-		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
+	// the source type of an application with multiple sources is the type of its first source which is not a ref
+	for i := range manifestInfos {
+		if !sources[i].IsRef() {
+			compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfos[i].SourceType)
+			break
+		}
 	}
 	return &compRes, nil
 }
 
-func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource) error {
+func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, syncStatus *v1alpha1.SyncStatus, state *v1alpha1.OperationState) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History[len(app.Status.History)-1].ID + 1
 	}
 	history := append(app.Status.History, v1alpha1.RevisionHistory{
-		Revision:   revision,
-		DeployedAt: metav1.NewTime(time.Now().UTC()),
-		ID:         nextID,
-		Source:     source,
+		Revision:    syncStatus.Revision,
+		Revisions:   syncStatus.Revisions,
+		DeployedAt:  metav1.NewTime(time.Now().UTC()),
+		ID:          nextID,
+		Source:      syncStatus.ComparedTo.Source,
+		Sources:     syncStatus.ComparedTo.Sources,
+		Rollback:    state.Operation.Sync.Rollback,
+		InitiatedBy: state.Operation.InitiatedBy,
+		SyncOptions: state.Operation.Sync.Options(),
+		Phase:       state.Phase,
+		Message:     state.Message,
 	})
 
 	if len(history) > common.RevisionHistoryLimit {
@@ -468,6 +630,7 @@ func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revi
 // NewAppStateManager creates new instance of Ksonnet app comparator
 func NewAppStateManager(
 	db db.ArgoDB,
+	kubeClientset kubernetes.Interface,
 	appclientset appclientset.Interface,
 	repoClientset apiclient.Clientset,
 	namespace string,
@@ -480,6 +643,7 @@ func NewAppStateManager(
 	return &appStateManager{
 		liveStateCache: liveStateCache,
 		db:             db,
+		kubeClientset:  kubeClientset,
 		appclientset:   appclientset,
 		kubectl:        kubectl,
 		repoClientset:  repoClientset,
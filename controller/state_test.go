@@ -30,7 +30,7 @@ func TestCompareAppStateEmpty(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -39,6 +39,42 @@ func TestCompareAppStateEmpty(t *testing.T) {
 	assert.Equal(t, 0, len(compRes.conditions))
 }
 
+// TestCompareAppStateMultipleSources tests comparison of an application with multiple sources
+func TestCompareAppStateMultipleSources(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Sources = argoappv1.ApplicationSources{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"},
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+	}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Equal(t, app.Spec.Sources, compRes.syncStatus.ComparedTo.Sources)
+	assert.Equal(t, []string{"abc123", "abc123"}, compRes.syncStatus.Revisions)
+	assert.Equal(t, "", compRes.syncStatus.Revision)
+	assert.Equal(t, 0, len(compRes.conditions))
+
+	// refs must be unique
+	app.Spec.Sources = append(app.Spec.Sources, argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd.git", Ref: "values"})
+	compRes, err = ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, argoappv1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	assert.Contains(t, compRes.conditions, argoappv1.ApplicationCondition{
+		Type:    argoappv1.ApplicationConditionComparisonError,
+		Message: "Multiple sources have the ref 'values'",
+	})
+}
+
 // TestCompareAppStateMissing tests when there is a manifest defined in git which doesn't exist in live
 func TestCompareAppStateMissing(t *testing.T) {
 	app := newFakeApp()
@@ -53,7 +89,7 @@ func TestCompareAppStateMissing(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
@@ -80,7 +116,7 @@ func TestCompareAppStateExtra(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
@@ -89,6 +125,37 @@ func TestCompareAppStateExtra(t *testing.T) {
 	assert.Equal(t, 0, len(compRes.conditions))
 }
 
+// TestCompareAppStateExtraNoPrune checks that extra resources which opted out of pruning are reported
+func TestCompareAppStateExtraNoPrune(t *testing.T) {
+	pod := test.NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	pod.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "Prune=false"})
+	app := newFakeApp()
+	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: app.Name}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			key: pod,
+		},
+	}
+	ctrl := newFakeController(&data)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, compRes)
+	assert.Equal(t, argoappv1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	assert.Equal(t, 1, len(compRes.resources))
+	assert.True(t, compRes.resources[0].RequiresPruning)
+	if assert.Equal(t, 1, len(compRes.conditions)) {
+		assert.Equal(t, argoappv1.ApplicationConditionPruneSkippedWarning, compRes.conditions[0].Type)
+		assert.Equal(t, "Resource /Pod my-pod requires pruning, but is not pruned because of the Prune=false sync option", compRes.conditions[0].Message)
+	}
+}
+
 // TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateHook(t *testing.T) {
@@ -107,7 +174,7 @@ func TestCompareAppStateHook(t *testing.T) {
 		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
 	}
 	ctrl := newFakeController(&data)
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -134,7 +201,7 @@ func TestCompareAppStateCompareOptionIgnoreExtraneous(t *testing.T) {
 	}
 	ctrl := newFakeController(&data)
 
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, compRes)
@@ -163,7 +230,7 @@ func TestCompareAppStateExtraHook(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
@@ -200,7 +267,7 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 		},
 	}
 	ctrl := newFakeController(&data)
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, compRes)
 	assert.Contains(t, compRes.conditions, argoappv1.ApplicationCondition{
@@ -251,7 +318,7 @@ func TestSetHealth(t *testing.T) {
 		},
 	})
 
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 	assert.NoError(t, err)
 
 	assert.Equal(t, compRes.healthStatus.Status, argoappv1.HealthStatusHealthy)
@@ -284,8 +351,39 @@ func TestSetHealthSelfReferencedApp(t *testing.T) {
 		},
 	})
 
-	compRes, err := ctrl.appStateManager.CompareAppState(app, "", app.Spec.Source, false, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, nil)
 	assert.NoError(t, err)
 
 	assert.Equal(t, compRes.healthStatus.Status, argoappv1.HealthStatusHealthy)
 }
+
+// TestCompareAppStateSignatureRequired tests that local manifests are refused if the project requires signed revisions
+func TestCompareAppStateSignatureRequired(t *testing.T) {
+	app := newFakeApp()
+	proj := defaultProj.DeepCopy()
+	proj.Spec.SignatureKeys = []argoappv1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}}
+	data := fakeData{
+		apps: []runtime.Object{app, proj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	verifySignature, signatureKeys, err := ctrl.appStateManager.(*appStateManager).getSignatureKeys(app)
+	assert.NoError(t, err)
+	assert.True(t, verifySignature)
+	assert.Len(t, signatureKeys, 0)
+
+	compRes, err := ctrl.appStateManager.CompareAppState(app, nil, app.Spec.GetSources(), false, []string{string(test.PodManifest)})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(compRes.resources))
+	assert.Contains(t, compRes.conditions, argoappv1.ApplicationCondition{
+		Type:    argoappv1.ApplicationConditionComparisonError,
+		Message: "Cannot use local manifests, because project 'default' requires revisions to be signed",
+	})
+}
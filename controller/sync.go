@@ -4,17 +4,22 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -22,7 +27,9 @@ import (
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/controller/metrics"
+	"github.com/argoproj/argo-cd/pkg/apis/application"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	listersv1alpha1 "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/health"
@@ -50,23 +57,43 @@ type syncContext struct {
 	syncOp              *v1alpha1.SyncOperation
 	syncRes             *v1alpha1.SyncOperationResult
 	syncResources       []v1alpha1.SyncOperationResource
-	opState             *v1alpha1.OperationState
-	log                 *log.Entry
+	labelSelector       labels.Selector
+	// retains the ignored fields of the live state when reverting a drift, nil otherwise
+	ignoredFieldsRetainer *argo.IgnoredFieldsRetainer
+	opState               *v1alpha1.OperationState
+	appclientset          appclientset.Interface
+	// namespace of the applications managed by the controller
+	appNamespace string
+	log          *log.Entry
 	// lock to protect concurrent updates of the result list
 	lock sync.Mutex
 }
 
+// Characters of revisions which are not valid in resource names
+var invalidRevisionNameCharsRegex = regexp.MustCompile(`[^a-z0-9]`)
+
+// shortRevision returns the first 7 characters of a revision, which is either a commit SHA, a chart
+// version or the digest of a chart, in a form which can be used in resource names
+func shortRevision(revision string) string {
+	short := invalidRevisionNameCharsRegex.ReplaceAllString(strings.ToLower(strings.TrimPrefix(revision, "sha256:")), "-")
+	if len(short) > 7 {
+		short = short[0:7]
+	}
+	return short
+}
+
 func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) {
 	// Sync requests might be requested with ambiguous revisions (e.g. master, HEAD, v1.2.3).
 	// This can change meaning when resuming operations (e.g a hook sync). After calculating a
 	// concrete git commit SHA, the SHA is remembered in the status.operationState.syncResult field.
 	// This ensures that when resuming an operation, we sync to the same revision that we initially
 	// started with.
-	var revision string
+	var revisions []string
 	var syncOp v1alpha1.SyncOperation
 	var syncRes *v1alpha1.SyncOperationResult
 	var syncResources []v1alpha1.SyncOperationResource
-	var source v1alpha1.ApplicationSource
+	var labelSelector labels.Selector
+	var sources []v1alpha1.ApplicationSource
 
 	if state.Operation.Sync == nil {
 		state.Phase = v1alpha1.OperationFailed
@@ -74,34 +101,63 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 	syncOp = *state.Operation.Sync
-	if syncOp.Source == nil {
+	if app.Spec.HasMultipleSources() {
+		if len(syncOp.Sources) == 0 {
+			// normal sync case (where sources are taken from app.spec.sources)
+			sources = app.Spec.Sources
+		} else {
+			// rollback case
+			sources = syncOp.Sources
+		}
+	} else if syncOp.Source == nil {
 		// normal sync case (where source is taken from app.spec.source)
-		source = app.Spec.Source
+		sources = []v1alpha1.ApplicationSource{app.Spec.Source}
 	} else {
 		// rollback case
-		source = *state.Operation.Sync.Source
+		sources = []v1alpha1.ApplicationSource{*state.Operation.Sync.Source}
 	}
 	syncResources = syncOp.Resources
+	if syncOp.LabelSelector != "" {
+		var err error
+		labelSelector, err = labels.Parse(syncOp.LabelSelector)
+		if err != nil {
+			state.Phase = v1alpha1.OperationFailed
+			state.Message = fmt.Sprintf("Invalid operation request: invalid label selector '%s': %v", syncOp.LabelSelector, err)
+			return
+		}
+	}
 	if state.SyncResult != nil {
 		syncRes = state.SyncResult
-		revision = state.SyncResult.Revision
+		if app.Spec.HasMultipleSources() {
+			revisions = state.SyncResult.Revisions
+		} else if state.SyncResult.Revision != "" {
+			revisions = []string{state.SyncResult.Revision}
+		}
 	} else {
 		syncRes = &v1alpha1.SyncOperationResult{}
 		// status.operationState.syncResult.source. must be set properly since auto-sync relies
 		// on this information to decide if it should sync (if source is different than the last
 		// sync attempt)
-		syncRes.Source = source
+		if app.Spec.HasMultipleSources() {
+			syncRes.Sources = sources
+		} else {
+			syncRes.Source = sources[0]
+		}
 		state.SyncResult = syncRes
 	}
 
-	if revision == "" {
+	if len(revisions) == 0 {
 		// if we get here, it means we did not remember a commit SHA which we should be syncing to.
 		// This typically indicates we are just about to begin a brand new sync/rollback operation.
 		// Take the value in the requested operation. We will resolve this to a SHA later.
-		revision = syncOp.Revision
+		if app.Spec.HasMultipleSources() {
+			revisions = syncOp.Revisions
+		} else {
+			revisions = []string{syncOp.Revision}
+		}
 	}
 
-	compareResult, err := m.CompareAppState(app, revision, source, false, syncOp.Manifests)
+	compareResult, err := m.CompareAppState(app, revisions, sources, false, syncOp.Manifests)
 	if err != nil {
 		state.Phase = v1alpha1.OperationError
 		state.Message = err.Error()
@@ -124,6 +180,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	// We now have a concrete commit SHA. Save this in the sync result revision so that we remember
 	// what we should be syncing to when resuming operations.
 	syncRes.Revision = compareResult.syncStatus.Revision
+	syncRes.Revisions = compareResult.syncStatus.Revisions
 
 	clst, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
 	if err != nil {
@@ -182,10 +239,22 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		syncOp:              &syncOp,
 		syncRes:             syncRes,
 		syncResources:       syncResources,
+		labelSelector:       labelSelector,
 		opState:             state,
+		appclientset:        m.appclientset,
+		appNamespace:        m.namespace,
 		log:                 log.WithFields(log.Fields{"application": app.Name}),
 	}
 
+	if syncOp.SelfHeal {
+		syncCtx.ignoredFieldsRetainer, err = argo.NewIgnoredFieldsRetainer(app.Spec.IgnoreDifferences, resourceOverrides)
+		if err != nil {
+			state.Phase = v1alpha1.OperationError
+			state.Message = fmt.Sprintf("Failed to load ignored differences: %v", err)
+			return
+		}
+	}
+
 	if state.Phase == v1alpha1.OperationTerminating {
 		syncCtx.terminate()
 	} else {
@@ -195,7 +264,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	syncCtx.log.Info("sync/terminate complete")
 
 	if !syncOp.DryRun && !syncCtx.isSelectiveSync() && syncCtx.opState.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus, state)
 		if err != nil {
 			syncCtx.setOperationPhase(v1alpha1.OperationError, fmt.Sprintf("failed to record sync to history: %v", err))
 		}
@@ -246,7 +315,19 @@ func (sc *syncContext) sync() {
 			}
 		} else {
 			// this must be calculated on the live object
-			healthStatus, err := health.GetResourceHealth(task.liveObj, sc.resourceOverrides)
+			var healthStatus *v1alpha1.HealthStatus
+			var err error
+			if sc.isCascadedChildApp(task) {
+				healthStatus, err = sc.getChildAppHealth(task.liveObj)
+				if err != nil {
+					sc.log.WithFields(log.Fields{"task": task}).Warnf("failed to sync child application: %v", err)
+				} else if healthStatus.Status == v1alpha1.HealthStatusProgressing {
+					// report what the sync of the child is waiting for
+					sc.setResourceResult(task, task.syncStatus, task.operationState, healthStatus.Message)
+				}
+			} else {
+				healthStatus, err = health.GetResourceHealth(task.liveObj, sc.resourceOverrides)
+			}
 			if err == nil {
 				log.WithFields(log.Fields{"task": task, "healthStatus": healthStatus}).Debug("attempting to update health of running task")
 				if healthStatus == nil {
@@ -290,17 +371,37 @@ func (sc *syncContext) sync() {
 		return
 	}
 
-	// remove any tasks not in this wave
+	// remove any tasks not in this wave, or of another weight of hooks
 	phase := tasks.phase()
 	wave := tasks.wave()
+	weight := tasks.weight()
 
 	// if it is the last phase/wave and the only remaining tasks are non-hooks, the we are successful
 	// EVEN if those objects subsequently degraded
 	// This handles the common case where neither hooks or waves are used and a sync equates to simply an (asynchronous) kubectl apply of manifests, which succeeds immediately.
-	complete := !tasks.Any(func(t *syncTask) bool { return t.phase != phase || wave != t.wave() || t.isHook() })
+	// Child applications which are cascaded are only synced once they have been applied, so they must be waited for too.
+	complete := !tasks.Any(func(t *syncTask) bool {
+		return t.phase != phase || wave != t.wave() || weight != t.weight() || t.isHook() || sc.isCascadedChildApp(t)
+	})
 
-	sc.log.WithFields(log.Fields{"phase": phase, "wave": wave, "tasks": tasks, "syncFailTasks": syncFailTasks}).Debug("filtering tasks in correct phase and wave")
-	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
+	sc.log.WithFields(log.Fields{"phase": phase, "wave": wave, "weight": weight, "tasks": tasks, "syncFailTasks": syncFailTasks}).Debug("filtering tasks in correct phase and wave")
+	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave && t.weight() == weight })
+
+	// hooks of previous syncs with the BeforeHookCreation policy are deleted, and their deletion is waited for,
+	// before they are created again
+	if hooksPendingDeletion := tasks.Filter(func(t *syncTask) bool { return t.deleteBeforeCreation() }); len(hooksPendingDeletion) > 0 {
+		for _, task := range hooksPendingDeletion {
+			if task.liveObj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			err := sc.deleteResource(task)
+			if err != nil && !apierr.IsNotFound(err) {
+				sc.setResourceResult(task, "", v1alpha1.OperationError, fmt.Sprintf("failed to delete resource: %v", err))
+			}
+		}
+		sc.setOperationPhase(v1alpha1.OperationRunning, "waiting for the deletion of hooks of previous syncs")
+		return
+	}
 
 	sc.setOperationPhase(v1alpha1.OperationRunning, "one or more tasks are running")
 
@@ -314,6 +415,55 @@ func (sc *syncContext) sync() {
 	}
 }
 
+// isCascadedChildApp returns whether the task applies an application, which is managed by this controller and asks to
+// be synced as part of the sync of its parent application with the CascadeSync=true sync option
+func (sc *syncContext) isCascadedChildApp(task *syncTask) bool {
+	if task.targetObj == nil || task.isHook() || task.group() != application.Group || task.kind() != application.ApplicationKind {
+		return false
+	}
+	return sc.server == common.KubernetesInternalAPIServerAddr && task.namespace() == sc.appNamespace &&
+		resource.HasAnnotationOption(task.targetObj, common.AnnotationSyncOptions, "CascadeSync=true")
+}
+
+// getChildAppHealth returns the health of a cascaded child application, which is only healthy once it has been synced
+// successfully since the sync of its parent started. Starts the sync of the child if it has not been synced yet, and
+// the sync windows of its project allow it.
+func (sc *syncContext) getChildAppHealth(liveObj *unstructured.Unstructured) (*v1alpha1.HealthStatus, error) {
+	var child v1alpha1.Application
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(liveObj.Object, &child)
+	if err != nil {
+		return nil, err
+	}
+	opState := child.Status.OperationState
+	switch {
+	case child.Operation != nil || opState != nil && !opState.Phase.Completed():
+		return &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing, Message: "Waiting for the sync of the child application to complete"}, nil
+	case opState == nil || opState.StartedAt.Before(&sc.opState.StartedAt):
+		// an invalid project is reported by the sync operation of the child itself
+		proj, err := sc.appclientset.ArgoprojV1alpha1().AppProjects(sc.namespace).Get(child.Spec.GetProject(), metav1.GetOptions{})
+		if err == nil && !proj.Spec.SyncWindows.Matches(&child).CanSync(time.Now()) {
+			return &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing, Message: "The sync of the child application is blocked by sync window"}, nil
+		}
+		op := v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{Revision: child.Spec.Source.TargetRevision, Prune: sc.syncOp.Prune},
+		}
+		if child.Spec.SyncPolicy != nil {
+			op.Retry = child.Spec.SyncPolicy.Retry
+		}
+		_, err = argo.SetAppOperation(sc.appclientset.ArgoprojV1alpha1().Applications(child.Namespace), child.Name, &op)
+		// the child might have started an automated sync in the meantime, which it is waited for instead
+		if err != nil && status.Code(err) != codes.FailedPrecondition {
+			return nil, err
+		}
+		return &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing, Message: "Started the sync of the child application"}, nil
+	case !opState.Phase.Successful():
+		return &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusDegraded, Message: fmt.Sprintf("The sync of the child application failed: %s", opState.Message)}, nil
+	case child.Status.Sync.Status != v1alpha1.SyncStatusCodeSynced:
+		return &v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing, Message: "Waiting for the child application to be synced"}, nil
+	}
+	return &child.Status.Health, nil
+}
+
 func (sc *syncContext) setOperationFailed(syncFailTasks syncTasks, message string) {
 	if len(syncFailTasks) > 0 {
 		// if all the failure hooks are completed, don't run them again, and mark the sync as failed
@@ -338,7 +488,18 @@ func (sc *syncContext) started() bool {
 
 func (sc *syncContext) isSelectiveSync() bool {
 	// we've selected no resources
-	if sc.syncResources == nil {
+	if sc.syncResources == nil && sc.labelSelector == nil {
+		return false
+	}
+
+	// resources selected by a label selector, glob patterns or exclusions: the sync is selective
+	// unless it selects all of the application's resources
+	if sc.labelSelector != nil || containsSyncResourcePattern(sc.syncResources) {
+		for _, r := range sc.compareResult.managedResources {
+			if !r.Hook && !sc.selectsResource(r) {
+				return true
+			}
+		}
 		return false
 	}
 
@@ -368,9 +529,32 @@ func (sc *syncContext) skipHooks() bool {
 }
 
 func (sc *syncContext) containsResource(resourceState managedResource) bool {
-	return !sc.isSelectiveSync() ||
-		(resourceState.Live != nil && argo.ContainsSyncResource(resourceState.Live.GetName(), resourceState.Live.GroupVersionKind(), sc.syncResources)) ||
-		(resourceState.Target != nil && argo.ContainsSyncResource(resourceState.Target.GetName(), resourceState.Target.GroupVersionKind(), sc.syncResources))
+	return !sc.isSelectiveSync() || sc.selectsResource(resourceState)
+}
+
+// selectsResource returns whether either the live or the target object of the resource is selected by the sync
+// operation resources and label selector
+func (sc *syncContext) selectsResource(resourceState managedResource) bool {
+	return sc.selectsObj(resourceState.Live) || sc.selectsObj(resourceState.Target)
+}
+
+func (sc *syncContext) selectsObj(obj *unstructured.Unstructured) bool {
+	if obj == nil {
+		return false
+	}
+	if sc.syncResources != nil && !argo.ContainsSyncResource(obj.GetName(), obj.GroupVersionKind(), sc.syncResources) {
+		return false
+	}
+	return sc.labelSelector == nil || sc.labelSelector.Matches(labels.Set(obj.GetLabels()))
+}
+
+func containsSyncResourcePattern(resources []v1alpha1.SyncOperationResource) bool {
+	for _, r := range resources {
+		if r.IsPattern() {
+			return true
+		}
+	}
+	return false
 }
 
 // generates the list of sync tasks we will be performing during this sync.
@@ -394,8 +578,14 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 			continue
 		}
 
+		targetObj := resource.Target
+		if sc.ignoredFieldsRetainer != nil && targetObj != nil && resource.Live != nil {
+			targetObj = targetObj.DeepCopy()
+			sc.ignoredFieldsRetainer.Retain(targetObj, resource.Live)
+		}
+
 		for _, phase := range syncPhases(obj) {
-			resourceTasks = append(resourceTasks, &syncTask{phase: phase, targetObj: resource.Target, liveObj: resource.Live})
+			resourceTasks = append(resourceTasks, &syncTask{phase: phase, targetObj: targetObj, liveObj: resource.Live})
 		}
 	}
 
@@ -410,7 +600,7 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 				// metadata.generateName, then we will generate a formulated metadata.name before submission.
 				targetObj := obj.DeepCopy()
 				if targetObj.GetName() == "" {
-					postfix := strings.ToLower(fmt.Sprintf("%s-%s-%d", sc.syncRes.Revision[0:7], phase, sc.opState.StartedAt.UTC().Unix()))
+					postfix := strings.ToLower(fmt.Sprintf("%s-%s-%d", shortRevision(sc.syncRes.Revision), phase, sc.opState.StartedAt.UTC().Unix()))
 					generateName := obj.GetGenerateName()
 					targetObj.SetName(fmt.Sprintf("%s%s", generateName, postfix))
 				}
@@ -459,6 +649,13 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
+	// the dry-run of hooks which are deleted before they are created again would run against the previous hook
+	for _, task := range tasks {
+		if task.deleteBeforeCreation() {
+			task.skipDryRun = true
+		}
+	}
+
 	// check permissions
 	for _, task := range tasks {
 		serverRes, err := kube.ServerResourceForGroupVersionKind(sc.disco, task.groupVersionKind())
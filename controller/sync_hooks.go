@@ -17,18 +17,19 @@ import (
 
 // enforceHookDeletePolicy examines the hook deletion policy of a object and deletes it based on the status
 func enforceHookDeletePolicy(hook *unstructured.Unstructured, operation v1alpha1.OperationPhase) bool {
-
-	annotations := hook.GetAnnotations()
-	if annotations == nil {
-		return false
+	if hasHookDeletePolicy(hook, v1alpha1.HookDeletePolicyHookSucceeded) && operation == v1alpha1.OperationSucceeded {
+		return true
 	}
-	deletePolicies := strings.Split(annotations[common.AnnotationKeyHookDeletePolicy], ",")
-	for _, dp := range deletePolicies {
-		policy := v1alpha1.HookDeletePolicy(strings.TrimSpace(dp))
-		if policy == v1alpha1.HookDeletePolicyHookSucceeded && operation == v1alpha1.OperationSucceeded {
-			return true
-		}
-		if policy == v1alpha1.HookDeletePolicyHookFailed && operation == v1alpha1.OperationFailed {
+	if hasHookDeletePolicy(hook, v1alpha1.HookDeletePolicyHookFailed) && operation == v1alpha1.OperationFailed {
+		return true
+	}
+	return false
+}
+
+// hasHookDeletePolicy returns whether the hook deletion policy annotation of a object contains the given policy
+func hasHookDeletePolicy(hook *unstructured.Unstructured, policy v1alpha1.HookDeletePolicy) bool {
+	for _, dp := range strings.Split(hook.GetAnnotations()[common.AnnotationKeyHookDeletePolicy], ",") {
+		if v1alpha1.HookDeletePolicy(strings.TrimSpace(dp)) == policy {
 			return true
 		}
 	}
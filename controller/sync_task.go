@@ -34,8 +34,8 @@ func ternary(val bool, a, b string) string {
 }
 
 func (t *syncTask) String() string {
-	return fmt.Sprintf("%s/%d %s %s/%s:%s/%s %s->%s (%s,%s,%s)",
-		t.phase, t.wave(),
+	return fmt.Sprintf("%s/%d/%d %s %s/%s:%s/%s %s->%s (%s,%s,%s)",
+		t.phase, t.wave(), t.weight(),
 		ternary(t.isHook(), "hook", "resource"), t.group(), t.kind(), t.namespace(), t.name(),
 		ternary(t.liveObj != nil, "obj", "nil"), ternary(t.targetObj != nil, "obj", "nil"),
 		t.syncStatus, t.operationState, t.message,
@@ -53,8 +53,27 @@ func (t *syncTask) obj() *unstructured.Unstructured {
 }
 
 func (t *syncTask) wave() int {
+	return intAnnotation(t.obj(), common.AnnotationSyncWave)
+}
+
+// weight orders the hooks within a phase and wave, resources are always of weight zero
+func (t *syncTask) weight() int {
+	if !t.isHook() {
+		return 0
+	}
+	return intAnnotation(t.obj(), common.AnnotationKeyHookWeight)
+}
+
+// deleteBeforeCreation returns whether the task is a hook which has not been created by this sync yet, but whose live
+// object of a previous sync must be deleted before it is created again
+func (t *syncTask) deleteBeforeCreation() bool {
+	return t.isHook() && t.targetObj != nil && t.liveObj != nil && t.operationState == "" &&
+		hasHookDeletePolicy(t.targetObj, v1alpha1.HookDeletePolicyBeforeHookCreation)
+}
+
+func intAnnotation(obj *unstructured.Unstructured, key string) int {
 
-	text := t.obj().GetAnnotations()[common.AnnotationSyncWave]
+	text := obj.GetAnnotations()[key]
 	if text == "" {
 		return 0
 	}
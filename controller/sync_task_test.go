@@ -36,3 +36,26 @@ func Test_syncTask_hookType(t *testing.T) {
 		})
 	}
 }
+
+func Test_syncTask_weight(t *testing.T) {
+	assert.Equal(t, 0, (&syncTask{targetObj: test.NewHook(HookTypePreSync)}).weight())
+	assert.Equal(t, -1, (&syncTask{targetObj: test.Annotate(test.NewHook(HookTypePreSync), "argocd.argoproj.io/hook-weight", "-1")}).weight())
+	assert.Equal(t, 0, (&syncTask{targetObj: test.Annotate(test.NewHook(HookTypePreSync), "argocd.argoproj.io/hook-weight", "garbage")}).weight())
+	// resources are not ordered by weight
+	assert.Equal(t, 0, (&syncTask{targetObj: test.Annotate(test.NewPod(), "argocd.argoproj.io/hook-weight", "1")}).weight())
+}
+
+func Test_syncTask_deleteBeforeCreation(t *testing.T) {
+	newHook := func(policy string) *unstructured.Unstructured {
+		return test.Annotate(test.NewHook(HookTypePreSync), "argocd.argoproj.io/hook-delete-policy", policy)
+	}
+	assert.True(t, (&syncTask{targetObj: newHook("BeforeHookCreation"), liveObj: newHook("BeforeHookCreation")}).deleteBeforeCreation())
+	assert.True(t, (&syncTask{targetObj: newHook("HookSucceeded,BeforeHookCreation"), liveObj: test.NewHook(HookTypePreSync)}).deleteBeforeCreation())
+	assert.False(t, (&syncTask{targetObj: newHook("HookSucceeded"), liveObj: newHook("HookSucceeded")}).deleteBeforeCreation())
+	// the hook does not exist yet
+	assert.False(t, (&syncTask{targetObj: newHook("BeforeHookCreation")}).deleteBeforeCreation())
+	// the hook has already been created by this sync
+	assert.False(t, (&syncTask{targetObj: newHook("BeforeHookCreation"), liveObj: newHook("BeforeHookCreation"), operationState: OperationRunning}).deleteBeforeCreation())
+	// resources are never deleted
+	assert.False(t, (&syncTask{targetObj: test.Annotate(test.NewPod(), "argocd.argoproj.io/hook-delete-policy", "BeforeHookCreation"), liveObj: test.NewPod()}).deleteBeforeCreation())
+}
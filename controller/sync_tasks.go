@@ -67,8 +67,9 @@ func (s syncTasks) Swap(i, j int) {
 // order is
 // 1. phase
 // 2. wave
-// 3. kind
-// 4. name
+// 3. weight (of hooks)
+// 4. kind
+// 5. name
 func (s syncTasks) Less(i, j int) bool {
 
 	tA := s[i]
@@ -84,6 +85,11 @@ func (s syncTasks) Less(i, j int) bool {
 		return d < 0
 	}
 
+	d = tA.weight() - tB.weight()
+	if d != 0 {
+		return d < 0
+	}
+
 	a := tA.obj()
 	b := tB.obj()
 
@@ -165,3 +171,10 @@ func (s syncTasks) wave() int {
 	}
 	return 0
 }
+
+func (s syncTasks) weight() int {
+	if len(s) > 0 {
+		return s[0].weight()
+	}
+	return 0
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -11,16 +12,20 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakedisco "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/rest"
 	testcore "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	. "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kube/kubetest"
 )
@@ -371,6 +376,55 @@ func TestSelectiveSyncOnly(t *testing.T) {
 	assert.Equal(t, "pod-1", tasks[0].name())
 }
 
+func TestSelectiveSyncPatterns(t *testing.T) {
+	pod1 := test.NewPod()
+	pod1.SetName("pod-1")
+	pod1.SetLabels(map[string]string{"tier": "frontend"})
+	pod2 := test.NewPod()
+	pod2.SetName("pod-2")
+	pod2.SetLabels(map[string]string{"tier": "backend"})
+	pod3 := test.NewPod()
+	pod3.SetName("other")
+	managedResources := []managedResource{{Target: pod1}, {Target: pod2}, {Target: pod3}}
+
+	tests := []struct {
+		name          string
+		syncResources []v1alpha1.SyncOperationResource
+		labelSelector string
+		want          []string
+	}{
+		{"Glob", []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "pod-*"}}, "", []string{"pod-1", "pod-2"}},
+		{"MultipleGlobs", []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "pod-2"}, {Kind: "*", Name: "oth*"}}, "", []string{"pod-2", "other"}},
+		{"Exclusion", []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "pod-1", Exclude: true}}, "", []string{"pod-2", "other"}},
+		{"GlobAndExclusion", []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "*"}, {Kind: "Pod", Name: "pod-*", Exclude: true}}, "", []string{"other"}},
+		{"LabelSelector", nil, "tier=frontend", []string{"pod-1"}},
+		{"LabelSelectorAndExclusion", []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "other", Exclude: true}}, "tier!=frontend", []string{"pod-2"}},
+		{"All", []v1alpha1.SyncOperationResource{{Kind: "Pod", Name: "*"}}, "", []string{"pod-1", "pod-2", "other"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx()
+			syncCtx.compareResult = &comparisonResult{managedResources: managedResources}
+			syncCtx.syncResources = tt.syncResources
+			if tt.labelSelector != "" {
+				selector, err := labels.Parse(tt.labelSelector)
+				assert.NoError(t, err)
+				syncCtx.labelSelector = selector
+			}
+
+			tasks, successful := syncCtx.getSyncTasks()
+
+			assert.True(t, successful)
+			var names []string
+			for _, task := range tasks {
+				names = append(names, task.name())
+			}
+			assert.ElementsMatch(t, tt.want, names)
+			assert.Equal(t, len(tt.want) < len(managedResources), syncCtx.isSelectiveSync())
+		})
+	}
+}
+
 func TestUnnamedHooksGetUniqueNames(t *testing.T) {
 	syncCtx := newTestSyncCtx()
 	syncCtx.syncOp.SyncStrategy.Apply = nil
@@ -456,7 +510,8 @@ func TestPersistRevisionHistory(t *testing.T) {
 
 	// Sync with source unspecified
 	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{},
+		Sync:        &v1alpha1.SyncOperation{Prune: true},
+		InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"},
 	}}
 	ctrl.appStateManager.SyncAppState(app, opState)
 	// Ensure we record spec.source into sync result
@@ -467,6 +522,10 @@ func TestPersistRevisionHistory(t *testing.T) {
 	assert.Equal(t, 1, len(updatedApp.Status.History))
 	assert.Equal(t, app.Spec.Source, updatedApp.Status.History[0].Source)
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
+	// Ensure we record who initiated the sync, its options and its result
+	assert.Equal(t, v1alpha1.OperationInitiator{Username: "admin"}, updatedApp.Status.History[0].InitiatedBy)
+	assert.Equal(t, []string{"Strategy=hook", "Prune=true"}, updatedApp.Status.History[0].SyncOptions)
+	assert.Equal(t, v1alpha1.OperationSucceeded, updatedApp.Status.History[0].Phase)
 }
 
 func TestPersistRevisionHistoryRollback(t *testing.T) {
@@ -518,6 +577,248 @@ func TestPersistRevisionHistoryRollback(t *testing.T) {
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
 }
 
+func TestPersistRevisionHistoryMultipleSources(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	app.Spec.Sources = v1alpha1.ApplicationSources{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"},
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+	}
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+	// Ensure we record spec.sources and their revisions into sync result
+	assert.Equal(t, app.Spec.Sources, opState.SyncResult.Sources)
+	assert.Equal(t, []string{"abc123", "abc123"}, opState.SyncResult.Revisions)
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(app.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(updatedApp.Status.History))
+	assert.Equal(t, app.Spec.Sources, updatedApp.Status.History[0].Sources)
+	assert.Equal(t, []string{"abc123", "abc123"}, updatedApp.Status.History[0].Revisions)
+}
+
+func TestSyncCascadedChildApp(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "argoproj.io/v1alpha1",
+		APIResources: []v1.APIResource{{Kind: "Application", Group: "argoproj.io", Version: "v1alpha1", Namespaced: true}},
+	})
+	syncCtx.server = common.KubernetesInternalAPIServerAddr
+	syncCtx.appNamespace = test.FakeArgoCDNamespace
+	syncCtx.proj.Spec.Destinations = []ApplicationDestination{{Server: "*", Namespace: "*"}}
+	syncCtx.opState.StartedAt = metav1.NewTime(time.Now().Add(-time.Minute))
+	child := &Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Application"},
+		ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: test.FakeArgoCDNamespace},
+		Spec:       ApplicationSpec{Source: ApplicationSource{TargetRevision: "v1"}},
+	}
+	syncCtx.appclientset = appclientset.NewSimpleClientset(child)
+	childIf := syncCtx.appclientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace)
+	target := kube.MustToUnstructured(child)
+	target.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "CascadeSync=true"})
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: target}}}
+
+	// the child is applied, but not complete before it has been synced
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	app, err := childIf.Get("child", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+
+	// the sync of the child is started
+	syncCtx.compareResult.managedResources[0].Live = kube.MustToUnstructured(child)
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	app, err = childIf.Get("child", metav1.GetOptions{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, app.Operation) {
+		assert.Equal(t, "v1", app.Operation.Sync.Revision)
+	}
+
+	// the sync of the child is waited for
+	child.Operation = app.Operation
+	syncCtx.compareResult.managedResources[0].Live = kube.MustToUnstructured(child)
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+
+	// the parent is synced once the child is synced and healthy
+	child.Operation = nil
+	child.Status.OperationState = &OperationState{Phase: OperationSucceeded, StartedAt: metav1.Now()}
+	child.Status.Sync.Status = SyncStatusCodeSynced
+	child.Status.Health.Status = HealthStatusHealthy
+	syncCtx.compareResult.managedResources[0].Live = kube.MustToUnstructured(child)
+	syncCtx.sync()
+	assert.Equal(t, OperationSucceeded, syncCtx.opState.Phase)
+}
+
+func TestSyncCascadedChildAppBlockedBySyncWindow(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "argoproj.io/v1alpha1",
+		APIResources: []v1.APIResource{{Kind: "Application", Group: "argoproj.io", Version: "v1alpha1", Namespaced: true}},
+	})
+	syncCtx.server = common.KubernetesInternalAPIServerAddr
+	syncCtx.appNamespace = test.FakeArgoCDNamespace
+	syncCtx.proj.Spec.Destinations = []ApplicationDestination{{Server: "*", Namespace: "*"}}
+	syncCtx.opState.StartedAt = metav1.NewTime(time.Now().Add(-time.Minute))
+	child := &Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Application"},
+		ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: test.FakeArgoCDNamespace},
+		Spec:       ApplicationSpec{Project: "frozen"},
+	}
+	proj := &AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "frozen", Namespace: test.FakeArgoCDNamespace},
+		Spec: AppProjectSpec{
+			SyncWindows: SyncWindows{{Kind: SyncWindowKindDeny, Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}}},
+		},
+	}
+	syncCtx.appclientset = appclientset.NewSimpleClientset(child, proj)
+	target := kube.MustToUnstructured(child)
+	target.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "CascadeSync=true"})
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: target, Live: kube.MustToUnstructured(child)}}}
+
+	// the sync of the child is not started while the deny window is active
+	syncCtx.sync()
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	app, err := syncCtx.appclientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get("child", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+	assert.Equal(t, "The sync of the child application is blocked by sync window", syncCtx.syncRes.Resources[0].Message)
+}
+
+func TestSyncCascadedChildAppFailed(t *testing.T) {
+	syncCtx := newTestSyncCtx(&v1.APIResourceList{
+		GroupVersion: "argoproj.io/v1alpha1",
+		APIResources: []v1.APIResource{{Kind: "Application", Group: "argoproj.io", Version: "v1alpha1", Namespaced: true}},
+	})
+	syncCtx.server = common.KubernetesInternalAPIServerAddr
+	syncCtx.appNamespace = test.FakeArgoCDNamespace
+	syncCtx.proj.Spec.Destinations = []ApplicationDestination{{Server: "*", Namespace: "*"}}
+	syncCtx.opState.StartedAt = metav1.NewTime(time.Now().Add(-time.Minute))
+	child := &Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Application"},
+		ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: test.FakeArgoCDNamespace},
+	}
+	target := kube.MustToUnstructured(child)
+	target.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "CascadeSync=true"})
+	syncCtx.compareResult = &comparisonResult{managedResources: []managedResource{{Target: target}}}
+	syncCtx.sync()
+
+	child.Status.OperationState = &OperationState{Phase: OperationFailed, StartedAt: metav1.Now(), Message: "one or more objects failed to apply"}
+	syncCtx.compareResult.managedResources[0].Live = kube.MustToUnstructured(child)
+	syncCtx.sync()
+	assert.Equal(t, OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "The sync of the child application failed: one or more objects failed to apply", syncCtx.syncRes.Resources[0].Message)
+}
+
+func TestSelfHealRetainsIgnoredFields(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	target := test.NewPod()
+	target.SetNamespace(test.FakeArgoCDNamespace)
+	live := target.DeepCopy()
+	live.SetAnnotations(map[string]string{"injected": "live"})
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{Target: target, Live: live, Kind: "Pod", Namespace: test.FakeArgoCDNamespace, Name: target.GetName()}},
+	}
+
+	tasks, successful := syncCtx.getSyncTasks()
+	assert.True(t, successful)
+	if assert.Len(t, tasks, 1) {
+		assert.Empty(t, tasks[0].targetObj.GetAnnotations())
+	}
+
+	var err error
+	syncCtx.ignoredFieldsRetainer, err = argo.NewIgnoredFieldsRetainer([]v1alpha1.ResourceIgnoreDifferences{{
+		Kind:         "Pod",
+		JSONPointers: []string{"/metadata/annotations"},
+	}}, nil)
+	assert.NoError(t, err)
+	tasks, successful = syncCtx.getSyncTasks()
+	assert.True(t, successful)
+	if assert.Len(t, tasks, 1) {
+		assert.Equal(t, map[string]string{"injected": "live"}, tasks[0].targetObj.GetAnnotations())
+	}
+	// the target state of the comparison is not modified
+	assert.Empty(t, target.GetAnnotations())
+}
+
+func TestSyncHookWeights(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.SyncStrategy.Apply = nil
+	first := test.Annotate(test.NewHook(HookTypePreSync), common.AnnotationKeyHookWeight, "-1")
+	first.SetName("first")
+	second := test.Annotate(test.NewHook(HookTypePreSync), common.AnnotationKeyHookWeight, "1")
+	second.SetName("second")
+	syncCtx.compareResult = &comparisonResult{hooks: []*unstructured.Unstructured{second, first}}
+
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+		assert.Equal(t, "first", syncCtx.syncRes.Resources[0].Name)
+	}
+
+	// the second hook is only created once the first has completed
+	syncCtx.syncRes.Resources[0].HookPhase = OperationSucceeded
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	if assert.Len(t, syncCtx.syncRes.Resources, 2) {
+		assert.Equal(t, "second", syncCtx.syncRes.Resources[1].Name)
+	}
+}
+
+func TestSyncBeforeHookCreation(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.SyncStrategy.Apply = nil
+	hook := test.Annotate(test.NewHook(HookTypePreSync), common.AnnotationKeyHookDeletePolicy, "BeforeHookCreation")
+	hook.SetNamespace(test.FakeArgoCDNamespace)
+	liveHook := hook.DeepCopy()
+	dynamicIf := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), liveHook)
+	syncCtx.dynamicIf = dynamicIf
+	syncCtx.compareResult = &comparisonResult{
+		managedResources: []managedResource{{Live: liveHook, Kind: "Pod", Namespace: test.FakeArgoCDNamespace, Name: hook.GetName(), Hook: true}},
+		hooks:            []*unstructured.Unstructured{hook},
+	}
+
+	// the hook of the previous sync is deleted
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	assert.Equal(t, "waiting for the deletion of hooks of previous syncs", syncCtx.opState.Message)
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+	if assert.Len(t, dynamicIf.Actions(), 1) {
+		action := dynamicIf.Actions()[0].(testcore.DeleteAction)
+		assert.Equal(t, test.FakeArgoCDNamespace, action.GetNamespace())
+		assert.Equal(t, hook.GetName(), action.GetName())
+	}
+
+	// and created again once it is gone
+	syncCtx.compareResult.managedResources = nil
+	syncCtx.sync()
+	assert.Equal(t, OperationRunning, syncCtx.opState.Phase)
+	if assert.Len(t, syncCtx.syncRes.Resources, 1) {
+		assert.Equal(t, OperationRunning, syncCtx.syncRes.Resources[0].HookPhase)
+	}
+}
+
 func TestSyncFailureHookWithSuccessfulSync(t *testing.T) {
 	syncCtx := newTestSyncCtx()
 	syncCtx.syncOp.SyncStrategy.Apply = nil
@@ -660,3 +961,10 @@ func Test_syncContext_liveObj(t *testing.T) {
 		})
 	}
 }
+
+func TestShortRevision(t *testing.T) {
+	assert.Equal(t, "aaaaaaa", shortRevision("aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd"))
+	assert.Equal(t, "0-2-0", shortRevision("0.2.0"))
+	assert.Equal(t, "1-0-0-r", shortRevision("1.0.0-RC.1"))
+	assert.Equal(t, "2c26b46", shortRevision("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"))
+}
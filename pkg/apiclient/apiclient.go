@@ -29,7 +29,9 @@ import (
 	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
 	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
 	clusterpkg "github.com/argoproj/argo-cd/pkg/apiclient/cluster"
+	gpgkeypkg "github.com/argoproj/argo-cd/pkg/apiclient/gpgkey"
 	projectpkg "github.com/argoproj/argo-cd/pkg/apiclient/project"
+	repocredspkg "github.com/argoproj/argo-cd/pkg/apiclient/repocreds"
 	repositorypkg "github.com/argoproj/argo-cd/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
@@ -44,6 +46,10 @@ import (
 
 const (
 	MetaDataTokenKey = "token"
+	// MetaDataImpersonateUserKey is the metadata key holding the user a request is performed on behalf of
+	MetaDataImpersonateUserKey = "impersonate-user"
+	// MetaDataImpersonateGroupsKey is the metadata key holding the comma separated groups a request is performed on behalf of
+	MetaDataImpersonateGroupsKey = "impersonate-groups"
 	// EnvArgoCDServer is the environment variable to look for an Argo CD server address
 	EnvArgoCDServer = "ARGOCD_SERVER"
 	// EnvArgoCDAuthToken is the environment variable to look for an Argo CD auth token
@@ -59,8 +65,12 @@ type Client interface {
 	OIDCConfig(context.Context, *settingspkg.Settings) (*oauth2.Config, *oidc.Provider, error)
 	NewRepoClient() (io.Closer, repositorypkg.RepositoryServiceClient, error)
 	NewRepoClientOrDie() (io.Closer, repositorypkg.RepositoryServiceClient)
+	NewRepoCredsClient() (io.Closer, repocredspkg.RepoCredsServiceClient, error)
+	NewRepoCredsClientOrDie() (io.Closer, repocredspkg.RepoCredsServiceClient)
 	NewCertClient() (io.Closer, certificatepkg.CertificateServiceClient, error)
 	NewCertClientOrDie() (io.Closer, certificatepkg.CertificateServiceClient)
+	NewGPGKeyClient() (io.Closer, gpgkeypkg.GPGKeyServiceClient, error)
+	NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServiceClient)
 	NewClusterClient() (io.Closer, clusterpkg.ClusterServiceClient, error)
 	NewClusterClientOrDie() (io.Closer, clusterpkg.ClusterServiceClient)
 	NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error)
@@ -89,6 +99,10 @@ type ClientOptions struct {
 	Context    string
 	UserAgent  string
 	GRPCWeb    bool
+	// ImpersonateUser is the user the requests are performed on behalf of
+	ImpersonateUser string
+	// ImpersonateGroups are the groups the requests are performed on behalf of
+	ImpersonateGroups []string
 }
 
 type client struct {
@@ -101,6 +115,9 @@ type client struct {
 	UserAgent    string
 	GRPCWeb      bool
 
+	ImpersonateUser   string
+	ImpersonateGroups []string
+
 	proxyMutex      *sync.Mutex
 	proxyListener   net.Listener
 	proxyServer     *grpc.Server
@@ -182,6 +199,8 @@ func NewClient(opts *ClientOptions) (Client, error) {
 	if opts.GRPCWeb {
 		c.GRPCWeb = true
 	}
+	c.ImpersonateUser = opts.ImpersonateUser
+	c.ImpersonateGroups = opts.ImpersonateGroups
 	if localCfg != nil {
 		err = c.refreshAuthToken(localCfg, ctxName, opts.ConfigPath)
 		if err != nil {
@@ -353,6 +372,28 @@ func (c jwtCredentials) GetRequestMetadata(context.Context, ...string) (map[stri
 	}, nil
 }
 
+// impersonationCredentials implements the gRPC credentials.Credentials interface, to
+// pass the user and groups a request is performed on behalf of to the server
+type impersonationCredentials struct {
+	User   string
+	Groups []string
+}
+
+func (c impersonationCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func (c impersonationCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	md := make(map[string]string)
+	if c.User != "" {
+		md[MetaDataImpersonateUserKey] = c.User
+	}
+	if len(c.Groups) > 0 {
+		md[MetaDataImpersonateGroupsKey] = strings.Join(c.Groups, ",")
+	}
+	return md, nil
+}
+
 func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
 	closers := make([]io.Closer, 0)
 	serverAddr := c.ServerAddr
@@ -381,6 +422,12 @@ func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
 	}
 	var dialOpts []grpc.DialOption
 	dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(endpointCredentials))
+	if c.ImpersonateUser != "" || len(c.ImpersonateGroups) > 0 {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(impersonationCredentials{
+			User:   c.ImpersonateUser,
+			Groups: c.ImpersonateGroups,
+		}))
+	}
 	dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxGRPCMessageSize)))
 	if c.UserAgent != "" {
 		dialOpts = append(dialOpts, grpc.WithUserAgent(c.UserAgent))
@@ -416,10 +463,12 @@ func (c *client) tlsConfig() (*tls.Config, error) {
 
 func (c *client) ClientOptions() ClientOptions {
 	return ClientOptions{
-		ServerAddr: c.ServerAddr,
-		PlainText:  c.PlainText,
-		Insecure:   c.Insecure,
-		AuthToken:  c.AuthToken,
+		ServerAddr:        c.ServerAddr,
+		PlainText:         c.PlainText,
+		Insecure:          c.Insecure,
+		AuthToken:         c.AuthToken,
+		ImpersonateUser:   c.ImpersonateUser,
+		ImpersonateGroups: c.ImpersonateGroups,
 	}
 }
 
@@ -440,6 +489,23 @@ func (c *client) NewRepoClientOrDie() (io.Closer, repositorypkg.RepositoryServic
 	return conn, repoIf
 }
 
+func (c *client) NewRepoCredsClient() (io.Closer, repocredspkg.RepoCredsServiceClient, error) {
+	conn, closer, err := c.newConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	repoIf := repocredspkg.NewRepoCredsServiceClient(conn)
+	return closer, repoIf, nil
+}
+
+func (c *client) NewRepoCredsClientOrDie() (io.Closer, repocredspkg.RepoCredsServiceClient) {
+	conn, repoIf, err := c.NewRepoCredsClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, repoIf
+}
+
 func (c *client) NewCertClient() (io.Closer, certificatepkg.CertificateServiceClient, error) {
 	conn, closer, err := c.newConn()
 	if err != nil {
@@ -457,6 +523,23 @@ func (c *client) NewCertClientOrDie() (io.Closer, certificatepkg.CertificateServ
 	return conn, certIf
 }
 
+func (c *client) NewGPGKeyClient() (io.Closer, gpgkeypkg.GPGKeyServiceClient, error) {
+	conn, closer, err := c.newConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	gpgkeyIf := gpgkeypkg.NewGPGKeyServiceClient(conn)
+	return closer, gpgkeyIf, nil
+}
+
+func (c *client) NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServiceClient) {
+	conn, gpgkeyIf, err := c.NewGPGKeyClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, gpgkeyIf
+}
+
 func (c *client) NewClusterClient() (io.Closer, clusterpkg.ClusterServiceClient, error) {
 	conn, closer, err := c.newConn()
 	if err != nil {
@@ -0,0 +1,78 @@
+package apiclient
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
+)
+
+// Starts a gRPC server which records the metadata of the last request it
+// received. Every request is answered with an Unimplemented error.
+func startMetadataRecordingServer(t *testing.T) (string, func() metadata.MD, func()) {
+	mdCh := make(chan metadata.MD, 1)
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+		md, _ := metadata.FromIncomingContext(stream.Context())
+		mdCh <- md
+		return status.Error(codes.Unimplemented, "not implemented")
+	}))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go func() { _ = server.Serve(listener) }()
+	return listener.Addr().String(), func() metadata.MD {
+		return <-mdCh
+	}, server.Stop
+}
+
+func TestImpersonationMetadata(t *testing.T) {
+	addr, receivedMetadata, stop := startMetadataRecordingServer(t)
+	defer stop()
+
+	configPath := filepath.Join(t.Name(), "does-not-exist")
+
+	t.Run("WithImpersonation", func(t *testing.T) {
+		client, err := NewClient(&ClientOptions{
+			ServerAddr:        addr,
+			PlainText:         true,
+			ConfigPath:        configPath,
+			ImpersonateUser:   "alice",
+			ImpersonateGroups: []string{"team-a", "team-b"},
+		})
+		assert.NoError(t, err)
+		conn, certIf, err := client.NewCertClient()
+		assert.NoError(t, err)
+		defer func() { _ = conn.Close() }()
+
+		_, err = certIf.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		md := receivedMetadata()
+		assert.Equal(t, []string{"alice"}, md.Get(MetaDataImpersonateUserKey))
+		assert.Equal(t, []string{"team-a,team-b"}, md.Get(MetaDataImpersonateGroupsKey))
+	})
+
+	t.Run("WithoutImpersonation", func(t *testing.T) {
+		client, err := NewClient(&ClientOptions{
+			ServerAddr: addr,
+			PlainText:  true,
+			ConfigPath: configPath,
+		})
+		assert.NoError(t, err)
+		conn, certIf, err := client.NewCertClient()
+		assert.NoError(t, err)
+		defer func() { _ = conn.Close() }()
+
+		_, err = certIf.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		md := receivedMetadata()
+		assert.Empty(t, md.Get(MetaDataImpersonateUserKey))
+		assert.Empty(t, md.Get(MetaDataImpersonateGroupsKey))
+	})
+}
@@ -52,7 +52,7 @@ func (m *ApplicationQuery) Reset()         { *m = ApplicationQuery{} }
 func (m *ApplicationQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationQuery) ProtoMessage()    {}
 func (*ApplicationQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{0}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{0}
 }
 func (m *ApplicationQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +123,7 @@ func (m *RevisionMetadataQuery) Reset()         { *m = RevisionMetadataQuery{} }
 func (m *RevisionMetadataQuery) String() string { return proto.CompactTextString(m) }
 func (*RevisionMetadataQuery) ProtoMessage()    {}
 func (*RevisionMetadataQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{1}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{1}
 }
 func (m *RevisionMetadataQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,7 +181,7 @@ func (m *ApplicationResourceEventsQuery) Reset()         { *m = ApplicationResou
 func (m *ApplicationResourceEventsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceEventsQuery) ProtoMessage()    {}
 func (*ApplicationResourceEventsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{2}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{2}
 }
 func (m *ApplicationResourceEventsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,8 +240,10 @@ func (m *ApplicationResourceEventsQuery) GetResourceUID() string {
 
 // ManifestQuery is a query for manifest resources
 type ApplicationManifestQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             string   `protobuf:"bytes,2,opt,name=revision" json:"revision"`
+	Name     *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision string  `protobuf:"bytes,2,opt,name=revision" json:"revision"`
+	// the ID of a history entry, whose source and revision the manifests are generated from
+	HistoryID            *int64   `protobuf:"varint,3,opt,name=historyID" json:"historyID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -251,7 +253,7 @@ func (m *ApplicationManifestQuery) Reset()         { *m = ApplicationManifestQue
 func (m *ApplicationManifestQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQuery) ProtoMessage()    {}
 func (*ApplicationManifestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{3}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{3}
 }
 func (m *ApplicationManifestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,6 +296,13 @@ func (m *ApplicationManifestQuery) GetRevision() string {
 	return ""
 }
 
+func (m *ApplicationManifestQuery) GetHistoryID() int64 {
+	if m != nil && m.HistoryID != nil {
+		return *m.HistoryID
+	}
+	return 0
+}
+
 type ApplicationResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -304,7 +313,7 @@ func (m *ApplicationResponse) Reset()         { *m = ApplicationResponse{} }
 func (m *ApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResponse) ProtoMessage()    {}
 func (*ApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{4}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{4}
 }
 func (m *ApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,7 +354,7 @@ func (m *ApplicationCreateRequest) Reset()         { *m = ApplicationCreateReque
 func (m *ApplicationCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationCreateRequest) ProtoMessage()    {}
 func (*ApplicationCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{5}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{5}
 }
 func (m *ApplicationCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +408,7 @@ func (m *ApplicationUpdateRequest) Reset()         { *m = ApplicationUpdateReque
 func (m *ApplicationUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateRequest) ProtoMessage()    {}
 func (*ApplicationUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{6}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{6}
 }
 func (m *ApplicationUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,7 +456,7 @@ func (m *ApplicationDeleteRequest) Reset()         { *m = ApplicationDeleteReque
 func (m *ApplicationDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationDeleteRequest) ProtoMessage()    {}
 func (*ApplicationDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{7}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{7}
 }
 func (m *ApplicationDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,6 +508,8 @@ type ApplicationSyncRequest struct {
 	Strategy             *v1alpha1.SyncStrategy           `protobuf:"bytes,5,opt,name=strategy" json:"strategy,omitempty"`
 	Resources            []v1alpha1.SyncOperationResource `protobuf:"bytes,7,rep,name=resources" json:"resources"`
 	Manifests            []string                         `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
+	LabelSelector        string                           `protobuf:"bytes,9,opt,name=labelSelector" json:"labelSelector"`
+	RetryStrategy        *v1alpha1.RetryStrategy          `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
 	XXX_unrecognized     []byte                           `json:"-"`
 	XXX_sizecache        int32                            `json:"-"`
@@ -508,7 +519,7 @@ func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{}
 func (m *ApplicationSyncRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncRequest) ProtoMessage()    {}
 func (*ApplicationSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{8}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{8}
 }
 func (m *ApplicationSyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,6 +597,20 @@ func (m *ApplicationSyncRequest) GetManifests() []string {
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *ApplicationSyncRequest) GetRetryStrategy() *v1alpha1.RetryStrategy {
+	if m != nil {
+		return m.RetryStrategy
+	}
+	return nil
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -599,7 +624,7 @@ func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateS
 func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
 func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{9}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{9}
 }
 func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +681,7 @@ func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest
 func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPatchRequest) ProtoMessage()    {}
 func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{10}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{10}
 }
 func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,10 +732,12 @@ func (m *ApplicationPatchRequest) GetPatchType() string {
 }
 
 type ApplicationRollbackRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	ID                   int64    `protobuf:"varint,2,req,name=id" json:"id"`
-	DryRun               bool     `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
-	Prune                bool     `protobuf:"varint,4,opt,name=prune" json:"prune"`
+	Name   *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	ID     int64   `protobuf:"varint,2,req,name=id" json:"id"`
+	DryRun bool    `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
+	Prune  bool    `protobuf:"varint,4,opt,name=prune" json:"prune"`
+	// a git revision, or chart version, of the application source to roll back to instead of the history entry with the ID
+	Revision             string   `protobuf:"bytes,5,opt,name=revision" json:"revision"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -720,7 +747,7 @@ func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackR
 func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationRollbackRequest) ProtoMessage()    {}
 func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{11}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{11}
 }
 func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,6 +804,13 @@ func (m *ApplicationRollbackRequest) GetPrune() bool {
 	return false
 }
 
+func (m *ApplicationRollbackRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type ApplicationResourceRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
@@ -793,7 +827,7 @@ func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceR
 func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceRequest) ProtoMessage()    {}
 func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{12}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{12}
 }
 func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -882,7 +916,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{13}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{13}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -984,7 +1018,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{14}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{14}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,23 +1097,24 @@ func (m *ApplicationResourceDeleteRequest) GetForce() bool {
 }
 
 type ResourceActionRunRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
-	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
-	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
-	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
-	Action               string   `protobuf:"bytes,7,req,name=action" json:"action"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 *string                        `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace            string                         `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	ResourceName         string                         `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
+	Version              string                         `protobuf:"bytes,4,req,name=version" json:"version"`
+	Group                string                         `protobuf:"bytes,5,req,name=group" json:"group"`
+	Kind                 string                         `protobuf:"bytes,6,req,name=kind" json:"kind"`
+	Action               string                         `protobuf:"bytes,7,req,name=action" json:"action"`
+	Params               []v1alpha1.ResourceActionParam `protobuf:"bytes,8,rep,name=params" json:"params"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunRequest{} }
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{15}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{15}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1157,6 +1192,13 @@ func (m *ResourceActionRunRequest) GetAction() string {
 	return ""
 }
 
+func (m *ResourceActionRunRequest) GetParams() []v1alpha1.ResourceActionParam {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 type ResourceActionsListResponse struct {
 	Actions              []v1alpha1.ResourceAction `protobuf:"bytes,1,rep,name=actions" json:"actions"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
@@ -1168,7 +1210,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{16}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{16}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1257,7 @@ func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResource
 func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceResponse) ProtoMessage()    {}
 func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{17}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{17}
 }
 func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1269,7 +1311,7 @@ func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery
 func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPodLogsQuery) ProtoMessage()    {}
 func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{18}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{18}
 }
 func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1354,9 +1396,133 @@ func (m *ApplicationPodLogsQuery) GetFollow() bool {
 	return false
 }
 
+// ApplicationResourceLogsQuery is a query for the logs of the pods of an application, or of one of its resources
+type ApplicationResourceLogsQuery struct {
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// the resource whose pods, or which itself is a pod, the logs are returned of; all pods of the application if the kind is empty
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace" json:"namespace"`
+	Group        string `protobuf:"bytes,3,opt,name=group" json:"group"`
+	Kind         string `protobuf:"bytes,4,opt,name=kind" json:"kind"`
+	ResourceName string `protobuf:"bytes,5,opt,name=resourceName" json:"resourceName"`
+	// the container the logs are returned of; the only container of each pod if empty
+	Container            string   `protobuf:"bytes,6,opt,name=container" json:"container"`
+	SinceSeconds         int64    `protobuf:"varint,7,opt,name=sinceSeconds" json:"sinceSeconds"`
+	SinceTime            *v1.Time `protobuf:"bytes,8,opt,name=sinceTime" json:"sinceTime,omitempty"`
+	TailLines            int64    `protobuf:"varint,9,opt,name=tailLines" json:"tailLines"`
+	Follow               bool     `protobuf:"varint,10,opt,name=follow" json:"follow"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationResourceLogsQuery) Reset()         { *m = ApplicationResourceLogsQuery{} }
+func (m *ApplicationResourceLogsQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationResourceLogsQuery) ProtoMessage()    {}
+func (*ApplicationResourceLogsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{19}
+}
+func (m *ApplicationResourceLogsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationResourceLogsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationResourceLogsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationResourceLogsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationResourceLogsQuery.Merge(dst, src)
+}
+func (m *ApplicationResourceLogsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationResourceLogsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationResourceLogsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationResourceLogsQuery proto.InternalMessageInfo
+
+func (m *ApplicationResourceLogsQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationResourceLogsQuery) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ApplicationResourceLogsQuery) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ApplicationResourceLogsQuery) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ApplicationResourceLogsQuery) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *ApplicationResourceLogsQuery) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *ApplicationResourceLogsQuery) GetSinceSeconds() int64 {
+	if m != nil {
+		return m.SinceSeconds
+	}
+	return 0
+}
+
+func (m *ApplicationResourceLogsQuery) GetSinceTime() *v1.Time {
+	if m != nil {
+		return m.SinceTime
+	}
+	return nil
+}
+
+func (m *ApplicationResourceLogsQuery) GetTailLines() int64 {
+	if m != nil {
+		return m.TailLines
+	}
+	return 0
+}
+
+func (m *ApplicationResourceLogsQuery) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
+
 type LogEntry struct {
-	Content              string   `protobuf:"bytes,1,req,name=content" json:"content"`
-	TimeStamp            v1.Time  `protobuf:"bytes,2,req,name=timeStamp" json:"timeStamp"`
+	Content   string  `protobuf:"bytes,1,req,name=content" json:"content"`
+	TimeStamp v1.Time `protobuf:"bytes,2,req,name=timeStamp" json:"timeStamp"`
+	// the pod the entry was logged by
+	PodName              string   `protobuf:"bytes,3,opt,name=podName" json:"podName"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1366,7 +1532,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{19}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{20}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1409,6 +1575,13 @@ func (m *LogEntry) GetTimeStamp() v1.Time {
 	return v1.Time{}
 }
 
+func (m *LogEntry) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
 type OperationTerminateRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1420,7 +1593,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{20}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{21}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1466,7 +1639,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{21}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{22}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1496,7 +1669,17 @@ func (m *OperationTerminateResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_OperationTerminateResponse proto.InternalMessageInfo
 
 type ResourcesQuery struct {
-	ApplicationName      *string  `protobuf:"bytes,1,req,name=applicationName" json:"applicationName,omitempty"`
+	ApplicationName *string `protobuf:"bytes,1,req,name=applicationName" json:"applicationName,omitempty"`
+	// kind only includes resources of the given kind in the resource tree
+	Kind string `protobuf:"bytes,2,opt,name=kind" json:"kind"`
+	// health only includes resources with the given health status in the resource tree
+	Health string `protobuf:"bytes,3,opt,name=health" json:"health"`
+	// name only includes resources whose name matches the given glob pattern in the resource tree
+	Name string `protobuf:"bytes,4,opt,name=name" json:"name"`
+	// limit is the maximum number of resources in the resource tree. Zero means no limit
+	Limit int64 `protobuf:"varint,5,opt,name=limit" json:"limit"`
+	// offset is the number of resources to skip, in order to page through the resource tree together with limit
+	Offset               int64    `protobuf:"varint,6,opt,name=offset" json:"offset"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1506,7 +1689,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{22}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{23}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1542,6 +1725,41 @@ func (m *ResourcesQuery) GetApplicationName() string {
 	return ""
 }
 
+func (m *ResourcesQuery) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ResourcesQuery) GetHealth() string {
+	if m != nil {
+		return m.Health
+	}
+	return ""
+}
+
+func (m *ResourcesQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourcesQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ResourcesQuery) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 type ManagedResourcesResponse struct {
 	Items                []*v1alpha1.ResourceDiff `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -1553,7 +1771,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_application_25eb5dc9e619c6e4, []int{23}
+	return fileDescriptor_application_35f5a7a9e4aec120, []int{24}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1609,6 +1827,7 @@ func init() {
 	proto.RegisterType((*ResourceActionsListResponse)(nil), "application.ResourceActionsListResponse")
 	proto.RegisterType((*ApplicationResourceResponse)(nil), "application.ApplicationResourceResponse")
 	proto.RegisterType((*ApplicationPodLogsQuery)(nil), "application.ApplicationPodLogsQuery")
+	proto.RegisterType((*ApplicationResourceLogsQuery)(nil), "application.ApplicationResourceLogsQuery")
 	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
 	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
 	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
@@ -1667,6 +1886,8 @@ type ApplicationServiceClient interface {
 	DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// PodLogs returns stream of log entries for the specified pod. Pod
 	PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error)
+	// ResourceLogs returns stream of log entries for the pods of the application, or of one of its resources
+	ResourceLogs(ctx context.Context, in *ApplicationResourceLogsQuery, opts ...grpc.CallOption) (ApplicationService_ResourceLogsClient, error)
 }
 
 type applicationServiceClient struct {
@@ -1921,6 +2142,38 @@ func (x *applicationServicePodLogsClient) Recv() (*LogEntry, error) {
 	return m, nil
 }
 
+func (c *applicationServiceClient) ResourceLogs(ctx context.Context, in *ApplicationResourceLogsQuery, opts ...grpc.CallOption) (ApplicationService_ResourceLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[2], "/application.ApplicationService/ResourceLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationServiceResourceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApplicationService_ResourceLogsClient interface {
+	Recv() (*LogEntry, error)
+	grpc.ClientStream
+}
+
+type applicationServiceResourceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationServiceResourceLogsClient) Recv() (*LogEntry, error) {
+	m := new(LogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for ApplicationService service
 
 type ApplicationServiceServer interface {
@@ -1964,6 +2217,8 @@ type ApplicationServiceServer interface {
 	DeleteResource(context.Context, *ApplicationResourceDeleteRequest) (*ApplicationResponse, error)
 	// PodLogs returns stream of log entries for the specified pod. Pod
 	PodLogs(*ApplicationPodLogsQuery, ApplicationService_PodLogsServer) error
+	// ResourceLogs returns stream of log entries for the pods of the application, or of one of its resources
+	ResourceLogs(*ApplicationResourceLogsQuery, ApplicationService_ResourceLogsServer) error
 }
 
 func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
@@ -2372,6 +2627,27 @@ func (x *applicationServicePodLogsServer) Send(m *LogEntry) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ApplicationService_ResourceLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplicationResourceLogsQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).ResourceLogs(m, &applicationServiceResourceLogsServer{stream})
+}
+
+type ApplicationService_ResourceLogsServer interface {
+	Send(*LogEntry) error
+	grpc.ServerStream
+}
+
+type applicationServiceResourceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceResourceLogsServer) Send(m *LogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "application.ApplicationService",
 	HandlerType: (*ApplicationServiceServer)(nil),
@@ -2468,6 +2744,11 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ApplicationService_PodLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ResourceLogs",
+			Handler:       _ApplicationService_ResourceLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "server/application/application.proto",
 }
@@ -2629,6 +2910,11 @@ func (m *ApplicationManifestQuery) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
 	i += copy(dAtA[i:], m.Revision)
+	if m.HistoryID != nil {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(*m.HistoryID))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2847,6 +3133,20 @@ func (m *ApplicationSyncRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.LabelSelector)))
+	i += copy(dAtA[i:], m.LabelSelector)
+	if m.RetryStrategy != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.RetryStrategy.Size()))
+		n4, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2879,11 +3179,11 @@ func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.Spec.Size()))
-	n4, err := m.Spec.MarshalTo(dAtA[i:])
+	n5, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2969,6 +3269,10 @@ func (m *ApplicationRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0
 	}
 	i++
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -3187,6 +3491,18 @@ func (m *ResourceActionRunRequest) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(len(m.Action)))
 	i += copy(dAtA[i:], m.Action)
+	if len(m.Params) > 0 {
+		for _, msg := range m.Params {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintApplication(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -3297,11 +3613,11 @@ func (m *ApplicationPodLogsQuery) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintApplication(dAtA, i, uint64(m.SinceTime.Size()))
-		n5, err := m.SinceTime.MarshalTo(dAtA[i:])
+		n6, err := m.SinceTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	dAtA[i] = 0x38
 	i++
@@ -3320,40 +3636,7 @@ func (m *ApplicationPodLogsQuery) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *LogEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(len(m.Content)))
-	i += copy(dAtA[i:], m.Content)
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplication(dAtA, i, uint64(m.TimeStamp.Size()))
-	n6, err := m.TimeStamp.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n6
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationResourceLogsQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3363,7 +3646,7 @@ func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperationTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationResourceLogsQuery) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -3376,11 +3659,121 @@ func (m *OperationTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
 		i += copy(dAtA[i:], *m.Name)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
+	i += copy(dAtA[i:], m.ResourceName)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Container)))
+	i += copy(dAtA[i:], m.Container)
+	dAtA[i] = 0x38
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.SinceSeconds))
+	if m.SinceTime != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.SinceTime.Size()))
+		n7, err := m.SinceTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	dAtA[i] = 0x48
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.TailLines))
+	dAtA[i] = 0x50
+	i++
+	if m.Follow {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *LogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Content)))
+	i += copy(dAtA[i:], m.Content)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.TimeStamp.Size()))
+	n8, err := m.TimeStamp.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.PodName)))
+	i += copy(dAtA[i:], m.PodName)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i += copy(dAtA[i:], *m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
 
 func (m *OperationTerminateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -3426,6 +3819,24 @@ func (m *ResourcesQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ApplicationName)))
 		i += copy(dAtA[i:], *m.ApplicationName)
 	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Health)))
+	i += copy(dAtA[i:], m.Health)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.Limit))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.Offset))
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -3544,6 +3955,9 @@ func (m *ApplicationManifestQuery) Size() (n int) {
 	}
 	l = len(m.Revision)
 	n += 1 + l + sovApplication(uint64(l))
+	if m.HistoryID != nil {
+		n += 1 + sovApplication(uint64(*m.HistoryID))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3629,6 +4043,12 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	l = len(m.LabelSelector)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3677,6 +4097,8 @@ func (m *ApplicationRollbackRequest) Size() (n int) {
 	n += 1 + sovApplication(uint64(m.ID))
 	n += 2
 	n += 2
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3778,6 +4200,12 @@ func (m *ResourceActionRunRequest) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = len(m.Action)
 	n += 1 + l + sovApplication(uint64(l))
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3838,6 +4266,36 @@ func (m *ApplicationPodLogsQuery) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationResourceLogsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ResourceName)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 1 + sovApplication(uint64(m.SinceSeconds))
+	if m.SinceTime != nil {
+		l = m.SinceTime.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	n += 1 + sovApplication(uint64(m.TailLines))
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *LogEntry) Size() (n int) {
 	var l int
 	_ = l
@@ -3845,6 +4303,8 @@ func (m *LogEntry) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = m.TimeStamp.Size()
 	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.PodName)
+	n += 1 + l + sovApplication(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3880,6 +4340,14 @@ func (m *ResourcesQuery) Size() (n int) {
 		l = len(*m.ApplicationName)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Health)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 1 + sovApplication(uint64(m.Limit))
+	n += 1 + sovApplication(uint64(m.Offset))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4478,6 +4946,26 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryID", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoryID = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -5080,6 +5568,68 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &v1alpha1.RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -5498,16 +6048,45 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Prune = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplication
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
@@ -6607,6 +7186,37 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000040)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, v1alpha1.ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7106,6 +7716,328 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationResourceLogsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourceLogsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourceLogsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeconds", wireType)
+			}
+			m.SinceSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SinceTime == nil {
+				m.SinceTime = &v1.Time{}
+			}
+			if err := m.SinceTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailLines", wireType)
+			}
+			m.TailLines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TailLines |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
@@ -7197,6 +8129,35 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7423,6 +8384,131 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			m.ApplicationName = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Health = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7636,127 +8722,141 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/application/application.proto", fileDescriptor_application_25eb5dc9e619c6e4)
-}
-
-var fileDescriptor_application_25eb5dc9e619c6e4 = []byte{
-	// 1873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x6f, 0x1c, 0x49,
-	0x15, 0xa6, 0x66, 0x6c, 0xcf, 0xcc, 0x73, 0xd8, 0xcd, 0xd6, 0x6e, 0x42, 0xd3, 0x9e, 0x38, 0xa3,
-	0x4a, 0xe2, 0x38, 0xde, 0xb8, 0x3b, 0x36, 0x01, 0x16, 0x83, 0xb4, 0x1b, 0x6f, 0x82, 0x37, 0x90,
-	0x04, 0x33, 0xce, 0x82, 0x84, 0x84, 0x50, 0x6f, 0x77, 0x79, 0xdc, 0x78, 0xa6, 0xbb, 0xe9, 0xee,
-	0x19, 0x34, 0x44, 0x39, 0xec, 0x0a, 0x21, 0x0e, 0x08, 0x84, 0xe0, 0xb0, 0x20, 0x7e, 0x69, 0xcf,
-	0x70, 0x42, 0x5c, 0x38, 0x70, 0x03, 0xed, 0x11, 0x09, 0xce, 0x11, 0xb2, 0xf8, 0x03, 0x38, 0x71,
-	0x46, 0x55, 0x5d, 0xd5, 0x5d, 0x35, 0x99, 0xe9, 0x99, 0xac, 0x87, 0x43, 0x6e, 0x35, 0xaf, 0xaa,
-	0xdf, 0xfb, 0xde, 0x7b, 0x5f, 0xbd, 0xaa, 0x57, 0x03, 0x97, 0x13, 0x1a, 0x0f, 0x68, 0x6c, 0x3b,
-	0x51, 0xd4, 0xf5, 0x5d, 0x27, 0xf5, 0xc3, 0x40, 0x1d, 0x5b, 0x51, 0x1c, 0xa6, 0x21, 0x5e, 0x56,
-	0x44, 0xe6, 0x2b, 0x9d, 0xb0, 0x13, 0x72, 0xb9, 0xcd, 0x46, 0xd9, 0x12, 0xb3, 0xd9, 0x09, 0xc3,
-	0x4e, 0x97, 0xda, 0x4e, 0xe4, 0xdb, 0x4e, 0x10, 0x84, 0x29, 0x5f, 0x9c, 0x88, 0x59, 0x72, 0xfc,
-	0x5a, 0x62, 0xf9, 0x21, 0x9f, 0x75, 0xc3, 0x98, 0xda, 0x83, 0x2d, 0xbb, 0x43, 0x03, 0x1a, 0x3b,
-	0x29, 0xf5, 0xc4, 0x9a, 0x9b, 0xc5, 0x9a, 0x9e, 0xe3, 0x1e, 0xf9, 0x01, 0x8d, 0x87, 0x76, 0x74,
-	0xdc, 0x61, 0x82, 0xc4, 0xee, 0xd1, 0xd4, 0x19, 0xf7, 0xd5, 0xdd, 0x8e, 0x9f, 0x1e, 0xf5, 0xdf,
-	0xb1, 0xdc, 0xb0, 0x67, 0x3b, 0x31, 0x07, 0xf6, 0x6d, 0x3e, 0xd8, 0x74, 0xbd, 0xe2, 0x6b, 0xd5,
-	0xbd, 0xc1, 0x96, 0xd3, 0x8d, 0x8e, 0x9c, 0xa7, 0x55, 0xed, 0x96, 0xa9, 0x8a, 0x69, 0x14, 0x8a,
-	0x58, 0xf1, 0xa1, 0x9f, 0x86, 0xf1, 0x50, 0x19, 0x66, 0x3a, 0xc8, 0xfb, 0x08, 0xce, 0xde, 0x2a,
-	0x8c, 0x7d, 0xb5, 0x4f, 0xe3, 0x21, 0xc6, 0xb0, 0x10, 0x38, 0x3d, 0x6a, 0xa0, 0x16, 0x5a, 0x6f,
-	0xb4, 0xf9, 0x18, 0x1b, 0x50, 0x8b, 0xe9, 0x61, 0x4c, 0x93, 0x23, 0xa3, 0xc2, 0xc5, 0xf2, 0x27,
-	0x5e, 0x83, 0x1a, 0xb3, 0x4c, 0xdd, 0xd4, 0xa8, 0xb6, 0xaa, 0xeb, 0x8d, 0xdd, 0x33, 0x27, 0x4f,
-	0x2e, 0xd6, 0xf7, 0x33, 0x51, 0xd2, 0x96, 0x93, 0xd8, 0x82, 0x17, 0x63, 0x9a, 0x84, 0xfd, 0xd8,
-	0xa5, 0x5f, 0xa3, 0x71, 0xe2, 0x87, 0x81, 0xb1, 0xc0, 0x34, 0xed, 0x2e, 0x7c, 0xf8, 0xe4, 0xe2,
-	0xc7, 0xda, 0xa3, 0x93, 0x64, 0x0f, 0xce, 0xb5, 0xe9, 0xc0, 0x67, 0xe3, 0xfb, 0x34, 0x75, 0x3c,
-	0x27, 0x75, 0x46, 0xe1, 0x55, 0x72, 0x78, 0x26, 0xd4, 0x63, 0xb1, 0xd8, 0xa8, 0x70, 0x79, 0xfe,
-	0x9b, 0xfc, 0x19, 0xc1, 0xaa, 0xe2, 0x63, 0x5b, 0xd8, 0xb9, 0x33, 0xa0, 0x41, 0x9a, 0x4c, 0x56,
-	0xb9, 0x0d, 0x2f, 0x49, 0x48, 0x0f, 0x9c, 0x1e, 0x4d, 0x22, 0xc7, 0xa5, 0x99, 0x6e, 0x81, 0xf8,
-	0xe9, 0x69, 0xbc, 0x0e, 0x67, 0x54, 0xa1, 0x51, 0x55, 0x96, 0x6b, 0x33, 0x78, 0x0d, 0x96, 0xe5,
-	0xef, 0xb7, 0xef, 0xde, 0x36, 0x16, 0x94, 0x85, 0xea, 0x04, 0xd9, 0x07, 0x43, 0xc1, 0x7e, 0xdf,
-	0x09, 0xfc, 0x43, 0x9a, 0xa4, 0x93, 0x51, 0xb7, 0xb4, 0x40, 0x14, 0xe1, 0x2d, 0xc2, 0x71, 0x0e,
-	0x5e, 0xd6, 0xa3, 0x11, 0x85, 0x41, 0x42, 0xc9, 0x07, 0x48, 0xb3, 0xf4, 0x66, 0x4c, 0x9d, 0x94,
-	0xb6, 0xe9, 0x77, 0xfa, 0x34, 0x49, 0x71, 0x00, 0xea, 0x96, 0xe2, 0x06, 0x97, 0xb7, 0xbf, 0x68,
-	0x15, 0x04, 0xb4, 0x24, 0x01, 0xf9, 0xe0, 0x5b, 0xae, 0x67, 0x45, 0xc7, 0x1d, 0x8b, 0x71, 0xd9,
-	0x52, 0xb7, 0xa7, 0xe4, 0xb2, 0xa5, 0x58, 0x92, 0x5e, 0x2b, 0xeb, 0xf0, 0x79, 0x58, 0xea, 0x47,
-	0x09, 0x8d, 0x53, 0xee, 0x43, 0xbd, 0x2d, 0x7e, 0x91, 0xef, 0xeb, 0x20, 0xdf, 0x8e, 0x3c, 0x05,
-	0xe4, 0xd1, 0xff, 0x11, 0xa4, 0x06, 0x8f, 0xbc, 0xa5, 0xa1, 0xb8, 0x4d, 0xbb, 0xb4, 0x40, 0x31,
-	0x2e, 0x29, 0x06, 0xd4, 0x5c, 0x27, 0x71, 0x1d, 0x8f, 0x0a, 0x7f, 0xe4, 0x4f, 0xf2, 0x6e, 0x15,
-	0xce, 0x2b, 0xaa, 0x0e, 0x86, 0x81, 0x5b, 0xa6, 0x68, 0x6a, 0x76, 0x71, 0x13, 0x96, 0xbc, 0x78,
-	0xd8, 0xee, 0x07, 0x46, 0x95, 0x59, 0x12, 0xf3, 0x42, 0x86, 0x4d, 0x58, 0x8c, 0xe2, 0x7e, 0x40,
-	0xf9, 0xce, 0x93, 0x93, 0x99, 0x08, 0xbb, 0x50, 0x4f, 0x52, 0x56, 0x5f, 0x3a, 0x43, 0x63, 0xb1,
-	0x85, 0xd6, 0x97, 0xb7, 0xf7, 0x4e, 0x11, 0x3b, 0xe6, 0xc9, 0x81, 0x50, 0xd7, 0xce, 0x15, 0xe3,
-	0x14, 0x1a, 0x92, 0xdd, 0x89, 0x51, 0x6b, 0x55, 0xd7, 0x97, 0xb7, 0xf7, 0x4f, 0x69, 0xe5, 0x2b,
-	0x11, 0xab, 0x8a, 0xca, 0xc6, 0x16, 0x6e, 0x15, 0x86, 0x70, 0x13, 0x1a, 0x3d, 0xb1, 0x73, 0x12,
-	0xa3, 0xce, 0x8a, 0x54, 0xbb, 0x10, 0xb0, 0x1a, 0xd8, 0x7c, 0x8a, 0x54, 0x07, 0x11, 0x2d, 0xcd,
-	0x84, 0x07, 0x0b, 0x49, 0x44, 0x5d, 0x5e, 0x10, 0x96, 0xb7, 0xbf, 0x34, 0x1f, 0x96, 0x31, 0xa3,
-	0x02, 0x3d, 0xd7, 0x4e, 0x7a, 0xf0, 0x09, 0x65, 0x7a, 0xdf, 0x49, 0xdd, 0xa3, 0x32, 0x50, 0x2c,
-	0xbd, 0x6c, 0x8d, 0x56, 0xa6, 0x32, 0x11, 0x26, 0xd0, 0xe0, 0x83, 0x87, 0xc3, 0x48, 0xaf, 0x4b,
-	0x85, 0x98, 0xfc, 0x00, 0x81, 0xa9, 0x92, 0x3e, 0xec, 0x76, 0xdf, 0x71, 0xdc, 0xe3, 0x72, 0x93,
-	0x15, 0xdf, 0xe3, 0xf6, 0xaa, 0xbb, 0xc0, 0xf4, 0x9d, 0x3c, 0xb9, 0x58, 0xb9, 0x7b, 0xbb, 0x5d,
-	0xf1, 0xbd, 0x8f, 0xce, 0x45, 0xf2, 0xcf, 0x11, 0x20, 0x22, 0x93, 0x65, 0x40, 0x08, 0x34, 0x82,
-	0xb1, 0x65, 0xba, 0x10, 0x3f, 0x43, 0x79, 0x5e, 0x85, 0xda, 0x20, 0x3f, 0xa4, 0x8a, 0x45, 0x52,
-	0xc8, 0xc0, 0x77, 0xe2, 0xb0, 0x1f, 0x19, 0x8b, 0x6a, 0xa4, 0xb9, 0x08, 0x1b, 0xb0, 0x70, 0xec,
-	0x07, 0x9e, 0xb1, 0xa4, 0x4c, 0x71, 0x09, 0xf9, 0x45, 0x05, 0x2e, 0x8e, 0x71, 0x6b, 0x6a, 0x5e,
-	0x9f, 0x03, 0xdf, 0x0a, 0xee, 0xd5, 0xa6, 0x70, 0xaf, 0x3e, 0x9e, 0x7b, 0xff, 0x45, 0xd0, 0x1a,
-	0x13, 0x9b, 0xe9, 0xc5, 0xf5, 0x39, 0x09, 0xce, 0x61, 0x18, 0xbb, 0xd4, 0xa8, 0xe5, 0x5c, 0x47,
-	0xed, 0x4c, 0x44, 0xfe, 0x83, 0xc0, 0x90, 0xde, 0xde, 0x72, 0xb9, 0xef, 0xfd, 0xe0, 0x79, 0x77,
-	0xb8, 0x09, 0x4b, 0x0e, 0xf7, 0x45, 0xa3, 0x83, 0x90, 0x91, 0x1f, 0x22, 0x58, 0xd1, 0x5d, 0x4e,
-	0xee, 0xf9, 0x49, 0x2a, 0xef, 0x22, 0xd8, 0x87, 0x5a, 0xb6, 0x32, 0x31, 0x10, 0x3f, 0x23, 0xee,
-	0x9e, 0xa2, 0xbe, 0xea, 0x86, 0xa4, 0x7b, 0x42, 0x3f, 0x79, 0x1d, 0x56, 0xc6, 0x16, 0x1a, 0x81,
-	0xa4, 0x05, 0x75, 0x79, 0x50, 0x64, 0x39, 0x90, 0x07, 0xae, 0x94, 0x92, 0xbf, 0x56, 0xf4, 0x1a,
-	0x1d, 0x7a, 0xf7, 0xc2, 0x4e, 0xc9, 0xb5, 0x72, 0x96, 0xec, 0x19, 0x50, 0x8b, 0x42, 0xaf, 0x48,
-	0x5c, 0x5b, 0xfe, 0x64, 0x5f, 0xbb, 0x61, 0x90, 0x3a, 0xac, 0xdb, 0xd0, 0xf2, 0x55, 0x88, 0x59,
-	0xee, 0x13, 0x3f, 0x70, 0xe9, 0x01, 0x75, 0xc3, 0xc0, 0x4b, 0x78, 0xe2, 0xaa, 0x32, 0xf7, 0xea,
-	0x0c, 0x7e, 0x0b, 0x1a, 0xfc, 0xf7, 0x43, 0xbf, 0x47, 0x8d, 0x25, 0x7e, 0xe6, 0x6f, 0x58, 0x59,
-	0x5b, 0x63, 0xa9, 0x6d, 0x4d, 0x11, 0x61, 0xd6, 0xd6, 0x58, 0x83, 0x2d, 0x8b, 0x7d, 0xd1, 0x2e,
-	0x3e, 0x66, 0xb8, 0x52, 0xc7, 0xef, 0xde, 0xf3, 0x03, 0x7e, 0xae, 0x17, 0x06, 0x0b, 0x31, 0xe3,
-	0xc4, 0x61, 0xd8, 0xed, 0x86, 0xdf, 0xe5, 0x25, 0x20, 0x3f, 0x0e, 0x32, 0x19, 0xf9, 0x1e, 0xd4,
-	0xef, 0x85, 0x9d, 0x3b, 0x41, 0x1a, 0x0f, 0x19, 0x27, 0x99, 0x3b, 0x34, 0xd0, 0x83, 0x2e, 0x85,
-	0xf8, 0x01, 0x34, 0x52, 0xbf, 0x47, 0x0f, 0x52, 0xa7, 0x17, 0x89, 0x13, 0xf8, 0x19, 0x70, 0xe7,
-	0xc8, 0xa4, 0x0a, 0x62, 0xc3, 0x27, 0xf3, 0x5b, 0xc4, 0x43, 0x1a, 0xf7, 0xfc, 0xc0, 0x29, 0xad,
-	0x39, 0xa4, 0x09, 0xe6, 0xb8, 0x0f, 0xc4, 0x55, 0xfa, 0x0d, 0x78, 0x41, 0x12, 0x49, 0x10, 0xc1,
-	0x82, 0x17, 0x15, 0x6e, 0x3e, 0xc8, 0xd5, 0x89, 0x4a, 0x30, 0x3a, 0x49, 0x86, 0x60, 0xdc, 0x77,
-	0x02, 0xa7, 0x43, 0xbd, 0x5c, 0x51, 0x4e, 0xc9, 0x6f, 0xc2, 0xa2, 0x9f, 0xd2, 0x9e, 0xdc, 0x1a,
-	0x7b, 0x73, 0xd8, 0x1a, 0xb7, 0xfd, 0xc3, 0xc3, 0x76, 0xa6, 0x75, 0xfb, 0x0f, 0x2b, 0x80, 0xd5,
-	0x2b, 0x09, 0x8d, 0x07, 0xbe, 0x4b, 0xf1, 0x4f, 0x10, 0x2c, 0xb0, 0x3d, 0x8a, 0x2f, 0x68, 0xaa,
-	0x46, 0x7b, 0x47, 0x73, 0x4e, 0x37, 0x21, 0x66, 0x8a, 0x34, 0xdf, 0xfb, 0xc7, 0xbf, 0x7f, 0x56,
-	0x39, 0x8f, 0x5f, 0xe1, 0x7d, 0xf8, 0x60, 0x4b, 0x6d, 0x8b, 0x13, 0xfc, 0x23, 0x04, 0x58, 0x54,
-	0x0d, 0xa5, 0x9f, 0xc3, 0xaf, 0x4e, 0xc2, 0x37, 0xa6, 0xef, 0x33, 0x2f, 0x28, 0xac, 0xb1, 0x58,
-	0xa3, 0xcf, 0x38, 0xc2, 0x17, 0x70, 0x00, 0x1b, 0x1c, 0xc0, 0x65, 0x4c, 0xc6, 0x01, 0xb0, 0x1f,
-	0x31, 0x2a, 0x3c, 0xb6, 0x69, 0x66, 0xf7, 0xb7, 0x08, 0x16, 0xbf, 0xce, 0x4f, 0xbb, 0x29, 0x11,
-	0xda, 0x9f, 0x4f, 0x84, 0xb8, 0x2d, 0x0e, 0x95, 0x5c, 0xe2, 0x30, 0x2f, 0xe0, 0x15, 0x09, 0x33,
-	0x49, 0x63, 0xea, 0xf4, 0x34, 0xb4, 0x37, 0x10, 0xfe, 0x00, 0xc1, 0x52, 0xd6, 0xd6, 0xe1, 0x2b,
-	0x93, 0x20, 0x6a, 0x6d, 0x9f, 0x39, 0xa7, 0xe6, 0x89, 0x5c, 0xe3, 0x00, 0x2f, 0x91, 0xb1, 0x89,
-	0xdc, 0xd1, 0x3a, 0xbf, 0x9f, 0x22, 0xa8, 0xee, 0xd1, 0xa9, 0x34, 0x9b, 0x17, 0xb2, 0xa7, 0x42,
-	0x37, 0x26, 0xc3, 0xf8, 0x6f, 0x08, 0xce, 0x8e, 0x3e, 0x45, 0x60, 0xa2, 0x29, 0x1f, 0xfb, 0x52,
-	0x61, 0x7e, 0xf9, 0x54, 0x7b, 0x53, 0xd7, 0x48, 0x6e, 0x71, 0xa8, 0x9f, 0xc7, 0x9f, 0x2b, 0x23,
-	0xa3, 0xec, 0x03, 0x13, 0xfb, 0x91, 0x1c, 0x3e, 0xe6, 0x6f, 0x51, 0x1c, 0xf3, 0x7b, 0x08, 0xce,
-	0xec, 0xd1, 0x54, 0xbe, 0x22, 0x24, 0x93, 0x79, 0xa0, 0x3d, 0x34, 0x98, 0x4d, 0x4b, 0x79, 0x38,
-	0x92, 0x53, 0x79, 0xb9, 0xdb, 0xe4, 0xc0, 0xae, 0xe2, 0x2b, 0x65, 0xc0, 0xf2, 0x76, 0x0b, 0xff,
-	0x05, 0xc1, 0x52, 0xd6, 0x63, 0x4d, 0x36, 0xaf, 0x35, 0xf6, 0x73, 0x4b, 0xf6, 0x1d, 0x0e, 0xf4,
-	0x75, 0xf3, 0xc6, 0x78, 0xa0, 0xea, 0xf7, 0x32, 0x64, 0x16, 0x47, 0xaf, 0x53, 0xf4, 0x8f, 0x08,
-	0xa0, 0x68, 0x12, 0xf1, 0xb5, 0x72, 0x27, 0x94, 0x46, 0xd2, 0x9c, 0x63, 0x9b, 0x48, 0x2c, 0xee,
-	0xcc, 0xba, 0xd9, 0x2a, 0x8b, 0x3a, 0x6b, 0x22, 0x77, 0x78, 0x2b, 0x89, 0x7f, 0x8d, 0x60, 0x91,
-	0x37, 0x1a, 0xf8, 0xf2, 0x24, 0xc0, 0x6a, 0x1f, 0x32, 0xb7, 0xa0, 0xaf, 0x71, 0x9c, 0xad, 0xed,
-	0xb2, 0x1d, 0xb6, 0x83, 0x36, 0xf0, 0x00, 0x96, 0xb2, 0xbb, 0xfe, 0x64, 0x56, 0x68, 0xbd, 0x80,
-	0xd9, 0x2a, 0x29, 0xf4, 0x19, 0x31, 0xc5, 0xe6, 0xde, 0x28, 0xdd, 0xdc, 0xbf, 0x43, 0xb0, 0x70,
-	0x30, 0x0c, 0x5c, 0x7c, 0x69, 0x92, 0x3e, 0xe5, 0x51, 0x66, 0x6e, 0x51, 0x79, 0x95, 0x43, 0xbb,
-	0x42, 0xca, 0xb3, 0x37, 0x0c, 0x5c, 0x16, 0x9a, 0xf7, 0x11, 0x9c, 0x1d, 0xbd, 0x0e, 0xe0, 0x95,
-	0x91, 0xfa, 0xa3, 0xde, 0x37, 0x4c, 0x3d, 0x84, 0x93, 0xae, 0x12, 0xe4, 0x0d, 0x8e, 0x62, 0x07,
-	0xbf, 0x36, 0x75, 0x43, 0x3c, 0x90, 0x9b, 0x98, 0x29, 0xda, 0x2c, 0x5e, 0x56, 0xfe, 0x84, 0xe0,
-	0x8c, 0xd4, 0xfb, 0x30, 0xa6, 0xb4, 0x1c, 0xd6, 0x9c, 0xf8, 0xcf, 0x0c, 0x91, 0x2f, 0x70, 0xec,
-	0x9f, 0xc1, 0x37, 0x67, 0xc4, 0x2e, 0x31, 0x6f, 0xa6, 0x0c, 0xe6, 0xef, 0x11, 0xd4, 0xe5, 0xf3,
-	0x06, 0xbe, 0x3a, 0x91, 0x49, 0xfa, 0x03, 0xc8, 0xdc, 0xb2, 0x6f, 0x73, 0xec, 0xd7, 0xc8, 0xe5,
-	0xd2, 0x52, 0x2e, 0x8c, 0x33, 0x06, 0xfc, 0x1c, 0x01, 0xce, 0xef, 0x99, 0xf9, 0xcd, 0x13, 0xaf,
-	0x69, 0xa6, 0x26, 0x5e, 0x61, 0xcd, 0xab, 0x53, 0xd7, 0xe9, 0xa5, 0x7c, 0xa3, 0xb4, 0x94, 0x87,
-	0xb9, 0xfd, 0x1f, 0x23, 0x58, 0xde, 0xa3, 0xf9, 0x0d, 0xac, 0x24, 0x90, 0xfa, 0x03, 0x8e, 0xb9,
-	0x3e, 0x7d, 0xa1, 0x40, 0x74, 0x9d, 0x23, 0x5a, 0xc3, 0xe5, 0xa1, 0x92, 0x00, 0x7e, 0x85, 0xe0,
-	0xe3, 0xa2, 0x8a, 0x09, 0xc9, 0xf5, 0x69, 0x96, 0xb4, 0xa2, 0x37, 0x3b, 0xae, 0x4f, 0x71, 0x5c,
-	0x9b, 0x64, 0x26, 0x5c, 0x3b, 0xe2, 0x1d, 0xe4, 0x37, 0x08, 0x5e, 0x56, 0xaf, 0xac, 0xa2, 0xf7,
-	0xfd, 0xa8, 0x71, 0x2b, 0x69, 0xa1, 0xc9, 0x4d, 0x8e, 0xcf, 0xc2, 0xd7, 0x67, 0xc1, 0x67, 0x8b,
-	0x6e, 0x18, 0xff, 0x12, 0xc1, 0x4b, 0xfc, 0xf5, 0x41, 0x55, 0x3c, 0x52, 0x90, 0x27, 0xbd, 0x55,
-	0xcc, 0x50, 0x90, 0xc5, 0x9e, 0x25, 0xcf, 0x04, 0x6a, 0x47, 0xbc, 0x1a, 0xb0, 0x16, 0xe4, 0x05,
-	0x79, 0x04, 0x88, 0xec, 0x6e, 0x4e, 0x0b, 0xdc, 0xb3, 0x1e, 0x19, 0x82, 0x6e, 0x1b, 0xb3, 0xd1,
-	0xed, 0x5d, 0x04, 0x35, 0xd1, 0xf0, 0x97, 0x9c, 0xaa, 0xca, 0x8b, 0x80, 0x79, 0x4e, 0x5b, 0x25,
-	0x1b, 0x5e, 0xf2, 0x59, 0x6e, 0x76, 0x0b, 0xdb, 0x65, 0x66, 0xa3, 0xd0, 0x4b, 0xec, 0x47, 0xe2,
-	0x25, 0xe0, 0xb1, 0xdd, 0x0d, 0x3b, 0xc9, 0x0d, 0xb4, 0xfb, 0xe6, 0x87, 0x27, 0xab, 0xe8, 0xef,
-	0x27, 0xab, 0xe8, 0x5f, 0x27, 0xab, 0xe8, 0x1b, 0x9f, 0x9e, 0xe1, 0xef, 0x45, 0xb7, 0xeb, 0xd3,
-	0x20, 0x55, 0x4d, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x14, 0xec, 0xfd, 0x57, 0x1d, 0x00,
-	0x00,
+	proto.RegisterFile("server/application/application.proto", fileDescriptor_application_35f5a7a9e4aec120)
+}
+
+var fileDescriptor_application_35f5a7a9e4aec120 = []byte{
+	// 2102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xa7, 0x66, 0xc6, 0xf3, 0xf1, 0x9c, 0xec, 0x47, 0xed, 0x6e, 0x68, 0x3a, 0x93, 0x64, 0x54,
+	0x49, 0x1c, 0xc7, 0x89, 0xbb, 0xd7, 0x26, 0xc0, 0x62, 0x90, 0x96, 0x78, 0x1d, 0x1c, 0x43, 0x62,
+	0xcc, 0x38, 0x0b, 0x12, 0x12, 0x42, 0x9d, 0x9e, 0xf2, 0xb8, 0xf1, 0x4c, 0x77, 0xd3, 0xdd, 0x1e,
+	0x34, 0x0a, 0x39, 0xb0, 0xe2, 0xc0, 0x01, 0xb1, 0x20, 0x38, 0xc0, 0x8a, 0x05, 0xb4, 0x27, 0x24,
+	0xb8, 0x21, 0x2e, 0x1c, 0xb8, 0x81, 0xf6, 0xc0, 0x01, 0x09, 0xce, 0x16, 0xb2, 0xf8, 0x1b, 0x38,
+	0xa3, 0xaa, 0xae, 0xea, 0xae, 0x1a, 0xcf, 0xf4, 0x38, 0xeb, 0xe1, 0x90, 0x5b, 0xcd, 0xab, 0xea,
+	0xf7, 0x7e, 0xf5, 0xde, 0xef, 0xbd, 0xaa, 0x7a, 0x36, 0x5c, 0x8b, 0x69, 0x34, 0xa0, 0x91, 0xed,
+	0x84, 0x61, 0xcf, 0x73, 0x9d, 0xc4, 0x0b, 0x7c, 0x75, 0x6c, 0x85, 0x51, 0x90, 0x04, 0x78, 0x5e,
+	0x11, 0x99, 0xaf, 0x76, 0x83, 0x6e, 0xc0, 0xe5, 0x36, 0x1b, 0xa5, 0x4b, 0xcc, 0x66, 0x37, 0x08,
+	0xba, 0x3d, 0x6a, 0x3b, 0xa1, 0x67, 0x3b, 0xbe, 0x1f, 0x24, 0x7c, 0x71, 0x2c, 0x66, 0xc9, 0xc1,
+	0x1b, 0xb1, 0xe5, 0x05, 0x7c, 0xd6, 0x0d, 0x22, 0x6a, 0x0f, 0x56, 0xec, 0x2e, 0xf5, 0x69, 0xe4,
+	0x24, 0xb4, 0x23, 0xd6, 0xdc, 0xc9, 0xd7, 0xf4, 0x1d, 0x77, 0xdf, 0xf3, 0x69, 0x34, 0xb4, 0xc3,
+	0x83, 0x2e, 0x13, 0xc4, 0x76, 0x9f, 0x26, 0xce, 0xb8, 0xaf, 0xb6, 0xba, 0x5e, 0xb2, 0x7f, 0xf8,
+	0xd8, 0x72, 0x83, 0xbe, 0xed, 0x44, 0x1c, 0xd8, 0xb7, 0xf9, 0x60, 0xd9, 0xed, 0xe4, 0x5f, 0xab,
+	0xdb, 0x1b, 0xac, 0x38, 0xbd, 0x70, 0xdf, 0x39, 0xa9, 0x6a, 0xbd, 0x48, 0x55, 0x44, 0xc3, 0x40,
+	0xf8, 0x8a, 0x0f, 0xbd, 0x24, 0x88, 0x86, 0xca, 0x30, 0xd5, 0x41, 0x7e, 0x81, 0xe0, 0xa5, 0xbb,
+	0xb9, 0xb1, 0xaf, 0x1e, 0xd2, 0x68, 0x88, 0x31, 0x54, 0x7c, 0xa7, 0x4f, 0x0d, 0xd4, 0x42, 0x8b,
+	0x8d, 0x36, 0x1f, 0x63, 0x03, 0x6a, 0x11, 0xdd, 0x8b, 0x68, 0xbc, 0x6f, 0x94, 0xb8, 0x58, 0xfe,
+	0xc4, 0x0b, 0x50, 0x63, 0x96, 0xa9, 0x9b, 0x18, 0xe5, 0x56, 0x79, 0xb1, 0xb1, 0x7e, 0xee, 0xf8,
+	0xe8, 0x4a, 0x7d, 0x27, 0x15, 0xc5, 0x6d, 0x39, 0x89, 0x2d, 0x78, 0x31, 0xa2, 0x71, 0x70, 0x18,
+	0xb9, 0xf4, 0x6b, 0x34, 0x8a, 0xbd, 0xc0, 0x37, 0x2a, 0x4c, 0xd3, 0x7a, 0xe5, 0xc3, 0xa3, 0x2b,
+	0x1f, 0x6b, 0x8f, 0x4e, 0x92, 0x4d, 0x78, 0xad, 0x4d, 0x07, 0x1e, 0x1b, 0x3f, 0xa4, 0x89, 0xd3,
+	0x71, 0x12, 0x67, 0x14, 0x5e, 0x29, 0x83, 0x67, 0x42, 0x3d, 0x12, 0x8b, 0x8d, 0x12, 0x97, 0x67,
+	0xbf, 0xc9, 0x9f, 0x11, 0x5c, 0x56, 0xf6, 0xd8, 0x16, 0x76, 0xee, 0x0d, 0xa8, 0x9f, 0xc4, 0x93,
+	0x55, 0xae, 0xc2, 0xcb, 0x12, 0xd2, 0xb6, 0xd3, 0xa7, 0x71, 0xe8, 0xb8, 0x34, 0xd5, 0x2d, 0x10,
+	0x9f, 0x9c, 0xc6, 0x8b, 0x70, 0x4e, 0x15, 0x1a, 0x65, 0x65, 0xb9, 0x36, 0x83, 0x17, 0x60, 0x5e,
+	0xfe, 0x7e, 0x7b, 0x6b, 0xc3, 0xa8, 0x28, 0x0b, 0xd5, 0x09, 0xf2, 0x14, 0x0c, 0x05, 0xfb, 0x43,
+	0xc7, 0xf7, 0xf6, 0x68, 0x9c, 0x4c, 0x46, 0xdd, 0xd2, 0x1c, 0x91, 0xbb, 0x37, 0x93, 0xe2, 0x5b,
+	0xd0, 0xd8, 0xf7, 0x62, 0xc6, 0x81, 0xad, 0x0d, 0xa3, 0xdc, 0x42, 0x8b, 0xe5, 0xf5, 0xf3, 0xc7,
+	0x47, 0x57, 0x1a, 0xf7, 0xa5, 0xb0, 0x9d, 0xcf, 0x93, 0xd7, 0xe0, 0x15, 0xdd, 0x75, 0x61, 0xe0,
+	0xc7, 0x94, 0x7c, 0x80, 0x34, 0x58, 0x6f, 0x45, 0xd4, 0x49, 0x68, 0x9b, 0x7e, 0xe7, 0x90, 0xc6,
+	0x09, 0xf6, 0x41, 0xcd, 0x3f, 0x8e, 0x6e, 0x7e, 0xf5, 0x8b, 0x56, 0xce, 0x56, 0x4b, 0xb2, 0x95,
+	0x0f, 0xbe, 0xe5, 0x76, 0xac, 0xf0, 0xa0, 0x6b, 0x31, 0xe2, 0x5b, 0x6a, 0x2e, 0x4b, 0xe2, 0x5b,
+	0x8a, 0x25, 0xe9, 0x22, 0x65, 0x1d, 0xbe, 0x00, 0xd5, 0xc3, 0x30, 0xa6, 0x51, 0xc2, 0x37, 0x5c,
+	0x6f, 0x8b, 0x5f, 0xe4, 0x07, 0x3a, 0xc8, 0xb7, 0xc3, 0x8e, 0x02, 0x72, 0xff, 0xff, 0x08, 0x52,
+	0x83, 0x47, 0xee, 0x6b, 0x28, 0x36, 0x68, 0x8f, 0xe6, 0x28, 0xc6, 0x45, 0xd0, 0x80, 0x9a, 0xeb,
+	0xc4, 0xae, 0xd3, 0xa1, 0x62, 0x3f, 0xf2, 0x27, 0x79, 0xbf, 0x02, 0x17, 0x14, 0x55, 0xbb, 0x43,
+	0xdf, 0x2d, 0x52, 0x34, 0x9d, 0x0a, 0x4d, 0xa8, 0x76, 0xa2, 0x61, 0xfb, 0xd0, 0xe7, 0x3c, 0xa8,
+	0x8b, 0x79, 0x21, 0xc3, 0x26, 0xcc, 0x85, 0xd1, 0xa1, 0x4f, 0x8d, 0x8a, 0x32, 0x99, 0x8a, 0xb0,
+	0x0b, 0xf5, 0x38, 0x61, 0xc5, 0xa8, 0x3b, 0x34, 0xe6, 0x5a, 0x68, 0x71, 0x7e, 0x75, 0xf3, 0x0c,
+	0xbe, 0x63, 0x3b, 0xd9, 0x15, 0xea, 0xda, 0x99, 0x62, 0x9c, 0x40, 0x43, 0xa6, 0x42, 0x6c, 0xd4,
+	0x5a, 0xe5, 0xc5, 0xf9, 0xd5, 0x9d, 0x33, 0x5a, 0xf9, 0x4a, 0x48, 0xa3, 0x34, 0x46, 0x42, 0xb1,
+	0xd8, 0x56, 0x6e, 0x08, 0x37, 0xa1, 0xd1, 0x17, 0x69, 0x16, 0x1b, 0x75, 0x56, 0xd1, 0xda, 0xb9,
+	0x00, 0x2f, 0xc1, 0xf9, 0x9e, 0xf3, 0x98, 0xf6, 0x76, 0x69, 0x8f, 0xba, 0x49, 0x10, 0x19, 0x0d,
+	0xc5, 0xb3, 0xfa, 0x14, 0xf6, 0xe1, 0x7c, 0x44, 0x93, 0x68, 0x28, 0xb7, 0x66, 0x00, 0xf7, 0xd4,
+	0xfd, 0x33, 0xec, 0xa1, 0xad, 0xea, 0x6b, 0xeb, 0xea, 0x59, 0x31, 0x6f, 0x9e, 0x20, 0xfc, 0x6e,
+	0x48, 0x0b, 0x59, 0xd2, 0x81, 0x4a, 0x1c, 0x52, 0x97, 0x57, 0xb6, 0xf9, 0xd5, 0x2f, 0xcd, 0x26,
+	0x03, 0x98, 0x51, 0xe1, 0x13, 0xae, 0x9d, 0xf4, 0xe1, 0xe3, 0xca, 0xf4, 0x8e, 0x93, 0xb8, 0xfb,
+	0x45, 0xa0, 0x18, 0xf5, 0xd8, 0x1a, 0xad, 0xde, 0xa6, 0x22, 0x4c, 0xa0, 0xc1, 0x07, 0x8f, 0x86,
+	0xa1, 0x5e, 0x60, 0x73, 0x31, 0xf9, 0x1d, 0x02, 0x53, 0x4d, 0xc8, 0xa0, 0xd7, 0x7b, 0xec, 0xb8,
+	0x07, 0xc5, 0x26, 0x4b, 0x5e, 0x87, 0xdb, 0x2b, 0xaf, 0x03, 0xd3, 0x77, 0x7c, 0x74, 0xa5, 0xb4,
+	0xb5, 0xd1, 0x2e, 0x79, 0x9d, 0x33, 0xe4, 0x89, 0x9a, 0x83, 0x73, 0xe3, 0x72, 0x90, 0xfc, 0x6b,
+	0x04, 0xaa, 0xe0, 0x61, 0x11, 0x54, 0x02, 0x0d, 0x7f, 0xec, 0x89, 0xd4, 0xf0, 0x3f, 0xc2, 0x49,
+	0x74, 0x19, 0x6a, 0x83, 0xec, 0x3c, 0xce, 0x17, 0x49, 0x21, 0xdb, 0x5e, 0x37, 0x0a, 0x0e, 0x43,
+	0x63, 0x4e, 0x8d, 0x05, 0x17, 0x61, 0x03, 0x2a, 0x07, 0x9e, 0xdf, 0x31, 0xaa, 0xca, 0x14, 0x97,
+	0x90, 0x5f, 0x96, 0xe0, 0xca, 0x98, 0x6d, 0x4d, 0x8d, 0xfc, 0x73, 0xb0, 0xb7, 0x9c, 0x9d, 0xb5,
+	0x29, 0xec, 0xac, 0x8f, 0x67, 0xe7, 0x7f, 0x11, 0xb4, 0xc6, 0xf8, 0x66, 0xfa, 0xd1, 0xf0, 0x9c,
+	0x38, 0x67, 0x2f, 0x88, 0x5c, 0x6a, 0xd4, 0xb2, 0x6c, 0x40, 0xed, 0x54, 0x44, 0x8e, 0x4a, 0x60,
+	0xc8, 0xdd, 0xde, 0x75, 0xf9, 0xde, 0x0f, 0xfd, 0xe7, 0x7d, 0xc3, 0x4d, 0xa8, 0x3a, 0x7c, 0x2f,
+	0x1a, 0x1d, 0x84, 0x0c, 0xf7, 0xa0, 0x1a, 0x3a, 0x91, 0xd3, 0x4f, 0x8f, 0x92, 0xf9, 0xd5, 0xed,
+	0x33, 0x15, 0x7f, 0xd5, 0x75, 0x3b, 0x4c, 0xad, 0xb4, 0x96, 0xda, 0x20, 0x3f, 0x44, 0x70, 0x51,
+	0x5f, 0x15, 0x3f, 0xf0, 0xe2, 0x44, 0xde, 0xdb, 0xb0, 0x07, 0xb5, 0x14, 0x57, 0x6c, 0x20, 0x0e,
+	0x67, 0x6b, 0x66, 0x70, 0xa4, 0x33, 0x85, 0x7e, 0xf2, 0x26, 0x5c, 0x1c, 0x5b, 0xd6, 0x04, 0x92,
+	0x16, 0xd4, 0xe5, 0xa1, 0x9a, 0x46, 0x5c, 0x16, 0x46, 0x29, 0x25, 0x7f, 0x2d, 0xe9, 0x67, 0x46,
+	0xd0, 0x79, 0x10, 0x74, 0x0b, 0xee, 0xeb, 0xa7, 0xe1, 0x8a, 0x01, 0xb5, 0x30, 0xe8, 0xe4, 0x34,
+	0x69, 0xcb, 0x9f, 0xec, 0x6b, 0x37, 0xf0, 0x13, 0x87, 0x3d, 0xe3, 0x34, 0x76, 0xe4, 0x62, 0xc6,
+	0xb4, 0xd8, 0xf3, 0x5d, 0xba, 0x4b, 0xdd, 0xc0, 0xef, 0xc4, 0x9c, 0x26, 0x65, 0xc9, 0x34, 0x75,
+	0x06, 0xdf, 0x87, 0x06, 0xff, 0xfd, 0xc8, 0xeb, 0x53, 0xa3, 0xca, 0x4f, 0xfd, 0x25, 0x2b, 0x7d,
+	0x2f, 0x5a, 0xea, 0x7b, 0x31, 0xf7, 0x30, 0x7b, 0x2f, 0x5a, 0x83, 0x15, 0x8b, 0x7d, 0xd1, 0xce,
+	0x3f, 0x66, 0xb8, 0x12, 0xc7, 0xeb, 0x3d, 0xf0, 0x7c, 0x7e, 0x07, 0xca, 0x0d, 0xe6, 0x62, 0xc6,
+	0xc0, 0xbd, 0xa0, 0xd7, 0x0b, 0xbe, 0xcb, 0x0b, 0x4e, 0x76, 0x3c, 0xa5, 0x32, 0xf2, 0x93, 0x32,
+	0x34, 0xc7, 0x44, 0xe2, 0x99, 0x9c, 0x89, 0xc6, 0x39, 0x33, 0x4b, 0x97, 0xb2, 0x32, 0x3f, 0x92,
+	0x2e, 0xea, 0x0b, 0x2f, 0x4d, 0x97, 0xd1, 0x74, 0x55, 0x4f, 0x45, 0x3d, 0x5d, 0xb5, 0x90, 0x54,
+	0x55, 0x0c, 0x93, 0x43, 0x52, 0xe3, 0xef, 0x99, 0xa9, 0x21, 0xa9, 0xcf, 0x2c, 0x24, 0x0d, 0xc5,
+	0xe0, 0xd8, 0x90, 0x80, 0x7a, 0x63, 0x10, 0x21, 0x79, 0x0f, 0x41, 0xfd, 0x41, 0xd0, 0xbd, 0xe7,
+	0x27, 0xd1, 0x90, 0x55, 0x25, 0xb6, 0x1f, 0xea, 0xeb, 0x89, 0x20, 0x85, 0x78, 0x1b, 0x1a, 0x89,
+	0xd7, 0xa7, 0xbb, 0x89, 0xd3, 0x0f, 0xc5, 0x2d, 0xed, 0x19, 0x80, 0x67, 0xd0, 0xa4, 0x0a, 0x66,
+	0x2f, 0xcf, 0x81, 0xdc, 0xa9, 0x52, 0x48, 0x6c, 0xf8, 0x44, 0x76, 0x4b, 0x7e, 0x44, 0xa3, 0xbe,
+	0xe7, 0x3b, 0x85, 0xa7, 0x12, 0x69, 0x82, 0x39, 0xee, 0x03, 0xf1, 0x54, 0xfc, 0x3b, 0x82, 0x17,
+	0x24, 0xe7, 0x04, 0xe1, 0x2c, 0x78, 0x51, 0x29, 0x28, 0xdb, 0x99, 0x3e, 0x71, 0x58, 0x8c, 0x4e,
+	0x66, 0x64, 0x2a, 0x9d, 0x20, 0x53, 0x13, 0xaa, 0xfb, 0xd4, 0xe9, 0x25, 0xfb, 0xda, 0x56, 0x84,
+	0x8c, 0x7d, 0xc7, 0xc1, 0x6a, 0x24, 0x94, 0xf7, 0xcb, 0x9e, 0xd7, 0xf7, 0x12, 0x63, 0x4e, 0x09,
+	0x5f, 0x2a, 0x62, 0x3a, 0x83, 0xbd, 0xbd, 0x98, 0x26, 0x46, 0x55, 0x99, 0x14, 0x32, 0x32, 0x04,
+	0xe3, 0xa1, 0xe3, 0x3b, 0x5d, 0xda, 0xc9, 0x36, 0x95, 0xd5, 0xb4, 0x6f, 0xc2, 0x9c, 0x97, 0xd0,
+	0xbe, 0xac, 0xad, 0x9b, 0x33, 0xa8, 0xad, 0x1b, 0xde, 0xde, 0x5e, 0x3b, 0xd5, 0xba, 0xfa, 0xfb,
+	0x26, 0x60, 0xf5, 0x8e, 0x4d, 0xa3, 0x81, 0xe7, 0x52, 0xfc, 0x2e, 0x82, 0x0a, 0x2b, 0xf2, 0xf8,
+	0x92, 0xa6, 0x6a, 0xb4, 0xab, 0x63, 0xce, 0xe8, 0x6a, 0xcf, 0x4c, 0x91, 0xe6, 0x3b, 0xff, 0xfc,
+	0xcf, 0xcf, 0x4a, 0x17, 0xf0, 0xab, 0xbc, 0x43, 0x36, 0x58, 0x51, 0x1b, 0x56, 0x31, 0xfe, 0x11,
+	0x02, 0x2c, 0x8e, 0x1d, 0xa5, 0xd3, 0x82, 0x6f, 0x4d, 0xc2, 0x37, 0xa6, 0x23, 0x63, 0x5e, 0x52,
+	0x28, 0x6e, 0xb9, 0x41, 0x44, 0x19, 0xa1, 0xf9, 0x02, 0x0e, 0x60, 0x89, 0x03, 0xb8, 0x86, 0xc9,
+	0x38, 0x00, 0xf6, 0x13, 0x16, 0xe4, 0xa7, 0x36, 0x4d, 0xed, 0xfe, 0x06, 0xc1, 0xdc, 0xd7, 0xf9,
+	0xe5, 0x6c, 0x8a, 0x87, 0x76, 0x66, 0xe3, 0x21, 0x6e, 0x8b, 0x43, 0x25, 0x57, 0x39, 0xcc, 0x4b,
+	0xf8, 0xa2, 0x84, 0x19, 0x27, 0x11, 0x75, 0xfa, 0x1a, 0xda, 0xd7, 0x11, 0xfe, 0x00, 0x41, 0x35,
+	0xed, 0xa1, 0xe0, 0xeb, 0x93, 0x20, 0x6a, 0x3d, 0x16, 0x73, 0x46, 0x9d, 0x0a, 0x72, 0x93, 0x03,
+	0xbc, 0x4a, 0xc6, 0x06, 0x72, 0x4d, 0x6b, 0xb3, 0xfc, 0x14, 0x41, 0x79, 0x93, 0x4e, 0xa5, 0xd9,
+	0xac, 0x90, 0x9d, 0x70, 0xdd, 0x98, 0x08, 0xe3, 0xbf, 0x21, 0x78, 0x69, 0xb4, 0x49, 0x88, 0x89,
+	0xa6, 0x7c, 0x6c, 0x0f, 0xd1, 0xfc, 0xf2, 0x99, 0x72, 0x53, 0xd7, 0x48, 0xee, 0x72, 0xa8, 0x9f,
+	0xc3, 0x9f, 0x2d, 0x22, 0xa3, 0x7c, 0xf0, 0xc5, 0xf6, 0x13, 0x39, 0x7c, 0x6a, 0xf7, 0x85, 0x0a,
+	0xfc, 0x0e, 0x82, 0x73, 0x9b, 0x34, 0x79, 0x98, 0xf5, 0x19, 0x26, 0xf2, 0x40, 0x6b, 0x01, 0x9a,
+	0x4d, 0x4b, 0x69, 0xe9, 0xca, 0xa9, 0xac, 0xf6, 0x2e, 0x73, 0x60, 0x37, 0xf0, 0xf5, 0x22, 0x60,
+	0x79, 0x6f, 0xe3, 0x2f, 0x08, 0xaa, 0x69, 0xd3, 0x60, 0xb2, 0x79, 0xad, 0x8b, 0x36, 0xb3, 0x60,
+	0xdf, 0xe3, 0x40, 0xdf, 0x34, 0x5f, 0x1f, 0x0f, 0x54, 0xfd, 0x5e, 0xba, 0xcc, 0xe2, 0xe8, 0x75,
+	0x8a, 0xfe, 0x11, 0x01, 0xe4, 0x5d, 0x0f, 0x7c, 0xb3, 0x78, 0x13, 0x4a, 0x67, 0xc4, 0x9c, 0x61,
+	0xdf, 0x83, 0x58, 0x7c, 0x33, 0x8b, 0x66, 0xab, 0xc8, 0xeb, 0x71, 0x48, 0xdd, 0x35, 0xde, 0x1b,
+	0xc1, 0xef, 0x23, 0x98, 0xe3, 0xef, 0x62, 0x7c, 0x6d, 0x12, 0x60, 0xf5, 0xd9, 0x3c, 0x33, 0xa7,
+	0x2f, 0x70, 0x9c, 0xad, 0xd5, 0xa2, 0x0c, 0x5b, 0x43, 0x4b, 0x78, 0x00, 0xd5, 0xf4, 0x69, 0x3a,
+	0x99, 0x15, 0xda, 0xd3, 0xd5, 0x6c, 0x15, 0x14, 0xfa, 0x94, 0x98, 0x22, 0xb9, 0x97, 0x0a, 0x93,
+	0xfb, 0xb7, 0x08, 0x2a, 0xac, 0x67, 0x87, 0xaf, 0x4e, 0xd2, 0xa7, 0x74, 0x40, 0x67, 0xe6, 0x95,
+	0x5b, 0x1c, 0xda, 0x75, 0x52, 0x1c, 0xbd, 0xa1, 0xef, 0x32, 0xd7, 0xb0, 0x3f, 0x9f, 0x8c, 0x5e,
+	0x07, 0xf0, 0xc5, 0x91, 0xfa, 0xa3, 0xde, 0x7d, 0x4c, 0xdd, 0x85, 0x93, 0xae, 0x12, 0xe4, 0x0b,
+	0x1c, 0xc5, 0x1a, 0x7e, 0x63, 0x6a, 0x42, 0x6c, 0xcb, 0x24, 0x66, 0x8a, 0x96, 0xf3, 0x36, 0xe6,
+	0x9f, 0x10, 0x9c, 0x93, 0x7a, 0x1f, 0x45, 0x94, 0x16, 0xc3, 0x9a, 0x11, 0xff, 0x99, 0x21, 0xf2,
+	0x79, 0x8e, 0xfd, 0xd3, 0xf8, 0xce, 0x29, 0xb1, 0x4b, 0xcc, 0xcb, 0x09, 0x83, 0xf9, 0x07, 0x04,
+	0x75, 0xd9, 0xaf, 0xc3, 0x37, 0x26, 0x32, 0x49, 0xef, 0xe8, 0xcd, 0x2c, 0xfa, 0x36, 0xc7, 0x7e,
+	0x93, 0x5c, 0x2b, 0x2c, 0xe5, 0xc2, 0x38, 0x63, 0xc0, 0xcf, 0x11, 0xe0, 0xec, 0xd2, 0x9b, 0x5d,
+	0x83, 0xf1, 0x82, 0x66, 0x6a, 0xe2, 0x7d, 0xda, 0xbc, 0x31, 0x75, 0x9d, 0x5e, 0xca, 0x97, 0x0a,
+	0x4b, 0x79, 0x90, 0xd9, 0xff, 0x31, 0x82, 0xf9, 0x4d, 0x9a, 0xdd, 0xc0, 0x0a, 0x1c, 0xa9, 0xf7,
+	0x1b, 0xcd, 0xc5, 0xe9, 0x0b, 0x05, 0xa2, 0xdb, 0x1c, 0xd1, 0x02, 0x2e, 0x76, 0x95, 0x04, 0xf0,
+	0x2b, 0x04, 0xe7, 0x45, 0x15, 0x13, 0x92, 0xdb, 0xd3, 0x2c, 0x69, 0x45, 0xef, 0xf4, 0xb8, 0x3e,
+	0xc9, 0x71, 0x2d, 0x93, 0x53, 0xe1, 0x5a, 0x13, 0x6d, 0xbb, 0x5f, 0x23, 0x78, 0x45, 0xbd, 0xb2,
+	0x8a, 0xe6, 0xc9, 0x47, 0xf5, 0x5b, 0x41, 0x0f, 0x86, 0xdc, 0xe1, 0xf8, 0x2c, 0x7c, 0xfb, 0x34,
+	0xf8, 0x6c, 0xd1, 0x4e, 0xc1, 0xef, 0x21, 0x78, 0x99, 0x37, 0xcb, 0x54, 0xc5, 0x23, 0x05, 0x79,
+	0x52, 0x6b, 0xed, 0x14, 0x05, 0x59, 0xe4, 0x2c, 0x79, 0x26, 0x50, 0x6b, 0xb2, 0xc9, 0xf5, 0x2e,
+	0x82, 0x17, 0xe4, 0x11, 0x20, 0xa2, 0xbb, 0x3c, 0xcd, 0x71, 0xcf, 0x7a, 0x64, 0x08, 0xba, 0x2d,
+	0x9d, 0x8e, 0x6e, 0xdf, 0x47, 0x50, 0x13, 0x1d, 0xa3, 0x82, 0x53, 0x55, 0x69, 0x29, 0x99, 0xaf,
+	0x69, 0xab, 0xe4, 0xeb, 0x9c, 0x7c, 0x86, 0x9b, 0x5d, 0xc1, 0x76, 0x91, 0xd9, 0x30, 0xe8, 0xc4,
+	0xf6, 0x13, 0xf1, 0x80, 0x7e, 0x6a, 0xf7, 0x82, 0x2e, 0xbb, 0xd5, 0x7f, 0x2f, 0x2f, 0xc0, 0x1c,
+	0xc7, 0xcd, 0x69, 0x2e, 0x99, 0x0a, 0x66, 0x91, 0x83, 0x21, 0xb8, 0xf0, 0x6c, 0x4a, 0xad, 0xaf,
+	0xbf, 0xf5, 0xe1, 0xf1, 0x65, 0xf4, 0x8f, 0xe3, 0xcb, 0xe8, 0xdf, 0xc7, 0x97, 0xd1, 0x37, 0x3e,
+	0x75, 0x8a, 0x7f, 0x3b, 0x70, 0x7b, 0x1e, 0xf5, 0x13, 0x55, 0xe7, 0xff, 0x06, 0x00, 0xa0, 0x03,
+	0x58, 0x80, 0x6f, 0x21, 0x00, 0x00,
 }
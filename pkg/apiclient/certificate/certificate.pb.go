@@ -16,6 +16,7 @@ import math "math"
 import v1alpha1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 import _ "github.com/gogo/protobuf/gogoproto"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
+import v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
@@ -40,7 +41,9 @@ type RepositoryCertificateQuery struct {
 	// The type of the certificate to match (ssh or https)
 	CertType string `protobuf:"bytes,2,opt,name=certType,proto3" json:"certType,omitempty"`
 	// The sub type of the certificate to match (protocol dependent, usually only used for ssh certs)
-	CertSubType          string   `protobuf:"bytes,3,opt,name=certSubType,proto3" json:"certSubType,omitempty"`
+	CertSubType string `protobuf:"bytes,3,opt,name=certSubType,proto3" json:"certSubType,omitempty"`
+	// The SHA256 fingerprint of the certificate to match, with or without the SHA256: prefix
+	Fingerprint          string   `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -50,7 +53,7 @@ func (m *RepositoryCertificateQuery) Reset()         { *m = RepositoryCertificat
 func (m *RepositoryCertificateQuery) String() string { return proto.CompactTextString(m) }
 func (*RepositoryCertificateQuery) ProtoMessage()    {}
 func (*RepositoryCertificateQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certificate_90e5027f94a37a72, []int{0}
+	return fileDescriptor_certificate_2b6ab27e674d9d26, []int{0}
 }
 func (m *RepositoryCertificateQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -100,12 +103,21 @@ func (m *RepositoryCertificateQuery) GetCertSubType() string {
 	return ""
 }
 
+func (m *RepositoryCertificateQuery) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
 // Request to create a set of certificates
 type RepositoryCertificateCreateRequest struct {
 	// List of certificates to be created
 	Certificates *v1alpha1.RepositoryCertificateList `protobuf:"bytes,1,opt,name=certificates" json:"certificates,omitempty"`
 	// Whether to upsert already existing certificates
-	Upsert               bool     `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	Upsert bool `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	// Whether to only validate the request and return the certificates that would be created, without persisting them
+	DryRun               bool     `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -115,7 +127,7 @@ func (m *RepositoryCertificateCreateRequest) Reset()         { *m = RepositoryCe
 func (m *RepositoryCertificateCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepositoryCertificateCreateRequest) ProtoMessage()    {}
 func (*RepositoryCertificateCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certificate_90e5027f94a37a72, []int{1}
+	return fileDescriptor_certificate_2b6ab27e674d9d26, []int{1}
 }
 func (m *RepositoryCertificateCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,6 +170,13 @@ func (m *RepositoryCertificateCreateRequest) GetUpsert() bool {
 	return false
 }
 
+func (m *RepositoryCertificateCreateRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type RepositoryCertificateResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -168,7 +187,7 @@ func (m *RepositoryCertificateResponse) Reset()         { *m = RepositoryCertifi
 func (m *RepositoryCertificateResponse) String() string { return proto.CompactTextString(m) }
 func (*RepositoryCertificateResponse) ProtoMessage()    {}
 func (*RepositoryCertificateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certificate_90e5027f94a37a72, []int{2}
+	return fileDescriptor_certificate_2b6ab27e674d9d26, []int{2}
 }
 func (m *RepositoryCertificateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,10 +216,219 @@ func (m *RepositoryCertificateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RepositoryCertificateResponse proto.InternalMessageInfo
 
+// Number of configured certificates that match a RepositoryCertificateQuery
+type RepositoryCertificateCountResponse struct {
+	// Total number of matching certificates
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Number of matching SSH known hosts entries
+	Ssh int64 `protobuf:"varint,2,opt,name=ssh,proto3" json:"ssh,omitempty"`
+	// Number of matching TLS certificates
+	Https                int64    `protobuf:"varint,3,opt,name=https,proto3" json:"https,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepositoryCertificateCountResponse) Reset()         { *m = RepositoryCertificateCountResponse{} }
+func (m *RepositoryCertificateCountResponse) String() string { return proto.CompactTextString(m) }
+func (*RepositoryCertificateCountResponse) ProtoMessage()    {}
+func (*RepositoryCertificateCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certificate_2b6ab27e674d9d26, []int{3}
+}
+func (m *RepositoryCertificateCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryCertificateCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepositoryCertificateCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepositoryCertificateCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryCertificateCountResponse.Merge(dst, src)
+}
+func (m *RepositoryCertificateCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryCertificateCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryCertificateCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryCertificateCountResponse proto.InternalMessageInfo
+
+func (m *RepositoryCertificateCountResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *RepositoryCertificateCountResponse) GetSsh() int64 {
+	if m != nil {
+		return m.Ssh
+	}
+	return 0
+}
+
+func (m *RepositoryCertificateCountResponse) GetHttps() int64 {
+	if m != nil {
+		return m.Https
+	}
+	return 0
+}
+
+// Request to query the status of the certificate store
+type RepositoryCertificateStoreStatusQuery struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepositoryCertificateStoreStatusQuery) Reset()         { *m = RepositoryCertificateStoreStatusQuery{} }
+func (m *RepositoryCertificateStoreStatusQuery) String() string { return proto.CompactTextString(m) }
+func (*RepositoryCertificateStoreStatusQuery) ProtoMessage()    {}
+func (*RepositoryCertificateStoreStatusQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certificate_2b6ab27e674d9d26, []int{4}
+}
+func (m *RepositoryCertificateStoreStatusQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryCertificateStoreStatusQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepositoryCertificateStoreStatusQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepositoryCertificateStoreStatusQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryCertificateStoreStatusQuery.Merge(dst, src)
+}
+func (m *RepositoryCertificateStoreStatusQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryCertificateStoreStatusQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryCertificateStoreStatusQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryCertificateStoreStatusQuery proto.InternalMessageInfo
+
+// Status of the certificate store
+type RepositoryCertificateStoreStatus struct {
+	// Time the server last reconciled its view of the certificate store with the certificate ConfigMaps
+	LastReconciledAt     *v1.Time `protobuf:"bytes,1,opt,name=lastReconciledAt" json:"lastReconciledAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepositoryCertificateStoreStatus) Reset()         { *m = RepositoryCertificateStoreStatus{} }
+func (m *RepositoryCertificateStoreStatus) String() string { return proto.CompactTextString(m) }
+func (*RepositoryCertificateStoreStatus) ProtoMessage()    {}
+func (*RepositoryCertificateStoreStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certificate_2b6ab27e674d9d26, []int{5}
+}
+func (m *RepositoryCertificateStoreStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryCertificateStoreStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepositoryCertificateStoreStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepositoryCertificateStoreStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryCertificateStoreStatus.Merge(dst, src)
+}
+func (m *RepositoryCertificateStoreStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryCertificateStoreStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryCertificateStoreStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryCertificateStoreStatus proto.InternalMessageInfo
+
+func (m *RepositoryCertificateStoreStatus) GetLastReconciledAt() *v1.Time {
+	if m != nil {
+		return m.LastReconciledAt
+	}
+	return nil
+}
+
+// Request to scan a SSH server for its host keys
+type SSHHostKeyScanRequest struct {
+	// Address of the SSH server to scan, in the form host[:port]
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHHostKeyScanRequest) Reset()         { *m = SSHHostKeyScanRequest{} }
+func (m *SSHHostKeyScanRequest) String() string { return proto.CompactTextString(m) }
+func (*SSHHostKeyScanRequest) ProtoMessage()    {}
+func (*SSHHostKeyScanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certificate_2b6ab27e674d9d26, []int{6}
+}
+func (m *SSHHostKeyScanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHHostKeyScanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHHostKeyScanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SSHHostKeyScanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHHostKeyScanRequest.Merge(dst, src)
+}
+func (m *SSHHostKeyScanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHHostKeyScanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHHostKeyScanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHHostKeyScanRequest proto.InternalMessageInfo
+
+func (m *SSHHostKeyScanRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RepositoryCertificateQuery)(nil), "certificate.RepositoryCertificateQuery")
 	proto.RegisterType((*RepositoryCertificateCreateRequest)(nil), "certificate.RepositoryCertificateCreateRequest")
 	proto.RegisterType((*RepositoryCertificateResponse)(nil), "certificate.RepositoryCertificateResponse")
+	proto.RegisterType((*RepositoryCertificateCountResponse)(nil), "certificate.RepositoryCertificateCountResponse")
+	proto.RegisterType((*RepositoryCertificateStoreStatusQuery)(nil), "certificate.RepositoryCertificateStoreStatusQuery")
+	proto.RegisterType((*RepositoryCertificateStoreStatus)(nil), "certificate.RepositoryCertificateStoreStatus")
+	proto.RegisterType((*SSHHostKeyScanRequest)(nil), "certificate.SSHHostKeyScanRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -216,6 +444,12 @@ const _ = grpc.SupportPackageIsVersion4
 type CertificateServiceClient interface {
 	// List all available repository certificates
 	ListCertificates(ctx context.Context, in *RepositoryCertificateQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
+	// Count all available repository certificates, without returning their data
+	CountCertificates(ctx context.Context, in *RepositoryCertificateQuery, opts ...grpc.CallOption) (*RepositoryCertificateCountResponse, error)
+	// Get the status of the certificate store
+	GetCertificateStoreStatus(ctx context.Context, in *RepositoryCertificateStoreStatusQuery, opts ...grpc.CallOption) (*RepositoryCertificateStoreStatus, error)
+	// Scan a SSH server from the API server for its host keys, without storing them
+	ScanSSHHostKeys(ctx context.Context, in *SSHHostKeyScanRequest, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
 	// Creates repository certificates on the server
 	CreateCertificate(ctx context.Context, in *RepositoryCertificateCreateRequest, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
 	// Delete the certificates that match the RepositoryCertificateQuery
@@ -239,6 +473,33 @@ func (c *certificateServiceClient) ListCertificates(ctx context.Context, in *Rep
 	return out, nil
 }
 
+func (c *certificateServiceClient) CountCertificates(ctx context.Context, in *RepositoryCertificateQuery, opts ...grpc.CallOption) (*RepositoryCertificateCountResponse, error) {
+	out := new(RepositoryCertificateCountResponse)
+	err := c.cc.Invoke(ctx, "/certificate.CertificateService/CountCertificates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateServiceClient) GetCertificateStoreStatus(ctx context.Context, in *RepositoryCertificateStoreStatusQuery, opts ...grpc.CallOption) (*RepositoryCertificateStoreStatus, error) {
+	out := new(RepositoryCertificateStoreStatus)
+	err := c.cc.Invoke(ctx, "/certificate.CertificateService/GetCertificateStoreStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateServiceClient) ScanSSHHostKeys(ctx context.Context, in *SSHHostKeyScanRequest, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error) {
+	out := new(v1alpha1.RepositoryCertificateList)
+	err := c.cc.Invoke(ctx, "/certificate.CertificateService/ScanSSHHostKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certificateServiceClient) CreateCertificate(ctx context.Context, in *RepositoryCertificateCreateRequest, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error) {
 	out := new(v1alpha1.RepositoryCertificateList)
 	err := c.cc.Invoke(ctx, "/certificate.CertificateService/CreateCertificate", in, out, opts...)
@@ -262,6 +523,12 @@ func (c *certificateServiceClient) DeleteCertificate(ctx context.Context, in *Re
 type CertificateServiceServer interface {
 	// List all available repository certificates
 	ListCertificates(context.Context, *RepositoryCertificateQuery) (*v1alpha1.RepositoryCertificateList, error)
+	// Count all available repository certificates, without returning their data
+	CountCertificates(context.Context, *RepositoryCertificateQuery) (*RepositoryCertificateCountResponse, error)
+	// Get the status of the certificate store
+	GetCertificateStoreStatus(context.Context, *RepositoryCertificateStoreStatusQuery) (*RepositoryCertificateStoreStatus, error)
+	// Scan a SSH server from the API server for its host keys, without storing them
+	ScanSSHHostKeys(context.Context, *SSHHostKeyScanRequest) (*v1alpha1.RepositoryCertificateList, error)
 	// Creates repository certificates on the server
 	CreateCertificate(context.Context, *RepositoryCertificateCreateRequest) (*v1alpha1.RepositoryCertificateList, error)
 	// Delete the certificates that match the RepositoryCertificateQuery
@@ -290,6 +557,60 @@ func _CertificateService_ListCertificates_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CertificateService_CountCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryCertificateQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).CountCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/certificate.CertificateService/CountCertificates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).CountCertificates(ctx, req.(*RepositoryCertificateQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CertificateService_GetCertificateStoreStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepositoryCertificateStoreStatusQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).GetCertificateStoreStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/certificate.CertificateService/GetCertificateStoreStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).GetCertificateStoreStatus(ctx, req.(*RepositoryCertificateStoreStatusQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CertificateService_ScanSSHHostKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHHostKeyScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).ScanSSHHostKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/certificate.CertificateService/ScanSSHHostKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).ScanSSHHostKeys(ctx, req.(*SSHHostKeyScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CertificateService_CreateCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepositoryCertificateCreateRequest)
 	if err := dec(in); err != nil {
@@ -334,6 +655,18 @@ var _CertificateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListCertificates",
 			Handler:    _CertificateService_ListCertificates_Handler,
 		},
+		{
+			MethodName: "CountCertificates",
+			Handler:    _CertificateService_CountCertificates_Handler,
+		},
+		{
+			MethodName: "GetCertificateStoreStatus",
+			Handler:    _CertificateService_GetCertificateStoreStatus_Handler,
+		},
+		{
+			MethodName: "ScanSSHHostKeys",
+			Handler:    _CertificateService_ScanSSHHostKeys_Handler,
+		},
 		{
 			MethodName: "CreateCertificate",
 			Handler:    _CertificateService_CreateCertificate_Handler,
@@ -380,6 +713,12 @@ func (m *RepositoryCertificateQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCertificate(dAtA, i, uint64(len(m.CertSubType)))
 		i += copy(dAtA[i:], m.CertSubType)
 	}
+	if len(m.Fingerprint) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCertificate(dAtA, i, uint64(len(m.Fingerprint)))
+		i += copy(dAtA[i:], m.Fingerprint)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -421,6 +760,16 @@ func (m *RepositoryCertificateCreateRequest) MarshalTo(dAtA []byte) (int, error)
 		}
 		i++
 	}
+	if m.DryRun {
+		dAtA[i] = 0x18
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -448,55 +797,230 @@ func (m *RepositoryCertificateResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintCertificate(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RepositoryCertificateCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *RepositoryCertificateQuery) Size() (n int) {
+
+func (m *RepositoryCertificateCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.HostNamePattern)
-	if l > 0 {
-		n += 1 + l + sovCertificate(uint64(l))
+	if m.Total != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCertificate(dAtA, i, uint64(m.Total))
 	}
-	l = len(m.CertType)
-	if l > 0 {
-		n += 1 + l + sovCertificate(uint64(l))
+	if m.Ssh != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCertificate(dAtA, i, uint64(m.Ssh))
 	}
-	l = len(m.CertSubType)
-	if l > 0 {
-		n += 1 + l + sovCertificate(uint64(l))
+	if m.Https != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCertificate(dAtA, i, uint64(m.Https))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RepositoryCertificateCreateRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Certificates != nil {
-		l = m.Certificates.Size()
-		n += 1 + l + sovCertificate(uint64(l))
-	}
-	if m.Upsert {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RepositoryCertificateStoreStatusQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RepositoryCertificateResponse) Size() (n int) {
+func (m *RepositoryCertificateStoreStatusQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepositoryCertificateStoreStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryCertificateStoreStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LastReconciledAt != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCertificate(dAtA, i, uint64(m.LastReconciledAt.Size()))
+		n2, err := m.LastReconciledAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SSHHostKeyScanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHHostKeyScanRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCertificate(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintCertificate(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *RepositoryCertificateQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostNamePattern)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	l = len(m.CertType)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	l = len(m.CertSubType)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepositoryCertificateCreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Certificates != nil {
+		l = m.Certificates.Size()
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepositoryCertificateResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepositoryCertificateCountResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovCertificate(uint64(m.Total))
+	}
+	if m.Ssh != 0 {
+		n += 1 + sovCertificate(uint64(m.Ssh))
+	}
+	if m.Https != 0 {
+		n += 1 + sovCertificate(uint64(m.Https))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepositoryCertificateStoreStatusQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepositoryCertificateStoreStatus) Size() (n int) {
 	var l int
 	_ = l
+	if m.LastReconciledAt != nil {
+		l = m.LastReconciledAt.Size()
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHHostKeyScanRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -632,6 +1156,35 @@ func (m *RepositoryCertificateQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.CertSubType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCertificate(dAtA[iNdEx:])
@@ -736,6 +1289,26 @@ func (m *RepositoryCertificateCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Upsert = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCertificate(dAtA[iNdEx:])
@@ -809,6 +1382,329 @@ func (m *RepositoryCertificateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepositoryCertificateCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCertificate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCertificateCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCertificateCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ssh", wireType)
+			}
+			m.Ssh = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ssh |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Https", wireType)
+			}
+			m.Https = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Https |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCertificate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryCertificateStoreStatusQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCertificate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCertificateStoreStatusQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCertificateStoreStatusQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCertificate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryCertificateStoreStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCertificate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCertificateStoreStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCertificateStoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReconciledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastReconciledAt == nil {
+				m.LastReconciledAt = &v1.Time{}
+			}
+			if err := m.LastReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCertificate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHHostKeyScanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCertificate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHHostKeyScanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHHostKeyScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCertificate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCertificate(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -915,38 +1811,55 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/certificate/certificate.proto", fileDescriptor_certificate_90e5027f94a37a72)
-}
-
-var fileDescriptor_certificate_90e5027f94a37a72 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0x4f, 0x6b, 0x14, 0x31,
-	0x14, 0x27, 0x55, 0x4a, 0x4d, 0x05, 0x6d, 0x28, 0xa5, 0x0c, 0x75, 0x2d, 0x43, 0xc1, 0x52, 0x30,
-	0x61, 0x2b, 0x5e, 0x3c, 0xba, 0x5e, 0x04, 0x11, 0x9d, 0xf6, 0xe4, 0x45, 0xb2, 0xb3, 0xcf, 0x6c,
-	0xec, 0x34, 0x89, 0xc9, 0x9b, 0xc1, 0xbd, 0x8a, 0xdf, 0xc0, 0x0f, 0xa2, 0x27, 0x3f, 0x80, 0x07,
-	0xf1, 0x28, 0xf8, 0x05, 0x64, 0xf1, 0x83, 0xc8, 0x64, 0x5b, 0x9b, 0x91, 0x11, 0xbd, 0x2c, 0x78,
-	0x7b, 0x79, 0x2f, 0xef, 0xfd, 0xfe, 0xbc, 0x84, 0xee, 0x05, 0xf0, 0x0d, 0x78, 0x51, 0x82, 0x47,
-	0xfd, 0x42, 0x97, 0x12, 0x21, 0x8d, 0xb9, 0xf3, 0x16, 0x2d, 0x5b, 0x4f, 0x52, 0xd9, 0xa6, 0xb2,
-	0xca, 0xc6, 0xbc, 0x68, 0xa3, 0xc5, 0x95, 0x6c, 0x47, 0x59, 0xab, 0x2a, 0x10, 0xd2, 0x69, 0x21,
-	0x8d, 0xb1, 0x28, 0x51, 0x5b, 0x13, 0xce, 0xaa, 0x0f, 0x95, 0xc6, 0x69, 0x3d, 0xe6, 0xa5, 0x3d,
-	0x15, 0xd2, 0xc7, 0xf6, 0x97, 0x31, 0xb8, 0x5d, 0x4e, 0x84, 0x3b, 0x51, 0x6d, 0x5b, 0x10, 0xd2,
-	0xb9, 0xaa, 0xc5, 0xd0, 0xd6, 0x88, 0x66, 0x28, 0x2b, 0x37, 0x95, 0x43, 0xa1, 0xc0, 0x80, 0x97,
-	0x08, 0x93, 0xc5, 0xa8, 0xfc, 0x2d, 0xa1, 0x59, 0x01, 0xce, 0x06, 0x8d, 0xd6, 0xcf, 0x46, 0x17,
-	0xc4, 0x9e, 0xd6, 0xe0, 0x67, 0x6c, 0x9f, 0x5e, 0x9b, 0xda, 0x80, 0x8f, 0xe5, 0x29, 0x3c, 0x91,
-	0x88, 0xe0, 0xcd, 0x36, 0xd9, 0x25, 0xfb, 0x57, 0x8a, 0xdf, 0xd3, 0x2c, 0xa3, 0x6b, 0xad, 0xac,
-	0xe3, 0x99, 0x83, 0xed, 0x95, 0x78, 0xe5, 0xd7, 0x99, 0xed, 0xd2, 0x28, 0xf9, 0xa8, 0x1e, 0xc7,
-	0xf2, 0xa5, 0x58, 0x4e, 0x53, 0xf9, 0x47, 0x42, 0xf3, 0x5e, 0x1a, 0x23, 0x0f, 0x12, 0xa1, 0x80,
-	0x57, 0x35, 0x04, 0x64, 0xaf, 0xe9, 0xd5, 0xc4, 0xbb, 0x10, 0xb9, 0xac, 0x1f, 0x1e, 0xf3, 0x0b,
-	0x3f, 0xf8, 0xb9, 0x1f, 0x31, 0x78, 0x5e, 0x4e, 0xb8, 0x3b, 0x51, 0xbc, 0xf5, 0x83, 0x27, 0x7e,
-	0xf0, 0x73, 0x3f, 0x78, 0x2f, 0xe8, 0x23, 0x1d, 0xb0, 0xe8, 0x20, 0xb1, 0x2d, 0xba, 0x5a, 0xbb,
-	0x00, 0x1e, 0xa3, 0xb8, 0xb5, 0xe2, 0xec, 0x94, 0xdf, 0xa4, 0x37, 0x7a, 0x47, 0x14, 0x10, 0x9c,
-	0x35, 0x01, 0x0e, 0x3f, 0x5d, 0xa6, 0x2c, 0xc9, 0x1f, 0x81, 0x6f, 0x74, 0x09, 0xec, 0x3d, 0xa1,
-	0xd7, 0x5b, 0x98, 0x51, 0x0a, 0x72, 0x8b, 0xa7, 0x8f, 0xe5, 0xcf, 0x6b, 0xc9, 0x96, 0xa2, 0x38,
-	0xdf, 0x79, 0xf3, 0xed, 0xc7, 0xbb, 0x95, 0x2d, 0xb6, 0x19, 0x9f, 0x5d, 0x33, 0x14, 0x1d, 0x07,
-	0x3e, 0x13, 0xba, 0xb1, 0xd8, 0x46, 0xd2, 0xc7, 0xc4, 0xdf, 0x29, 0x77, 0x56, 0xb8, 0x24, 0xea,
-	0x07, 0x91, 0xfa, 0x5e, 0xde, 0x4b, 0xfd, 0x5e, 0x77, 0x95, 0x1f, 0x08, 0xdd, 0x78, 0x00, 0x15,
-	0x74, 0x85, 0xfc, 0x1f, 0xde, 0x1f, 0xf4, 0x0a, 0xb8, 0x3f, 0xfa, 0x32, 0x1f, 0x90, 0xaf, 0xf3,
-	0x01, 0xf9, 0x3e, 0x1f, 0x90, 0x67, 0x77, 0xff, 0xe1, 0xfb, 0x97, 0x95, 0x06, 0x83, 0xe9, 0x94,
-	0xf1, 0x6a, 0xfc, 0xf1, 0x77, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x67, 0xe0, 0x7a, 0x1e, 0xa5,
-	0x04, 0x00, 0x00,
+	proto.RegisterFile("server/certificate/certificate.proto", fileDescriptor_certificate_2b6ab27e674d9d26)
+}
+
+var fileDescriptor_certificate_2b6ab27e674d9d26 = []byte{
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xdd, 0x6a, 0x14, 0x4b,
+	0x10, 0x66, 0xb2, 0xe7, 0xe4, 0xec, 0xe9, 0x08, 0x49, 0x9a, 0x18, 0xd6, 0x35, 0x7f, 0x8c, 0x09,
+	0x09, 0x81, 0xf4, 0xb0, 0x51, 0x41, 0xbc, 0xd3, 0x15, 0x8c, 0x28, 0xa2, 0xb3, 0xc1, 0x0b, 0x6f,
+	0xa4, 0x77, 0xb6, 0x32, 0xdb, 0x66, 0xb6, 0x7b, 0xec, 0xae, 0x59, 0x5c, 0x2f, 0x7d, 0x03, 0xf1,
+	0x11, 0x04, 0x6f, 0xfd, 0x79, 0x08, 0xf1, 0x4a, 0x04, 0x5f, 0x40, 0x82, 0x0f, 0x22, 0xdd, 0xbb,
+	0xeb, 0xce, 0x26, 0x13, 0x12, 0x85, 0x80, 0x77, 0x55, 0xd5, 0x35, 0x55, 0x5f, 0x7d, 0xf5, 0x75,
+	0x0f, 0x59, 0x35, 0xa0, 0xbb, 0xa0, 0x83, 0x08, 0x34, 0x8a, 0x3d, 0x11, 0x71, 0x84, 0xbc, 0xcd,
+	0x52, 0xad, 0x50, 0xd1, 0xa9, 0x5c, 0xa8, 0x3a, 0x17, 0xab, 0x58, 0xb9, 0x78, 0x60, 0xad, 0x7e,
+	0x4a, 0x75, 0x21, 0x56, 0x2a, 0x4e, 0x20, 0xe0, 0xa9, 0x08, 0xb8, 0x94, 0x0a, 0x39, 0x0a, 0x25,
+	0xcd, 0xe0, 0xf4, 0xca, 0xfe, 0x35, 0xc3, 0x84, 0xb2, 0xa7, 0x1d, 0x1e, 0xb5, 0x85, 0x04, 0xdd,
+	0x0b, 0xd2, 0xfd, 0xd8, 0x06, 0x4c, 0xd0, 0x01, 0xe4, 0x41, 0xb7, 0x16, 0xc4, 0x20, 0x41, 0x73,
+	0x84, 0xd6, 0xe0, 0xab, 0x3b, 0xb1, 0xc0, 0x76, 0xd6, 0x64, 0x91, 0xea, 0x04, 0x5c, 0xbb, 0xa6,
+	0x4f, 0x9d, 0xb1, 0x15, 0xb5, 0x46, 0x5f, 0xf3, 0x34, 0x4d, 0x2c, 0x32, 0xa1, 0x64, 0xd0, 0xad,
+	0xf1, 0x24, 0x6d, 0xf3, 0x23, 0xa5, 0xfc, 0xb7, 0x1e, 0xa9, 0x86, 0x90, 0x2a, 0x23, 0x50, 0xe9,
+	0x5e, 0x7d, 0x34, 0xce, 0xc3, 0x0c, 0x74, 0x8f, 0x6e, 0x90, 0xe9, 0xb6, 0x32, 0x78, 0x9f, 0x77,
+	0xe0, 0x01, 0x47, 0x04, 0x2d, 0x2b, 0xde, 0x8a, 0xb7, 0xf1, 0x7f, 0x78, 0x38, 0x4c, 0xab, 0xa4,
+	0x6c, 0xc9, 0xd8, 0xed, 0xa5, 0x50, 0x99, 0x70, 0x29, 0xbf, 0x7c, 0xba, 0x42, 0x1c, 0x51, 0x8d,
+	0xac, 0xe9, 0x8e, 0x4b, 0xee, 0x38, 0x1f, 0xb2, 0x19, 0x7b, 0x42, 0xc6, 0xa0, 0x53, 0x2d, 0x24,
+	0x56, 0xfe, 0xe9, 0x67, 0xe4, 0x42, 0xfe, 0x17, 0x8f, 0xf8, 0x85, 0x40, 0xeb, 0x1a, 0x38, 0x42,
+	0x08, 0xcf, 0x32, 0x30, 0x48, 0x9f, 0x93, 0x73, 0xb9, 0x9d, 0x18, 0x87, 0x76, 0x6a, 0x7b, 0x97,
+	0x8d, 0x18, 0x63, 0x43, 0xc6, 0x9c, 0xf1, 0x24, 0x6a, 0xb1, 0x74, 0x3f, 0x66, 0x96, 0x31, 0x96,
+	0x63, 0x8c, 0x0d, 0x19, 0x63, 0x85, 0x4d, 0xef, 0x09, 0x83, 0xe1, 0x58, 0x27, 0x3a, 0x4f, 0x26,
+	0xb3, 0xd4, 0x80, 0x46, 0x37, 0x7e, 0x39, 0x1c, 0x78, 0x36, 0xde, 0xd2, 0xbd, 0x30, 0x93, 0x6e,
+	0xee, 0x72, 0x38, 0xf0, 0xfc, 0x65, 0xb2, 0x58, 0x58, 0x3a, 0x04, 0x93, 0x2a, 0x69, 0xc0, 0x6f,
+	0x1e, 0x37, 0xb0, 0xca, 0x24, 0x0e, 0xb3, 0xe8, 0x1c, 0xf9, 0x17, 0x15, 0xf2, 0xc4, 0x4d, 0x5a,
+	0x0a, 0xfb, 0x0e, 0x9d, 0x21, 0x25, 0x63, 0xda, 0x0e, 0x49, 0x29, 0xb4, 0xa6, 0xcd, 0x6b, 0x23,
+	0xa6, 0xc6, 0xa1, 0x28, 0x85, 0x7d, 0xc7, 0x5f, 0x27, 0x6b, 0x85, 0x3d, 0x1a, 0xa8, 0x34, 0x34,
+	0x90, 0x63, 0x66, 0x9c, 0x10, 0xfc, 0x17, 0x64, 0xe5, 0xa4, 0x44, 0xfa, 0x88, 0xcc, 0x24, 0xdc,
+	0x60, 0x08, 0x91, 0x92, 0x91, 0x48, 0xa0, 0x75, 0x03, 0x07, 0xfc, 0x6f, 0xb2, 0xbe, 0xce, 0x59,
+	0x5e, 0xe7, 0x23, 0xde, 0xad, 0xce, 0x59, 0xb7, 0xc6, 0x76, 0x45, 0x07, 0xc2, 0x23, 0x35, 0xfc,
+	0x1a, 0x39, 0xdf, 0x68, 0xec, 0xec, 0x28, 0x83, 0x77, 0xa1, 0xd7, 0x88, 0xb8, 0x1c, 0x2e, 0xbb,
+	0x42, 0xfe, 0xe3, 0xad, 0x96, 0x06, 0x63, 0x06, 0xaa, 0x1c, 0xba, 0xdb, 0x1f, 0xca, 0x84, 0xe6,
+	0x51, 0x82, 0xee, 0x8a, 0x08, 0xe8, 0x3b, 0x8f, 0xcc, 0xd8, 0xd5, 0xd5, 0xf3, 0x8b, 0x5b, 0x67,
+	0xf9, 0x8b, 0x7d, 0xfc, 0x65, 0xa8, 0x9e, 0x89, 0x8a, 0xfc, 0x85, 0x97, 0xdf, 0x7e, 0xbc, 0x9e,
+	0x98, 0xa7, 0x73, 0xee, 0x89, 0xe8, 0xd6, 0x82, 0x31, 0x55, 0xbd, 0xf2, 0xc8, 0xac, 0x5b, 0xf8,
+	0x9f, 0x41, 0x0e, 0x4e, 0x4e, 0x1c, 0x93, 0x93, 0xef, 0x3b, 0x34, 0x0b, 0xb4, 0x5a, 0x84, 0x26,
+	0x88, 0x6c, 0x2e, 0x7d, 0xe3, 0x91, 0x0b, 0xb7, 0x01, 0x8f, 0x51, 0xc1, 0xf6, 0xc9, 0x2d, 0x0f,
+	0xab, 0xab, 0xba, 0xf5, 0x5b, 0xdf, 0xf8, 0x97, 0x1c, 0xc8, 0x45, 0x7a, 0xb1, 0x10, 0xa4, 0xe9,
+	0xe3, 0xf8, 0xe8, 0x91, 0x69, 0x2b, 0x96, 0x91, 0x74, 0x0c, 0xf5, 0xc7, 0xfa, 0x14, 0x8a, 0xea,
+	0x8c, 0xb6, 0xbc, 0xe6, 0x20, 0x2f, 0xd3, 0xc5, 0x62, 0xc8, 0xa6, 0x1d, 0x98, 0x88, 0x4b, 0xfa,
+	0xc9, 0xae, 0xdb, 0x3d, 0x68, 0xb9, 0x02, 0xf4, 0x34, 0x5b, 0xcc, 0xbf, 0x82, 0x67, 0x34, 0xc3,
+	0xa6, 0x9b, 0x61, 0xd5, 0x2f, 0x54, 0xea, 0xf5, 0xf1, 0xd7, 0xf0, 0xbd, 0x47, 0x66, 0x6f, 0x41,
+	0x02, 0xe3, 0x83, 0xfc, 0x1d, 0x57, 0x6d, 0xb3, 0x70, 0x80, 0x9b, 0xf5, 0xcf, 0x07, 0x4b, 0xde,
+	0xd7, 0x83, 0x25, 0xef, 0xfb, 0xc1, 0x92, 0xf7, 0xf8, 0xea, 0x29, 0xfe, 0xb1, 0x51, 0x22, 0x40,
+	0x62, 0xbe, 0x4a, 0x73, 0xd2, 0xfd, 0x56, 0x2f, 0xff, 0x1c, 0x00, 0xe5, 0xe2, 0x0c, 0xbf, 0x40,
+	0x08, 0x00, 0x00,
 }
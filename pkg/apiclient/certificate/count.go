@@ -0,0 +1,20 @@
+package certificate
+
+import (
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// CountCertificates counts the given certificates by their type, as reported by CountCertificates of the server
+func CountCertificates(certs []v1alpha1.RepositoryCertificate) *RepositoryCertificateCountResponse {
+	counts := &RepositoryCertificateCountResponse{}
+	for _, c := range certs {
+		switch c.CertType {
+		case "ssh":
+			counts.Ssh++
+		case "https":
+			counts.Https++
+		}
+		counts.Total++
+	}
+	return counts
+}
@@ -0,0 +1,888 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: server/gpgkey/gpgkey.proto
+
+package gpgkey // import "github.com/argoproj/argo-cd/pkg/apiclient/gpgkey"
+
+/*
+	GnuPG public key service
+
+	GnuPG public key API performs CRUD actions against the GnuPG public keys,
+	which the signatures of revisions are verified with.
+*/
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import v1alpha1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+import _ "github.com/gogo/protobuf/gogoproto"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Message to query the server for configured GnuPG public keys
+type GnuPGPublicKeyQuery struct {
+	// The key ID to query for, in long format of 16 hexadecimal digits
+	KeyID                string   `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GnuPGPublicKeyQuery) Reset()         { *m = GnuPGPublicKeyQuery{} }
+func (m *GnuPGPublicKeyQuery) String() string { return proto.CompactTextString(m) }
+func (*GnuPGPublicKeyQuery) ProtoMessage()    {}
+func (*GnuPGPublicKeyQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gpgkey_d8ba288603a21852, []int{0}
+}
+func (m *GnuPGPublicKeyQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GnuPGPublicKeyQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GnuPGPublicKeyQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GnuPGPublicKeyQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GnuPGPublicKeyQuery.Merge(dst, src)
+}
+func (m *GnuPGPublicKeyQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GnuPGPublicKeyQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GnuPGPublicKeyQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GnuPGPublicKeyQuery proto.InternalMessageInfo
+
+func (m *GnuPGPublicKeyQuery) GetKeyID() string {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+// Request to create one or more GnuPG public keys
+type GnuPGPublicKeyCreateRequest struct {
+	// Raw key data of the GPG key(s) to create
+	Publickey *v1alpha1.GnuPGPublicKey `protobuf:"bytes,1,opt,name=publickey" json:"publickey,omitempty"`
+	// Whether to upsert already existing public keys
+	Upsert               bool     `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GnuPGPublicKeyCreateRequest) Reset()         { *m = GnuPGPublicKeyCreateRequest{} }
+func (m *GnuPGPublicKeyCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*GnuPGPublicKeyCreateRequest) ProtoMessage()    {}
+func (*GnuPGPublicKeyCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gpgkey_d8ba288603a21852, []int{1}
+}
+func (m *GnuPGPublicKeyCreateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GnuPGPublicKeyCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GnuPGPublicKeyCreateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GnuPGPublicKeyCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GnuPGPublicKeyCreateRequest.Merge(dst, src)
+}
+func (m *GnuPGPublicKeyCreateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GnuPGPublicKeyCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GnuPGPublicKeyCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GnuPGPublicKeyCreateRequest proto.InternalMessageInfo
+
+func (m *GnuPGPublicKeyCreateRequest) GetPublickey() *v1alpha1.GnuPGPublicKey {
+	if m != nil {
+		return m.Publickey
+	}
+	return nil
+}
+
+func (m *GnuPGPublicKeyCreateRequest) GetUpsert() bool {
+	if m != nil {
+		return m.Upsert
+	}
+	return false
+}
+
+type GnuPGPublicKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GnuPGPublicKeyResponse) Reset()         { *m = GnuPGPublicKeyResponse{} }
+func (m *GnuPGPublicKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GnuPGPublicKeyResponse) ProtoMessage()    {}
+func (*GnuPGPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gpgkey_d8ba288603a21852, []int{2}
+}
+func (m *GnuPGPublicKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GnuPGPublicKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GnuPGPublicKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GnuPGPublicKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GnuPGPublicKeyResponse.Merge(dst, src)
+}
+func (m *GnuPGPublicKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GnuPGPublicKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GnuPGPublicKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GnuPGPublicKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GnuPGPublicKeyQuery)(nil), "gpgkey.GnuPGPublicKeyQuery")
+	proto.RegisterType((*GnuPGPublicKeyCreateRequest)(nil), "gpgkey.GnuPGPublicKeyCreateRequest")
+	proto.RegisterType((*GnuPGPublicKeyResponse)(nil), "gpgkey.GnuPGPublicKeyResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for GPGKeyService service
+
+type GPGKeyServiceClient interface {
+	// List all configured GPG public keys
+	List(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKeyList, error)
+	// Get information about specified GPG public key from the server
+	Get(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKey, error)
+	// Create one or more GPG public keys in the server's configuration
+	Create(ctx context.Context, in *GnuPGPublicKeyCreateRequest, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKeyList, error)
+	// Delete specified GPG public key from the server's configuration
+	Delete(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*GnuPGPublicKeyResponse, error)
+}
+
+type gPGKeyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGPGKeyServiceClient(cc *grpc.ClientConn) GPGKeyServiceClient {
+	return &gPGKeyServiceClient{cc}
+}
+
+func (c *gPGKeyServiceClient) List(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKeyList, error) {
+	out := new(v1alpha1.GnuPGPublicKeyList)
+	err := c.cc.Invoke(ctx, "/gpgkey.GPGKeyService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPGKeyServiceClient) Get(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKey, error) {
+	out := new(v1alpha1.GnuPGPublicKey)
+	err := c.cc.Invoke(ctx, "/gpgkey.GPGKeyService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPGKeyServiceClient) Create(ctx context.Context, in *GnuPGPublicKeyCreateRequest, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKeyList, error) {
+	out := new(v1alpha1.GnuPGPublicKeyList)
+	err := c.cc.Invoke(ctx, "/gpgkey.GPGKeyService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPGKeyServiceClient) Delete(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*GnuPGPublicKeyResponse, error) {
+	out := new(GnuPGPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/gpgkey.GPGKeyService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for GPGKeyService service
+
+type GPGKeyServiceServer interface {
+	// List all configured GPG public keys
+	List(context.Context, *GnuPGPublicKeyQuery) (*v1alpha1.GnuPGPublicKeyList, error)
+	// Get information about specified GPG public key from the server
+	Get(context.Context, *GnuPGPublicKeyQuery) (*v1alpha1.GnuPGPublicKey, error)
+	// Create one or more GPG public keys in the server's configuration
+	Create(context.Context, *GnuPGPublicKeyCreateRequest) (*v1alpha1.GnuPGPublicKeyList, error)
+	// Delete specified GPG public key from the server's configuration
+	Delete(context.Context, *GnuPGPublicKeyQuery) (*GnuPGPublicKeyResponse, error)
+}
+
+func RegisterGPGKeyServiceServer(s *grpc.Server, srv GPGKeyServiceServer) {
+	s.RegisterService(&_GPGKeyService_serviceDesc, srv)
+}
+
+func _GPGKeyService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GnuPGPublicKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPGKeyServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpgkey.GPGKeyService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPGKeyServiceServer).List(ctx, req.(*GnuPGPublicKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPGKeyService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GnuPGPublicKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPGKeyServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpgkey.GPGKeyService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPGKeyServiceServer).Get(ctx, req.(*GnuPGPublicKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPGKeyService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GnuPGPublicKeyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPGKeyServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpgkey.GPGKeyService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPGKeyServiceServer).Create(ctx, req.(*GnuPGPublicKeyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPGKeyService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GnuPGPublicKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPGKeyServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpgkey.GPGKeyService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPGKeyServiceServer).Delete(ctx, req.(*GnuPGPublicKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GPGKeyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gpgkey.GPGKeyService",
+	HandlerType: (*GPGKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _GPGKeyService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _GPGKeyService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _GPGKeyService_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GPGKeyService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/gpgkey/gpgkey.proto",
+}
+
+func (m *GnuPGPublicKeyQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GnuPGPublicKeyQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGpgkey(dAtA, i, uint64(len(m.KeyID)))
+		i += copy(dAtA[i:], m.KeyID)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GnuPGPublicKeyCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GnuPGPublicKeyCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Publickey != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGpgkey(dAtA, i, uint64(m.Publickey.Size()))
+		n1, err := m.Publickey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Upsert {
+		dAtA[i] = 0x10
+		i++
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GnuPGPublicKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GnuPGPublicKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintGpgkey(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GnuPGPublicKeyQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovGpgkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GnuPGPublicKeyCreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Publickey != nil {
+		l = m.Publickey.Size()
+		n += 1 + l + sovGpgkey(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GnuPGPublicKeyResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovGpgkey(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozGpgkey(x uint64) (n int) {
+	return sovGpgkey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GnuPGPublicKeyQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpgkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GnuPGPublicKeyQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GnuPGPublicKeyQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpgkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpgkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpgkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGpgkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GnuPGPublicKeyCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpgkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GnuPGPublicKeyCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GnuPGPublicKeyCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publickey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpgkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGpgkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Publickey == nil {
+				m.Publickey = &v1alpha1.GnuPGPublicKey{}
+			}
+			if err := m.Publickey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpgkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpgkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGpgkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GnuPGPublicKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpgkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GnuPGPublicKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GnuPGPublicKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpgkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGpgkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGpgkey(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGpgkey
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGpgkey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGpgkey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthGpgkey
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowGpgkey
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipGpgkey(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthGpgkey = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGpgkey   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("server/gpgkey/gpgkey.proto", fileDescriptor_gpgkey_d8ba288603a21852) }
+
+var fileDescriptor_gpgkey_d8ba288603a21852 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x99, 0x5a, 0x83, 0x1d, 0x11, 0x71, 0x2c, 0xed, 0xba, 0x2d, 0x6b, 0x89, 0x97, 0xa2,
+	0x38, 0xe3, 0xd6, 0x9b, 0x07, 0x0f, 0x5a, 0x08, 0x4b, 0x15, 0xd6, 0x78, 0xf3, 0xa0, 0xcc, 0x66,
+	0x1f, 0xd3, 0x31, 0x31, 0x33, 0xce, 0x4c, 0x02, 0x41, 0xbc, 0x78, 0x15, 0xbc, 0x78, 0x17, 0xbf,
+	0x82, 0xdf, 0xc2, 0xa3, 0xe0, 0x17, 0x90, 0xc5, 0x0f, 0x22, 0x99, 0x24, 0xb4, 0x1b, 0x16, 0xf5,
+	0xb0, 0xa7, 0x7d, 0x6f, 0x5f, 0xde, 0x7b, 0x3f, 0xfe, 0xff, 0x37, 0x78, 0x68, 0xc1, 0x94, 0x60,
+	0x98, 0xd0, 0x22, 0x85, 0xaa, 0xfd, 0xa1, 0xda, 0x28, 0xa7, 0x48, 0xd0, 0x64, 0xc3, 0x6d, 0xa1,
+	0x84, 0xf2, 0x7f, 0xb1, 0x3a, 0x6a, 0xaa, 0xc3, 0x7d, 0xa1, 0x94, 0xc8, 0x80, 0x71, 0x2d, 0x19,
+	0xcf, 0x73, 0xe5, 0xb8, 0x93, 0x2a, 0xb7, 0x6d, 0x75, 0x22, 0xa4, 0x3b, 0x2d, 0x66, 0x34, 0x51,
+	0x6f, 0x18, 0x37, 0xbe, 0xfd, 0xb5, 0x0f, 0xee, 0x26, 0x73, 0xa6, 0x53, 0x51, 0xb7, 0x59, 0xc6,
+	0xb5, 0xce, 0x64, 0xe2, 0x1b, 0x59, 0x39, 0xe6, 0x99, 0x3e, 0xe5, 0x63, 0x26, 0x20, 0x07, 0xc3,
+	0x1d, 0xcc, 0x9b, 0x51, 0xe1, 0x1d, 0x7c, 0x3d, 0xca, 0x8b, 0x69, 0x34, 0x2d, 0x66, 0x99, 0x4c,
+	0x4e, 0xa0, 0x7a, 0x56, 0x80, 0xa9, 0xc8, 0x36, 0xbe, 0x98, 0x42, 0x35, 0x39, 0x1e, 0xa0, 0x03,
+	0x74, 0xb8, 0x15, 0x37, 0x49, 0xf8, 0x05, 0xe1, 0xbd, 0xe5, 0xaf, 0x1f, 0x1b, 0xe0, 0x0e, 0x62,
+	0x78, 0x5b, 0x80, 0x75, 0x44, 0xe0, 0x2d, 0xed, 0x2b, 0x29, 0x54, 0xbe, 0xf3, 0xf2, 0xd1, 0x84,
+	0x9e, 0xb1, 0xd2, 0x8e, 0xd5, 0x07, 0xaf, 0x92, 0x39, 0xd5, 0xa9, 0xa0, 0x35, 0x2b, 0x3d, 0xc7,
+	0x4a, 0x3b, 0x56, 0xba, 0xbc, 0x2a, 0x3e, 0x9b, 0x4d, 0x76, 0x70, 0x50, 0x68, 0x0b, 0xc6, 0x0d,
+	0x36, 0x0e, 0xd0, 0xe1, 0xa5, 0xb8, 0xcd, 0xc2, 0x01, 0xde, 0xe9, 0x35, 0x81, 0xd5, 0x2a, 0xb7,
+	0x70, 0xf4, 0x6d, 0x13, 0x5f, 0x89, 0xa6, 0xd1, 0x09, 0x54, 0xcf, 0xc1, 0x94, 0x32, 0x01, 0xf2,
+	0x11, 0xe1, 0xcd, 0x27, 0xd2, 0x3a, 0xb2, 0x47, 0x5b, 0x63, 0x56, 0x08, 0x31, 0x7c, 0xba, 0x36,
+	0xfe, 0x7a, 0x57, 0xb8, 0xfb, 0xe1, 0xe7, 0xef, 0xcf, 0x1b, 0xd7, 0xc8, 0x55, 0xef, 0x6c, 0x39,
+	0x6e, 0x6f, 0xc2, 0x92, 0x4f, 0x08, 0x5f, 0x88, 0xe0, 0x1f, 0x30, 0xeb, 0x13, 0x33, 0xbc, 0xe9,
+	0x41, 0x6e, 0x90, 0xdd, 0x1e, 0x08, 0x7b, 0xe7, 0xad, 0x7e, 0x4f, 0xbe, 0x22, 0x1c, 0x34, 0xee,
+	0x92, 0x5b, 0xab, 0x99, 0x96, 0xbc, 0x5f, 0xb7, 0x50, 0xa1, 0xe7, 0xdb, 0x0f, 0xfb, 0x42, 0x3d,
+	0x38, 0x77, 0x05, 0x2f, 0x71, 0x70, 0x0c, 0x19, 0x38, 0xf8, 0xbb, 0x6a, 0xa3, 0xd5, 0xc5, 0xee,
+	0x34, 0x3a, 0x4f, 0x6e, 0xf7, 0x57, 0x3d, 0x7a, 0xf8, 0x7d, 0x31, 0x42, 0x3f, 0x16, 0x23, 0xf4,
+	0x6b, 0x31, 0x42, 0x2f, 0xee, 0xfd, 0xc7, 0xa3, 0x4b, 0x32, 0x09, 0xb9, 0x6b, 0x07, 0xcc, 0x02,
+	0xff, 0xc4, 0xee, 0xff, 0x19, 0x00, 0x12, 0x47, 0x1d, 0x5a, 0x07, 0x04, 0x00, 0x00,
+}
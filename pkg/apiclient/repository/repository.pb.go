@@ -47,7 +47,7 @@ func (m *RepoAppsQuery) Reset()         { *m = RepoAppsQuery{} }
 func (m *RepoAppsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAppsQuery) ProtoMessage()    {}
 func (*RepoAppsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{0}
+	return fileDescriptor_repository_e14309eaad990a26, []int{0}
 }
 func (m *RepoAppsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -103,7 +103,7 @@ func (m *AppInfo) Reset()         { *m = AppInfo{} }
 func (m *AppInfo) String() string { return proto.CompactTextString(m) }
 func (*AppInfo) ProtoMessage()    {}
 func (*AppInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{1}
+	return fileDescriptor_repository_e14309eaad990a26, []int{1}
 }
 func (m *AppInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +162,7 @@ func (m *RepoAppDetailsQuery) Reset()         { *m = RepoAppDetailsQuery{} }
 func (m *RepoAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsQuery) ProtoMessage()    {}
 func (*RepoAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{2}
+	return fileDescriptor_repository_e14309eaad990a26, []int{2}
 }
 func (m *RepoAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +238,7 @@ func (m *RepoAppsResponse) Reset()         { *m = RepoAppsResponse{} }
 func (m *RepoAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppsResponse) ProtoMessage()    {}
 func (*RepoAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{3}
+	return fileDescriptor_repository_e14309eaad990a26, []int{3}
 }
 func (m *RepoAppsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +286,7 @@ func (m *RepoQuery) Reset()         { *m = RepoQuery{} }
 func (m *RepoQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoQuery) ProtoMessage()    {}
 func (*RepoQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{4}
+	return fileDescriptor_repository_e14309eaad990a26, []int{4}
 }
 func (m *RepoQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +337,13 @@ type RepoAccessQuery struct {
 	// TLS client cert data for accessing HTTPS repository
 	TlsClientCertData string `protobuf:"bytes,6,opt,name=tlsClientCertData,proto3" json:"tlsClientCertData,omitempty"`
 	// TLS client cert key for accessing HTTPS repository
-	TlsClientCertKey     string   `protobuf:"bytes,7,opt,name=tlsClientCertKey,proto3" json:"tlsClientCertKey,omitempty"`
+	TlsClientCertKey string `protobuf:"bytes,7,opt,name=tlsClientCertKey,proto3" json:"tlsClientCertKey,omitempty"`
+	// The type of the repo
+	Type string `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	// The name of the repo
+	Name string `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	// The HTTP(S) proxy used to access the repo
+	Proxy                string   `protobuf:"bytes,10,opt,name=proxy,proto3" json:"proxy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -347,7 +353,7 @@ func (m *RepoAccessQuery) Reset()         { *m = RepoAccessQuery{} }
 func (m *RepoAccessQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAccessQuery) ProtoMessage()    {}
 func (*RepoAccessQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{5}
+	return fileDescriptor_repository_e14309eaad990a26, []int{5}
 }
 func (m *RepoAccessQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,6 +431,27 @@ func (m *RepoAccessQuery) GetTlsClientCertKey() string {
 	return ""
 }
 
+func (m *RepoAccessQuery) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RepoAccessQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RepoAccessQuery) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
 type RepoResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -435,7 +462,7 @@ func (m *RepoResponse) Reset()         { *m = RepoResponse{} }
 func (m *RepoResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoResponse) ProtoMessage()    {}
 func (*RepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{6}
+	return fileDescriptor_repository_e14309eaad990a26, []int{6}
 }
 func (m *RepoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,6 +491,130 @@ func (m *RepoResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RepoResponse proto.InternalMessageInfo
 
+// RepoConnectionCheck is the result of a single stage of checking the connection to a repo
+type RepoConnectionCheck struct {
+	// The stage of the check, one of DNS, TCP, TLS or Auth
+	Stage string `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	// Whether the stage succeeded
+	Successful bool `protobuf:"varint,2,opt,name=successful,proto3" json:"successful,omitempty"`
+	// Whether the stage was skipped, because it does not apply to the repo or a previous stage failed
+	Skipped bool `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// Details of the result of the stage
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoConnectionCheck) Reset()         { *m = RepoConnectionCheck{} }
+func (m *RepoConnectionCheck) String() string { return proto.CompactTextString(m) }
+func (*RepoConnectionCheck) ProtoMessage()    {}
+func (*RepoConnectionCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_e14309eaad990a26, []int{7}
+}
+func (m *RepoConnectionCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoConnectionCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoConnectionCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepoConnectionCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoConnectionCheck.Merge(dst, src)
+}
+func (m *RepoConnectionCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoConnectionCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoConnectionCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoConnectionCheck proto.InternalMessageInfo
+
+func (m *RepoConnectionCheck) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *RepoConnectionCheck) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+func (m *RepoConnectionCheck) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *RepoConnectionCheck) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// RepoConnectionDiagnostics are the results of checking the connection to a repo stage by stage
+type RepoConnectionDiagnostics struct {
+	Checks               []*RepoConnectionCheck `protobuf:"bytes,1,rep,name=checks" json:"checks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *RepoConnectionDiagnostics) Reset()         { *m = RepoConnectionDiagnostics{} }
+func (m *RepoConnectionDiagnostics) String() string { return proto.CompactTextString(m) }
+func (*RepoConnectionDiagnostics) ProtoMessage()    {}
+func (*RepoConnectionDiagnostics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_e14309eaad990a26, []int{8}
+}
+func (m *RepoConnectionDiagnostics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoConnectionDiagnostics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoConnectionDiagnostics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RepoConnectionDiagnostics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoConnectionDiagnostics.Merge(dst, src)
+}
+func (m *RepoConnectionDiagnostics) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoConnectionDiagnostics) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoConnectionDiagnostics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoConnectionDiagnostics proto.InternalMessageInfo
+
+func (m *RepoConnectionDiagnostics) GetChecks() []*RepoConnectionCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
 type RepoCreateRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
 	Upsert               bool                 `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
@@ -476,7 +627,7 @@ func (m *RepoCreateRequest) Reset()         { *m = RepoCreateRequest{} }
 func (m *RepoCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoCreateRequest) ProtoMessage()    {}
 func (*RepoCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{7}
+	return fileDescriptor_repository_e14309eaad990a26, []int{9}
 }
 func (m *RepoCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +681,7 @@ func (m *RepoUpdateRequest) Reset()         { *m = RepoUpdateRequest{} }
 func (m *RepoUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoUpdateRequest) ProtoMessage()    {}
 func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_3b37238e26fe0d6f, []int{8}
+	return fileDescriptor_repository_e14309eaad990a26, []int{10}
 }
 func (m *RepoUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,6 +725,8 @@ func init() {
 	proto.RegisterType((*RepoQuery)(nil), "repository.RepoQuery")
 	proto.RegisterType((*RepoAccessQuery)(nil), "repository.RepoAccessQuery")
 	proto.RegisterType((*RepoResponse)(nil), "repository.RepoResponse")
+	proto.RegisterType((*RepoConnectionCheck)(nil), "repository.RepoConnectionCheck")
+	proto.RegisterType((*RepoConnectionDiagnostics)(nil), "repository.RepoConnectionDiagnostics")
 	proto.RegisterType((*RepoCreateRequest)(nil), "repository.RepoCreateRequest")
 	proto.RegisterType((*RepoUpdateRequest)(nil), "repository.RepoUpdateRequest")
 }
@@ -591,6 +744,10 @@ const _ = grpc.SupportPackageIsVersion4
 type RepositoryServiceClient interface {
 	// List returns list of repos
 	List(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
+	// Get returns a repo
+	Get(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
+	// GetConnectionDiagnostics checks the connection to a repo stage by stage
+	GetConnectionDiagnostics(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoConnectionDiagnostics, error)
 	// ListApps returns list of apps in the repo
 	ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
@@ -622,6 +779,24 @@ func (c *repositoryServiceClient) List(ctx context.Context, in *RepoQuery, opts
 	return out, nil
 }
 
+func (c *repositoryServiceClient) Get(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error) {
+	out := new(v1alpha1.Repository)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetConnectionDiagnostics(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoConnectionDiagnostics, error) {
+	out := new(RepoConnectionDiagnostics)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/GetConnectionDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error) {
 	out := new(RepoAppsResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListApps", in, out, opts...)
@@ -681,6 +856,10 @@ func (c *repositoryServiceClient) ValidateAccess(ctx context.Context, in *RepoAc
 type RepositoryServiceServer interface {
 	// List returns list of repos
 	List(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
+	// Get returns a repo
+	Get(context.Context, *RepoQuery) (*v1alpha1.Repository, error)
+	// GetConnectionDiagnostics checks the connection to a repo stage by stage
+	GetConnectionDiagnostics(context.Context, *RepoQuery) (*RepoConnectionDiagnostics, error)
 	// ListApps returns list of apps in the repo
 	ListApps(context.Context, *RepoAppsQuery) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
@@ -717,6 +896,42 @@ func _RepositoryService_List_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).Get(ctx, req.(*RepoQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetConnectionDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetConnectionDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/GetConnectionDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetConnectionDiagnostics(ctx, req.(*RepoQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoAppsQuery)
 	if err := dec(in); err != nil {
@@ -833,6 +1048,14 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _RepositoryService_List_Handler,
 		},
+		{
+			MethodName: "Get",
+			Handler:    _RepositoryService_Get_Handler,
+		},
+		{
+			MethodName: "GetConnectionDiagnostics",
+			Handler:    _RepositoryService_GetConnectionDiagnostics_Handler,
+		},
 		{
 			MethodName: "ListApps",
 			Handler:    _RepositoryService_ListApps_Handler,
@@ -1108,6 +1331,24 @@ func (m *RepoAccessQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.TlsClientCertKey)))
 		i += copy(dAtA[i:], m.TlsClientCertKey)
 	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Proxy) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Proxy)))
+		i += copy(dAtA[i:], m.Proxy)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1135,6 +1376,92 @@ func (m *RepoResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RepoConnectionCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoConnectionCheck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stage) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Stage)))
+		i += copy(dAtA[i:], m.Stage)
+	}
+	if m.Successful {
+		dAtA[i] = 0x10
+		i++
+		if m.Successful {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Skipped {
+		dAtA[i] = 0x18
+		i++
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepoConnectionDiagnostics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoConnectionDiagnostics) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, msg := range m.Checks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *RepoCreateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1337,6 +1664,18 @@ func (m *RepoAccessQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Proxy)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1352,6 +1691,44 @@ func (m *RepoResponse) Size() (n int) {
 	return n
 }
 
+func (m *RepoConnectionCheck) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Successful {
+		n += 2
+	}
+	if m.Skipped {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoConnectionDiagnostics) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RepoCreateRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -2201,11 +2578,98 @@ func (m *RepoAccessQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.TlsClientCertKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthRepository
@@ -2274,6 +2738,237 @@ func (m *RepoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepoConnectionCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoConnectionCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoConnectionCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Successful = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoConnectionDiagnostics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoConnectionDiagnostics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoConnectionDiagnostics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, &RepoConnectionCheck{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RepoCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2568,60 +3263,70 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/repository/repository.proto", fileDescriptor_repository_3b37238e26fe0d6f)
-}
-
-var fileDescriptor_repository_3b37238e26fe0d6f = []byte{
-	// 815 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xd6, 0xec, 0x7a, 0x6d, 0xa7, 0x43, 0x96, 0xdd, 0xde, 0x65, 0x65, 0x66, 0xbd, 0x89, 0xd5,
-	0xac, 0x84, 0x77, 0x15, 0x66, 0x64, 0x87, 0x03, 0x42, 0x20, 0x94, 0x1f, 0x04, 0x51, 0x38, 0xc0,
-	0x20, 0x90, 0xe0, 0x00, 0xea, 0x8c, 0x8b, 0x71, 0xe3, 0xf1, 0x74, 0xd3, 0xdd, 0x1e, 0x64, 0x45,
-	0xb9, 0x20, 0x91, 0x07, 0x80, 0x3b, 0x47, 0x9e, 0x85, 0x03, 0x07, 0x24, 0x5e, 0x00, 0x45, 0xbc,
-	0x04, 0x37, 0xd4, 0x3d, 0x3f, 0x1e, 0xff, 0xc4, 0x41, 0x28, 0xda, 0x5b, 0x75, 0x75, 0x55, 0x7d,
-	0x5f, 0xd7, 0x5f, 0x23, 0xa2, 0x40, 0xa6, 0x20, 0x7d, 0x09, 0x82, 0x2b, 0xa6, 0xb9, 0x9c, 0x56,
-	0x44, 0x4f, 0x48, 0xae, 0x39, 0x46, 0x33, 0x8d, 0xfb, 0x30, 0xe2, 0x11, 0xb7, 0x6a, 0xdf, 0x48,
-	0x99, 0x85, 0xdb, 0x8e, 0x38, 0x8f, 0x62, 0xf0, 0xa9, 0x60, 0x3e, 0x4d, 0x12, 0xae, 0xa9, 0x66,
-	0x3c, 0x51, 0xf9, 0x2d, 0x19, 0xbd, 0xa5, 0x3c, 0xc6, 0xed, 0x6d, 0xc8, 0x25, 0xf8, 0x69, 0xcf,
-	0x8f, 0x20, 0x01, 0x49, 0x35, 0x0c, 0x72, 0x9b, 0xe3, 0x88, 0xe9, 0xe1, 0xe4, 0xd4, 0x0b, 0xf9,
-	0xd8, 0xa7, 0xd2, 0x42, 0x7c, 0x6b, 0x85, 0x37, 0xc2, 0x81, 0x2f, 0x46, 0x91, 0x71, 0x56, 0x3e,
-	0x15, 0x22, 0x66, 0xa1, 0x0d, 0xee, 0xa7, 0x3d, 0x1a, 0x8b, 0x21, 0x5d, 0x0e, 0x75, 0xb0, 0x2e,
-	0x94, 0x7d, 0xca, 0xb5, 0x4f, 0x26, 0xef, 0xa1, 0xad, 0x00, 0x04, 0xdf, 0x17, 0x42, 0x7d, 0x32,
-	0x01, 0x39, 0xc5, 0x18, 0xd5, 0x8c, 0x51, 0xcb, 0xe9, 0x38, 0xdd, 0x8d, 0xc0, 0xca, 0xd8, 0x45,
-	0x4d, 0x09, 0x29, 0x53, 0x8c, 0x27, 0xad, 0x5b, 0x56, 0x5f, 0x9e, 0x49, 0x0f, 0x35, 0xf6, 0x85,
-	0x38, 0x4e, 0xbe, 0xe1, 0xc6, 0x55, 0x4f, 0x05, 0x14, 0xae, 0x46, 0x36, 0x3a, 0x41, 0xf5, 0x30,
-	0x77, 0xb3, 0x32, 0xf9, 0xdd, 0x41, 0x0f, 0x72, 0xd0, 0x23, 0xd0, 0x94, 0xc5, 0xff, 0x0f, 0xba,
-	0x8c, 0x7d, 0x7b, 0x16, 0x1b, 0xef, 0xa1, 0xda, 0x10, 0xe2, 0x71, 0xab, 0xd6, 0x71, 0xba, 0x9b,
-	0xfd, 0x1d, 0xaf, 0xf2, 0xe0, 0x0f, 0x21, 0x1e, 0x2f, 0x40, 0x06, 0xd6, 0x18, 0xbf, 0x83, 0x1a,
-	0x23, 0xc5, 0x93, 0x04, 0x74, 0xeb, 0x8e, 0xf5, 0x23, 0x55, 0xbf, 0x93, 0xec, 0x6a, 0xd1, 0xb5,
-	0x70, 0x21, 0xef, 0xa2, 0x7b, 0x45, 0x0a, 0x03, 0x50, 0x82, 0x27, 0x0a, 0xf0, 0x33, 0x74, 0x87,
-	0x69, 0x18, 0xab, 0x96, 0xd3, 0xb9, 0xdd, 0xdd, 0xec, 0x3f, 0xa8, 0xc6, 0xcb, 0xd3, 0x15, 0x64,
-	0x16, 0x64, 0x07, 0x6d, 0x18, 0xf7, 0x2b, 0x53, 0x40, 0xfe, 0x71, 0xd0, 0xcb, 0x16, 0x20, 0x0c,
-	0x41, 0xad, 0x4f, 0xd5, 0x44, 0x81, 0x4c, 0xe8, 0x18, 0x8a, 0x54, 0x15, 0x67, 0x73, 0x27, 0xa8,
-	0x52, 0xdf, 0x73, 0x39, 0xc8, 0xd3, 0x55, 0x9e, 0xf1, 0x53, 0xb4, 0xa5, 0xd4, 0xf0, 0x63, 0xc9,
-	0x52, 0xaa, 0xe1, 0x04, 0xa6, 0x36, 0x77, 0x1b, 0xc1, 0xbc, 0xd2, 0x44, 0x60, 0x89, 0x82, 0x70,
-	0x22, 0xc1, 0x26, 0xa9, 0x19, 0x94, 0x67, 0xbc, 0x8b, 0xee, 0xeb, 0x58, 0x1d, 0xc6, 0x0c, 0x12,
-	0x7d, 0x08, 0x52, 0x1f, 0x51, 0x4d, 0x5b, 0x75, 0x1b, 0x65, 0xf9, 0x02, 0x3f, 0x47, 0xf7, 0xe6,
-	0x94, 0x06, 0xb2, 0x61, 0x8d, 0x97, 0xf4, 0xe4, 0x2e, 0x7a, 0xc9, 0x3c, 0xbd, 0xc8, 0x2b, 0xb9,
-	0x70, 0xd0, 0x7d, 0xa3, 0x38, 0x94, 0x40, 0x35, 0x04, 0xf0, 0xdd, 0x04, 0x94, 0xc6, 0x5f, 0x54,
-	0xb2, 0xb1, 0xd9, 0x7f, 0xdf, 0x9b, 0xcd, 0x85, 0x57, 0xcc, 0x85, 0x15, 0xbe, 0x0e, 0x07, 0x9e,
-	0x18, 0x45, 0x9e, 0x19, 0x31, 0xaf, 0x32, 0x62, 0x5e, 0x31, 0x62, 0x5e, 0x50, 0x96, 0x29, 0x4f,
-	0xea, 0x23, 0x54, 0x9f, 0x08, 0x05, 0x52, 0xdb, 0x94, 0x36, 0x83, 0xfc, 0x44, 0x92, 0x8c, 0xc7,
-	0x67, 0x62, 0xf0, 0x42, 0x78, 0xf4, 0x7f, 0x6d, 0x64, 0x80, 0x99, 0xf2, 0x53, 0x90, 0x29, 0x0b,
-	0x01, 0x5f, 0x38, 0xa8, 0xf6, 0x11, 0x53, 0x1a, 0xbf, 0x52, 0x6d, 0xb0, 0xb2, 0x9d, 0xdc, 0xe3,
-	0x1b, 0xa1, 0x60, 0x10, 0x48, 0xfb, 0x87, 0x3f, 0xff, 0xfe, 0xf9, 0xd6, 0x23, 0xfc, 0xd0, 0x6e,
-	0xb7, 0xb4, 0x37, 0x5b, 0x25, 0x0c, 0x14, 0x1e, 0xa3, 0xa6, 0xb1, 0x32, 0x33, 0x80, 0x5f, 0x5d,
-	0xe4, 0x52, 0x2e, 0x17, 0xb7, 0xbd, 0xea, 0xaa, 0x2c, 0x6e, 0xd7, 0x42, 0x10, 0xdc, 0x59, 0x05,
-	0xe1, 0x9f, 0x99, 0xd3, 0xb9, 0xd9, 0x8c, 0x0a, 0xff, 0xe8, 0xa0, 0xad, 0x0f, 0xaa, 0x23, 0x89,
-	0x77, 0x56, 0x44, 0xae, 0x8e, 0xab, 0x4b, 0xae, 0x36, 0x28, 0x09, 0xf8, 0x96, 0xc0, 0x33, 0xfc,
-	0xfa, 0x75, 0x04, 0xfc, 0x33, 0xb3, 0x6c, 0xce, 0xf1, 0x4f, 0x0e, 0xaa, 0x67, 0xad, 0x88, 0x9f,
-	0x2c, 0xc6, 0x9f, 0x6b, 0x51, 0xf7, 0x66, 0x9a, 0x81, 0x10, 0xcb, 0xb0, 0x4d, 0x56, 0x56, 0xe1,
-	0xed, 0xac, 0x65, 0x7f, 0x71, 0x50, 0x3d, 0xeb, 0xcb, 0x65, 0x52, 0x73, 0xfd, 0x7a, 0x53, 0xa4,
-	0x3c, 0x4b, 0xaa, 0xeb, 0xae, 0xa9, 0x9b, 0xe5, 0x71, 0x9e, 0x13, 0xfc, 0x0a, 0xd5, 0x8f, 0x20,
-	0x06, 0x0d, 0x57, 0xb5, 0x6d, 0x6b, 0x51, 0x5d, 0x56, 0xe8, 0x35, 0x0b, 0xf5, 0xe4, 0xf9, 0xe3,
-	0x35, 0x15, 0xc2, 0x67, 0xe8, 0xee, 0xe7, 0x34, 0x66, 0xe6, 0xa5, 0xd9, 0xce, 0xc4, 0x8f, 0x97,
-	0x8a, 0x3f, 0xdb, 0xa5, 0x6b, 0xd0, 0xfa, 0x16, 0x6d, 0x97, 0x3c, 0x5d, 0xd7, 0x0f, 0x69, 0x0e,
-	0x95, 0x3d, 0xee, 0xe0, 0xe0, 0xb7, 0xcb, 0x6d, 0xe7, 0x8f, 0xcb, 0x6d, 0xe7, 0xaf, 0xcb, 0x6d,
-	0xe7, 0xcb, 0x37, 0xff, 0xc3, 0x6f, 0x1f, 0xda, 0x8d, 0x57, 0xf9, 0x9a, 0x4f, 0xeb, 0xf6, 0x6f,
-	0xde, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xda, 0x10, 0xbf, 0xb4, 0x08, 0x00, 0x00,
+	proto.RegisterFile("server/repository/repository.proto", fileDescriptor_repository_e14309eaad990a26)
+}
+
+var fileDescriptor_repository_e14309eaad990a26 = []byte{
+	// 964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0x1c, 0x45,
+	0x10, 0xd6, 0xf8, 0xb1, 0x5e, 0x97, 0x71, 0x88, 0x3b, 0x26, 0x9a, 0x6c, 0x1c, 0xdb, 0x6a, 0x82,
+	0xd8, 0x44, 0x61, 0x46, 0xde, 0x20, 0x81, 0x10, 0x08, 0xc5, 0x36, 0x32, 0x56, 0x38, 0xc0, 0xf0,
+	0x90, 0xe0, 0x00, 0xea, 0xcc, 0x56, 0x66, 0x9b, 0x9d, 0x9d, 0x6e, 0xa6, 0x7b, 0x17, 0x56, 0x96,
+	0x85, 0x84, 0x44, 0x04, 0x57, 0xb8, 0xf3, 0x37, 0x38, 0x71, 0xe7, 0xc0, 0x01, 0x89, 0x3f, 0x80,
+	0x2c, 0x7e, 0x08, 0xea, 0x9e, 0xc7, 0xce, 0x3e, 0x89, 0x90, 0x95, 0x5b, 0x57, 0x75, 0x57, 0x7d,
+	0x5f, 0x7f, 0x53, 0x55, 0x3d, 0x40, 0x15, 0xa6, 0x03, 0x4c, 0xfd, 0x14, 0xa5, 0x50, 0x5c, 0x8b,
+	0x74, 0x58, 0x59, 0x7a, 0x32, 0x15, 0x5a, 0x10, 0x18, 0x79, 0x1a, 0xdb, 0x91, 0x88, 0x84, 0x75,
+	0xfb, 0x66, 0x95, 0x9d, 0x68, 0xec, 0x44, 0x42, 0x44, 0x31, 0xfa, 0x4c, 0x72, 0x9f, 0x25, 0x89,
+	0xd0, 0x4c, 0x73, 0x91, 0xa8, 0x7c, 0x97, 0x76, 0x5f, 0x57, 0x1e, 0x17, 0x76, 0x37, 0x14, 0x29,
+	0xfa, 0x83, 0x03, 0x3f, 0xc2, 0x04, 0x53, 0xa6, 0xb1, 0x9d, 0x9f, 0x39, 0x8d, 0xb8, 0xee, 0xf4,
+	0x1f, 0x79, 0xa1, 0xe8, 0xf9, 0x2c, 0xb5, 0x10, 0x5f, 0xda, 0xc5, 0x2b, 0x61, 0xdb, 0x97, 0xdd,
+	0xc8, 0x04, 0x2b, 0x9f, 0x49, 0x19, 0xf3, 0xd0, 0x26, 0xf7, 0x07, 0x07, 0x2c, 0x96, 0x1d, 0x36,
+	0x9d, 0xea, 0x70, 0x51, 0x2a, 0x7b, 0x95, 0xff, 0xbc, 0x32, 0x7d, 0x1b, 0x36, 0x03, 0x94, 0xe2,
+	0x81, 0x94, 0xea, 0x83, 0x3e, 0xa6, 0x43, 0x42, 0x60, 0xc5, 0x1c, 0x72, 0x9d, 0x7d, 0xa7, 0xb9,
+	0x1e, 0xd8, 0x35, 0x69, 0x40, 0x3d, 0xc5, 0x01, 0x57, 0x5c, 0x24, 0xee, 0x92, 0xf5, 0x97, 0x36,
+	0x3d, 0x80, 0xb5, 0x07, 0x52, 0x9e, 0x26, 0x8f, 0x85, 0x09, 0xd5, 0x43, 0x89, 0x45, 0xa8, 0x59,
+	0x1b, 0x9f, 0x64, 0xba, 0x93, 0x87, 0xd9, 0x35, 0xfd, 0xc3, 0x81, 0x6b, 0x39, 0xe8, 0x31, 0x6a,
+	0xc6, 0xe3, 0xff, 0x07, 0x5d, 0xe6, 0x5e, 0x1e, 0xe5, 0x26, 0xf7, 0x61, 0xa5, 0x83, 0x71, 0xcf,
+	0x5d, 0xd9, 0x77, 0x9a, 0x1b, 0xad, 0x3d, 0xaf, 0x72, 0xe1, 0x77, 0x31, 0xee, 0x4d, 0x40, 0x06,
+	0xf6, 0x30, 0x79, 0x13, 0xd6, 0xba, 0x4a, 0x24, 0x09, 0x6a, 0x77, 0xd5, 0xc6, 0xd1, 0x6a, 0xdc,
+	0xc3, 0x6c, 0x6b, 0x32, 0xb4, 0x08, 0xa1, 0x6f, 0xc1, 0xd5, 0x42, 0xc2, 0x00, 0x95, 0x14, 0x89,
+	0x42, 0x72, 0x07, 0x56, 0xb9, 0xc6, 0x9e, 0x72, 0x9d, 0xfd, 0xe5, 0xe6, 0x46, 0xeb, 0x5a, 0x35,
+	0x5f, 0x2e, 0x57, 0x90, 0x9d, 0xa0, 0x7b, 0xb0, 0x6e, 0xc2, 0xe7, 0x4a, 0x40, 0x7f, 0x5d, 0x82,
+	0xe7, 0x2d, 0x40, 0x18, 0xa2, 0x5a, 0x2c, 0x55, 0x5f, 0x61, 0x9a, 0xb0, 0x1e, 0x16, 0x52, 0x15,
+	0xb6, 0xd9, 0x93, 0x4c, 0xa9, 0xaf, 0x45, 0xda, 0xce, 0xe5, 0x2a, 0x6d, 0x72, 0x1b, 0x36, 0x95,
+	0xea, 0xbc, 0x9f, 0xf2, 0x01, 0xd3, 0xf8, 0x10, 0x87, 0x56, 0xbb, 0xf5, 0x60, 0xdc, 0x69, 0x32,
+	0xf0, 0x44, 0x61, 0xd8, 0x4f, 0xd1, 0x8a, 0x54, 0x0f, 0x4a, 0x9b, 0xdc, 0x83, 0x2d, 0x1d, 0xab,
+	0xa3, 0x98, 0x63, 0xa2, 0x8f, 0x30, 0xd5, 0xc7, 0x4c, 0x33, 0xb7, 0x66, 0xb3, 0x4c, 0x6f, 0x90,
+	0xbb, 0x70, 0x75, 0xcc, 0x69, 0x20, 0xd7, 0xec, 0xe1, 0x29, 0x7f, 0x59, 0x52, 0xf5, 0xf1, 0x92,
+	0xb2, 0x77, 0x5c, 0xcf, 0x7c, 0xf6, 0x7e, 0xdb, 0xb0, 0x2a, 0x53, 0xf1, 0xcd, 0xd0, 0x05, 0xeb,
+	0xcc, 0x0c, 0x7a, 0x05, 0x9e, 0x33, 0xc2, 0x15, 0x5f, 0x85, 0x7e, 0x9b, 0xd5, 0xdd, 0x91, 0xf9,
+	0x6e, 0xa1, 0xe9, 0xad, 0xa3, 0x0e, 0x86, 0x5d, 0x13, 0xac, 0x34, 0x8b, 0x8a, 0xc2, 0xcd, 0x0c,
+	0xb2, 0x0b, 0xa0, 0xfa, 0x56, 0xf2, 0xc7, 0xfd, 0xd8, 0x0a, 0x5a, 0x0f, 0x2a, 0x1e, 0xe2, 0xc2,
+	0x9a, 0xea, 0x72, 0x29, 0x31, 0x53, 0xb4, 0x1e, 0x14, 0xa6, 0xd9, 0xe9, 0xa1, 0x52, 0x26, 0x63,
+	0x26, 0x65, 0x61, 0xd2, 0x8f, 0xe0, 0xc6, 0x38, 0x81, 0x63, 0xce, 0xa2, 0x44, 0x28, 0xcd, 0x43,
+	0x45, 0x5e, 0x83, 0x5a, 0x68, 0xf8, 0x14, 0x45, 0x33, 0x56, 0xbc, 0x33, 0x78, 0x07, 0xf9, 0x71,
+	0xfa, 0xc4, 0x81, 0x2d, 0xbb, 0x9f, 0x22, 0xd3, 0x18, 0xe0, 0x57, 0x7d, 0x54, 0x9a, 0x7c, 0x5a,
+	0x29, 0x91, 0x8d, 0xd6, 0x3b, 0xde, 0x68, 0x58, 0x78, 0xc5, 0xb0, 0xb0, 0x8b, 0x2f, 0xc2, 0xb6,
+	0x27, 0xbb, 0x91, 0x67, 0xe6, 0x8e, 0x57, 0x99, 0x3b, 0x5e, 0x31, 0x77, 0xbc, 0xa0, 0xa4, 0x91,
+	0x57, 0xda, 0x75, 0xa8, 0xf5, 0xa5, 0xc2, 0x54, 0xe7, 0xb2, 0xe4, 0x16, 0x4d, 0x32, 0x1e, 0x1f,
+	0xcb, 0xf6, 0x33, 0xe1, 0xd1, 0xfa, 0x6d, 0x1d, 0xb6, 0x46, 0xce, 0x0f, 0x31, 0x1d, 0xf0, 0x10,
+	0xc9, 0x13, 0x07, 0x56, 0xde, 0xe3, 0x4a, 0x93, 0x17, 0x26, 0x05, 0xb4, 0xbd, 0xd3, 0x38, 0xbd,
+	0x14, 0x0a, 0x06, 0x81, 0xee, 0x7c, 0xf7, 0xd7, 0x3f, 0x3f, 0x2f, 0x5d, 0x27, 0xdb, 0x76, 0xe4,
+	0x0f, 0x0e, 0x46, 0xf3, 0x95, 0xa3, 0x22, 0x3f, 0x38, 0xb0, 0x7c, 0x82, 0x73, 0x79, 0x5c, 0x8e,
+	0x14, 0xf4, 0x45, 0xcb, 0xe1, 0x16, 0xb9, 0x39, 0x8b, 0x83, 0x7f, 0x66, 0xac, 0x73, 0xf2, 0xa3,
+	0x03, 0xee, 0x09, 0xea, 0xd9, 0x85, 0x37, 0x87, 0xdf, 0x4b, 0xf3, 0xeb, 0xaf, 0x12, 0x4d, 0x7d,
+	0x8b, 0x7f, 0x87, 0xbc, 0xbc, 0x00, 0xdf, 0x6f, 0x57, 0xe0, 0x7a, 0x50, 0x37, 0xe2, 0x99, 0x79,
+	0x49, 0x6e, 0x4c, 0x62, 0x94, 0x0f, 0x51, 0x63, 0x67, 0xd6, 0x56, 0xd9, 0xca, 0x4d, 0x8b, 0x4a,
+	0xc9, 0xfe, 0x22, 0x54, 0x66, 0x20, 0xbe, 0x77, 0x60, 0xf3, 0xa4, 0x3a, 0xbe, 0xc9, 0xde, 0x8c,
+	0xcc, 0xd5, 0xd1, 0xde, 0xa0, 0xf3, 0x0f, 0x94, 0x04, 0x9e, 0xea, 0xda, 0x86, 0x80, 0x7f, 0x66,
+	0x1e, 0xa6, 0x73, 0xf2, 0x93, 0x03, 0xb5, 0xac, 0x43, 0xc9, 0xad, 0x29, 0x65, 0xab, 0x9d, 0x7b,
+	0x59, 0x85, 0x41, 0x2d, 0xc3, 0x1d, 0x3a, 0xb3, 0x38, 0xdf, 0xc8, 0x3a, 0xf9, 0x17, 0x07, 0x6a,
+	0x59, 0xbb, 0x4e, 0x93, 0x1a, 0x6b, 0xe3, 0xcb, 0x22, 0xe5, 0x59, 0x52, 0xcd, 0xc6, 0x82, 0xef,
+	0x66, 0x79, 0x9c, 0xe7, 0x04, 0x3f, 0x87, 0xda, 0x31, 0xc6, 0xa8, 0x71, 0x5e, 0x95, 0xba, 0x93,
+	0xee, 0xf2, 0x0b, 0xe5, 0x8d, 0x71, 0x77, 0x61, 0x63, 0x9c, 0xc1, 0x95, 0x4f, 0x58, 0xcc, 0xcd,
+	0x4d, 0xb3, 0xf7, 0x95, 0xdc, 0x9c, 0xfa, 0xf8, 0xa3, 0x77, 0x77, 0x01, 0x5a, 0xcb, 0xa2, 0xdd,
+	0xa3, 0xb7, 0x17, 0xd5, 0xc3, 0x20, 0x87, 0xca, 0x2e, 0x77, 0x78, 0xf8, 0xfb, 0xc5, 0xae, 0xf3,
+	0xe7, 0xc5, 0xae, 0xf3, 0xf7, 0xc5, 0xae, 0xf3, 0xd9, 0xab, 0x4f, 0xf1, 0x67, 0x18, 0xda, 0xd7,
+	0x71, 0x94, 0x7b, 0xf8, 0xa8, 0x66, 0xff, 0xe3, 0xee, 0xff, 0x3b, 0x00, 0xea, 0x53, 0x29, 0xf2,
+	0xe0, 0x0a, 0x00, 0x00,
 }
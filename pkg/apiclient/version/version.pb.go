@@ -42,6 +42,8 @@ type VersionMessage struct {
 	Compiler             string   `protobuf:"bytes,7,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
 	Platform             string   `protobuf:"bytes,8,opt,name=Platform,proto3" json:"Platform,omitempty"`
 	KsonnetVersion       string   `protobuf:"bytes,9,opt,name=KsonnetVersion,proto3" json:"KsonnetVersion,omitempty"`
+	HelmVersion          string   `protobuf:"bytes,10,opt,name=HelmVersion,proto3" json:"HelmVersion,omitempty"`
+	KustomizeVersion     string   `protobuf:"bytes,11,opt,name=KustomizeVersion,proto3" json:"KustomizeVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -51,7 +53,7 @@ func (m *VersionMessage) Reset()         { *m = VersionMessage{} }
 func (m *VersionMessage) String() string { return proto.CompactTextString(m) }
 func (*VersionMessage) ProtoMessage()    {}
 func (*VersionMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_version_1975a99ee4067d4c, []int{0}
+	return fileDescriptor_version_493cc7983bbe0dbc, []int{0}
 }
 func (m *VersionMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,6 +145,20 @@ func (m *VersionMessage) GetKsonnetVersion() string {
 	return ""
 }
 
+func (m *VersionMessage) GetHelmVersion() string {
+	if m != nil {
+		return m.HelmVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetKustomizeVersion() string {
+	if m != nil {
+		return m.KustomizeVersion
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*VersionMessage)(nil), "version.VersionMessage")
 }
@@ -290,6 +306,18 @@ func (m *VersionMessage) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintVersion(dAtA, i, uint64(len(m.KsonnetVersion)))
 		i += copy(dAtA[i:], m.KsonnetVersion)
 	}
+	if len(m.HelmVersion) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.HelmVersion)))
+		i += copy(dAtA[i:], m.HelmVersion)
+	}
+	if len(m.KustomizeVersion) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.KustomizeVersion)))
+		i += copy(dAtA[i:], m.KustomizeVersion)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -344,6 +372,14 @@ func (m *VersionMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVersion(uint64(l))
 	}
+	l = len(m.HelmVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	l = len(m.KustomizeVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -653,6 +689,64 @@ func (m *VersionMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.KsonnetVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KustomizeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVersion(dAtA[iNdEx:])
@@ -781,31 +875,33 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("server/version/version.proto", fileDescriptor_version_1975a99ee4067d4c)
-}
-
-var fileDescriptor_version_1975a99ee4067d4c = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x49, 0xbf, 0xcf, 0xfe, 0x19, 0x4a, 0x17, 0x83, 0xd4, 0x21, 0x96, 0x22, 0x5d, 0x88,
-	0x1b, 0x13, 0xd4, 0x07, 0x10, 0x5a, 0xa5, 0x0b, 0x11, 0x8a, 0x15, 0x17, 0xee, 0x26, 0xe9, 0xed,
-	0x38, 0x9a, 0xcc, 0x0d, 0x93, 0x69, 0xc1, 0xad, 0xaf, 0xe0, 0x0b, 0xf8, 0x38, 0x2e, 0x05, 0x5f,
-	0x40, 0x8a, 0x0f, 0x22, 0x99, 0x64, 0x22, 0x75, 0x95, 0x7b, 0xce, 0xef, 0xe6, 0x24, 0x9c, 0x4b,
-	0x06, 0x39, 0xe8, 0x35, 0xe8, 0x70, 0x0d, 0x3a, 0x97, 0xa8, 0xdc, 0x33, 0xc8, 0x34, 0x1a, 0xa4,
-	0xad, 0x4a, 0xfa, 0x03, 0x81, 0x28, 0x12, 0x08, 0x79, 0x26, 0x43, 0xae, 0x14, 0x1a, 0x6e, 0x24,
-	0xaa, 0xbc, 0x5c, 0xf3, 0xf7, 0x2b, 0x6a, 0x55, 0xb4, 0x5a, 0x86, 0x90, 0x66, 0xe6, 0xb9, 0x84,
-	0xa3, 0xb7, 0x06, 0xe9, 0xdd, 0x95, 0x31, 0xd7, 0x90, 0xe7, 0x5c, 0x00, 0x65, 0xa4, 0x55, 0x39,
-	0xcc, 0x3b, 0xf0, 0x8e, 0x3a, 0x37, 0x4e, 0xd2, 0x01, 0xe9, 0x8c, 0x57, 0x32, 0x59, 0x5c, 0x70,
-	0x03, 0xac, 0x61, 0xd9, 0xaf, 0x51, 0xd0, 0xa9, 0x34, 0x13, 0x4c, 0x53, 0x69, 0xd8, 0xbf, 0x92,
-	0xd6, 0x06, 0xed, 0x93, 0xe6, 0x54, 0x9a, 0x5b, 0x2e, 0xd8, 0x7f, 0x8b, 0x2a, 0x45, 0x47, 0xa4,
-	0x5b, 0x4c, 0x1a, 0x60, 0x6e, 0x8a, 0xd8, 0x1d, 0x4b, 0xb7, 0x3c, 0x9b, 0x8c, 0xee, 0x9f, 0x9a,
-	0x55, 0xb2, 0x33, 0xa8, 0x4f, 0xda, 0x13, 0x4c, 0x33, 0x99, 0x80, 0x66, 0x2d, 0x0b, 0x6b, 0x5d,
-	0xb0, 0x59, 0xc2, 0xcd, 0x12, 0x75, 0xca, 0xda, 0x25, 0x73, 0x9a, 0x1e, 0x92, 0xde, 0x55, 0x8e,
-	0x4a, 0x81, 0x71, 0xd1, 0x1d, 0xbb, 0xf1, 0xc7, 0x3d, 0x8d, 0xea, 0x86, 0xe6, 0xa0, 0xd7, 0x32,
-	0x06, 0x3a, 0xab, 0x1b, 0xa2, 0xfd, 0xa0, 0x6c, 0x37, 0x70, 0xed, 0x06, 0x97, 0x45, 0xbb, 0xfe,
-	0x5e, 0xe0, 0x6e, 0xb5, 0xdd, 0xee, 0x68, 0xf7, 0xe5, 0xf3, 0xfb, 0xb5, 0xd1, 0xa3, 0x5d, 0x7b,
-	0xad, 0x6a, 0x69, 0x7c, 0xfe, 0xbe, 0x19, 0x7a, 0x1f, 0x9b, 0xa1, 0xf7, 0xb5, 0x19, 0x7a, 0xf7,
-	0x27, 0x42, 0x9a, 0x87, 0x55, 0x14, 0xc4, 0x98, 0x86, 0x5c, 0x0b, 0xcc, 0x34, 0x3e, 0xda, 0xe1,
-	0x38, 0x5e, 0x84, 0xd9, 0x93, 0x28, 0x5e, 0x8d, 0x13, 0x09, 0xca, 0xb8, 0x80, 0xa8, 0x69, 0xbf,
-	0x7f, 0xf6, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x2e, 0x73, 0x5d, 0x32, 0x02, 0x00, 0x00,
+	proto.RegisterFile("server/version/version.proto", fileDescriptor_version_493cc7983bbe0dbc)
+}
+
+var fileDescriptor_version_493cc7983bbe0dbc = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xdf, 0x4a, 0xe3, 0x40,
+	0x18, 0xc5, 0x49, 0x77, 0xb7, 0x7f, 0xa6, 0xa5, 0x2c, 0xc3, 0xd2, 0x0d, 0xd9, 0x52, 0x4a, 0x2f,
+	0x96, 0x65, 0xc1, 0x04, 0xf5, 0x01, 0x84, 0x56, 0xa9, 0x50, 0x84, 0x62, 0xc5, 0x0b, 0xef, 0x26,
+	0xe9, 0xd7, 0x38, 0x9a, 0xc9, 0x17, 0x26, 0x93, 0x82, 0x5e, 0xfa, 0x0a, 0xbe, 0x94, 0x97, 0x82,
+	0x2f, 0x20, 0xc5, 0x6b, 0x9f, 0x41, 0x32, 0xc9, 0x44, 0xab, 0x57, 0x99, 0x73, 0x7e, 0x27, 0x27,
+	0x61, 0x0e, 0xe9, 0xa7, 0x20, 0xd7, 0x20, 0xbd, 0x35, 0xc8, 0x94, 0x63, 0x6c, 0x9e, 0x6e, 0x22,
+	0x51, 0x21, 0x6d, 0x94, 0xd2, 0xe9, 0x87, 0x88, 0x61, 0x04, 0x1e, 0x4b, 0xb8, 0xc7, 0xe2, 0x18,
+	0x15, 0x53, 0x1c, 0xe3, 0xb4, 0x88, 0x39, 0x7f, 0x4a, 0xaa, 0x95, 0x9f, 0xad, 0x3c, 0x10, 0x89,
+	0xba, 0x29, 0xe0, 0xe8, 0xb5, 0x46, 0xba, 0xe7, 0x45, 0xcd, 0x09, 0xa4, 0x29, 0x0b, 0x81, 0xda,
+	0xa4, 0x51, 0x3a, 0xb6, 0x35, 0xb4, 0xfe, 0xb5, 0x4e, 0x8d, 0xa4, 0x7d, 0xd2, 0x1a, 0x67, 0x3c,
+	0x5a, 0x1e, 0x32, 0x05, 0x76, 0x4d, 0xb3, 0x77, 0x23, 0xa7, 0x53, 0xae, 0x26, 0x28, 0x04, 0x57,
+	0xf6, 0xb7, 0x82, 0x56, 0x06, 0xed, 0x91, 0xfa, 0x94, 0xab, 0x33, 0x16, 0xda, 0xdf, 0x35, 0x2a,
+	0x15, 0x1d, 0x91, 0x4e, 0x7e, 0x92, 0x00, 0x0b, 0x95, 0xd7, 0xfe, 0xd0, 0x74, 0xcb, 0xd3, 0xcd,
+	0x68, 0xfe, 0xa9, 0x5e, 0x36, 0x1b, 0x83, 0x3a, 0xa4, 0x39, 0x41, 0x91, 0xf0, 0x08, 0xa4, 0xdd,
+	0xd0, 0xb0, 0xd2, 0x39, 0x9b, 0x47, 0x4c, 0xad, 0x50, 0x0a, 0xbb, 0x59, 0x30, 0xa3, 0xe9, 0x5f,
+	0xd2, 0x9d, 0xa5, 0x18, 0xc7, 0xa0, 0x4c, 0x75, 0x4b, 0x27, 0x3e, 0xb9, 0x74, 0x48, 0xda, 0xc7,
+	0x10, 0x09, 0x13, 0x22, 0x3a, 0xf4, 0xd1, 0xa2, 0xff, 0xc9, 0xcf, 0x59, 0x96, 0x2a, 0x14, 0xfc,
+	0x16, 0x4c, 0xac, 0xad, 0x63, 0x5f, 0xfc, 0x3d, 0xbf, 0xba, 0xef, 0x05, 0xc8, 0x35, 0x0f, 0x80,
+	0xce, 0xab, 0xfb, 0xa6, 0x3d, 0xb7, 0xd8, 0xca, 0x35, 0x5b, 0xb9, 0x47, 0xf9, 0x56, 0xce, 0x6f,
+	0xd7, 0x2c, 0xbf, 0xbd, 0xd5, 0xe8, 0xd7, 0xdd, 0xd3, 0xcb, 0x7d, 0xad, 0x4b, 0x3b, 0x7a, 0xfb,
+	0x32, 0x34, 0x3e, 0x78, 0xd8, 0x0c, 0xac, 0xc7, 0xcd, 0xc0, 0x7a, 0xde, 0x0c, 0xac, 0x8b, 0xdd,
+	0x90, 0xab, 0xcb, 0xcc, 0x77, 0x03, 0x14, 0x1e, 0x93, 0x21, 0x26, 0x12, 0xaf, 0xf4, 0x61, 0x27,
+	0x58, 0x7a, 0xc9, 0x75, 0x98, 0xbf, 0x1a, 0x44, 0x1c, 0x62, 0x65, 0x0a, 0xfc, 0xba, 0xfe, 0xfe,
+	0xfe, 0xdb, 0x00, 0xeb, 0x4f, 0xaa, 0x83, 0x80, 0x02, 0x00, 0x00,
 }
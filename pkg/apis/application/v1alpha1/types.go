@@ -8,7 +8,10 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,7 +26,9 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/cron"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/gpg"
 	"github.com/argoproj/argo-cd/util/rbac"
 )
 
@@ -43,7 +48,7 @@ type Application struct {
 // ApplicationSpec represents desired application state. Contains link to repository with application definition and additional parameters link definition revision.
 type ApplicationSpec struct {
 	// Source is a reference to the location ksonnet application definition
-	Source ApplicationSource `json:"source" protobuf:"bytes,1,opt,name=source"`
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,1,opt,name=source"`
 	// Destination overrides the kubernetes server and namespace defined in the environment ksonnet app.yaml
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a application project name. Empty name means that application belongs to 'default' project.
@@ -54,6 +59,35 @@ type ApplicationSpec struct {
 	IgnoreDifferences []ResourceIgnoreDifferences `json:"ignoreDifferences,omitempty" protobuf:"bytes,5,name=ignoreDifferences"`
 	// Infos contains a list of useful information (URLs, email addresses, and plain text) that relates to the application
 	Info []Info `json:"info,omitempty" protobuf:"bytes,6,name=info"`
+	// Sources is a list of sources the manifests of the application are generated from, used instead of Source if set
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,7,opt,name=sources"`
+}
+
+// HasMultipleSources returns whether the application is generated from its Sources instead of its Source
+func (spec *ApplicationSpec) HasMultipleSources() bool {
+	return len(spec.Sources) > 0
+}
+
+// GetSources returns the sources of the application, which is only the Source unless the application has multiple sources
+func (spec *ApplicationSpec) GetSources() ApplicationSources {
+	if spec.HasMultipleSources() {
+		return spec.Sources
+	}
+	return ApplicationSources{spec.Source}
+}
+
+// GetSource returns the Source of the application, or the first of its sources which is not a ref if the application
+// has multiple sources
+func (spec *ApplicationSpec) GetSource() ApplicationSource {
+	if spec.HasMultipleSources() {
+		for _, source := range spec.Sources {
+			if !source.IsRef() {
+				return source
+			}
+		}
+		return spec.Sources[0]
+	}
+	return spec.Source
 }
 
 // ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.
@@ -69,11 +103,29 @@ type EnvEntry struct {
 	// the name, usually uppercase
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// the value
-	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// ValueFrom is the source of the value, if the value is not set
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty" protobuf:"bytes,3,opt,name=valueFrom"`
 }
 
 func (a *EnvEntry) IsZero() bool {
-	return a == nil || a.Name == "" && a.Value == ""
+	return a == nil || a.Name == "" && a.Value == "" && a.ValueFrom == nil
+}
+
+// EnvVarSource is the source of the value of an environment variable
+type EnvVarSource struct {
+	// SecretKeyRef selects a key of a secret in the namespace of Argo CD. The secret must have the label
+	// argocd.argoproj.io/secret-type=env, list the project of the application in its annotation
+	// argocd.argoproj.io/env-projects, and the repo server must allow the name of the variable.
+	SecretKeyRef *SecretKeySelector `json:"secretKeyRef,omitempty" protobuf:"bytes,1,opt,name=secretKeyRef"`
+}
+
+// SecretKeySelector selects a key of a secret
+type SecretKeySelector struct {
+	// Name is the name of the secret
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Key is the key of the secret
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
 type Env []*EnvEntry
@@ -82,22 +134,43 @@ func (e Env) IsZero() bool {
 	return len(e) == 0
 }
 
-func (e Env) Environ() []string {
+// Environ returns the variables as NAME=VALUE. The variables whose values are sourced from secrets take their values
+// from the given resolved values, and are omitted if they are not resolved.
+func (e Env) Environ(resolved map[string]string) []string {
 	var environ []string
 	for _, item := range e {
-		if !item.IsZero() {
-			environ = append(environ, fmt.Sprintf("%s=%s", item.Name, item.Value))
+		if item.IsZero() {
+			continue
+		}
+		if item.ValueFrom != nil {
+			if value, ok := resolved[item.Name]; ok {
+				environ = append(environ, fmt.Sprintf("%s=%s", item.Name, value))
+			}
+			continue
 		}
+		environ = append(environ, fmt.Sprintf("%s=%s", item.Name, item.Value))
 	}
 	return environ
 }
 
+// SecretRefs returns the secret key selectors of the variables whose values are sourced from secrets, by the names
+// of the variables
+func (e Env) SecretRefs() map[string]*SecretKeySelector {
+	refs := map[string]*SecretKeySelector{}
+	for _, item := range e {
+		if item != nil && item.ValueFrom != nil && item.ValueFrom.SecretKeyRef != nil {
+			refs[item.Name] = item.ValueFrom.SecretKeyRef
+		}
+	}
+	return refs
+}
+
 // ApplicationSource contains information about github repository, path within repository and target application environment.
 type ApplicationSource struct {
 	// RepoURL is the git repository URL of the application manifests
 	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
 	// Path is a directory path within the repository containing a
-	Path string `json:"path" protobuf:"bytes,2,opt,name=path"`
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
 	// Environment is a ksonnet application environment name
 	// TargetRevision defines the commit, tag, or branch in which to sync the application to.
 	// If omitted, will sync to HEAD
@@ -112,13 +185,47 @@ type ApplicationSource struct {
 	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,10,opt,name=directory"`
 	// ConfigManagementPlugin holds config management plugin specific options
 	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
+	// Chart is a Helm chart name, used instead of Path if RepoURL is a Helm chart repository. TargetRevision
+	// is the version of the chart then, or a semantic version constraint the latest matching version is used for.
+	Chart string `json:"chart,omitempty" protobuf:"bytes,12,opt,name=chart"`
+	// Ref is the name of the source among the sources of an application, which the value files of the Helm sources
+	// refer to with the $ref prefix, e.g. $values/prod.yaml. A source with a ref generates no manifests.
+	Ref string `json:"ref,omitempty" protobuf:"bytes,13,opt,name=ref"`
+}
+
+// IsHelmChart returns whether the source is a chart of a Helm chart repository
+func (a *ApplicationSource) IsHelmChart() bool {
+	return a.Chart != ""
+}
+
+// IsRef returns whether the source only provides value files to the other sources of an application
+func (a *ApplicationSource) IsRef() bool {
+	return a.Ref != ""
+}
+
+// ApplicationSources is a list of application sources
+type ApplicationSources []ApplicationSource
+
+// Equals returns whether the sources are equal to the other sources, in the same order
+func (s ApplicationSources) Equals(other ApplicationSources) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for i := range s {
+		if !s[i].Equals(other[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 func (a *ApplicationSource) IsZero() bool {
 	return a == nil ||
 		a.RepoURL == "" &&
 			a.Path == "" &&
+			a.Chart == "" &&
 			a.TargetRevision == "" &&
+			a.Ref == "" &&
 			a.Helm.IsZero() &&
 			a.Kustomize.IsZero() &&
 			a.Ksonnet.IsZero() &&
@@ -151,6 +258,28 @@ type ApplicationSourceHelm struct {
 	Parameters []HelmParameter `json:"parameters,omitempty" protobuf:"bytes,2,opt,name=parameters"`
 	// The Helm release name. If omitted it will use the application name
 	ReleaseName string `json:"releaseName,omitempty" protobuf:"bytes,3,opt,name=releaseName"`
+	// ValueFilesRepos are Git repositories outside of the source, whose files the value files refer to with the $ref
+	// prefix of the repository, e.g. $values/prod.yaml
+	ValueFilesRepos []HelmValueFilesRepo `json:"valueFilesRepos,omitempty" protobuf:"bytes,4,opt,name=valueFilesRepos"`
+	// Values is a block of YAML values, which override the values of the value files
+	Values string `json:"values,omitempty" protobuf:"bytes,5,opt,name=values"`
+	// FileParameters are parameters whose values are the contents of files of the repository
+	FileParameters []HelmFileParameter `json:"fileParameters,omitempty" protobuf:"bytes,6,opt,name=fileParameters"`
+	// Version is the name of the Helm version registered in the settings, which renders the chart. If omitted, the
+	// helm bundled with the repo server is used.
+	Version string `json:"version,omitempty" protobuf:"bytes,7,opt,name=version"`
+	// Env is the environment of the helm commands
+	Env Env `json:"env,omitempty" protobuf:"bytes,8,opt,name=env"`
+}
+
+// HelmValueFilesRepo is a Git repository at a revision, which holds value files of a Helm source
+type HelmValueFilesRepo struct {
+	// Ref is the name of the repository, which the value files refer to with the $ref prefix
+	Ref string `json:"ref" protobuf:"bytes,1,opt,name=ref"`
+	// RepoURL is the URL of the Git repository
+	RepoURL string `json:"repoURL" protobuf:"bytes,2,opt,name=repoURL"`
+	// TargetRevision is the revision of the repository the value files are read at. If omitted, HEAD is used.
+	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,3,opt,name=targetRevision"`
 }
 
 // HelmParameter is a parameter to a helm template
@@ -163,8 +292,16 @@ type HelmParameter struct {
 	ForceString bool `json:"forceString,omitempty" protobuf:"bytes,3,opt,name=forceString"`
 }
 
+// HelmFileParameter is a parameter to a helm template, whose value is the content of a file
+type HelmFileParameter struct {
+	// Name is the name of the helm parameter
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Path is the path of the file in the repository, relative to the path of the application
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+}
+
 func (h *ApplicationSourceHelm) IsZero() bool {
-	return h == nil || (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0
+	return h == nil || (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.ValueFilesRepos) == 0 && h.Values == "" && len(h.FileParameters) == 0 && h.Version == "" && h.Env.IsZero()
 }
 
 // ApplicationSourceKustomize holds kustomize specific options
@@ -177,6 +314,51 @@ type ApplicationSourceKustomize struct {
 	Images []string `json:"images,omitempty" protobuf:"bytes,3,opt,name=images"`
 	// CommonLabels adds additional kustomize commonLabels
 	CommonLabels map[string]string `json:"commonLabels,omitempty" protobuf:"bytes,4,opt,name=commonLabels"`
+	// Components are paths of kustomize components added to the kustomization
+	Components []string `json:"components,omitempty" protobuf:"bytes,5,opt,name=components"`
+	// Patches are inline strategic merge or JSON 6902 patches added to the kustomization
+	Patches []KustomizePatch `json:"patches,omitempty" protobuf:"bytes,6,opt,name=patches"`
+	// Replicas are overrides of the replica counts of resources
+	Replicas []KustomizeReplica `json:"replicas,omitempty" protobuf:"bytes,7,opt,name=replicas"`
+	// Version is the name of the kustomize version registered in the settings, which builds the kustomization.
+	// If omitted, the kustomize bundled with the repo server is used.
+	Version string `json:"version,omitempty" protobuf:"bytes,8,opt,name=version"`
+	// Env is the environment of the kustomize commands
+	Env Env `json:"env,omitempty" protobuf:"bytes,9,opt,name=env"`
+}
+
+// KustomizePatch is an inline strategic merge or JSON 6902 patch of the resources of a kustomization
+type KustomizePatch struct {
+	// Patch is the strategic merge patch or the JSON 6902 patch, in YAML or JSON
+	Patch string `json:"patch" protobuf:"bytes,1,opt,name=patch"`
+	// Target selects the resources the patch applies to. Required for JSON 6902 patches.
+	Target *KustomizeSelector `json:"target,omitempty" protobuf:"bytes,2,opt,name=target"`
+}
+
+// KustomizeSelector selects the resources a kustomize patch applies to
+type KustomizeSelector struct {
+	// Group is the API group of the resources
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Version is the API version of the resources
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	// Kind is the kind of the resources
+	Kind string `json:"kind,omitempty" protobuf:"bytes,3,opt,name=kind"`
+	// Name is the name of the resources
+	Name string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// Namespace is the namespace of the resources
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,5,opt,name=namespace"`
+	// LabelSelector selects the resources by their labels
+	LabelSelector string `json:"labelSelector,omitempty" protobuf:"bytes,6,opt,name=labelSelector"`
+	// AnnotationSelector selects the resources by their annotations
+	AnnotationSelector string `json:"annotationSelector,omitempty" protobuf:"bytes,7,opt,name=annotationSelector"`
+}
+
+// KustomizeReplica is an override of the replica count of the resources with a name
+type KustomizeReplica struct {
+	// Name is the name of the resources, e.g. of a deployment
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Count is the number of replicas
+	Count int64 `json:"count" protobuf:"varint,2,opt,name=count"`
 }
 
 // KustomizeImageTag is a kustomize image tag
@@ -188,7 +370,8 @@ type KustomizeImageTag struct {
 }
 
 func (k *ApplicationSourceKustomize) IsZero() bool {
-	return k == nil || k.NamePrefix == "" && len(k.ImageTags) == 0 && len(k.Images) == 0 && len(k.CommonLabels) == 0
+	return k == nil || k.NamePrefix == "" && len(k.ImageTags) == 0 && len(k.Images) == 0 && len(k.CommonLabels) == 0 &&
+		len(k.Components) == 0 && len(k.Patches) == 0 && len(k.Replicas) == 0 && k.Version == "" && k.Env.IsZero()
 }
 
 // JsonnetVar is a jsonnet variable
@@ -196,6 +379,9 @@ type JsonnetVar struct {
 	Name  string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
 	Code  bool   `json:"code,omitempty" protobuf:"bytes,3,opt,name=code"`
+	// File is whether the value is the path of a file of the repository, relative to the application path, whose
+	// content is the value of the variable
+	File bool `json:"file,omitempty" protobuf:"bytes,4,opt,name=file"`
 }
 
 // ApplicationSourceJsonnet holds jsonnet specific options
@@ -204,10 +390,12 @@ type ApplicationSourceJsonnet struct {
 	ExtVars []JsonnetVar `json:"extVars,omitempty" protobuf:"bytes,1,opt,name=extVars"`
 	// TLAS is a list of Jsonnet Top-level Arguments
 	TLAs []JsonnetVar `json:"tlas,omitempty" protobuf:"bytes,2,opt,name=tlas"`
+	// Libs is a list of additional library search dirs, relative to the root of the repository
+	Libs []string `json:"libs,omitempty" protobuf:"bytes,3,opt,name=libs"`
 }
 
 func (j *ApplicationSourceJsonnet) IsZero() bool {
-	return j == nil || len(j.ExtVars) == 0 && len(j.TLAs) == 0
+	return j == nil || len(j.ExtVars) == 0 && len(j.TLAs) == 0 && len(j.Libs) == 0
 }
 
 // ApplicationSourceKsonnet holds ksonnet specific options
@@ -232,20 +420,38 @@ func (k *ApplicationSourceKsonnet) IsZero() bool {
 type ApplicationSourceDirectory struct {
 	Recurse bool                     `json:"recurse,omitempty" protobuf:"bytes,1,opt,name=recurse"`
 	Jsonnet ApplicationSourceJsonnet `json:"jsonnet,omitempty" protobuf:"bytes,2,opt,name=jsonnet"`
+	// Exclude are glob patterns of the files and directories which are excluded from the manifests. Patterns without
+	// a slash match the names of files and directories at any depth, other patterns match their paths relative to the
+	// application path, e.g. "docs" or "config/*.yaml".
+	Exclude []string `json:"exclude,omitempty" protobuf:"bytes,3,opt,name=exclude"`
+	// Include are glob patterns of the files whose manifests are included, which match like the exclude patterns. If
+	// omitted, all YAML, JSON and Jsonnet files are included.
+	Include []string `json:"include,omitempty" protobuf:"bytes,4,opt,name=include"`
+	// Substitute is whether the references to the variables of the build environment, e.g. ${ARGOCD_APP_NAMESPACE}, in
+	// the YAML and JSON manifests are replaced by their values
+	Substitute bool `json:"substitute,omitempty" protobuf:"bytes,5,opt,name=substitute"`
 }
 
 func (d *ApplicationSourceDirectory) IsZero() bool {
-	return d == nil || !d.Recurse && d.Jsonnet.IsZero()
+	return d == nil || !d.Recurse && d.Jsonnet.IsZero() && len(d.Exclude) == 0 && len(d.Include) == 0 && !d.Substitute
 }
 
 // ApplicationSourcePlugin holds config management plugin specific options
 type ApplicationSourcePlugin struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	Env  `json:"env,omitempty" protobuf:"bytes,2,opt,name=env"`
+	// Parameters are the values of the parameters declared by a config management plugin sidecar
+	Parameters []PluginParameter `json:"parameters,omitempty" protobuf:"bytes,3,opt,name=parameters"`
 }
 
 func (c *ApplicationSourcePlugin) IsZero() bool {
-	return c == nil || c.Name == "" && c.Env.IsZero()
+	return c == nil || c.Name == "" && c.Env.IsZero() && len(c.Parameters) == 0
+}
+
+// PluginParameter is the value of a parameter of a config management plugin sidecar
+type PluginParameter struct {
+	Name  string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
 }
 
 // ApplicationDestination contains deployment destination information
@@ -273,6 +479,18 @@ type ApplicationStatus struct {
 // Operation contains requested operation parameters.
 type Operation struct {
 	Sync *SyncOperation `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	// Retry controls the retries of the operation if it fails
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,2,opt,name=retry"`
+	// InitiatedBy contains information about who initiated the operation
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,3,opt,name=initiatedBy"`
+}
+
+// OperationInitiator holds information about the initiator of an operation
+type OperationInitiator struct {
+	// Username is the name of the user who initiated the operation
+	Username string `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
+	// Automated is true if the operation was initiated by an automated sync
+	Automated bool `json:"automated,omitempty" protobuf:"bytes,2,opt,name=automated"`
 }
 
 // SyncOperationResource contains resources to sync.
@@ -280,14 +498,28 @@ type SyncOperationResource struct {
 	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
 	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
 	Name  string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Exclude excludes the matching resources from the sync instead of selecting them
+	Exclude bool `json:"exclude,omitempty" protobuf:"bytes,4,opt,name=exclude"`
 }
 
-// HasIdentity determines whether a sync operation is identified by a manifest.
+// HasIdentity determines whether a sync operation is identified by a manifest. The group, kind
+// and name of the sync operation resource may be glob patterns.
 func (r SyncOperationResource) HasIdentity(name string, gvk schema.GroupVersionKind) bool {
-	if name == r.Name && gvk.Kind == r.Kind && gvk.Group == r.Group {
+	return syncResourceFieldMatch(r.Name, name) && syncResourceFieldMatch(r.Kind, gvk.Kind) && syncResourceFieldMatch(r.Group, gvk.Group)
+}
+
+// IsPattern returns whether the sync operation resource is an exclusion or contains glob patterns,
+// and so may match other resources than the one it names.
+func (r SyncOperationResource) IsPattern() bool {
+	return r.Exclude || strings.ContainsAny(r.Group+r.Kind+r.Name, "*?[\\")
+}
+
+func syncResourceFieldMatch(pattern string, val string) bool {
+	if pattern == val {
 		return true
 	}
-	return false
+	ok, err := filepath.Match(pattern, val)
+	return ok && err == nil
 }
 
 // SyncOperation contains sync operation details.
@@ -308,12 +540,42 @@ type SyncOperation struct {
 	Source *ApplicationSource `json:"source,omitempty" protobuf:"bytes,7,opt,name=source"`
 	// Manifests is an optional field that overrides sync source with a local directory for development
 	Manifests []string `json:"manifests,omitempty" protobuf:"bytes,8,opt,name=manifests"`
+	// LabelSelector limits the sync to the resources whose labels match the selector
+	LabelSelector string `json:"labelSelector,omitempty" protobuf:"bytes,9,opt,name=labelSelector"`
+	// SelfHeal indicates that the sync reverts a drift of the live state, which retains the ignored differences of the application
+	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,10,opt,name=selfHeal"`
+	// Rollback indicates that the sync rolls back the application to a previously deployed or given revision
+	Rollback bool `json:"rollback,omitempty" protobuf:"bytes,11,opt,name=rollback"`
+	// Revisions are the revisions of the sources to sync an application with multiple sources to, in the order of
+	// the sources. If omitted, will use the target revisions of the sources.
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,12,opt,name=revisions"`
+	// Sources overrides the sources of an application with multiple sources, typically in a rollback
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,13,opt,name=sources"`
 }
 
 func (o *SyncOperation) IsApplyStrategy() bool {
 	return o.SyncStrategy != nil && o.SyncStrategy.Apply != nil
 }
 
+// Options returns the options of the sync, e.g. "Prune=true", in the format of the sync options annotation
+func (o *SyncOperation) Options() []string {
+	strategy := "hook"
+	if o.IsApplyStrategy() {
+		strategy = "apply"
+	}
+	options := []string{"Strategy=" + strategy}
+	if o.Prune {
+		options = append(options, "Prune=true")
+	}
+	if o.SyncStrategy.Force() {
+		options = append(options, "Force=true")
+	}
+	if o.SelfHeal {
+		options = append(options, "SelfHeal=true")
+	}
+	return options
+}
+
 type OperationPhase string
 
 const (
@@ -350,6 +612,8 @@ type OperationState struct {
 	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,6,opt,name=startedAt"`
 	// FinishedAt contains time of operation completion
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
+	// RetryCount contains the number of times the operation has been retried
+	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
 }
 
 type Info struct {
@@ -361,6 +625,8 @@ type Info struct {
 type SyncPolicy struct {
 	// Automated will keep an application synced to the target revision
 	Automated *SyncPolicyAutomated `json:"automated,omitempty" protobuf:"bytes,1,opt,name=automated"`
+	// Retry controls the retries of failed automated syncs
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,2,opt,name=retry"`
 }
 
 // SyncPolicyAutomated controls the behavior of an automated sync
@@ -371,6 +637,79 @@ type SyncPolicyAutomated struct {
 	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,2,opt,name=selfHeal"`
 }
 
+// RetryStrategy controls the retries of a failed sync operation
+type RetryStrategy struct {
+	// Limit is the maximum number of retries of a failed sync. No retries are made if not positive.
+	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
+	// Backoff controls how long to wait between the retries
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+}
+
+// Backoff is the exponential backoff between the retries of a failed sync operation
+type Backoff struct {
+	// Duration is the time to wait before the first retry, as a duration (e.g. "30s", "2m") or a number of seconds (default: 5s)
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+	// Factor multiplies the duration after each retry (default: 2)
+	Factor *int64 `json:"factor,omitempty" protobuf:"bytes,2,opt,name=factor"`
+	// MaxDuration is the maximum time to wait between two retries (default: 3m)
+	MaxDuration string `json:"maxDuration,omitempty" protobuf:"bytes,3,opt,name=maxDuration"`
+}
+
+const (
+	// DefaultSyncRetryDuration is the default time to wait before the first retry of a failed sync
+	DefaultSyncRetryDuration = 5 * time.Second
+	// DefaultSyncRetryFactor is the default factor the backoff duration is multiplied with after each retry
+	DefaultSyncRetryFactor = int64(2)
+	// DefaultSyncRetryMaxDuration is the default maximum time to wait between two retries of a failed sync
+	DefaultSyncRetryMaxDuration = 3 * time.Minute
+)
+
+// parseRetryDuration parses a duration (e.g. "2m") or a number of seconds
+func parseRetryDuration(duration string) (time.Duration, error) {
+	if seconds, err := strconv.ParseInt(duration, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(duration)
+}
+
+// Validate returns an error if the durations of the retry strategy are invalid
+func (r *RetryStrategy) Validate() error {
+	_, err := r.NextRetryAt(time.Time{}, 0)
+	return err
+}
+
+// NextRetryAt returns the time of the retry of a sync which failed at the given time after the given number of retries
+func (r *RetryStrategy) NextRetryAt(lastAttempt time.Time, retryCount int64) (time.Time, error) {
+	duration := DefaultSyncRetryDuration
+	factor := DefaultSyncRetryFactor
+	maxDuration := DefaultSyncRetryMaxDuration
+	if r.Backoff != nil {
+		var err error
+		if r.Backoff.Duration != "" {
+			if duration, err = parseRetryDuration(r.Backoff.Duration); err != nil {
+				return time.Time{}, fmt.Errorf("invalid backoff duration '%s': %v", r.Backoff.Duration, err)
+			}
+		}
+		if r.Backoff.Factor != nil {
+			if factor = *r.Backoff.Factor; factor < 1 {
+				return time.Time{}, fmt.Errorf("invalid backoff factor %d: must be at least 1", factor)
+			}
+		}
+		if r.Backoff.MaxDuration != "" {
+			if maxDuration, err = parseRetryDuration(r.Backoff.MaxDuration); err != nil {
+				return time.Time{}, fmt.Errorf("invalid backoff max duration '%s': %v", r.Backoff.MaxDuration, err)
+			}
+		}
+	}
+	for i := int64(0); i < retryCount && duration < maxDuration; i++ {
+		duration *= time.Duration(factor)
+	}
+	if duration > maxDuration {
+		duration = maxDuration
+	}
+	return lastAttempt.Add(duration), nil
+}
+
 // SyncStrategy controls the manner in which a sync is performed
 type SyncStrategy struct {
 	// Apply wil perform a `kubectl apply` to perform the sync.
@@ -425,8 +764,9 @@ const (
 type HookDeletePolicy string
 
 const (
-	HookDeletePolicyHookSucceeded HookDeletePolicy = "HookSucceeded"
-	HookDeletePolicyHookFailed    HookDeletePolicy = "HookFailed"
+	HookDeletePolicyHookSucceeded      HookDeletePolicy = "HookSucceeded"
+	HookDeletePolicyHookFailed         HookDeletePolicy = "HookFailed"
+	HookDeletePolicyBeforeHookCreation HookDeletePolicy = "BeforeHookCreation"
 )
 
 // data about a specific revision within a repo
@@ -454,6 +794,10 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	// Revisions holds the revisions of the sources of the sync of an application with multiple sources
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,4,opt,name=revisions"`
+	// Sources records the sources of the sync of an application with multiple sources
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,5,opt,name=sources"`
 }
 
 type ResultCode string
@@ -537,6 +881,20 @@ type RevisionHistory struct {
 	DeployedAt metav1.Time       `json:"deployedAt" protobuf:"bytes,4,opt,name=deployedAt"`
 	ID         int64             `json:"id" protobuf:"bytes,5,opt,name=id"`
 	Source     ApplicationSource `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
+	// Rollback indicates that the revision was deployed by a rollback
+	Rollback bool `json:"rollback,omitempty" protobuf:"bytes,7,opt,name=rollback"`
+	// InitiatedBy contains information about who initiated the sync which deployed the revision
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,8,opt,name=initiatedBy"`
+	// SyncOptions are the options of the sync which deployed the revision, e.g. "Prune=true"
+	SyncOptions []string `json:"syncOptions,omitempty" protobuf:"bytes,9,rep,name=syncOptions"`
+	// Phase is the phase the sync which deployed the revision completed with
+	Phase OperationPhase `json:"phase,omitempty" protobuf:"bytes,10,opt,name=phase"`
+	// Message is the message the sync which deployed the revision completed with
+	Message string `json:"message,omitempty" protobuf:"bytes,11,opt,name=message"`
+	// Revisions are the revisions of the sources which were deployed, for an application with multiple sources
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,12,opt,name=revisions"`
+	// Sources are the sources which were deployed, for an application with multiple sources
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,13,opt,name=sources"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -599,6 +957,10 @@ const (
 	ApplicationConditionRepeatedResourceWarning = "RepeatedResourceWarning"
 	// ApplicationConditionExcludedResourceWarning indicates that application has resource which is configured to be excluded
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
+	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
+	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionPruneSkippedWarning indicates that application has resources which require pruning, but opted out of it
+	ApplicationConditionPruneSkippedWarning = "PruneSkippedWarning"
 )
 
 // ApplicationCondition contains details about current application condition
@@ -613,6 +975,8 @@ type ApplicationCondition struct {
 type ComparedTo struct {
 	Source      ApplicationSource      `json:"source" protobuf:"bytes,1,opt,name=source"`
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,opt,name=destination"`
+	// Sources are the sources an application with multiple sources was compared to
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,3,opt,name=sources"`
 }
 
 // SyncStatus is a comparison result of application spec and deployed application.
@@ -620,6 +984,8 @@ type SyncStatus struct {
 	Status     SyncStatusCode `json:"status" protobuf:"bytes,1,opt,name=status,casttype=SyncStatusCode"`
 	ComparedTo ComparedTo     `json:"comparedTo,omitempty" protobuf:"bytes,2,opt,name=comparedTo"`
 	Revision   string         `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+	// Revisions are the revisions of the sources an application with multiple sources was compared to
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,4,opt,name=revisions"`
 }
 
 type HealthStatus struct {
@@ -658,7 +1024,11 @@ type ResourceNetworkingInfo struct {
 
 // ApplicationTree holds nodes which belongs to the application
 type ApplicationTree struct {
+	// Nodes contains the nodes which are directly managed by the application and their children
 	Nodes []ResourceNode `json:"nodes,omitempty" protobuf:"bytes,1,rep,name=nodes"`
+	// OrphanedNodes contains the top level nodes in the destination namespace which are not managed by any application.
+	// It is only populated if orphaned resources monitoring is enabled in the project of the application.
+	OrphanedNodes []ResourceNode `json:"orphanedNodes,omitempty" protobuf:"bytes,2,rep,name=orphanedNodes"`
 }
 
 type ApplicationSummary struct {
@@ -668,10 +1038,13 @@ type ApplicationSummary struct {
 	Images []string `json:"images,omitempty" protobuf:"bytes,2,opt,name=images"`
 }
 
+// FindNode returns the managed or orphaned node with the given group, kind, namespace and name, or nil if there is none
 func (t *ApplicationTree) FindNode(group string, kind string, namespace string, name string) *ResourceNode {
-	for _, n := range t.Nodes {
-		if n.Group == group && n.Kind == kind && n.Namespace == namespace && n.Name == name {
-			return &n
+	for _, nodes := range [][]ResourceNode{t.Nodes, t.OrphanedNodes} {
+		for _, n := range nodes {
+			if n.Group == group && n.Kind == kind && n.Namespace == namespace && n.Name == name {
+				return &n
+			}
 		}
 	}
 	return nil
@@ -783,6 +1156,25 @@ type Cluster struct {
 	Config ClusterConfig `json:"config" protobuf:"bytes,3,opt,name=config"`
 	// ConnectionState contains information about cluster connection state
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,4,opt,name=connectionState"`
+	// Shard is the shard of the application controller which processes the applications of the cluster. If omitted,
+	// the shard is derived from the server address.
+	Shard *int64 `json:"shard,omitempty" protobuf:"varint,5,opt,name=shard"`
+	// Namespaces is the list of namespaces which are accessible by the credentials of the cluster. If omitted, the
+	// credentials of the cluster have cluster-wide access.
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,6,rep,name=namespaces"`
+}
+
+// IsNamespaceAllowed returns whether the credentials of the cluster have access to the namespace
+func (c *Cluster) IsNamespaceAllowed(namespace string) bool {
+	if len(c.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range c.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
 }
 
 // ClusterList is a collection of Clusters.
@@ -800,6 +1192,22 @@ type AWSAuthConfig struct {
 	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,2,opt,name=roleARN"`
 }
 
+// ExecProviderConfig is config used to call an external command to perform cluster authentication
+// See: https://godoc.org/k8s.io/client-go/tools/clientcmd/api#ExecConfig
+type ExecProviderConfig struct {
+	// Command to execute
+	Command string `json:"command,omitempty" protobuf:"bytes,1,opt,name=command"`
+
+	// Arguments to pass to the command when executing it
+	Args []string `json:"args,omitempty" protobuf:"bytes,2,rep,name=args"`
+
+	// Env defines additional environment variables to expose to the process
+	Env map[string]string `json:"env,omitempty" protobuf:"bytes,3,opt,name=env"`
+
+	// Preferred input version of the ExecInfo
+	APIVersion string `json:"apiVersion,omitempty" protobuf:"bytes,4,opt,name=apiVersion"`
+}
+
 // ClusterConfig is the configuration attributes. This structure is subset of the go-client
 // rest.Config with annotations added for marshalling.
 type ClusterConfig struct {
@@ -817,6 +1225,9 @@ type ClusterConfig struct {
 
 	// AWSAuthConfig contains IAM authentication configuration
 	AWSAuthConfig *AWSAuthConfig `json:"awsAuthConfig,omitempty" protobuf:"bytes,5,opt,name=awsAuthConfig"`
+
+	// ExecProviderConfig contains configuration for an exec provider, e.g. `aws eks get-token`
+	ExecProviderConfig *ExecProviderConfig `json:"execProviderConfig,omitempty" protobuf:"bytes,6,opt,name=execProviderConfig"`
 }
 
 // TLSClientConfig contains settings to enable transport layer security
@@ -872,6 +1283,8 @@ type ResourceActions struct {
 type ResourceActionDefinition struct {
 	Name      string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	ActionLua string `json:"action.lua" yaml:"action.lua" protobuf:"bytes,2,opt,name=actionLua"`
+	// Params are the parameters the action accepts, unless they are declared by the discovery script
+	Params []ResourceActionParam `json:"params,omitempty" protobuf:"bytes,3,rep,name=params"`
 }
 
 type ResourceAction struct {
@@ -879,6 +1292,8 @@ type ResourceAction struct {
 	Params []ResourceActionParam `json:"params,omitempty" protobuf:"bytes,2,rep,name=params"`
 }
 
+// ResourceActionParam is a parameter of a resource action. Its type is one of string (the default), number,
+// integer or boolean.
 type ResourceActionParam struct {
 	Name    string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	Value   string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
@@ -908,6 +1323,26 @@ type Repository struct {
 	TLSClientCertData string `json:"tlsClientCertData,omitempty" protobuf:"bytes,9,opt,name=tlsClientCertData"`
 	// TLS client cert key for authenticating at the repo server
 	TLSClientCertKey string `json:"tlsClientCertKey,omitempty" protobuf:"bytes,10,opt,name=tlsClientCertKey"`
+	// Type of the repo, either "git" (the default) or "helm"
+	Type string `json:"type,omitempty" protobuf:"bytes,11,opt,name=type"`
+	// Name of the repo, used as repository name for Helm chart repositories
+	Name string `json:"name,omitempty" protobuf:"bytes,12,opt,name=name"`
+	// Proxy is the URL of the HTTP(S) proxy used to access the repo, instead of the proxy configured by the environment
+	Proxy string `json:"proxy,omitempty" protobuf:"bytes,13,opt,name=proxy"`
+	// Whether the submodules of the repo should be initialized recursively when checking out a revision
+	EnableSubmodules bool `json:"enableSubmodules,omitempty" protobuf:"bytes,14,opt,name=enableSubmodules"`
+}
+
+const (
+	// RepositoryTypeGit is the type of Git repositories
+	RepositoryTypeGit = "git"
+	// RepositoryTypeHelm is the type of Helm chart repositories
+	RepositoryTypeHelm = "helm"
+)
+
+// IsHelm returns whether the repo is a Helm chart repository
+func (repo *Repository) IsHelm() bool {
+	return repo.Type == RepositoryTypeHelm
 }
 
 func (repo *Repository) IsInsecure() bool {
@@ -918,8 +1353,13 @@ func (repo *Repository) IsLFSEnabled() bool {
 	return repo.EnableLFS
 }
 
+// IsSubmodulesEnabled returns whether the submodules of the repo are initialized on checkout
+func (repo *Repository) IsSubmodulesEnabled() bool {
+	return repo.EnableSubmodules && !repo.IsHelm()
+}
+
 func (m *Repository) HasCredentials() bool {
-	return m.Username != "" || m.Password != "" || m.SSHPrivateKey != "" || m.InsecureIgnoreHostKey
+	return m.Username != "" || m.Password != "" || m.SSHPrivateKey != "" || m.TLSClientCertData != "" || m.TLSClientCertKey != "" || m.InsecureIgnoreHostKey
 }
 
 func (m *Repository) CopyCredentialsFrom(source *Repository) {
@@ -941,6 +1381,30 @@ type RepositoryList struct {
 	Items           []Repository `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// RepoCreds holds a credential template, which is used for all repositories
+// whose URL starts with the URL of the template and which have no credentials
+// configured themselves
+type RepoCreds struct {
+	// URL prefix of the repositories the credentials are used for
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Username for authenticating at the repo server
+	Username string `json:"username,omitempty" protobuf:"bytes,2,opt,name=username"`
+	// Password for authenticating at the repo server
+	Password string `json:"password,omitempty" protobuf:"bytes,3,opt,name=password"`
+	// SSH private key data for authenticating at the repo server
+	SSHPrivateKey string `json:"sshPrivateKey,omitempty" protobuf:"bytes,4,opt,name=sshPrivateKey"`
+	// TLS client cert data for authenticating at the repo server
+	TLSClientCertData string `json:"tlsClientCertData,omitempty" protobuf:"bytes,5,opt,name=tlsClientCertData"`
+	// TLS client cert key for authenticating at the repo server
+	TLSClientCertKey string `json:"tlsClientCertKey,omitempty" protobuf:"bytes,6,opt,name=tlsClientCertKey"`
+}
+
+// RepoCredsList is a collection of credential templates
+type RepoCredsList struct {
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RepoCreds `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // A RepositoryCertificate is either SSH known hosts entry or TLS certificate
 type RepositoryCertificate struct {
 	// Name of the server the certificate is intended for
@@ -953,6 +1417,12 @@ type RepositoryCertificate struct {
 	CertData []byte `json:"certdata" protobuf:"bytes,4,opt,name=certdata"`
 	// Certificate fingerprint
 	CertFingerprint string `json:"certfingerprint" protobuf:"bytes,5,opt,name=certfingerprint"`
+	// Annotations holds additional information about the certificate, e.g. the date it should be rotated by
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,6,opt,name=annotations"`
+	// Start of the validity period of the certificate, only set for https certificates
+	NotBefore *metav1.Time `json:"notBefore,omitempty" protobuf:"bytes,7,opt,name=notBefore"`
+	// End of the validity period of the certificate, only set for https certificates
+	NotAfter *metav1.Time `json:"notAfter,omitempty" protobuf:"bytes,8,opt,name=notAfter"`
 }
 
 // RepositoryCertificateList is a collection of RepositoryCertificates
@@ -962,6 +1432,27 @@ type RepositoryCertificateList struct {
 	Items []RepositoryCertificate `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// GnuPGPublicKey is a GnuPG public key, which may be used for verifying the signatures of revisions
+type GnuPGPublicKey struct {
+	// ID of the key in hexadecimal notation
+	KeyID string `json:"keyID" protobuf:"bytes,1,opt,name=keyID"`
+	// Fingerprint of the key
+	Fingerprint string `json:"fingerprint,omitempty" protobuf:"bytes,2,opt,name=fingerprint"`
+	// Owner of the key, i.e. the first identity of the key
+	Owner string `json:"owner,omitempty" protobuf:"bytes,3,opt,name=owner"`
+	// The sub type of the key, i.e. "rsa4096"
+	SubType string `json:"subType,omitempty" protobuf:"bytes,4,opt,name=subType"`
+	// ASCII armored data of the key
+	KeyData string `json:"keyData,omitempty" protobuf:"bytes,5,opt,name=keyData"`
+}
+
+// GnuPGPublicKeyList is a collection of GnuPGPublicKeys
+type GnuPGPublicKeyList struct {
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of keys
+	Items []GnuPGPublicKey `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // AppProjectList is list of AppProject resources
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type AppProjectList struct {
@@ -1026,6 +1517,21 @@ func (p *AppProject) ValidateProject() error {
 		}
 		srcRepos[src] = true
 	}
+	for i, window := range p.Spec.SyncWindows {
+		if err := window.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "sync window %d is invalid: %v", i, err)
+		}
+	}
+	signatureKeys := make(map[string]bool)
+	for _, key := range p.Spec.SignatureKeys {
+		if !gpg.IsKeyID(key.KeyID) {
+			return status.Errorf(codes.InvalidArgument, "signature key '%s' is not a key ID of 16 hexadecimal digits", key.KeyID)
+		}
+		if _, ok := signatureKeys[key.KeyID]; ok {
+			return status.Errorf(codes.InvalidArgument, "signature key '%s' already added", key.KeyID)
+		}
+		signatureKeys[key.KeyID] = true
+	}
 
 	roleNames := make(map[string]bool)
 	for _, role := range p.Spec.Roles {
@@ -1213,6 +1719,142 @@ type AppProjectSpec struct {
 	ClusterResourceWhitelist []metav1.GroupKind `json:"clusterResourceWhitelist,omitempty" protobuf:"bytes,5,opt,name=clusterResourceWhitelist"`
 	// NamespaceResourceBlacklist contains list of blacklisted namespace level resources
 	NamespaceResourceBlacklist []metav1.GroupKind `json:"namespaceResourceBlacklist,omitempty" protobuf:"bytes,6,opt,name=namespaceResourceBlacklist"`
+	// SignatureKeys contains a list of GnuPG key IDs, one of which the revisions synced to must be signed with
+	SignatureKeys []SignatureKey `json:"signatureKeys,omitempty" protobuf:"bytes,7,rep,name=signatureKeys"`
+	// SyncWindows controls when syncs can be run for the applications of the project
+	SyncWindows SyncWindows `json:"syncWindows,omitempty" protobuf:"bytes,8,rep,name=syncWindows"`
+	// OrphanedResources specifies if controller should monitor orphaned resources of apps in this project
+	OrphanedResources *OrphanedResourcesMonitorSettings `json:"orphanedResources,omitempty" protobuf:"bytes,9,opt,name=orphanedResources"`
+}
+
+// OrphanedResourcesMonitorSettings holds settings of orphaned resources monitoring
+type OrphanedResourcesMonitorSettings struct {
+	// Warn indicates if warning condition should be created for apps which have orphaned resources
+	Warn *bool `json:"warn,omitempty" protobuf:"bytes,1,opt,name=warn"`
+}
+
+// IsWarn returns whether a warning condition should be created for apps which have orphaned resources, which is the default
+func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
+	return s.Warn == nil || *s.Warn
+}
+
+// SignatureKey is the specification of a key, which revisions may be signed with
+type SignatureKey struct {
+	// The ID of the key in hexadecimal notation
+	KeyID string `json:"keyID" protobuf:"bytes,1,opt,name=keyID"`
+}
+
+const (
+	// SyncWindowKindAllow is the kind of sync windows, outside of which no syncs are allowed
+	SyncWindowKindAllow = "allow"
+	// SyncWindowKindDeny is the kind of sync windows, during which no syncs are allowed
+	SyncWindowKindDeny = "deny"
+)
+
+// SyncWindows is a list of sync windows
+type SyncWindows []SyncWindow
+
+// SyncWindow is a recurring time window in which syncs of the matching applications are either allowed or denied
+type SyncWindow struct {
+	// Kind is either allow or deny
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Schedule is the cron schedule of the start of the window, e.g. "0 22 * * *"
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is how long the window lasts after the start, e.g. "1h"
+	Duration string `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// Applications contains glob patterns of the names of the applications the window applies to
+	Applications []string `json:"applications,omitempty" protobuf:"bytes,4,rep,name=applications"`
+	// Namespaces contains glob patterns of the destination namespaces the window applies to
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,5,rep,name=namespaces"`
+	// Clusters contains glob patterns of the destination servers the window applies to
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,6,rep,name=clusters"`
+	// Disabled disables the window, so that it applies to no application
+	Disabled bool `json:"disabled,omitempty" protobuf:"bytes,7,opt,name=disabled"`
+}
+
+// Validate returns an error if the kind, schedule or duration of the window are invalid
+func (w SyncWindow) Validate() error {
+	if w.Kind != SyncWindowKindAllow && w.Kind != SyncWindowKindDeny {
+		return fmt.Errorf("kind '%s' must be either %s or %s", w.Kind, SyncWindowKindAllow, SyncWindowKindDeny)
+	}
+	if _, err := cron.Parse(w.Schedule); err != nil {
+		return err
+	}
+	if duration, err := time.ParseDuration(w.Duration); err != nil {
+		return fmt.Errorf("invalid duration '%s': %v", w.Duration, err)
+	} else if duration <= 0 {
+		return fmt.Errorf("duration '%s' must be positive", w.Duration)
+	}
+	return nil
+}
+
+// IsActive returns whether the window is active at the given time, i.e. whether it started less than its duration
+// before. Invalid windows are never active.
+func (w SyncWindow) IsActive(t time.Time) bool {
+	schedule, err := cron.Parse(w.Schedule)
+	if err != nil {
+		return false
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return false
+	}
+	_, ok := schedule.Prev(t, t.Add(-duration).Add(time.Nanosecond))
+	return ok
+}
+
+// Matches returns whether the window applies to the application, i.e. whether it is enabled and either the name of
+// the application, its destination namespace or its destination server match one of the patterns of the window
+func (w SyncWindow) Matches(app *Application) bool {
+	if w.Disabled {
+		return false
+	}
+	for _, pattern := range w.Applications {
+		if globMatch(pattern, app.Name) {
+			return true
+		}
+	}
+	for _, pattern := range w.Namespaces {
+		if globMatch(pattern, app.Spec.Destination.Namespace) {
+			return true
+		}
+	}
+	for _, pattern := range w.Clusters {
+		if globMatch(pattern, app.Spec.Destination.Server) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns the windows which apply to the application
+func (s SyncWindows) Matches(app *Application) SyncWindows {
+	var matching SyncWindows
+	for _, w := range s {
+		if w.Matches(app) {
+			matching = append(matching, w)
+		}
+	}
+	return matching
+}
+
+// CanSync returns whether the windows allow a sync at the given time. This is the case if no deny window is active,
+// and if there are allow windows, one of them is active.
+func (s SyncWindows) CanSync(t time.Time) bool {
+	hasAllow, allowActive := false, false
+	for _, w := range s {
+		active := w.IsActive(t)
+		switch w.Kind {
+		case SyncWindowKindDeny:
+			if active {
+				return false
+			}
+		case SyncWindowKindAllow:
+			hasAllow = true
+			allowActive = allowActive || active
+		}
+	}
+	return !hasAllow || allowActive
 }
 
 func (d AppProjectSpec) DestinationClusters() []string {
@@ -1225,6 +1867,15 @@ func (d AppProjectSpec) DestinationClusters() []string {
 	return servers
 }
 
+// SignatureKeyIDs returns the IDs of the keys revisions must be signed with, if any
+func (d AppProjectSpec) SignatureKeyIDs() []string {
+	keyIDs := make([]string, 0)
+	for _, k := range d.SignatureKeys {
+		keyIDs = append(keyIDs, k.KeyID)
+	}
+	return keyIDs
+}
+
 // ProjectRole represents a role that has access to a project
 type ProjectRole struct {
 	// Name is a name for this role
@@ -1258,6 +1909,22 @@ type ConfigManagementPlugin struct {
 	Generate Command  `json:"generate" protobuf:"bytes,3,name=generate"`
 }
 
+// KustomizeVersion is a version of kustomize registered in the settings, with the path of its binary in the repo server
+type KustomizeVersion struct {
+	// Name is the name of the version, e.g. v3.5.4
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+	// Path is the path of the kustomize binary of the version
+	Path string `json:"path" protobuf:"bytes,2,name=path"`
+}
+
+// HelmVersion is a version of helm registered in the settings, with the path of its binary in the repo server
+type HelmVersion struct {
+	// Name is the name of the version, e.g. v3.1.2
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+	// Path is the path of the helm binary of the version
+	Path string `json:"path" protobuf:"bytes,2,name=path"`
+}
+
 // ProjectPoliciesString returns Casbin formated string of a project's policies for each role
 func (proj *AppProject) ProjectPoliciesString() string {
 	var policies []string
@@ -1428,6 +2095,16 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 	return false
 }
 
+// IsSourcesPermitted validates if all sources of the provided application spec are allowed sources for the project.
+func (proj AppProject) IsSourcesPermitted(spec ApplicationSpec) bool {
+	for _, src := range spec.GetSources() {
+		if !proj.IsSourcePermitted(src) {
+			return false
+		}
+	}
+	return true
+}
+
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
 func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	for _, item := range proj.Spec.Destinations {
@@ -1468,6 +2145,26 @@ func (c *Cluster) RESTConfig() *rest.Config {
 					Args:       args,
 				},
 			}
+		} else if c.Config.ExecProviderConfig != nil {
+			var env []api.ExecEnvVar
+			for key, value := range c.Config.ExecProviderConfig.Env {
+				env = append(env, api.ExecEnvVar{Name: key, Value: value})
+			}
+			// the environment is sorted, so the configs of the cluster share the cached credentials of the command until
+			// they expire
+			sort.Slice(env, func(i, j int) bool {
+				return env[i].Name < env[j].Name
+			})
+			config = &rest.Config{
+				Host:            c.Server,
+				TLSClientConfig: tlsClientConfig,
+				ExecProvider: &api.ExecConfig{
+					APIVersion: c.Config.ExecProviderConfig.APIVersion,
+					Command:    c.Config.ExecProviderConfig.Command,
+					Args:       c.Config.ExecProviderConfig.Args,
+					Env:        env,
+				},
+			}
 		} else {
 			config = &rest.Config{
 				Host:            c.Server,
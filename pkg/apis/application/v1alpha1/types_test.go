@@ -3,9 +3,12 @@ package v1alpha1
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/clientcmd/api"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -437,6 +440,16 @@ func TestRepository_HasCredentials(t *testing.T) {
 			repo: Repository{InsecureIgnoreHostKey: true},
 			want: true,
 		},
+		{
+			name: "TestHasTLSClientCertData",
+			repo: Repository{TLSClientCertData: "foo"},
+			want: true,
+		},
+		{
+			name: "TestHasTLSClientCertKey",
+			repo: Repository{TLSClientCertKey: "foo"},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -750,6 +763,7 @@ func TestEnvEntry_IsZero(t *testing.T) {
 		{"Empty", &EnvEntry{}, true},
 		{"Name", &EnvEntry{Name: "FOO"}, false},
 		{"Value", &EnvEntry{Value: "foo"}, false},
+		{"ValueFrom", &EnvEntry{ValueFrom: &EnvVarSource{}}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -782,12 +796,189 @@ func TestEnv_Environ(t *testing.T) {
 	}{
 		{"Nil", nil, nil},
 		{"Env", Env{{}}, nil},
-		{"One", Env{{"FOO", "bar"}}, []string{"FOO=bar"}},
-		{"Two", Env{{"FOO", "bar"}, {"FOO", "bar"}}, []string{"FOO=bar", "FOO=bar"}},
+		{"One", Env{{Name: "FOO", Value: "bar"}}, []string{"FOO=bar"}},
+		{"Two", Env{{Name: "FOO", Value: "bar"}, {Name: "FOO", Value: "bar"}}, []string{"FOO=bar", "FOO=bar"}},
+		{"Resolved", Env{{Name: "TOKEN", ValueFrom: &EnvVarSource{SecretKeyRef: &SecretKeySelector{Name: "tokens", Key: "token"}}}}, []string{"TOKEN=secret"}},
+		{"Unresolved", Env{{Name: "OTHER", ValueFrom: &EnvVarSource{SecretKeyRef: &SecretKeySelector{Name: "tokens", Key: "other"}}}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.e.Environ(map[string]string{"TOKEN": "secret"}))
+		})
+	}
+}
+
+func TestEnv_SecretRefs(t *testing.T) {
+	ref := &SecretKeySelector{Name: "tokens", Key: "token"}
+	e := Env{{Name: "FOO", Value: "bar"}, {Name: "TOKEN", ValueFrom: &EnvVarSource{SecretKeyRef: ref}}, nil}
+	assert.Equal(t, map[string]*SecretKeySelector{"TOKEN": ref}, e.SecretRefs())
+}
+
+func TestSyncOperationResource_HasIdentity(t *testing.T) {
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	tests := []struct {
+		name string
+		r    SyncOperationResource
+		want bool
+	}{
+		{"Exact", SyncOperationResource{Group: "apps", Kind: "Deployment", Name: "guestbook-ui"}, true},
+		{"DifferentName", SyncOperationResource{Group: "apps", Kind: "Deployment", Name: "guestbook"}, false},
+		{"BlankGroup", SyncOperationResource{Kind: "Deployment", Name: "guestbook-ui"}, false},
+		{"GlobName", SyncOperationResource{Group: "apps", Kind: "Deployment", Name: "guestbook-*"}, true},
+		{"GlobAll", SyncOperationResource{Group: "*", Kind: "*", Name: "*"}, true},
+		{"GlobKind", SyncOperationResource{Group: "apps", Kind: "Stateful*", Name: "*"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.r.HasIdentity("guestbook-ui", deployment))
+		})
+	}
+}
+
+func TestSyncOperationResource_IsPattern(t *testing.T) {
+	assert.False(t, SyncOperationResource{Group: "apps", Kind: "Deployment", Name: "guestbook-ui"}.IsPattern())
+	assert.True(t, SyncOperationResource{Group: "apps", Kind: "Deployment", Name: "guestbook-*"}.IsPattern())
+	assert.True(t, SyncOperationResource{Kind: "Secret", Name: "guestbook-ui", Exclude: true}.IsPattern())
+}
+
+func TestRetryStrategy_NextRetryAt(t *testing.T) {
+	lastAttempt := time.Now()
+	int64Ptr := func(i int64) *int64 { return &i }
+	tests := []struct {
+		name       string
+		r          RetryStrategy
+		retryCount int64
+		want       time.Duration
+	}{
+		{"Default", RetryStrategy{Limit: 3}, 0, 5 * time.Second},
+		{"DefaultBackoff", RetryStrategy{Limit: 3}, 2, 20 * time.Second},
+		{"DefaultMaxDuration", RetryStrategy{Limit: 10}, 10, 3 * time.Minute},
+		{"Seconds", RetryStrategy{Backoff: &Backoff{Duration: "10"}}, 0, 10 * time.Second},
+		{"Factor", RetryStrategy{Backoff: &Backoff{Duration: "1m", Factor: int64Ptr(3)}}, 1, 3 * time.Minute},
+		{"MaxDuration", RetryStrategy{Backoff: &Backoff{Duration: "1m", MaxDuration: "90s"}}, 1, 90 * time.Second},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, tt.e.Environ())
+			retryAt, err := tt.r.NextRetryAt(lastAttempt, tt.retryCount)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, retryAt.Sub(lastAttempt))
 		})
 	}
 }
+
+func TestRetryStrategy_Validate(t *testing.T) {
+	int64Ptr := func(i int64) *int64 { return &i }
+	assert.NoError(t, (&RetryStrategy{Limit: 1}).Validate())
+	assert.NoError(t, (&RetryStrategy{Limit: 1, Backoff: &Backoff{Duration: "2m", Factor: int64Ptr(2), MaxDuration: "1h"}}).Validate())
+	assert.Error(t, (&RetryStrategy{Backoff: &Backoff{Duration: "two minutes"}}).Validate())
+	assert.Error(t, (&RetryStrategy{Backoff: &Backoff{MaxDuration: "1x"}}).Validate())
+	assert.Error(t, (&RetryStrategy{Backoff: &Backoff{Factor: int64Ptr(0)}}).Validate())
+}
+
+func TestSyncWindow_Validate(t *testing.T) {
+	assert.NoError(t, SyncWindow{Kind: SyncWindowKindAllow, Schedule: "0 22 * * *", Duration: "1h"}.Validate())
+	assert.NoError(t, SyncWindow{Kind: SyncWindowKindDeny, Schedule: "@daily", Duration: "90m"}.Validate())
+	assert.Error(t, SyncWindow{Kind: "block", Schedule: "0 22 * * *", Duration: "1h"}.Validate())
+	assert.Error(t, SyncWindow{Kind: SyncWindowKindAllow, Schedule: "0 22 * *", Duration: "1h"}.Validate())
+	assert.Error(t, SyncWindow{Kind: SyncWindowKindAllow, Schedule: "0 22 * * *", Duration: "1 hour"}.Validate())
+	assert.Error(t, SyncWindow{Kind: SyncWindowKindAllow, Schedule: "0 22 * * *", Duration: "0s"}.Validate())
+}
+
+func TestSyncWindow_IsActive(t *testing.T) {
+	window := SyncWindow{Kind: SyncWindowKindDeny, Schedule: "0 22 * * *", Duration: "8h"}
+	assert.True(t, window.IsActive(time.Date(2019, 10, 14, 22, 0, 0, 0, time.UTC)))
+	assert.True(t, window.IsActive(time.Date(2019, 10, 15, 5, 59, 59, 0, time.UTC)))
+	assert.False(t, window.IsActive(time.Date(2019, 10, 15, 6, 0, 0, 0, time.UTC)))
+	assert.False(t, window.IsActive(time.Date(2019, 10, 14, 21, 59, 59, 0, time.UTC)))
+	assert.False(t, SyncWindow{Kind: SyncWindowKindDeny, Schedule: "invalid", Duration: "8h"}.IsActive(time.Now()))
+}
+
+func TestSyncWindows_Matches(t *testing.T) {
+	app := &Application{
+		ObjectMeta: v1.ObjectMeta{Name: "guestbook"},
+		Spec:       ApplicationSpec{Destination: ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "prod-guestbook"}},
+	}
+	windows := SyncWindows{
+		{Kind: SyncWindowKindDeny, Applications: []string{"guest*"}},
+		{Kind: SyncWindowKindDeny, Namespaces: []string{"prod-*"}},
+		{Kind: SyncWindowKindDeny, Clusters: []string{"https://kubernetes.default.svc"}},
+		{Kind: SyncWindowKindDeny, Applications: []string{"guestbook"}, Disabled: true},
+		{Kind: SyncWindowKindDeny, Applications: []string{"other"}, Namespaces: []string{"dev-*"}},
+	}
+	assert.Equal(t, windows[0:3], windows.Matches(app))
+}
+
+func TestSyncWindows_CanSync(t *testing.T) {
+	now := time.Date(2019, 10, 14, 23, 0, 0, 0, time.UTC)
+	activeAllow := SyncWindow{Kind: SyncWindowKindAllow, Schedule: "0 22 * * *", Duration: "2h"}
+	inactiveAllow := SyncWindow{Kind: SyncWindowKindAllow, Schedule: "0 9 * * *", Duration: "2h"}
+	activeDeny := SyncWindow{Kind: SyncWindowKindDeny, Schedule: "30 22 * * *", Duration: "1h"}
+	inactiveDeny := SyncWindow{Kind: SyncWindowKindDeny, Schedule: "0 9 * * *", Duration: "1h"}
+	tests := []struct {
+		name    string
+		windows SyncWindows
+		want    bool
+	}{
+		{"None", nil, true},
+		{"ActiveAllow", SyncWindows{activeAllow}, true},
+		{"InactiveAllow", SyncWindows{inactiveAllow}, false},
+		{"OneActiveAllow", SyncWindows{inactiveAllow, activeAllow}, true},
+		{"ActiveDeny", SyncWindows{activeDeny}, false},
+		{"InactiveDeny", SyncWindows{inactiveDeny}, true},
+		{"ActiveAllowAndDeny", SyncWindows{activeAllow, activeDeny}, false},
+		{"ActiveAllowInactiveDeny", SyncWindows{activeAllow, inactiveDeny}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.windows.CanSync(now))
+		})
+	}
+}
+
+func TestApplicationTree_FindNode(t *testing.T) {
+	tree := ApplicationTree{
+		Nodes:         []ResourceNode{{ResourceRef: ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "managed"}}},
+		OrphanedNodes: []ResourceNode{{ResourceRef: ResourceRef{Kind: "ConfigMap", Namespace: "default", Name: "orphaned"}}},
+	}
+	assert.Equal(t, &tree.Nodes[0], tree.FindNode("apps", "Deployment", "default", "managed"))
+	assert.Equal(t, &tree.OrphanedNodes[0], tree.FindNode("", "ConfigMap", "default", "orphaned"))
+	assert.Nil(t, tree.FindNode("", "ConfigMap", "default", "managed"))
+}
+
+func TestOrphanedResourcesMonitorSettings_IsWarn(t *testing.T) {
+	assert.True(t, (&OrphanedResourcesMonitorSettings{}).IsWarn())
+	warn := false
+	assert.False(t, (&OrphanedResourcesMonitorSettings{Warn: &warn}).IsWarn())
+	warn = true
+	assert.True(t, (&OrphanedResourcesMonitorSettings{Warn: &warn}).IsWarn())
+}
+
+func TestCluster_RESTConfig_ExecProvider(t *testing.T) {
+	cluster := Cluster{
+		Server: "https://my-eks-cluster",
+		Config: ClusterConfig{
+			TLSClientConfig: TLSClientConfig{CAData: []byte("ca")},
+			ExecProviderConfig: &ExecProviderConfig{
+				APIVersion: "client.authentication.k8s.io/v1alpha1",
+				Command:    "aws",
+				Args:       []string{"eks", "get-token", "--cluster-name", "my-eks-cluster"},
+				Env:        map[string]string{"AWS_REGION": "us-east-1", "AWS_PROFILE": "argocd"},
+			},
+		},
+	}
+	config := cluster.RESTConfig()
+	assert.Empty(t, config.BearerToken)
+	assert.Equal(t, []byte("ca"), config.TLSClientConfig.CAData)
+	assert.Equal(t, &api.ExecConfig{
+		APIVersion: "client.authentication.k8s.io/v1alpha1",
+		Command:    "aws",
+		Args:       []string{"eks", "get-token", "--cluster-name", "my-eks-cluster"},
+		Env:        []api.ExecEnvVar{{Name: "AWS_PROFILE", Value: "argocd"}, {Name: "AWS_REGION", Value: "us-east-1"}},
+	}, config.ExecProvider)
+
+	cluster.Config.ExecProviderConfig = nil
+	cluster.Config.AWSAuthConfig = &AWSAuthConfig{ClusterName: "my-eks-cluster", RoleARN: "arn:aws:iam::123456789012:role/argocd"}
+	config = cluster.RESTConfig()
+	assert.Equal(t, "aws-iam-authenticator", config.ExecProvider.Command)
+	assert.Equal(t, []string{"token", "-i", "my-eks-cluster", "-r", "arn:aws:iam::123456789012:role/argocd"}, config.ExecProvider.Args)
+}
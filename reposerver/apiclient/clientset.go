@@ -2,15 +2,35 @@ package apiclient
 
 import (
 	"crypto/tls"
+	"net"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/git"
 	argogrpc "github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/hash"
+)
+
+const (
+	// dnsAddressPrefix is the prefix of addresses whose host is resolved to the addresses of all replicas, e.g. the
+	// ready pods of a headless service
+	dnsAddressPrefix = "dns:///"
+	// replicasResolveInterval is how long the resolved addresses of the replicas are used, before they are resolved again
+	replicasResolveInterval = 30 * time.Second
+	// replicaUnhealthyDuration is how long a replica which was unavailable is tried only after the healthy replicas
+	replicaUnhealthyDuration = 30 * time.Second
 )
 
 // Clientset represets repository server api clients
@@ -19,11 +39,32 @@ type Clientset interface {
 }
 
 type clientSet struct {
+	// the addresses of the repo server, separated by commas
 	address        string
 	timeoutSeconds int
+	lookupHost     func(host string) ([]string, error)
+
+	lock       sync.Mutex
+	replicas   []string
+	resolvedAt time.Time
+	// the times until which replicas are considered unhealthy, by their addresses
+	unhealthy map[string]time.Time
 }
 
 func (c *clientSet) NewRepoServerClient() (util.Closer, RepoServerServiceClient, error) {
+	replicas := c.getReplicas()
+	if len(replicas) == 1 {
+		conn, err := c.dial(replicas[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		return conn, NewRepoServerServiceClient(conn), nil
+	}
+	client := &shardedClient{clientset: c, replicas: replicas, clients: map[string]RepoServerServiceClient{}}
+	return client, client, nil
+}
+
+func (c *clientSet) dial(address string) (*grpc.ClientConn, error) {
 	retryOpts := []grpc_retry.CallOption{
 		grpc_retry.WithMax(3),
 		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000 * time.Millisecond)),
@@ -35,15 +76,200 @@ func (c *clientSet) NewRepoServerClient() (util.Closer, RepoServerServiceClient,
 	if c.timeoutSeconds > 0 {
 		opts = append(opts, grpc.WithUnaryInterceptor(argogrpc.WithTimeout(time.Duration(c.timeoutSeconds)*time.Second)))
 	}
-	conn, err := grpc.Dial(c.address, opts...)
+	conn, err := grpc.Dial(address, opts...)
+	if err != nil {
+		log.Errorf("Unable to connect to repository service with address %s", address)
+		return nil, err
+	}
+	return conn, nil
+}
+
+// getReplicas returns the addresses of the replicas of the repo server. The addresses with the dns:/// prefix are
+// resolved to the addresses of their host, which are resolved again periodically.
+func (c *clientSet) getReplicas() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.replicas != nil && time.Since(c.resolvedAt) < replicasResolveInterval {
+		return c.replicas
+	}
+	var replicas []string
+	for _, address := range strings.Split(c.address, ",") {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+		if !strings.HasPrefix(address, dnsAddressPrefix) {
+			replicas = append(replicas, address)
+			continue
+		}
+		address = strings.TrimPrefix(address, dnsAddressPrefix)
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			log.Warnf("Invalid repo server address %s: %v", address, err)
+			replicas = append(replicas, address)
+			continue
+		}
+		hosts, err := c.lookupHost(host)
+		if err != nil || len(hosts) == 0 {
+			// the host itself is used until it resolves, e.g. since no replica is ready yet
+			log.Warnf("Unable to resolve the replicas of repo server %s: %v", host, err)
+			replicas = append(replicas, address)
+			continue
+		}
+		sort.Strings(hosts)
+		for _, h := range hosts {
+			replicas = append(replicas, net.JoinHostPort(h, port))
+		}
+	}
+	c.replicas = replicas
+	c.resolvedAt = time.Now()
+	return replicas
+}
+
+// replicasFor returns the replicas in the order they are tried for the requests of a repo. The replicas are ordered by
+// rendezvous hashing of the repo, so the requests of a repo go to the same replica as long as it is healthy, and the
+// repos of a replica which is removed are distributed evenly over the remaining replicas. Unhealthy replicas are only
+// tried after the healthy replicas.
+func (c *clientSet) replicasFor(replicas []string, repoURL string) []string {
+	key := git.NormalizeGitURL(repoURL)
+	weights := make(map[string]uint32, len(replicas))
+	for _, replica := range replicas {
+		weights[replica] = hash.FNVa(replica + "|" + key)
+	}
+	c.lock.Lock()
+	unhealthy := make(map[string]bool)
+	for replica, until := range c.unhealthy {
+		if time.Now().Before(until) {
+			unhealthy[replica] = true
+		}
+	}
+	c.lock.Unlock()
+	ordered := append([]string{}, replicas...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if unhealthy[ordered[i]] != unhealthy[ordered[j]] {
+			return !unhealthy[ordered[i]]
+		}
+		return weights[ordered[i]] > weights[ordered[j]]
+	})
+	return ordered
+}
+
+func (c *clientSet) setUnhealthy(replica string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.unhealthy[replica] = time.Now().Add(replicaUnhealthyDuration)
+}
+
+// shardedClient sends the requests of each repo to the same replica of the repo server, so the replica's checkouts of
+// the repo stay warm. If the replica is unavailable, the requests fail over to the next replica of the repo.
+type shardedClient struct {
+	clientset *clientSet
+	replicas  []string
+	lock      sync.Mutex
+	// the clients of the replicas which were called, and their connections
+	clients map[string]RepoServerServiceClient
+	conns   []*grpc.ClientConn
+}
+
+func (c *shardedClient) client(replica string) (RepoServerServiceClient, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if client, ok := c.clients[replica]; ok {
+		return client, nil
+	}
+	conn, err := c.clientset.dial(replica)
 	if err != nil {
-		log.Errorf("Unable to connect to repository service with address %s", c.address)
-		return nil, nil, err
+		return nil, err
+	}
+	c.conns = append(c.conns, conn)
+	client := NewRepoServerServiceClient(conn)
+	c.clients[replica] = client
+	return client, nil
+}
+
+// invoke calls the replica of the repo, failing over to the next replicas while they are unavailable
+func (c *shardedClient) invoke(repo *v1alpha1.Repository, call func(client RepoServerServiceClient) error) error {
+	repoURL := ""
+	if repo != nil {
+		repoURL = repo.Repo
+	}
+	var err error
+	for _, replica := range c.clientset.replicasFor(c.replicas, repoURL) {
+		var client RepoServerServiceClient
+		client, err = c.client(replica)
+		if err == nil {
+			err = call(client)
+			if status.Code(err) != codes.Unavailable {
+				return err
+			}
+		}
+		log.Warnf("Repo server replica %s is unavailable: %v", replica, err)
+		c.clientset.setUnhealthy(replica)
+	}
+	return err
+}
+
+func (c *shardedClient) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var err error
+	for _, conn := range c.conns {
+		if closeErr := conn.Close(); closeErr != nil {
+			err = closeErr
+		}
 	}
-	return conn, NewRepoServerServiceClient(conn), nil
+	return err
+}
+
+func (c *shardedClient) GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error) {
+	var res *ManifestResponse
+	err := c.invoke(in.Repo, func(client RepoServerServiceClient) (err error) {
+		res, err = client.GenerateManifest(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+func (c *shardedClient) ListDir(ctx context.Context, in *ListDirRequest, opts ...grpc.CallOption) (*FileList, error) {
+	var res *FileList
+	err := c.invoke(in.Repo, func(client RepoServerServiceClient) (err error) {
+		res, err = client.ListDir(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+func (c *shardedClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error) {
+	var res *GetFileResponse
+	err := c.invoke(in.Repo, func(client RepoServerServiceClient) (err error) {
+		res, err = client.GetFile(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+func (c *shardedClient) GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error) {
+	var res *RepoAppDetailsResponse
+	err := c.invoke(in.Repo, func(client RepoServerServiceClient) (err error) {
+		res, err = client.GetAppDetails(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+func (c *shardedClient) GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
+	var res *v1alpha1.RevisionMetadata
+	err := c.invoke(in.Repo, func(client RepoServerServiceClient) (err error) {
+		res, err = client.GetRevisionMetadata(ctx, in, opts...)
+		return err
+	})
+	return res, err
 }
 
-// NewRepoServerClientset creates new instance of repo server Clientset
+// NewRepoServerClientset creates new instance of repo server Clientset. The address may be a comma separated list of
+// the addresses of multiple replicas of the repo server, and addresses with the prefix dns:/// are resolved to the
+// addresses of all replicas, e.g. dns:///argocd-repo-server-headless:8081. The requests of each repo are sent to the
+// same replica.
 func NewRepoServerClientset(address string, timeoutSeconds int) Clientset {
-	return &clientSet{address: address, timeoutSeconds: timeoutSeconds}
+	return &clientSet{address: address, timeoutSeconds: timeoutSeconds, lookupHost: net.LookupHost, unhealthy: map[string]time.Time{}}
 }
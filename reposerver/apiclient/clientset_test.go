@@ -0,0 +1,120 @@
+package apiclient
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newTestClientset(address string, hosts map[string][]string) *clientSet {
+	c := NewRepoServerClientset(address, 0).(*clientSet)
+	c.lookupHost = func(host string) ([]string, error) {
+		if addresses, ok := hosts[host]; ok {
+			return addresses, nil
+		}
+		return nil, fmt.Errorf("no such host %s", host)
+	}
+	return c
+}
+
+func TestGetReplicas(t *testing.T) {
+	assert.Equal(t, []string{"argocd-repo-server:8081"}, newTestClientset("argocd-repo-server:8081", nil).getReplicas())
+	assert.Equal(t, []string{"repo-server-0:8081", "repo-server-1:8081"}, newTestClientset("repo-server-0:8081, repo-server-1:8081", nil).getReplicas())
+
+	// the host of a dns:/// address is resolved to the addresses of the replicas
+	c := newTestClientset("dns:///argocd-repo-server-headless:8081", map[string][]string{"argocd-repo-server-headless": {"10.0.0.2", "10.0.0.1"}})
+	assert.Equal(t, []string{"10.0.0.1:8081", "10.0.0.2:8081"}, c.getReplicas())
+
+	// the host itself is used until it resolves
+	c = newTestClientset("dns:///argocd-repo-server-headless:8081", nil)
+	assert.Equal(t, []string{"argocd-repo-server-headless:8081"}, c.getReplicas())
+}
+
+func TestReplicasFor(t *testing.T) {
+	c := newTestClientset("", nil)
+	replicas := []string{"10.0.0.1:8081", "10.0.0.2:8081", "10.0.0.3:8081"}
+
+	// the requests of a repo go to the same replica, regardless of the form of its URL
+	assert.Equal(t, c.replicasFor(replicas, "https://github.com/argoproj/argocd-example-apps.git"), c.replicasFor(replicas, "https://github.com/argoproj/argocd-example-apps"))
+
+	// the repos are distributed over the replicas, and only the repos of a removed replica move to other replicas
+	counts := map[string]int{}
+	for i := 0; i < 300; i++ {
+		repoURL := fmt.Sprintf("https://git.example.com/repo-%d.git", i)
+		ordered := c.replicasFor(replicas, repoURL)
+		counts[ordered[0]]++
+		if ordered[0] != replicas[2] {
+			assert.Equal(t, ordered[0], c.replicasFor(replicas[:2], repoURL)[0])
+		}
+	}
+	for _, replica := range replicas {
+		assert.True(t, counts[replica] > 50, "replica %s serves %d repos", replica, counts[replica])
+	}
+
+	// unhealthy replicas are tried last
+	ordered := c.replicasFor(replicas, "https://git.example.com/repo.git")
+	c.setUnhealthy(ordered[0])
+	assert.Equal(t, append(ordered[1:], ordered[0]), c.replicasFor(replicas, "https://git.example.com/repo.git"))
+}
+
+type fakeRepoServerServiceClient struct {
+	RepoServerServiceClient
+	err   error
+	calls int
+}
+
+func (c *fakeRepoServerServiceClient) GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &ManifestResponse{Revision: in.Revision}, nil
+}
+
+func TestShardedClient(t *testing.T) {
+	c := newTestClientset("10.0.0.1:8081,10.0.0.2:8081", nil)
+	_, client, err := c.NewRepoServerClient()
+	assert.NoError(t, err)
+	sharded := client.(*shardedClient)
+	q := &ManifestRequest{Repo: &v1alpha1.Repository{Repo: "https://git.example.com/repo.git"}, Revision: "HEAD"}
+	ordered := c.replicasFor(sharded.replicas, q.Repo.Repo)
+	preferred := &fakeRepoServerServiceClient{}
+	other := &fakeRepoServerServiceClient{}
+	sharded.clients[ordered[0]] = preferred
+	sharded.clients[ordered[1]] = other
+
+	res, err := sharded.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, "HEAD", res.Revision)
+	assert.Equal(t, 1, preferred.calls)
+	assert.Equal(t, 0, other.calls)
+
+	// errors other than unavailability are returned as they are
+	preferred.err = status.Errorf(codes.InvalidArgument, "invalid")
+	_, err = sharded.GenerateManifest(context.Background(), q)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 0, other.calls)
+
+	// requests fail over to the next replica while the replica is unavailable
+	preferred.err = status.Errorf(codes.Unavailable, "connection refused")
+	res, err = sharded.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, "HEAD", res.Revision)
+	assert.Equal(t, 1, other.calls)
+	_, err = sharded.GenerateManifest(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, preferred.calls)
+	assert.Equal(t, 2, other.calls)
+
+	// the error of the last replica is returned if all replicas are unavailable
+	other.err = status.Errorf(codes.Unavailable, "connection refused")
+	_, err = sharded.GenerateManifest(context.Background(), q)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
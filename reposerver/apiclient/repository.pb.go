@@ -29,25 +29,44 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // ManifestRequest is a query for manifest generation.
 type ManifestRequest struct {
-	Repo                 *v1alpha1.Repository               `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
-	Revision             string                             `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
-	NoCache              bool                               `protobuf:"varint,3,opt,name=noCache,proto3" json:"noCache,omitempty"`
-	AppLabelKey          string                             `protobuf:"bytes,4,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
-	AppLabelValue        string                             `protobuf:"bytes,5,opt,name=appLabelValue,proto3" json:"appLabelValue,omitempty"`
-	Namespace            string                             `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ApplicationSource    *v1alpha1.ApplicationSource        `protobuf:"bytes,10,opt,name=applicationSource" json:"applicationSource,omitempty"`
-	HelmRepos            []*v1alpha1.HelmRepository         `protobuf:"bytes,11,rep,name=helmRepos" json:"helmRepos,omitempty"`
-	Plugins              []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,12,rep,name=plugins" json:"plugins,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+	Repo              *v1alpha1.Repository               `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Revision          string                             `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	NoCache           bool                               `protobuf:"varint,3,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	AppLabelKey       string                             `protobuf:"bytes,4,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
+	AppLabelValue     string                             `protobuf:"bytes,5,opt,name=appLabelValue,proto3" json:"appLabelValue,omitempty"`
+	Namespace         string                             `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ApplicationSource *v1alpha1.ApplicationSource        `protobuf:"bytes,10,opt,name=applicationSource" json:"applicationSource,omitempty"`
+	HelmRepos         []*v1alpha1.HelmRepository         `protobuf:"bytes,11,rep,name=helmRepos" json:"helmRepos,omitempty"`
+	Plugins           []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,12,rep,name=plugins" json:"plugins,omitempty"`
+	// Whether the revision must be signed with one of the signature keys
+	VerifySignature bool `protobuf:"varint,13,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
+	// ASCII armored GnuPG public keys, which the revision may be signed with
+	SignatureKeys []string `protobuf:"bytes,14,rep,name=signatureKeys" json:"signatureKeys,omitempty"`
+	// Credential templates used for the submodules of the repo, if submodules are enabled
+	SubmoduleCreds []*v1alpha1.RepoCreds `protobuf:"bytes,15,rep,name=submoduleCreds" json:"submoduleCreds,omitempty"`
+	// The ref sources of an application with multiple sources by their ref, which the Helm value files may refer to
+	RefSources map[string]*RefTarget `protobuf:"bytes,16,rep,name=refSources" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	// The kustomize versions registered in the settings, which applications may select
+	KustomizeVersions []*v1alpha1.KustomizeVersion `protobuf:"bytes,17,rep,name=kustomizeVersions" json:"kustomizeVersions,omitempty"`
+	// The helm versions registered in the settings, which applications may select
+	HelmVersions []*v1alpha1.HelmVersion `protobuf:"bytes,18,rep,name=helmVersions" json:"helmVersions,omitempty"`
+	// The jsonnet library search dirs of all applications, relative to the root of the repository
+	JsonnetLibs []string `protobuf:"bytes,19,rep,name=jsonnetLibs" json:"jsonnetLibs,omitempty"`
+	// The values of the environment variables of the application source which are sourced from secrets, by the names
+	// of the variables
+	EnvFromSecrets map[string]string `protobuf:"bytes,20,rep,name=envFromSecrets" json:"envFromSecrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The server URL of the destination cluster of the application
+	DestServer           string   `protobuf:"bytes,21,opt,name=destServer,proto3" json:"destServer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
 func (m *ManifestRequest) String() string { return proto.CompactTextString(m) }
 func (*ManifestRequest) ProtoMessage()    {}
 func (*ManifestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{0}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{0}
 }
 func (m *ManifestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,6 +158,125 @@ func (m *ManifestRequest) GetPlugins() []*v1alpha1.ConfigManagementPlugin {
 	return nil
 }
 
+func (m *ManifestRequest) GetVerifySignature() bool {
+	if m != nil {
+		return m.VerifySignature
+	}
+	return false
+}
+
+func (m *ManifestRequest) GetSignatureKeys() []string {
+	if m != nil {
+		return m.SignatureKeys
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetSubmoduleCreds() []*v1alpha1.RepoCreds {
+	if m != nil {
+		return m.SubmoduleCreds
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetRefSources() map[string]*RefTarget {
+	if m != nil {
+		return m.RefSources
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetKustomizeVersions() []*v1alpha1.KustomizeVersion {
+	if m != nil {
+		return m.KustomizeVersions
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetHelmVersions() []*v1alpha1.HelmVersion {
+	if m != nil {
+		return m.HelmVersions
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetJsonnetLibs() []string {
+	if m != nil {
+		return m.JsonnetLibs
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetEnvFromSecrets() map[string]string {
+	if m != nil {
+		return m.EnvFromSecrets
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetDestServer() string {
+	if m != nil {
+		return m.DestServer
+	}
+	return ""
+}
+
+// RefTarget is a ref source of an application with multiple sources
+type RefTarget struct {
+	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Revision             string               `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RefTarget) Reset()         { *m = RefTarget{} }
+func (m *RefTarget) String() string { return proto.CompactTextString(m) }
+func (*RefTarget) ProtoMessage()    {}
+func (*RefTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_repository_9bb513bd838310b2, []int{1}
+}
+func (m *RefTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RefTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefTarget.Merge(dst, src)
+}
+func (m *RefTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefTarget proto.InternalMessageInfo
+
+func (m *RefTarget) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RefTarget) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type ManifestResponse struct {
 	Manifests            []string `protobuf:"bytes,1,rep,name=manifests" json:"manifests,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -154,7 +292,7 @@ func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{1}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{2}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +370,7 @@ func (m *ListDirRequest) Reset()         { *m = ListDirRequest{} }
 func (m *ListDirRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDirRequest) ProtoMessage()    {}
 func (*ListDirRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{2}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{3}
 }
 func (m *ListDirRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +432,7 @@ func (m *FileList) Reset()         { *m = FileList{} }
 func (m *FileList) String() string { return proto.CompactTextString(m) }
 func (*FileList) ProtoMessage()    {}
 func (*FileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{3}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{4}
 }
 func (m *FileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +482,7 @@ func (m *GetFileRequest) Reset()         { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()    {}
 func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{4}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{5}
 }
 func (m *GetFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +544,7 @@ func (m *GetFileResponse) Reset()         { *m = GetFileResponse{} }
 func (m *GetFileResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFileResponse) ProtoMessage()    {}
 func (*GetFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{5}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{6}
 }
 func (m *GetFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,23 +582,27 @@ func (m *GetFileResponse) GetData() []byte {
 
 // RepoServerAppDetailsQuery contains query information for app details request
 type RepoServerAppDetailsQuery struct {
-	Repo                 *v1alpha1.Repository               `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
-	Revision             string                             `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
-	Path                 string                             `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	HelmRepos            []*v1alpha1.HelmRepository         `protobuf:"bytes,4,rep,name=helmRepos" json:"helmRepos,omitempty"`
-	Plugins              []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,5,rep,name=plugins" json:"plugins,omitempty"`
-	Helm                 *HelmAppDetailsQuery               `protobuf:"bytes,6,opt,name=helm" json:"helm,omitempty"`
-	Ksonnet              *KsonnetAppDetailsQuery            `protobuf:"bytes,7,opt,name=ksonnet" json:"ksonnet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+	Repo      *v1alpha1.Repository               `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Revision  string                             `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Path      string                             `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	HelmRepos []*v1alpha1.HelmRepository         `protobuf:"bytes,4,rep,name=helmRepos" json:"helmRepos,omitempty"`
+	Plugins   []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,5,rep,name=plugins" json:"plugins,omitempty"`
+	Helm      *HelmAppDetailsQuery               `protobuf:"bytes,6,opt,name=helm" json:"helm,omitempty"`
+	Ksonnet   *KsonnetAppDetailsQuery            `protobuf:"bytes,7,opt,name=ksonnet" json:"ksonnet,omitempty"`
+	// Credential templates used for the submodules of the repo, if submodules are enabled
+	SubmoduleCreds []*v1alpha1.RepoCreds `protobuf:"bytes,8,rep,name=submoduleCreds" json:"submoduleCreds,omitempty"`
+	// The helm versions registered in the settings, which the Helm query may select
+	HelmVersions         []*v1alpha1.HelmVersion `protobuf:"bytes,9,rep,name=helmVersions" json:"helmVersions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQuery{} }
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{6}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{7}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -538,8 +680,24 @@ func (m *RepoServerAppDetailsQuery) GetKsonnet() *KsonnetAppDetailsQuery {
 	return nil
 }
 
+func (m *RepoServerAppDetailsQuery) GetSubmoduleCreds() []*v1alpha1.RepoCreds {
+	if m != nil {
+		return m.SubmoduleCreds
+	}
+	return nil
+}
+
+func (m *RepoServerAppDetailsQuery) GetHelmVersions() []*v1alpha1.HelmVersion {
+	if m != nil {
+		return m.HelmVersions
+	}
+	return nil
+}
+
 type HelmAppDetailsQuery struct {
-	ValueFiles           []string `protobuf:"bytes,1,rep,name=valueFiles" json:"valueFiles,omitempty"`
+	ValueFiles []string `protobuf:"bytes,1,rep,name=valueFiles" json:"valueFiles,omitempty"`
+	// The name of the registered helm version to use, if not the bundled helm
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -549,7 +707,7 @@ func (m *HelmAppDetailsQuery) Reset()         { *m = HelmAppDetailsQuery{} }
 func (m *HelmAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*HelmAppDetailsQuery) ProtoMessage()    {}
 func (*HelmAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{7}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{8}
 }
 func (m *HelmAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,6 +743,13 @@ func (m *HelmAppDetailsQuery) GetValueFiles() []string {
 	return nil
 }
 
+func (m *HelmAppDetailsQuery) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 type KsonnetAppDetailsQuery struct {
 	Environment          string   `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -596,7 +761,7 @@ func (m *KsonnetAppDetailsQuery) Reset()         { *m = KsonnetAppDetailsQuery{}
 func (m *KsonnetAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*KsonnetAppDetailsQuery) ProtoMessage()    {}
 func (*KsonnetAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{8}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{9}
 }
 func (m *KsonnetAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +813,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{9}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{10}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,7 +891,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{10}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{11}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +950,7 @@ func (m *KsonnetAppSpec) Reset()         { *m = KsonnetAppSpec{} }
 func (m *KsonnetAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KsonnetAppSpec) ProtoMessage()    {}
 func (*KsonnetAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{11}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{12}
 }
 func (m *KsonnetAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,20 +1009,22 @@ func (m *KsonnetAppSpec) GetParameters() []*v1alpha1.KsonnetParameter {
 
 // HelmAppSpec contains helm app name and path in source repo
 type HelmAppSpec struct {
-	Name                 string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path                 string                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	ValueFiles           []string                  `protobuf:"bytes,3,rep,name=valueFiles" json:"valueFiles,omitempty"`
-	Parameters           []*v1alpha1.HelmParameter `protobuf:"bytes,4,rep,name=parameters" json:"parameters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Name       string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path       string                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	ValueFiles []string                  `protobuf:"bytes,3,rep,name=valueFiles" json:"valueFiles,omitempty"`
+	Parameters []*v1alpha1.HelmParameter `protobuf:"bytes,4,rep,name=parameters" json:"parameters,omitempty"`
+	// The version of the helm binary the chart was inspected with, e.g. v3.1.2+gd878d4d
+	Version              string   `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{12}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{13}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,6 +1081,13 @@ func (m *HelmAppSpec) GetParameters() []*v1alpha1.HelmParameter {
 	return nil
 }
 
+func (m *HelmAppSpec) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 // KustomizeAppSpec contains kustomize app name and path in source repo
 type KustomizeAppSpec struct {
 	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -930,7 +1104,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{13}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{14}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1172,7 @@ func (m *KsonnetEnvironment) Reset()         { *m = KsonnetEnvironment{} }
 func (m *KsonnetEnvironment) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironment) ProtoMessage()    {}
 func (*KsonnetEnvironment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{14}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{15}
 }
 func (m *KsonnetEnvironment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1069,7 +1243,7 @@ func (m *KsonnetEnvironmentDestination) Reset()         { *m = KsonnetEnvironmen
 func (m *KsonnetEnvironmentDestination) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironmentDestination) ProtoMessage()    {}
 func (*KsonnetEnvironmentDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{15}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{16}
 }
 func (m *KsonnetEnvironmentDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1297,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_repository_59158ed504c6ff1c, []int{16}
+	return fileDescriptor_repository_9bb513bd838310b2, []int{17}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1154,6 +1328,9 @@ var xxx_messageInfo_DirectoryAppSpec proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
+	proto.RegisterMapType((map[string]string)(nil), "repository.ManifestRequest.EnvFromSecretsEntry")
+	proto.RegisterMapType((map[string]*RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
+	proto.RegisterType((*RefTarget)(nil), "repository.RefTarget")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
 	proto.RegisterType((*ListDirRequest)(nil), "repository.ListDirRequest")
 	proto.RegisterType((*FileList)(nil), "repository.FileList")
@@ -1480,6 +1657,182 @@ func (m *ManifestRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.VerifySignature {
+		dAtA[i] = 0x68
+		i++
+		if m.VerifySignature {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.SignatureKeys) > 0 {
+		for _, s := range m.SignatureKeys {
+			dAtA[i] = 0x72
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.SubmoduleCreds) > 0 {
+		for _, msg := range m.SubmoduleCreds {
+			dAtA[i] = 0x7a
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RefSources) > 0 {
+		for k, _ := range m.RefSources {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.RefSources[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovRepository(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovRepository(uint64(len(k))) + msgSize
+			i = encodeVarintRepository(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintRepository(dAtA, i, uint64(v.Size()))
+				n3, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n3
+			}
+		}
+	}
+	if len(m.KustomizeVersions) > 0 {
+		for _, msg := range m.KustomizeVersions {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.HelmVersions) > 0 {
+		for _, msg := range m.HelmVersions {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.JsonnetLibs) > 0 {
+		for _, s := range m.JsonnetLibs {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.EnvFromSecrets) > 0 {
+		for k, _ := range m.EnvFromSecrets {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.EnvFromSecrets[k]
+			mapSize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			i = encodeVarintRepository(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.DestServer) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.DestServer)))
+		i += copy(dAtA[i:], m.DestServer)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RefTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefTarget) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
+		n4, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1565,11 +1918,11 @@ func (m *ListDirRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n3, err := m.Repo.MarshalTo(dAtA[i:])
+		n5, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n5
 	}
 	if len(m.Revision) > 0 {
 		dAtA[i] = 0x12
@@ -1644,11 +1997,11 @@ func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n4, err := m.Repo.MarshalTo(dAtA[i:])
+		n6, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	if len(m.Revision) > 0 {
 		dAtA[i] = 0x12
@@ -1714,11 +2067,11 @@ func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n5, err := m.Repo.MarshalTo(dAtA[i:])
+		n7, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if len(m.Revision) > 0 {
 		dAtA[i] = 0x12
@@ -1760,24 +2113,48 @@ func (m *RepoServerAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Helm.Size()))
-		n6, err := m.Helm.MarshalTo(dAtA[i:])
+		n8, err := m.Helm.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n8
 	}
 	if m.Ksonnet != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Ksonnet.Size()))
-		n7, err := m.Ksonnet.MarshalTo(dAtA[i:])
+		n9, err := m.Ksonnet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.SubmoduleCreds) > 0 {
+		for _, msg := range m.SubmoduleCreds {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.HelmVersions) > 0 {
+		for _, msg := range m.HelmVersions {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
@@ -1812,6 +2189,12 @@ func (m *HelmAppDetailsQuery) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -1870,41 +2253,41 @@ func (m *RepoAppDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Ksonnet.Size()))
-		n8, err := m.Ksonnet.MarshalTo(dAtA[i:])
+		n10, err := m.Ksonnet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	if m.Helm != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Helm.Size()))
-		n9, err := m.Helm.MarshalTo(dAtA[i:])
+		n11, err := m.Helm.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	if m.Kustomize != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Kustomize.Size()))
-		n10, err := m.Kustomize.MarshalTo(dAtA[i:])
+		n12, err := m.Kustomize.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.Directory != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Directory.Size()))
-		n11, err := m.Directory.MarshalTo(dAtA[i:])
+		n13, err := m.Directory.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1931,11 +2314,11 @@ func (m *RepoServerRevisionMetadataRequest) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Repo.Size()))
-		n12, err := m.Repo.MarshalTo(dAtA[i:])
+		n14, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n14
 	}
 	if len(m.Revision) > 0 {
 		dAtA[i] = 0x12
@@ -1996,11 +2379,11 @@ func (m *KsonnetAppSpec) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintRepository(dAtA, i, uint64(v.Size()))
-				n13, err := v.MarshalTo(dAtA[i:])
+				n15, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n13
+				i += n15
 			}
 		}
 	}
@@ -2076,6 +2459,12 @@ func (m *HelmAppSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2173,11 +2562,11 @@ func (m *KsonnetEnvironment) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRepository(dAtA, i, uint64(m.Destination.Size()))
-		n14, err := m.Destination.MarshalTo(dAtA[i:])
+		n16, err := m.Destination.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n16
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2290,6 +2679,81 @@ func (m *ManifestRequest) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if m.VerifySignature {
+		n += 2
+	}
+	if len(m.SignatureKeys) > 0 {
+		for _, s := range m.SignatureKeys {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.SubmoduleCreds) > 0 {
+		for _, e := range m.SubmoduleCreds {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.RefSources) > 0 {
+		for k, v := range m.RefSources {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRepository(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if len(m.KustomizeVersions) > 0 {
+		for _, e := range m.KustomizeVersions {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.HelmVersions) > 0 {
+		for _, e := range m.HelmVersions {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.JsonnetLibs) > 0 {
+		for _, s := range m.JsonnetLibs {
+			l = len(s)
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.EnvFromSecrets) > 0 {
+		for k, v := range m.EnvFromSecrets {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			n += mapEntrySize + 2 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.DestServer)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefTarget) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2432,6 +2896,18 @@ func (m *RepoServerAppDetailsQuery) Size() (n int) {
 		l = m.Ksonnet.Size()
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if len(m.SubmoduleCreds) > 0 {
+		for _, e := range m.SubmoduleCreds {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.HelmVersions) > 0 {
+		for _, e := range m.HelmVersions {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2447,6 +2923,10 @@ func (m *HelmAppDetailsQuery) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2571,6 +3051,10 @@ func (m *HelmAppSpec) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2796,19 +3280,584 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLabelValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationSource == nil {
+				m.ApplicationSource = &v1alpha1.ApplicationSource{}
+			}
+			if err := m.ApplicationSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmRepos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmRepos = append(m.HelmRepos, &v1alpha1.HelmRepository{})
+			if err := m.HelmRepos[len(m.HelmRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plugins = append(m.Plugins, &v1alpha1.ConfigManagementPlugin{})
+			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifySignature", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifySignature = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureKeys = append(m.SignatureKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmoduleCreds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmoduleCreds = append(m.SubmoduleCreds, &v1alpha1.RepoCreds{})
+			if err := m.SubmoduleCreds[len(m.SubmoduleCreds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RefSources == nil {
+				m.RefSources = make(map[string]*RefTarget)
+			}
+			var mapkey string
+			var mapvalue *RefTarget
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RefTarget{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RefSources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KustomizeVersions = append(m.KustomizeVersions, &v1alpha1.KustomizeVersion{})
+			if err := m.KustomizeVersions[len(m.KustomizeVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmVersions = append(m.HelmVersions, &v1alpha1.HelmVersion{})
+			if err := m.HelmVersions[len(m.HelmVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonnetLibs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonnetLibs = append(m.JsonnetLibs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFromSecrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnvFromSecrets == nil {
+				m.EnvFromSecrets = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EnvFromSecrets[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestServer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2833,73 +3882,62 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppLabelValue = string(dAtA[iNdEx:postIndex])
+			m.DestServer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSource", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplicationSource == nil {
-				m.ApplicationSource = &v1alpha1.ApplicationSource{}
-			}
-			if err := m.ApplicationSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HelmRepos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2923,16 +3961,18 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HelmRepos = append(m.HelmRepos, &v1alpha1.HelmRepository{})
-			if err := m.HelmRepos[len(m.HelmRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2942,22 +3982,20 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plugins = append(m.Plugins, &v1alpha1.ConfigManagementPlugin{})
-			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3871,6 +4909,68 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmoduleCreds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmoduleCreds = append(m.SubmoduleCreds, &v1alpha1.RepoCreds{})
+			if err := m.SubmoduleCreds[len(m.SubmoduleCreds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmVersions = append(m.HelmVersions, &v1alpha1.HelmVersion{})
+			if err := m.HelmVersions[len(m.HelmVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3951,6 +5051,35 @@ func (m *HelmAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4788,6 +5917,35 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -5387,81 +6545,97 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_repository_59158ed504c6ff1c)
-}
-
-var fileDescriptor_repository_59158ed504c6ff1c = []byte{
-	// 1150 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x17, 0xdb, 0x6e, 0x1b, 0x45,
-	0x34, 0x6b, 0x3b, 0x75, 0x7c, 0xdc, 0x4b, 0x3a, 0x8d, 0xc2, 0xe2, 0x06, 0x63, 0x46, 0x14, 0x05,
-	0x41, 0xd7, 0x34, 0x2d, 0x52, 0x14, 0x01, 0x52, 0x69, 0x42, 0x1a, 0x25, 0x11, 0xe9, 0xa6, 0x54,
-	0x02, 0x21, 0x55, 0x93, 0xf5, 0xe9, 0x7a, 0x6a, 0x7b, 0x77, 0xd9, 0x1d, 0x5b, 0x72, 0x7f, 0x80,
-	0x0f, 0xe0, 0x0d, 0xde, 0x78, 0x83, 0x9f, 0xe0, 0x95, 0x47, 0x1e, 0x11, 0x12, 0x12, 0xca, 0x0f,
-	0xf0, 0x0b, 0x68, 0xc6, 0x7b, 0x99, 0xdd, 0x6c, 0x22, 0x24, 0x0b, 0xda, 0x97, 0xd5, 0x99, 0x33,
-	0xe7, 0x7e, 0x9b, 0xb3, 0xf0, 0x4e, 0x88, 0x81, 0x1f, 0x61, 0x38, 0xc1, 0xb0, 0xab, 0x40, 0x2e,
-	0xfc, 0x70, 0xaa, 0x81, 0x56, 0x10, 0xfa, 0xc2, 0x27, 0x90, 0x61, 0x5a, 0x2b, 0xae, 0xef, 0xfa,
-	0x0a, 0xdd, 0x95, 0xd0, 0x8c, 0xa2, 0xb5, 0xe6, 0xfa, 0xbe, 0x3b, 0xc4, 0x2e, 0x0b, 0x78, 0x97,
-	0x79, 0x9e, 0x2f, 0x98, 0xe0, 0xbe, 0x17, 0xc5, 0xb7, 0x74, 0xb0, 0x19, 0x59, 0xdc, 0x57, 0xb7,
-	0x8e, 0x1f, 0x62, 0x77, 0x72, 0xa7, 0xeb, 0xa2, 0x87, 0x21, 0x13, 0xd8, 0x8b, 0x69, 0xf6, 0x5c,
-	0x2e, 0xfa, 0xe3, 0x13, 0xcb, 0xf1, 0x47, 0x5d, 0x16, 0x2a, 0x15, 0xcf, 0x15, 0x70, 0xdb, 0xe9,
-	0x75, 0x83, 0x81, 0x2b, 0x99, 0xa3, 0x2e, 0x0b, 0x82, 0x21, 0x77, 0x94, 0xf0, 0xee, 0xe4, 0x0e,
-	0x1b, 0x06, 0x7d, 0x76, 0x46, 0x14, 0xfd, 0xa3, 0x06, 0xd7, 0x0e, 0x99, 0xc7, 0x9f, 0x61, 0x24,
-	0x6c, 0xfc, 0x66, 0x8c, 0x91, 0x20, 0x5f, 0x42, 0x4d, 0x3a, 0x61, 0x1a, 0x1d, 0x63, 0xbd, 0xb9,
-	0xb1, 0x63, 0x65, 0xda, 0xac, 0x44, 0x9b, 0x02, 0x9e, 0x3a, 0x3d, 0x2b, 0x18, 0xb8, 0x96, 0xd4,
-	0x66, 0x69, 0xda, 0xac, 0x44, 0x9b, 0x65, 0xa7, 0xb1, 0xb0, 0x95, 0x48, 0xd2, 0x82, 0xa5, 0x10,
-	0x27, 0x3c, 0xe2, 0xbe, 0x67, 0x56, 0x3a, 0xc6, 0x7a, 0xc3, 0x4e, 0xcf, 0xc4, 0x84, 0xba, 0xe7,
-	0x3f, 0x60, 0x4e, 0x1f, 0xcd, 0x6a, 0xc7, 0x58, 0x5f, 0xb2, 0x93, 0x23, 0xe9, 0x40, 0x93, 0x05,
-	0xc1, 0x01, 0x3b, 0xc1, 0xe1, 0x3e, 0x4e, 0xcd, 0x9a, 0x62, 0xd4, 0x51, 0xe4, 0x6d, 0xb8, 0x92,
-	0x1c, 0x9f, 0xb0, 0xe1, 0x18, 0xcd, 0x45, 0x45, 0x93, 0x47, 0x92, 0x35, 0x68, 0x78, 0x6c, 0x84,
-	0x51, 0xc0, 0x1c, 0x34, 0x97, 0x14, 0x45, 0x86, 0x20, 0x2f, 0xe0, 0xba, 0xe6, 0xc4, 0xb1, 0x3f,
-	0x0e, 0x1d, 0x34, 0x41, 0xc5, 0xe0, 0x60, 0x8e, 0x18, 0xdc, 0x2f, 0xca, 0xb4, 0xcf, 0xaa, 0x21,
-	0x2e, 0x34, 0xfa, 0x38, 0x1c, 0xa9, 0x78, 0x99, 0xcd, 0x4e, 0x75, 0xbd, 0xb9, 0xb1, 0x37, 0x87,
-	0xce, 0x87, 0x89, 0xac, 0x59, 0xec, 0x33, 0xd9, 0x64, 0x00, 0xf5, 0x60, 0x38, 0x76, 0xb9, 0x17,
-	0x99, 0x97, 0x95, 0x9a, 0x47, 0x73, 0xa8, 0x79, 0xe0, 0x7b, 0xcf, 0xb8, 0x7b, 0xc8, 0x3c, 0xe6,
-	0xe2, 0x08, 0x3d, 0x71, 0xa4, 0x24, 0xdb, 0x89, 0x06, 0xfa, 0xa3, 0x01, 0xcb, 0x59, 0x71, 0x45,
-	0x81, 0xef, 0x45, 0x2a, 0x09, 0xa3, 0x18, 0x17, 0x99, 0x46, 0xa7, 0x2a, 0x93, 0x90, 0x22, 0xf2,
-	0x29, 0xaa, 0x14, 0x53, 0xb4, 0x0a, 0x97, 0x66, 0x2d, 0xa8, 0x2a, 0xa4, 0x61, 0xc7, 0xa7, 0x5c,
-	0x59, 0xd5, 0x0a, 0x65, 0xd5, 0x06, 0x88, 0x54, 0x90, 0x1f, 0x4f, 0x03, 0x34, 0x2f, 0xa9, 0x5b,
-	0x0d, 0x43, 0x7f, 0x30, 0xe0, 0xea, 0x01, 0x8f, 0xc4, 0x36, 0x0f, 0x5f, 0x72, 0x03, 0x10, 0xa8,
-	0x05, 0x4c, 0xf4, 0x63, 0xdf, 0x14, 0x4c, 0x3b, 0xb0, 0xf4, 0x19, 0x1f, 0xa2, 0x34, 0x90, 0xac,
-	0xc0, 0x22, 0x17, 0x38, 0x4a, 0xa2, 0x36, 0x3b, 0x28, 0xfb, 0x77, 0x51, 0x48, 0xaa, 0x57, 0xd0,
-	0xfe, 0x5b, 0x70, 0x2d, 0x35, 0x2e, 0x2e, 0x00, 0x02, 0xb5, 0x1e, 0x13, 0x4c, 0x59, 0x77, 0xd9,
-	0x56, 0x30, 0xfd, 0xbb, 0x0a, 0xaf, 0x4b, 0x5d, 0xc7, 0x2a, 0x9f, 0xf7, 0x83, 0x60, 0x1b, 0x05,
-	0xe3, 0xc3, 0xe8, 0xd1, 0x18, 0xc3, 0xe9, 0x2b, 0xe4, 0x4f, 0xbe, 0x51, 0x6b, 0xff, 0x4f, 0xa3,
-	0x2e, 0xfe, 0xd7, 0x8d, 0x4a, 0xee, 0x42, 0x4d, 0x6a, 0x56, 0xdd, 0xd1, 0xdc, 0x78, 0xd3, 0xd2,
-	0x5e, 0x35, 0x69, 0x61, 0x21, 0x1f, 0xb6, 0x22, 0x26, 0x1f, 0x41, 0x7d, 0x10, 0xf9, 0x9e, 0x87,
-	0xc2, 0xac, 0x2b, 0x3e, 0xaa, 0xf3, 0xed, 0xcf, 0xae, 0x8a, 0xac, 0x09, 0x0b, 0xfd, 0x10, 0x6e,
-	0x94, 0x88, 0x96, 0xdd, 0x3a, 0x91, 0xb3, 0x5a, 0x56, 0x4c, 0x52, 0xe8, 0x1a, 0x86, 0x6e, 0xc1,
-	0x6a, 0xb9, 0x64, 0xf9, 0x48, 0xa0, 0x37, 0xe1, 0xa1, 0xef, 0x49, 0x0f, 0x55, 0xad, 0x34, 0x6c,
-	0x1d, 0x45, 0xbf, 0xad, 0xc0, 0xaa, 0x0c, 0x6e, 0xc6, 0xa9, 0xd7, 0xa4, 0x90, 0xe3, 0x61, 0xc6,
-	0xa5, 0x60, 0x72, 0x2f, 0xf3, 0xaf, 0xa2, 0xfc, 0x6b, 0x95, 0xfb, 0x77, 0x1c, 0xa0, 0x93, 0xfa,
-	0x45, 0xde, 0x8b, 0x43, 0x59, 0x55, 0x2c, 0xaf, 0x95, 0x84, 0x52, 0xd1, 0xcf, 0x42, 0xb8, 0x05,
-	0x8d, 0xc1, 0x38, 0x12, 0xfe, 0x88, 0xbf, 0x40, 0x35, 0xb8, 0x9a, 0x1b, 0x6b, 0x39, 0x25, 0xc9,
-	0x65, 0xc2, 0x96, 0x91, 0x4b, 0xde, 0x1e, 0x0f, 0xd1, 0x91, 0x84, 0xea, 0xb9, 0x2b, 0xf0, 0x6e,
-	0x27, 0x97, 0x29, 0x6f, 0x4a, 0x4e, 0xbf, 0x37, 0xe0, 0xad, 0xac, 0xdd, 0xec, 0xb8, 0xe0, 0x0f,
-	0x51, 0x30, 0xd9, 0x8d, 0x2f, 0x77, 0x8c, 0xd0, 0xdf, 0x2b, 0x70, 0x35, 0x1f, 0x5d, 0x99, 0x1e,
-	0xf9, 0x08, 0x24, 0xe9, 0x91, 0x70, 0xda, 0x9d, 0x15, 0xad, 0x3b, 0x8f, 0xe0, 0xb2, 0x96, 0xf0,
-	0xc8, 0xac, 0xaa, 0xce, 0x79, 0xff, 0xfc, 0xbc, 0x59, 0x3b, 0x1a, 0xf9, 0x8e, 0x27, 0xc2, 0xa9,
-	0x9d, 0x93, 0x40, 0x06, 0x00, 0x01, 0x0b, 0xd9, 0x08, 0x05, 0x86, 0x49, 0xc3, 0xef, 0xcf, 0x11,
-	0x89, 0x58, 0xfd, 0x51, 0x22, 0xd3, 0xd6, 0xc4, 0xb7, 0x9e, 0xc2, 0xf5, 0x33, 0xf6, 0x90, 0x65,
-	0xa8, 0x0e, 0x70, 0x1a, 0xbb, 0x2e, 0x41, 0x72, 0x0f, 0x16, 0x55, 0x47, 0xc4, 0x65, 0xd9, 0x2e,
-	0x71, 0x4f, 0x13, 0x63, 0xcf, 0x88, 0xb7, 0x2a, 0x9b, 0x06, 0xfd, 0xc5, 0x80, 0xa6, 0x56, 0x85,
-	0xff, 0x3a, 0xae, 0xf9, 0xae, 0xac, 0x16, 0xbb, 0x92, 0xf4, 0x4b, 0xa2, 0xf4, 0x70, 0xce, 0xb1,
-	0x58, 0x1a, 0x22, 0xfa, 0xb3, 0x01, 0xcb, 0xc5, 0xae, 0x48, 0x4d, 0x36, 0x34, 0x93, 0x9f, 0x43,
-	0x83, 0x8f, 0x98, 0x8b, 0x8f, 0x99, 0x1b, 0x99, 0x15, 0x65, 0xd1, 0x3c, 0x5b, 0x5c, 0xaa, 0x73,
-	0x2f, 0x16, 0x6a, 0x67, 0xe2, 0xe5, 0x5a, 0xa2, 0x0e, 0x49, 0x68, 0xe2, 0x13, 0xfd, 0xc9, 0x00,
-	0x72, 0x36, 0x21, 0xa5, 0x51, 0x6f, 0x03, 0x0c, 0x36, 0xa3, 0x27, 0x18, 0x6a, 0x2d, 0xa1, 0x61,
-	0x4a, 0xdf, 0xa2, 0x7d, 0x68, 0xf6, 0x30, 0x12, 0xdc, 0x53, 0xb6, 0xc6, 0xf3, 0xe3, 0xdd, 0x8b,
-	0xab, 0x61, 0x3b, 0x63, 0xb0, 0x75, 0x6e, 0xfa, 0x05, 0xbc, 0x71, 0x21, 0xb5, 0xb6, 0x7b, 0x19,
-	0xb9, 0xdd, 0xeb, 0xc2, 0x8d, 0x8d, 0x12, 0x58, 0x2e, 0x0e, 0xa2, 0x8d, 0x3f, 0xab, 0x70, 0x3d,
-	0x9b, 0x3e, 0xf2, 0xcb, 0x1d, 0x24, 0x9f, 0xc3, 0xf2, 0x6e, 0xfc, 0x73, 0x92, 0xec, 0x8c, 0xe4,
-	0xa6, 0xee, 0x4c, 0xe1, 0x37, 0xa5, 0xb5, 0x56, 0x7e, 0x39, 0x9b, 0xe8, 0x74, 0x81, 0x7c, 0x0c,
-	0xf5, 0x78, 0xaf, 0x23, 0xb9, 0xc9, 0x9d, 0x5f, 0xf6, 0x5a, 0x2b, 0xfa, 0x5d, 0xb2, 0x6b, 0xd1,
-	0x05, 0xb2, 0x0d, 0xf5, 0x78, 0x73, 0xc9, 0xb3, 0xe7, 0x77, 0xad, 0xd6, 0xcd, 0xd2, 0xbb, 0xd4,
-	0x88, 0xaf, 0xe1, 0xca, 0xae, 0xfe, 0x56, 0x91, 0x5b, 0x3a, 0xfd, 0xb9, 0x2b, 0x4f, 0x8b, 0x16,
-	0xc9, 0xce, 0x3e, 0x5a, 0x74, 0x81, 0x7c, 0x67, 0xc0, 0x8d, 0x5d, 0x14, 0xc5, 0x01, 0x4e, 0x6e,
-	0x97, 0x2b, 0x39, 0x67, 0xd0, 0xb7, 0xf6, 0xe7, 0x1a, 0xed, 0x79, 0x99, 0x74, 0xe1, 0xd3, 0x4f,
-	0x7e, 0x3d, 0x6d, 0x1b, 0xbf, 0x9d, 0xb6, 0x8d, 0xbf, 0x4e, 0xdb, 0xc6, 0x57, 0x1f, 0x5c, 0xf4,
-	0xb3, 0xaa, 0xfd, 0x54, 0xb3, 0x80, 0x3b, 0x43, 0x8e, 0x9e, 0x38, 0xb9, 0xa4, 0x7e, 0x4d, 0xef,
-	0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x03, 0x33, 0x0a, 0x18, 0x73, 0x0f, 0x00, 0x00,
+	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_repository_9bb513bd838310b2)
+}
+
+var fileDescriptor_repository_9bb513bd838310b2 = []byte{
+	// 1404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x4e, 0x13, 0x3f, 0xa7, 0xf9, 0x31, 0x49, 0xfb, 0xdd, 0xaf, 0x1b, 0x8c, 0x59,
+	0x51, 0x14, 0x54, 0x6a, 0xd3, 0xb4, 0x87, 0xaa, 0x02, 0xa4, 0xd2, 0xa4, 0x69, 0xe5, 0x54, 0x6d,
+	0x37, 0xa1, 0x08, 0x84, 0x54, 0x4d, 0xec, 0x97, 0xcd, 0xc4, 0xf6, 0xee, 0xb2, 0x33, 0xb6, 0xe4,
+	0x9e, 0x10, 0x17, 0xfe, 0x00, 0x6e, 0x70, 0xe3, 0x06, 0x7f, 0x09, 0xdc, 0x38, 0xc2, 0x01, 0x09,
+	0xf5, 0x2f, 0x41, 0x33, 0xfb, 0x6b, 0x76, 0xbd, 0x8d, 0x90, 0xac, 0x92, 0x5e, 0xac, 0x99, 0x37,
+	0xef, 0xd7, 0xbc, 0x37, 0xef, 0xf3, 0x9e, 0x17, 0xde, 0x0b, 0xd0, 0xf7, 0x38, 0x06, 0x63, 0x0c,
+	0xda, 0x6a, 0xc9, 0x84, 0x17, 0x4c, 0xb4, 0x65, 0xcb, 0x0f, 0x3c, 0xe1, 0x11, 0x48, 0x29, 0xf5,
+	0x0d, 0xc7, 0x73, 0x3c, 0x45, 0x6e, 0xcb, 0x55, 0xc8, 0x51, 0xdf, 0x74, 0x3c, 0xcf, 0x19, 0x60,
+	0x9b, 0xfa, 0xac, 0x4d, 0x5d, 0xd7, 0x13, 0x54, 0x30, 0xcf, 0xe5, 0xd1, 0xa9, 0xd5, 0xbf, 0xcd,
+	0x5b, 0xcc, 0x53, 0xa7, 0x5d, 0x2f, 0xc0, 0xf6, 0xf8, 0x46, 0xdb, 0x41, 0x17, 0x03, 0x2a, 0xb0,
+	0x17, 0xf1, 0x3c, 0x74, 0x98, 0x38, 0x19, 0x1d, 0xb5, 0xba, 0xde, 0xb0, 0x4d, 0x03, 0x65, 0xe2,
+	0x54, 0x2d, 0xae, 0x77, 0x7b, 0x6d, 0xbf, 0xef, 0x48, 0x61, 0xde, 0xa6, 0xbe, 0x3f, 0x60, 0x5d,
+	0xa5, 0xbc, 0x3d, 0xbe, 0x41, 0x07, 0xfe, 0x09, 0x9d, 0x52, 0x65, 0x7d, 0x53, 0x83, 0x95, 0x47,
+	0xd4, 0x65, 0xc7, 0xc8, 0x85, 0x8d, 0x5f, 0x8f, 0x90, 0x0b, 0xf2, 0x05, 0x54, 0xe4, 0x25, 0x4c,
+	0xa3, 0x69, 0x6c, 0xd5, 0xb6, 0x77, 0x5b, 0xa9, 0xb5, 0x56, 0x6c, 0x4d, 0x2d, 0x9e, 0x77, 0x7b,
+	0x2d, 0xbf, 0xef, 0xb4, 0xa4, 0xb5, 0x96, 0x66, 0xad, 0x15, 0x5b, 0x6b, 0xd9, 0x49, 0x2c, 0x6c,
+	0xa5, 0x92, 0xd4, 0x61, 0x31, 0xc0, 0x31, 0xe3, 0xcc, 0x73, 0xcd, 0x52, 0xd3, 0xd8, 0xaa, 0xda,
+	0xc9, 0x9e, 0x98, 0xb0, 0xe0, 0x7a, 0xf7, 0x68, 0xf7, 0x04, 0xcd, 0x72, 0xd3, 0xd8, 0x5a, 0xb4,
+	0xe3, 0x2d, 0x69, 0x42, 0x8d, 0xfa, 0xfe, 0x3e, 0x3d, 0xc2, 0x41, 0x07, 0x27, 0x66, 0x45, 0x09,
+	0xea, 0x24, 0xf2, 0x2e, 0x5c, 0x8c, 0xb7, 0xcf, 0xe8, 0x60, 0x84, 0xe6, 0xbc, 0xe2, 0xc9, 0x12,
+	0xc9, 0x26, 0x54, 0x5d, 0x3a, 0x44, 0xee, 0xd3, 0x2e, 0x9a, 0x8b, 0x8a, 0x23, 0x25, 0x90, 0x17,
+	0xb0, 0xa6, 0x5d, 0xe2, 0xc0, 0x1b, 0x05, 0x5d, 0x34, 0x41, 0xc5, 0x60, 0x7f, 0x86, 0x18, 0xdc,
+	0xcd, 0xeb, 0xb4, 0xa7, 0xcd, 0x10, 0x07, 0xaa, 0x27, 0x38, 0x18, 0xaa, 0x78, 0x99, 0xb5, 0x66,
+	0x79, 0xab, 0xb6, 0xfd, 0x70, 0x06, 0x9b, 0x0f, 0x62, 0x5d, 0x61, 0xec, 0x53, 0xdd, 0xa4, 0x0f,
+	0x0b, 0xfe, 0x60, 0xe4, 0x30, 0x97, 0x9b, 0x4b, 0xca, 0xcc, 0xd3, 0x19, 0xcc, 0xdc, 0xf3, 0xdc,
+	0x63, 0xe6, 0x3c, 0xa2, 0x2e, 0x75, 0x70, 0x88, 0xae, 0x78, 0xa2, 0x34, 0xdb, 0xb1, 0x05, 0xb2,
+	0x05, 0x2b, 0x63, 0x0c, 0xd8, 0xf1, 0xe4, 0x80, 0x39, 0x2e, 0x15, 0xa3, 0x00, 0xcd, 0x8b, 0x2a,
+	0xb3, 0x79, 0xb2, 0xcc, 0x1f, 0x8f, 0x37, 0x1d, 0x9c, 0x70, 0x73, 0xb9, 0x59, 0x96, 0xf9, 0xcb,
+	0x10, 0xc9, 0x00, 0x96, 0xf9, 0xe8, 0x68, 0xe8, 0xf5, 0x46, 0x03, 0xbc, 0x17, 0x60, 0x8f, 0x9b,
+	0x2b, 0xea, 0x0e, 0x3b, 0x33, 0x3e, 0x51, 0xa5, 0xcb, 0xce, 0xe9, 0x26, 0x1d, 0x80, 0x00, 0x8f,
+	0xc3, 0x04, 0x71, 0x73, 0x55, 0x59, 0xba, 0xd6, 0xd2, 0x0a, 0x3e, 0x57, 0x37, 0x2d, 0x3b, 0xe1,
+	0xde, 0x75, 0x45, 0x30, 0xb1, 0x35, 0x71, 0x32, 0x81, 0xb5, 0xfe, 0x88, 0x0b, 0x6f, 0xc8, 0x5e,
+	0xe0, 0x33, 0x0c, 0xe4, 0x83, 0xe7, 0xe6, 0x9a, 0xd2, 0xd9, 0x99, 0xc1, 0xfb, 0x4e, 0x4e, 0xa7,
+	0x3d, 0x6d, 0x85, 0x9c, 0xc2, 0x92, 0xcc, 0x7f, 0x62, 0x95, 0x28, 0xab, 0xf7, 0x67, 0x7c, 0x5e,
+	0xb1, 0xc1, 0x8c, 0x6e, 0x59, 0xa9, 0xa7, 0xdc, 0x73, 0x5d, 0x14, 0xfb, 0xec, 0x88, 0x9b, 0xeb,
+	0x2a, 0x8b, 0x3a, 0x89, 0x7c, 0x0e, 0xcb, 0xe8, 0x8e, 0xef, 0x07, 0xde, 0xf0, 0x00, 0xbb, 0x01,
+	0x0a, 0x6e, 0x6e, 0x28, 0x7f, 0xda, 0x67, 0x45, 0x76, 0x37, 0x23, 0x11, 0x46, 0x37, 0xa7, 0x86,
+	0x34, 0x00, 0x7a, 0xc8, 0xc5, 0x81, 0x82, 0x68, 0xf3, 0x92, 0xaa, 0x6e, 0x8d, 0x52, 0x3f, 0x84,
+	0x95, 0x5c, 0x82, 0xc8, 0x2a, 0x94, 0xfb, 0x38, 0x51, 0x38, 0x57, 0xb5, 0xe5, 0x92, 0x5c, 0x83,
+	0xf9, 0xb1, 0xc2, 0x8f, 0x92, 0xaa, 0xfb, 0x4b, 0xba, 0x53, 0x36, 0x1e, 0x1f, 0xd2, 0xc0, 0x41,
+	0x61, 0x87, 0x3c, 0x77, 0x4a, 0xb7, 0x8d, 0xfa, 0x5d, 0x58, 0x2f, 0x70, 0xae, 0x40, 0xf3, 0x86,
+	0xae, 0xb9, 0xaa, 0xa9, 0xb0, 0xbe, 0x35, 0xa0, 0x9a, 0xe8, 0x3e, 0x27, 0xf0, 0xb5, 0x7e, 0x32,
+	0x60, 0x35, 0x8d, 0x3a, 0xf7, 0x3d, 0x97, 0x2b, 0xbc, 0x1c, 0x46, 0x34, 0x6e, 0x1a, 0x2a, 0x97,
+	0x29, 0x21, 0x8b, 0xa6, 0xa5, 0x3c, 0x9a, 0x5e, 0x86, 0x0b, 0x61, 0xb7, 0x54, 0x60, 0x5e, 0xb5,
+	0xa3, 0x5d, 0xc6, 0x89, 0x4a, 0xae, 0x03, 0x34, 0x00, 0xb8, 0xca, 0xcf, 0xe1, 0xc4, 0x47, 0xf3,
+	0x42, 0x98, 0xc2, 0x94, 0x62, 0xfd, 0x68, 0xc0, 0xf2, 0x3e, 0xe3, 0x62, 0x87, 0x05, 0xe7, 0xdc,
+	0xab, 0x08, 0x54, 0x7c, 0x2a, 0x4e, 0xa2, 0xbb, 0xa9, 0xb5, 0xd5, 0x84, 0xc5, 0xfb, 0x6c, 0x80,
+	0xd2, 0x41, 0x99, 0x6d, 0x26, 0x70, 0x18, 0x47, 0x2d, 0xdc, 0x28, 0xff, 0xf7, 0x50, 0x48, 0xae,
+	0x37, 0xd0, 0xff, 0xab, 0xb0, 0x92, 0x38, 0x17, 0x3d, 0x00, 0x02, 0x95, 0x1e, 0x15, 0x54, 0x79,
+	0xb7, 0x64, 0xab, 0xb5, 0xf5, 0xdb, 0x3c, 0xfc, 0x5f, 0xda, 0x0a, 0xcb, 0xea, 0xae, 0xef, 0xef,
+	0xa0, 0xa0, 0x6c, 0xc0, 0x9f, 0x8e, 0x30, 0x98, 0xbc, 0x41, 0xf7, 0xc9, 0xf6, 0xd4, 0xca, 0x7f,
+	0xd3, 0x53, 0xe7, 0x5f, 0x7b, 0x4f, 0xbd, 0x09, 0x15, 0x69, 0x59, 0x55, 0x47, 0x6d, 0xfb, 0x6d,
+	0x1d, 0xa0, 0xa4, 0x87, 0xb9, 0x7c, 0xd8, 0x8a, 0x99, 0x7c, 0x04, 0x0b, 0xfd, 0x10, 0x83, 0xcd,
+	0x05, 0x25, 0x67, 0xe9, 0x72, 0x9d, 0xf0, 0x28, 0x2f, 0x1a, 0x8b, 0x14, 0xb4, 0xdd, 0xc5, 0xd7,
+	0xd8, 0x76, 0xf3, 0xed, 0xaa, 0xfa, 0xfa, 0xda, 0x95, 0xf5, 0x18, 0xd6, 0x0b, 0x82, 0x26, 0x71,
+	0x48, 0xc1, 0xb3, 0xac, 0x85, 0xb8, 0x84, 0x35, 0x8a, 0x9c, 0x54, 0xc7, 0x18, 0x68, 0x0f, 0x31,
+	0xde, 0x5a, 0x77, 0xe0, 0x72, 0x71, 0x34, 0x65, 0x67, 0x44, 0x77, 0xcc, 0x02, 0xcf, 0x95, 0x59,
+	0x8d, 0x3a, 0x83, 0x4e, 0xb2, 0xbe, 0x2b, 0xc1, 0x65, 0x19, 0x96, 0x54, 0x52, 0xaf, 0x43, 0x21,
+	0x21, 0x31, 0x94, 0x52, 0x6b, 0x72, 0x2b, 0xcd, 0x69, 0xd8, 0xac, 0xea, 0xc5, 0x39, 0x3d, 0xf0,
+	0xb1, 0x9b, 0xe6, 0xf2, 0x5a, 0xf4, 0x7c, 0xca, 0x4a, 0xe4, 0x7f, 0x05, 0xcf, 0x47, 0xf1, 0x87,
+	0xcf, 0xe6, 0x0e, 0x54, 0x93, 0x71, 0x42, 0x81, 0x75, 0x6d, 0x7b, 0x33, 0x63, 0x24, 0x3e, 0x8c,
+	0xc5, 0x52, 0x76, 0x29, 0xdb, 0x63, 0x01, 0x76, 0x25, 0xa3, 0x39, 0x3f, 0x2d, 0xbb, 0x13, 0x1f,
+	0x26, 0xb2, 0x09, 0xbb, 0xf5, 0x83, 0x01, 0xef, 0xa4, 0x10, 0x63, 0x47, 0x45, 0xfe, 0x08, 0x05,
+	0x95, 0x08, 0x74, 0xbe, 0xd0, 0x69, 0xfd, 0x51, 0x82, 0xe5, 0x6c, 0x74, 0x65, 0x7a, 0x64, 0xe3,
+	0x8b, 0xd3, 0x23, 0xd7, 0x09, 0x22, 0x95, 0x34, 0x44, 0x7a, 0x02, 0x4b, 0x5a, 0xc2, 0xb9, 0x59,
+	0x56, 0x4f, 0xfb, 0x83, 0x57, 0xe7, 0xad, 0xb5, 0xab, 0xb1, 0x87, 0x63, 0x4f, 0x46, 0x03, 0xe9,
+	0x03, 0xf8, 0x34, 0xa0, 0x43, 0x14, 0x18, 0xc4, 0x20, 0x37, 0xd3, 0x3c, 0x19, 0x9a, 0x7f, 0x12,
+	0xeb, 0xb4, 0x35, 0xf5, 0xf5, 0xe7, 0xb0, 0x36, 0xe5, 0x4f, 0xc1, 0xa4, 0x73, 0x2b, 0x3b, 0x43,
+	0x35, 0x0a, 0xae, 0xa7, 0xa9, 0xd1, 0x27, 0xa1, 0x3f, 0x0d, 0xa8, 0x69, 0xaf, 0xf0, 0x5f, 0xc7,
+	0x35, 0x5b, 0xaf, 0xe5, 0xa9, 0x7a, 0x3d, 0x29, 0x88, 0xd2, 0x83, 0x19, 0x01, 0xa5, 0x30, 0x44,
+	0x3a, 0x32, 0xcc, 0x67, 0x91, 0xe1, 0x17, 0x03, 0x56, 0xf3, 0xf5, 0x92, 0x5c, 0xc6, 0xd0, 0x2e,
+	0x73, 0x0a, 0x55, 0x36, 0xa4, 0x0e, 0x1e, 0x52, 0x87, 0x9b, 0xa5, 0x66, 0x79, 0xc6, 0xbf, 0x9f,
+	0x89, 0xcd, 0x87, 0x91, 0x52, 0x3b, 0x55, 0x2f, 0x87, 0x34, 0xb5, 0x89, 0x83, 0x16, 0xed, 0xac,
+	0x9f, 0x0d, 0x20, 0xd3, 0xa9, 0x2a, 0xcc, 0x47, 0x03, 0xa0, 0x7f, 0x9b, 0x3f, 0xcb, 0xc0, 0xa1,
+	0x46, 0x29, 0xec, 0xcc, 0x1d, 0xa8, 0xc9, 0xc1, 0x9c, 0xb9, 0xca, 0xd7, 0x08, 0x59, 0xde, 0x3f,
+	0xfb, 0x9d, 0xec, 0xa4, 0x02, 0xb6, 0x2e, 0x6d, 0x7d, 0x06, 0x6f, 0x9d, 0xc9, 0xad, 0x4d, 0xa2,
+	0x46, 0x66, 0x12, 0x3d, 0x73, 0x7e, 0xb5, 0x08, 0xac, 0xe6, 0x21, 0x6a, 0xfb, 0xaf, 0x32, 0xac,
+	0xa5, 0xb8, 0x24, 0x7f, 0x59, 0x17, 0xc9, 0x63, 0x58, 0xdd, 0x8b, 0xbe, 0xaa, 0xc4, 0x13, 0x34,
+	0xb9, 0x72, 0xc6, 0xbf, 0x99, 0xfa, 0x66, 0xf1, 0x61, 0x88, 0xf5, 0xd6, 0x1c, 0xf9, 0x18, 0x16,
+	0xa2, 0x29, 0x97, 0x64, 0x30, 0x3d, 0x3b, 0xfa, 0xd6, 0x37, 0xf4, 0xb3, 0x78, 0xf2, 0xb4, 0xe6,
+	0xc8, 0x0e, 0x2c, 0x44, 0x73, 0x5c, 0x56, 0x3c, 0x3b, 0x79, 0xd6, 0xaf, 0x14, 0x9e, 0x25, 0x4e,
+	0x7c, 0x05, 0x17, 0xf7, 0xf4, 0x2e, 0x46, 0xae, 0x66, 0xff, 0x0b, 0xbd, 0x62, 0x00, 0xac, 0x5b,
+	0x79, 0xb6, 0xe9, 0x76, 0x66, 0xcd, 0x91, 0xef, 0x0d, 0x58, 0xdf, 0x43, 0x91, 0x87, 0x76, 0x72,
+	0xbd, 0xd8, 0xc8, 0x2b, 0x5a, 0x40, 0xbd, 0x33, 0x13, 0xe8, 0x67, 0x75, 0x5a, 0x73, 0x9f, 0x7e,
+	0xf2, 0xeb, 0xcb, 0x86, 0xf1, 0xfb, 0xcb, 0x86, 0xf1, 0xf7, 0xcb, 0x86, 0xf1, 0xe5, 0x87, 0x67,
+	0x7d, 0x65, 0xd3, 0xbe, 0x06, 0x52, 0x9f, 0x75, 0x07, 0x0c, 0x5d, 0x71, 0x74, 0x41, 0x7d, 0x53,
+	0xbb, 0xf9, 0xcf, 0x00, 0x1d, 0x6b, 0x44, 0x5e, 0x2c, 0x14, 0x00, 0x00,
 }
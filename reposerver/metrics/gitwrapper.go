@@ -12,9 +12,9 @@ func wrapGitClient(repo string, metricsServer *MetricsServer, client git.Client)
 	return &gitClientWrapper{repo: repo, client: client, metricsServer: metricsServer}
 }
 
-func (w *gitClientWrapper) Fetch() error {
+func (w *gitClientWrapper) Fetch(revision string) error {
 	w.metricsServer.IncGitRequest(w.repo, GitRequestTypeFetch)
-	return w.client.Fetch()
+	return w.client.Fetch(revision)
 }
 
 func (w *gitClientWrapper) LsRemote(revision string) (string, error) {
@@ -38,6 +38,10 @@ func (w *gitClientWrapper) Checkout(revision string) error {
 	return w.client.Checkout(revision)
 }
 
+func (w *gitClientWrapper) SparseCheckout(revision string, paths []string) error {
+	return w.client.SparseCheckout(revision, paths)
+}
+
 func (w *gitClientWrapper) CommitSHA() (string, error) {
 	return w.client.CommitSHA()
 }
@@ -53,3 +57,25 @@ func (w *gitClientWrapper) Init() error {
 func (w *gitClientWrapper) RevisionMetadata(revision string) (*git.RevisionMetadata, error) {
 	return w.client.RevisionMetadata(revision)
 }
+
+func (w *gitClientWrapper) CatFile(revision string) (string, string, error) {
+	return w.client.CatFile(revision)
+}
+
+func (w *gitClientWrapper) Submodules() ([]git.Submodule, error) {
+	return w.client.Submodules()
+}
+
+func (w *gitClientWrapper) UpdateSubmodule(path string, creds git.Creds) error {
+	// Updating a submodule fetches it from its remote
+	w.metricsServer.IncGitRequest(w.repo, GitRequestTypeFetch)
+	return w.client.UpdateSubmodule(path, creds)
+}
+
+func (w *gitClientWrapper) Worktree(path string, revision string) (git.Client, error) {
+	client, err := w.client.Worktree(path, revision)
+	if err != nil {
+		return nil, err
+	}
+	return wrapGitClient(w.repo, w.metricsServer, client), nil
+}
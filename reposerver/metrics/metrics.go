@@ -10,9 +10,10 @@ import (
 )
 
 type MetricsServer struct {
-	handler           http.Handler
-	gitRequestCounter *prometheus.CounterVec
-	gitClientFactory  git.ClientFactory
+	handler                     http.Handler
+	gitRequestCounter           *prometheus.CounterVec
+	manifestCacheRequestCounter *prometheus.CounterVec
+	gitClientFactory            git.ClientFactory
 }
 
 type GitRequestType string
@@ -22,6 +23,13 @@ const (
 	GitRequestTypeFetch    = "fetch"
 )
 
+type ManifestCacheResult string
+
+const (
+	ManifestCacheResultHit  = "hit"
+	ManifestCacheResultMiss = "miss"
+)
+
 // NewMetricsServer returns a new prometheus server which collects application metrics
 func NewMetricsServer(gitClientFactory git.ClientFactory) *MetricsServer {
 	registry := prometheus.NewRegistry()
@@ -37,10 +45,20 @@ func NewMetricsServer(gitClientFactory git.ClientFactory) *MetricsServer {
 	)
 	registry.MustRegister(gitRequestCounter)
 
+	manifestCacheRequestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_manifest_cache_request_total",
+			Help: "Number of lookups of generated manifests in the cache of the repo server",
+		},
+		[]string{"repo", "result"},
+	)
+	registry.MustRegister(manifestCacheRequestCounter)
+
 	return &MetricsServer{
-		gitClientFactory:  gitClientFactory,
-		handler:           promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
-		gitRequestCounter: gitRequestCounter,
+		gitClientFactory:            gitClientFactory,
+		handler:                     promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		gitRequestCounter:           gitRequestCounter,
+		manifestCacheRequestCounter: manifestCacheRequestCounter,
 	}
 }
 
@@ -53,8 +71,13 @@ func (m *MetricsServer) IncGitRequest(repo string, requestType GitRequestType) {
 	m.gitRequestCounter.WithLabelValues(repo, string(requestType)).Inc()
 }
 
-func (m *MetricsServer) NewClient(repoURL string, path string, creds git.Creds, insecureIgnoreHostKey bool, lfsEnabled bool) (git.Client, error) {
-	client, err := m.gitClientFactory.NewClient(repoURL, path, creds, insecureIgnoreHostKey, lfsEnabled)
+// IncManifestCacheRequest increments the manifest cache requests counter
+func (m *MetricsServer) IncManifestCacheRequest(repo string, result ManifestCacheResult) {
+	m.manifestCacheRequestCounter.WithLabelValues(repo, string(result)).Inc()
+}
+
+func (m *MetricsServer) NewClient(repoURL string, path string, creds git.Creds, insecureIgnoreHostKey bool, lfsEnabled bool, proxy string) (git.Client, error) {
+	client, err := m.gitClientFactory.NewClient(repoURL, path, creds, insecureIgnoreHostKey, lfsEnabled, proxy)
 	if err != nil {
 		return nil, err
 	}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/TomOnTime/utfutil"
 	argoexec "github.com/argoproj/pkg/exec"
 	"github.com/ghodss/yaml"
 	"github.com/google/go-jsonnet"
+	gocache "github.com/patrickmn/go-cache"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
@@ -23,14 +28,18 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	pluginclient "github.com/argoproj/argo-cd/cmpserver/apiclient"
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/reposerver/metrics"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/config"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/gpg"
+	"github.com/argoproj/argo-cd/util/hash"
 	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/kube"
@@ -38,31 +47,61 @@ import (
 	"github.com/argoproj/argo-cd/util/text"
 )
 
+// The variables of the build environment of the manifests
 const (
-	PluginEnvAppName      = "ARGOCD_APP_NAME"
-	PluginEnvAppNamespace = "ARGOCD_APP_NAMESPACE"
+	PluginEnvAppName       = "ARGOCD_APP_NAME"
+	PluginEnvAppNamespace  = "ARGOCD_APP_NAMESPACE"
+	PluginEnvAppRevision   = "ARGOCD_APP_REVISION"
+	PluginEnvAppDestServer = "ARGOCD_APP_DEST_SERVER"
 )
 
+// pluginDiscoveryCacheExpiration is how long the plugin sidecar discovered for a revision of an application is cached
+const pluginDiscoveryCacheExpiration = time.Hour
+
+// pluginDiscoveryCache holds the names of the plugin sidecars discovered for the revisions of applications, or an empty
+// name if no sidecar supports a revision, so the discovery rules of the sidecars run once per revision
+var pluginDiscoveryCache = gocache.New(pluginDiscoveryCacheExpiration, pluginDiscoveryCacheExpiration)
+
 // Service implements ManifestService interface
 type Service struct {
 	repoLock                  *util.KeyLock
 	gitFactory                git.ClientFactory
+	metricsServer             *metrics.MetricsServer
+	newHelmClient             func(repoURL string, creds helm.Creds) helm.Client
+	newOCIClient              func(repoURL string, creds helm.Creds) helm.OCIClient
 	cache                     *cache.Cache
 	parallelismLimitSemaphore *semaphore.Weighted
+	// The worktrees the manifests of each repo are generated in concurrently, or nil if they are generated in the
+	// clone of the repo one at a time
+	worktrees *worktreeSlots
+	// Whether manifests are generated from sparse checkouts of the application path
+	sparseCheckout bool
+	// The names or glob patterns of the names of the environment variables which may be sourced from secrets
+	envFromSecretsAllowlist []string
 }
 
 // NewService returns a new instance of the Manifest service
-func NewService(gitFactory git.ClientFactory, cache *cache.Cache, parallelismLimit int64) *Service {
+func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, parallelismLimit int64, parallelismLimitPerRepo int64, sparseCheckout bool, envFromSecretsAllowlist []string) *Service {
 	var parallelismLimitSemaphore *semaphore.Weighted
 	if parallelismLimit > 0 {
 		parallelismLimitSemaphore = semaphore.NewWeighted(parallelismLimit)
 	}
+	var worktrees *worktreeSlots
+	if parallelismLimitPerRepo > 1 {
+		worktrees = newWorktreeSlots(int(parallelismLimitPerRepo))
+	}
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
+		worktrees:                 worktrees,
+		sparseCheckout:            sparseCheckout,
+		envFromSecretsAllowlist:   envFromSecretsAllowlist,
 
-		repoLock:   util.NewKeyLock(),
-		gitFactory: gitFactory,
-		cache:      cache,
+		repoLock:      util.NewKeyLock(),
+		gitFactory:    metricsServer,
+		metricsServer: metricsServer,
+		newHelmClient: helm.NewClient,
+		newOCIClient:  helm.NewOCIClient,
+		cache:         cache,
 	}
 }
 
@@ -79,7 +118,7 @@ func (s *Service) ListDir(ctx context.Context, q *apiclient.ListDirRequest) (*ap
 
 	s.repoLock.Lock(gitClient.Root())
 	defer s.repoLock.Unlock(gitClient.Root())
-	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	commitSHA, err = checkoutRevision(gitClient, commitSHA, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +149,7 @@ func (s *Service) GetFile(ctx context.Context, q *apiclient.GetFileRequest) (*ap
 
 	s.repoLock.Lock(gitClient.Root())
 	defer s.repoLock.Unlock(gitClient.Root())
-	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	commitSHA, err = checkoutRevision(gitClient, commitSHA, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -129,16 +168,36 @@ func (s *Service) GetFile(ctx context.Context, q *apiclient.GetFileRequest) (*ap
 }
 
 func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	if err := checkEnvFromSecrets(q.EnvFromSecrets, s.envFromSecretsAllowlist); err != nil {
+		return nil, err
+	}
+	if q.ApplicationSource.IsRef() {
+		return s.resolveRefSource(q)
+	}
+	if q.ApplicationSource.IsHelmChart() {
+		if q.VerifySignature {
+			return nil, status.Errorf(codes.FailedPrecondition, "signature verification is only supported for Git repositories")
+		}
+		return s.generateHelmChartManifest(c, q)
+	}
 	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
 	if err != nil {
 		return nil, err
 	}
+	refRevisions, err := s.resolveRefRevisions(q)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := func() string {
+		return manifestCacheKey(commitSHA, refRevisions, q)
+	}
 	getCached := func() *apiclient.ManifestResponse {
 		var res apiclient.ManifestResponse
 		if !q.NoCache {
-			err = s.cache.GetManifests(commitSHA, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
+			err = s.cache.GetManifests(cacheKey(), q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
 			if err == nil {
 				log.Infof("manifest cache hit: %s/%s", q.ApplicationSource.String(), commitSHA)
+				s.metricsServer.IncManifestCacheRequest(q.Repo.Repo, metrics.ManifestCacheResultHit)
 				return &res
 			}
 			if err != cache.ErrCacheMiss {
@@ -150,17 +209,44 @@ func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestReque
 		return nil
 	}
 
-	cached := getCached()
-	if cached != nil {
-		return cached, nil
+	// Cached manifests are only returned once the signature of the revision is verified
+	if !q.VerifySignature {
+		cached := getCached()
+		if cached != nil {
+			return cached, nil
+		}
+	}
+
+	// The ref sources are checked out before the repo is locked, since they may be the same repo
+	genQ, cleanup, err := s.copyRefValueFiles(q, refRevisions)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	// A worktree is acquired before the repo is locked, since the other worktrees of the repo are only released once
+	// their manifests are generated
+	var worktreeSlot int
+	if s.worktrees != nil {
+		worktreeSlot, err = s.worktrees.acquire(c, gitClient.Root())
+		if err != nil {
+			return nil, err
+		}
+		defer s.worktrees.release(gitClient.Root(), worktreeSlot)
 	}
 
 	s.repoLock.Lock(gitClient.Root())
-	defer s.repoLock.Unlock(gitClient.Root())
+	var unlockOnce sync.Once
+	unlockRepo := func() {
+		unlockOnce.Do(func() { s.repoLock.Unlock(gitClient.Root()) })
+	}
+	defer unlockRepo()
 
-	cached = getCached()
-	if cached != nil {
-		return cached, nil
+	if !q.VerifySignature {
+		cached := getCached()
+		if cached != nil {
+			return cached, nil
+		}
 	}
 
 	if s.parallelismLimitSemaphore != nil {
@@ -171,24 +257,355 @@ func (s *Service) GenerateManifest(c context.Context, q *apiclient.ManifestReque
 		defer s.parallelismLimitSemaphore.Release(1)
 	}
 
-	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	checkoutClient := gitClient
+	if s.worktrees != nil {
+		checkoutClient, commitSHA, err = checkoutWorktree(gitClient, commitSHA, worktreePath(gitClient.Root(), worktreeSlot))
+	} else {
+		commitSHA, err = checkoutRevision(gitClient, commitSHA, s.getSparseCheckoutPaths(q))
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	genRes, err := GenerateManifests(gitClient.Root(), q.ApplicationSource.Path, q)
+	if q.VerifySignature {
+		err = verifyRevisionSignature(checkoutClient, q.Revision, commitSHA, q.SignatureKeys)
+		if err != nil {
+			return nil, err
+		}
+		cached := getCached()
+		if cached != nil {
+			return cached, nil
+		}
+	}
+
+	if q.Repo.IsSubmodulesEnabled() {
+		err = updateSubmodules(checkoutClient, q.Repo, q.SubmoduleCreds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Once the worktree is checked out, the manifests of other revisions and paths of the repo may be generated
+	// concurrently
+	if s.worktrees != nil {
+		unlockRepo()
+	}
+
+	// the cache is looked up more than once, so a miss is only counted once the manifests are generated
+	if !q.NoCache {
+		s.metricsServer.IncManifestCacheRequest(q.Repo.Repo, metrics.ManifestCacheResultMiss)
+	}
+	revQ := *genQ
+	revQ.Revision = commitSHA
+	genRes, err := GenerateManifests(checkoutClient.Root(), q.ApplicationSource.Path, &revQ)
 	if err != nil {
 		return nil, err
 	}
 	res := *genRes
 	res.Revision = commitSHA
-	err = s.cache.SetManifests(commitSHA, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
+	err = s.cache.SetManifests(cacheKey(), q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), commitSHA, err)
 	}
 	return &res, nil
 }
 
+// generateHelmChartManifest generates manifests from a chart of a Helm chart repository. The target
+// revision of the source is resolved to a chart version, which is used as the revision of the manifests.
+// For OCI registries, the tag is resolved to the digest of the chart's manifest instead, which pins the
+// chart's content.
+func (s *Service) generateHelmChartManifest(c context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	repoURL := ""
+	if q.Repo != nil {
+		repoURL = q.Repo.Repo
+	}
+	var extractChart func(chart string, version string) (string, io.Closer, error)
+	var version string
+	if helm.IsOCIURL(repoURL) {
+		ociClient := s.newOCIClient(repoURL, argo.GetHelmCreds(q.Repo))
+		version = q.Revision
+		if !helm.IsDigest(version) {
+			digest, err := ociClient.ResolveDigest(q.ApplicationSource.Chart, version)
+			if err != nil {
+				return nil, err
+			}
+			version = digest
+		}
+		extractChart = ociClient.ExtractChart
+	} else {
+		helmClient := s.newHelmClient(repoURL, argo.GetHelmCreds(q.Repo))
+		index, err := helmClient.GetIndex()
+		if err != nil {
+			return nil, err
+		}
+		entries, err := index.GetEntries(q.ApplicationSource.Chart)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := entries.Resolve(q.Revision)
+		if err != nil {
+			return nil, err
+		}
+		version = entry.Version
+		extractChart = helmClient.ExtractChart
+	}
+	refRevisions, err := s.resolveRefRevisions(q)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := manifestCacheKey(version, refRevisions, q)
+
+	if !q.NoCache {
+		var res apiclient.ManifestResponse
+		err = s.cache.GetManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
+		if err == nil {
+			log.Infof("manifest cache hit: %s/%s", q.ApplicationSource.String(), version)
+			s.metricsServer.IncManifestCacheRequest(repoURL, metrics.ManifestCacheResultHit)
+			return &res, nil
+		}
+		if err != cache.ErrCacheMiss {
+			log.Warnf("manifest cache error %s: %v", q.ApplicationSource.String(), err)
+		} else {
+			log.Infof("manifest cache miss: %s/%s", q.ApplicationSource.String(), version)
+		}
+		s.metricsServer.IncManifestCacheRequest(repoURL, metrics.ManifestCacheResultMiss)
+	}
+
+	if s.parallelismLimitSemaphore != nil {
+		err = s.parallelismLimitSemaphore.Acquire(c, 1)
+		if err != nil {
+			return nil, err
+		}
+		defer s.parallelismLimitSemaphore.Release(1)
+	}
+
+	genQ, cleanup, err := s.copyRefValueFiles(q, refRevisions)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	chartPath, closer, err := extractChart(q.ApplicationSource.Chart, version)
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(closer)
+
+	revQ := *genQ
+	revQ.Revision = version
+	genRes, err := GenerateManifests(chartPath, "", &revQ)
+	if err != nil {
+		return nil, err
+	}
+	res := *genRes
+	res.Revision = version
+	err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppLabelValue, &res)
+	if err != nil {
+		log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), version, err)
+	}
+	return &res, nil
+}
+
+// resolveRefSource resolves the revision of a ref source of an application with multiple sources. Ref sources only
+// provide value files to the other sources, and generate no manifests themselves.
+func (s *Service) resolveRefSource(q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	if q.ApplicationSource.IsHelmChart() {
+		return nil, status.Errorf(codes.InvalidArgument, "source with ref '%s' must be a Git repository", q.ApplicationSource.Ref)
+	}
+	_, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &apiclient.ManifestResponse{Manifests: []string{}, Revision: commitSHA}, nil
+}
+
+// parseRefValueFile returns the ref and the path of a value file which refers to a ref source, e.g. $values/prod.yaml
+func parseRefValueFile(valueFile string) (string, string, bool) {
+	if !strings.HasPrefix(valueFile, "$") {
+		return "", "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(valueFile, "$"), "/", 2)
+	if len(parts) != 2 {
+		return parts[0], "", true
+	}
+	return parts[0], parts[1], true
+}
+
+// resolveRefRevisions resolves the revisions of the ref sources which the value files of the request refer to
+func (s *Service) resolveRefRevisions(q *apiclient.ManifestRequest) (map[string]string, error) {
+	revisions := make(map[string]string)
+	if q.ApplicationSource.Helm == nil {
+		return revisions, nil
+	}
+	for _, valueFile := range q.ApplicationSource.Helm.ValueFiles {
+		ref, path, ok := parseRefValueFile(valueFile)
+		if !ok {
+			continue
+		}
+		target, ok := q.RefSources[ref]
+		if !ok || target.Repo == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value file %s refers to unknown source '$%s'", valueFile, ref)
+		}
+		if path == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "value file %s must be a path within the source '$%s'", valueFile, ref)
+		}
+		if _, ok := revisions[ref]; ok {
+			continue
+		}
+		_, commitSHA, err := s.newClientResolveRevision(target.Repo, target.Revision)
+		if err != nil {
+			return nil, err
+		}
+		revisions[ref] = commitSHA
+	}
+	return revisions, nil
+}
+
+// checkEnvFromSecrets returns an error if the allowlist of the repo server does not match the name of an environment
+// variable sourced from a secret
+func checkEnvFromSecrets(envFromSecrets map[string]string, allowlist []string) error {
+	for name := range envFromSecrets {
+		allowed := false
+		for _, pattern := range allowlist {
+			if ok, _ := filepath.Match(pattern, name); ok {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return status.Errorf(codes.PermissionDenied, "environment variable %s from a secret is not allowed by the repo server", name)
+		}
+	}
+	return nil
+}
+
+// manifestCacheKey returns the key of the manifests of a source at the resolved revision, which the manifest cache
+// further keys by the source itself. The manifests also depend on the revisions of the ref sources the value files
+// refer to, on the values of the environment variables sourced from secrets, on the destination server of the build
+// environment, and on the settings which are passed with the request.
+func manifestCacheKey(revision string, refRevisions map[string]string, q *apiclient.ManifestRequest) string {
+	return revision + refRevisionsCacheKey(refRevisions) + envFromSecretsCacheKey(q.EnvFromSecrets) + destServerCacheKey(q.DestServer) + settingsCacheKey(q)
+}
+
+// settingsCacheKey returns a hash of the settings of the request, i.e. the Helm repositories, the config management
+// plugins, the registered Kustomize and Helm versions, and the Jsonnet library dirs. Changing any of them regenerates
+// the manifests, rather than waiting for the cache to expire.
+func settingsCacheKey(q *apiclient.ManifestRequest) string {
+	if len(q.HelmRepos) == 0 && len(q.Plugins) == 0 && len(q.KustomizeVersions) == 0 && len(q.HelmVersions) == 0 && len(q.JsonnetLibs) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal([]interface{}{q.HelmRepos, q.Plugins, q.KustomizeVersions, q.HelmVersions, q.JsonnetLibs})
+	return fmt.Sprintf("+settings=%d", hash.FNVa(string(data)))
+}
+
+// envFromSecretsCacheKey returns a hash of the values of the environment variables sourced from secrets, so that the
+// values are not part of the cache keys
+func envFromSecretsCacheKey(envFromSecrets map[string]string) string {
+	if len(envFromSecrets) == 0 {
+		return ""
+	}
+	var names []string
+	for name := range envFromSecrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	env := ""
+	for _, name := range names {
+		env += fmt.Sprintf("%s=%s\n", name, envFromSecrets[name])
+	}
+	return fmt.Sprintf("+env=%d", hash.FNVa(env))
+}
+
+func destServerCacheKey(server string) string {
+	if server == "" {
+		return ""
+	}
+	return "+server=" + server
+}
+
+func refRevisionsCacheKey(revisions map[string]string) string {
+	var refs []string
+	for ref := range revisions {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	key := ""
+	for _, ref := range refs {
+		key += fmt.Sprintf("+%s=%s", ref, revisions[ref])
+	}
+	return key
+}
+
+// copyRefValueFiles copies the value files which refer to ref sources from checkouts of the resolved revisions of the
+// ref sources into a temporary directory. It returns a copy of the request whose value files are the copies, and a
+// function removing them.
+func (s *Service) copyRefValueFiles(q *apiclient.ManifestRequest, refRevisions map[string]string) (*apiclient.ManifestRequest, func(), error) {
+	if len(refRevisions) == 0 {
+		return q, func() {}, nil
+	}
+	tempDir, err := ioutil.TempDir("", "ref-values")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		_ = os.RemoveAll(tempDir)
+	}
+	source := q.ApplicationSource.DeepCopy()
+	for ref, revision := range refRevisions {
+		err = s.copyRefValueFilesOfRef(q, source.Helm.ValueFiles, ref, revision, tempDir)
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+	}
+	for i, valueFile := range source.Helm.ValueFiles {
+		if _, _, ok := parseRefValueFile(valueFile); ok {
+			source.Helm.ValueFiles[i] = filepath.Join(tempDir, fmt.Sprintf("%d.yaml", i))
+		}
+	}
+	genQ := *q
+	genQ.ApplicationSource = source
+	return &genQ, cleanup, nil
+}
+
+// copyRefValueFilesOfRef checks out the revision of a ref source and copies the value files which refer to it into
+// the directory, named by their index
+func (s *Service) copyRefValueFilesOfRef(q *apiclient.ManifestRequest, valueFiles []string, ref string, revision string, dir string) error {
+	gitClient, err := s.newClient(q.RefSources[ref].Repo)
+	if err != nil {
+		return err
+	}
+	s.repoLock.Lock(gitClient.Root())
+	defer s.repoLock.Unlock(gitClient.Root())
+
+	commitSHA, err := checkoutRevision(gitClient, revision, nil)
+	if err != nil {
+		return err
+	}
+	if q.VerifySignature {
+		err = verifyRevisionSignature(gitClient, q.RefSources[ref].Revision, commitSHA, q.SignatureKeys)
+		if err != nil {
+			return err
+		}
+	}
+	for i, valueFile := range valueFiles {
+		valueFileRef, path, ok := parseRefValueFile(valueFile)
+		if !ok || valueFileRef != ref {
+			continue
+		}
+		// the path is cleaned as an absolute path first, so that it cannot refer to files outside the repo
+		data, err := ioutil.ReadFile(filepath.Join(gitClient.Root(), filepath.Clean("/"+path)))
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "unable to read value file %s: %v", valueFile, err)
+		}
+		err = ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("%d.yaml", i)), data, 0600)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func checkPath(root, path string) error {
 	info, err := os.Stat(filepath.Join(root, path))
 	if os.IsNotExist(err) {
@@ -203,6 +620,41 @@ func checkPath(root, path string) error {
 	return nil
 }
 
+// checkHelmFileParameters checks that the files of the Helm file parameters exist within the root of the repository,
+// so the contents of other files of the repo server cannot be read into the manifests
+func checkHelmFileParameters(root, appPath string, opts *v1alpha1.ApplicationSourceHelm) error {
+	if opts == nil {
+		return nil
+	}
+	for _, p := range opts.FileParameters {
+		if filepath.IsAbs(p.Path) {
+			return status.Errorf(codes.InvalidArgument, "file parameter %s: path %s must be relative to the application path", p.Name, p.Path)
+		}
+		if _, err := resolveRepoPath(root, appPath, p.Path); err != nil {
+			return status.Errorf(codes.InvalidArgument, "file parameter %s: %v", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// resolveRepoPath resolves the symlinks of a path relative to the directory dir, and returns an error if the
+// resolved path is outside of the repository at root
+func resolveRepoPath(root, dir, path string) (string, error) {
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	resolvedPath, err := filepath.EvalSymlinks(filepath.Join(dir, path))
+	if err != nil {
+		return "", err
+	}
+	relPath, err := filepath.Rel(resolvedRoot, resolvedPath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, "../") {
+		return "", fmt.Errorf("path %s is outside of the repository", path)
+	}
+	return resolvedPath, nil
+}
+
 // GenerateManifests generates manifests from a path
 func GenerateManifests(root, path string, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
 	err := checkPath(root, path)
@@ -214,7 +666,7 @@ func GenerateManifests(root, path string, q *apiclient.ManifestRequest) (*apicli
 	var targetObjs []*unstructured.Unstructured
 	var dest *v1alpha1.ApplicationDestination
 
-	appSourceType, err := GetAppSourceType(q.ApplicationSource, appPath)
+	appSourceType, err := getAppSourceType(q.ApplicationSource, appPath, pluginDiscoveryCacheKey(q.Repo, q.Revision, root, appPath))
 	creds := argo.GetRepoCreds(q.Repo)
 	repoURL := ""
 	if q.Repo != nil {
@@ -224,8 +676,22 @@ func GenerateManifests(root, path string, q *apiclient.ManifestRequest) (*apicli
 	case v1alpha1.ApplicationSourceTypeKsonnet:
 		targetObjs, dest, err = ksShow(q.AppLabelKey, appPath, q.ApplicationSource.Ksonnet)
 	case v1alpha1.ApplicationSourceTypeHelm:
-		h := helm.NewHelmApp(appPath, q.HelmRepos)
-		err := h.Init()
+		if err := checkHelmFileParameters(root, appPath, q.ApplicationSource.Helm); err != nil {
+			return nil, err
+		}
+		var helmVersion string
+		if q.ApplicationSource.Helm != nil {
+			helmVersion = q.ApplicationSource.Helm.Version
+		}
+		binaryPath, err := findHelmBinaryPath(helmVersion, q.HelmVersions)
+		if err != nil {
+			return nil, err
+		}
+		h := helm.NewHelmApp(appPath, q.HelmRepos, binaryPath)
+		if q.ApplicationSource.Helm != nil {
+			h.SetEnv(q.ApplicationSource.Helm.Env.Environ(q.EnvFromSecrets))
+		}
+		err = h.Init()
 		if err != nil {
 			return nil, err
 		}
@@ -245,16 +711,25 @@ func GenerateManifests(root, path string, q *apiclient.ManifestRequest) (*apicli
 			}
 		}
 	case v1alpha1.ApplicationSourceTypeKustomize:
-		k := kustomize.NewKustomizeApp(appPath, creds, repoURL)
+		var binaryPath string
+		binaryPath, err = findKustomizeBinaryPath(q.ApplicationSource.Kustomize, q.KustomizeVersions)
+		if err != nil {
+			return nil, err
+		}
+		var env []string
+		if q.ApplicationSource.Kustomize != nil {
+			env = q.ApplicationSource.Kustomize.Env.Environ(q.EnvFromSecrets)
+		}
+		k := kustomize.NewKustomizeApp(appPath, creds, repoURL, binaryPath, env)
 		targetObjs, _, _, err = k.Build(q.ApplicationSource.Kustomize)
 	case v1alpha1.ApplicationSourceTypePlugin:
-		targetObjs, err = runConfigManagementPlugin(appPath, q, creds)
+		targetObjs, err = runConfigManagementPlugin(root, appPath, q, creds)
 	case v1alpha1.ApplicationSourceTypeDirectory:
 		var directory *v1alpha1.ApplicationSourceDirectory
 		if directory = q.ApplicationSource.Directory; directory == nil {
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
-		targetObjs, err = findManifests(appPath, *directory)
+		targetObjs, err = findManifests(root, appPath, *directory, q.JsonnetLibs, buildEnv(q))
 	}
 	if err != nil {
 		return nil, err
@@ -314,6 +789,11 @@ func tempRepoPath(repo string) string {
 
 // GetAppSourceType returns explicit application source type or examines a directory and determines its application source type
 func GetAppSourceType(source *v1alpha1.ApplicationSource, appDirPath string) (v1alpha1.ApplicationSourceType, error) {
+	return getAppSourceType(source, appDirPath, "")
+}
+
+// getAppSourceType is GetAppSourceType, caching the discovered plugin sidecar under the given key unless it is empty
+func getAppSourceType(source *v1alpha1.ApplicationSource, appDirPath string, discoveryCacheKey string) (v1alpha1.ApplicationSourceType, error) {
 	appSourceType, err := source.ExplicitType()
 	if err != nil {
 		return "", err
@@ -333,6 +813,9 @@ func GetAppSourceType(source *v1alpha1.ApplicationSource, appDirPath string) (v1
 			return v1alpha1.ApplicationSourceTypeKustomize, nil
 		}
 	}
+	if discoverPlugin(appDirPath, discoveryCacheKey) != "" {
+		return v1alpha1.ApplicationSourceTypePlugin, nil
+	}
 	return v1alpha1.ApplicationSourceTypeDirectory, nil
 }
 
@@ -357,23 +840,243 @@ func isNullList(obj *unstructured.Unstructured) bool {
 }
 
 // checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
+// The checkout is restricted to the given paths, if there are any
 // Returns the 40 character commit SHA after the checkout has been performed
-func checkoutRevision(gitClient git.Client, commitSHA string) (string, error) {
+func checkoutRevision(gitClient git.Client, commitSHA string, sparsePaths []string) (string, error) {
 	err := gitClient.Init()
 	if err != nil {
 		return "", status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
 	}
-	err = gitClient.Fetch()
+	err = gitClient.Fetch(commitSHA)
 	if err != nil {
 		return "", status.Errorf(codes.Internal, "Failed to fetch git repo: %v", err)
 	}
-	err = gitClient.Checkout(commitSHA)
+	if len(sparsePaths) > 0 {
+		err = gitClient.SparseCheckout(commitSHA, sparsePaths)
+	} else {
+		err = gitClient.Checkout(commitSHA)
+	}
 	if err != nil {
 		return "", status.Errorf(codes.Internal, "Failed to checkout %s: %v", commitSHA, err)
 	}
 	return gitClient.CommitSHA()
 }
 
+// checkoutWorktree fetches the revision into the clone of the repo, and checks it out into the worktree at the given
+// path, which is added if it does not exist. Returns the client of the worktree and the commit SHA of the revision.
+// Sparse checkouts are not supported by worktrees, so the worktree is checked out completely.
+func checkoutWorktree(gitClient git.Client, commitSHA string, path string) (git.Client, string, error) {
+	err := gitClient.Init()
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
+	}
+	err = gitClient.Fetch(commitSHA)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "Failed to fetch git repo: %v", err)
+	}
+	worktree, err := gitClient.Worktree(path, commitSHA)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "Failed to add worktree %s: %v", path, err)
+	}
+	err = worktree.Checkout(commitSHA)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "Failed to checkout %s: %v", commitSHA, err)
+	}
+	commitSHA, err = worktree.CommitSHA()
+	if err != nil {
+		return nil, "", err
+	}
+	return worktree, commitSHA, nil
+}
+
+// worktreePath returns the path of the worktree of a slot of a repo, next to the clone of the repo
+func worktreePath(root string, slot int) string {
+	return fmt.Sprintf("%s-worktree-%d", root, slot)
+}
+
+// worktreeSlots limits the requests which generate manifests concurrently from the same repo, each in a worktree of
+// the repo. A request acquires a free slot, whose worktree it checks out and generates the manifests in, so there are
+// at most as many worktrees of a repo as slots.
+type worktreeSlots struct {
+	limit int
+	lock  sync.Mutex
+	// the free slots of each repo, by the root of the clone of the repo
+	free map[string]chan int
+}
+
+func newWorktreeSlots(limit int) *worktreeSlots {
+	return &worktreeSlots{limit: limit, free: map[string]chan int{}}
+}
+
+func (w *worktreeSlots) slots(root string) chan int {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	free, ok := w.free[root]
+	if !ok {
+		free = make(chan int, w.limit)
+		for slot := 0; slot < w.limit; slot++ {
+			free <- slot
+		}
+		w.free[root] = free
+	}
+	return free
+}
+
+// acquire blocks until a slot of the repo is free, or the context is done
+func (w *worktreeSlots) acquire(ctx context.Context, root string) (int, error) {
+	select {
+	case slot := <-w.slots(root):
+		return slot, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// release frees a slot of the repo which was acquired
+func (w *worktreeSlots) release(root string, slot int) {
+	w.slots(root) <- slot
+}
+
+// getSparseCheckoutPaths returns the paths a sparse checkout for generating the manifests of the
+// request is restricted to, i.e. the application path, the Helm value and parameter files, the
+// Kustomize components and the Jsonnet variable files and libraries. Returns no paths if sparse checkouts are disabled, or the application path
+// is the root of the repo.
+func (s *Service) getSparseCheckoutPaths(q *apiclient.ManifestRequest) []string {
+	if !s.sparseCheckout {
+		return nil
+	}
+	appPath := filepath.Clean(q.ApplicationSource.Path)
+	if appPath == "." || appPath == ".." || strings.HasPrefix(appPath, "../") || filepath.IsAbs(appPath) {
+		return nil
+	}
+	paths := []string{appPath}
+	if q.ApplicationSource.Helm != nil {
+		for _, valueFile := range q.ApplicationSource.Helm.ValueFiles {
+			// value files which refer to ref sources are not part of the repo
+			if _, _, ok := parseRefValueFile(valueFile); ok || strings.Contains(valueFile, "://") || filepath.IsAbs(valueFile) {
+				continue
+			}
+			valueFilePath := filepath.Clean(filepath.Join(appPath, valueFile))
+			if valueFilePath != ".." && !strings.HasPrefix(valueFilePath, "../") {
+				paths = append(paths, valueFilePath)
+			}
+		}
+		for _, p := range q.ApplicationSource.Helm.FileParameters {
+			filePath := filepath.Clean(filepath.Join(appPath, p.Path))
+			if !filepath.IsAbs(p.Path) && filePath != ".." && !strings.HasPrefix(filePath, "../") {
+				paths = append(paths, filePath)
+			}
+		}
+	}
+	if q.ApplicationSource.Kustomize != nil {
+		for _, component := range q.ApplicationSource.Kustomize.Components {
+			componentPath := filepath.Clean(filepath.Join(appPath, component))
+			if !strings.Contains(component, "://") && !filepath.IsAbs(component) && componentPath != ".." && !strings.HasPrefix(componentPath, "../") {
+				paths = append(paths, componentPath)
+			}
+		}
+	}
+	jsonnetLibs := q.JsonnetLibs
+	if q.ApplicationSource.Directory != nil {
+		sourceJsonnet := q.ApplicationSource.Directory.Jsonnet
+		for _, vars := range [][]v1alpha1.JsonnetVar{sourceJsonnet.ExtVars, sourceJsonnet.TLAs} {
+			for _, v := range vars {
+				filePath := filepath.Clean(filepath.Join(appPath, v.Value))
+				if v.File && !filepath.IsAbs(v.Value) && filePath != ".." && !strings.HasPrefix(filePath, "../") {
+					paths = append(paths, filePath)
+				}
+			}
+		}
+		jsonnetLibs = append(append([]string{}, sourceJsonnet.Libs...), jsonnetLibs...)
+	}
+	// the libraries are relative to the root of the repo
+	for _, lib := range jsonnetLibs {
+		libPath := filepath.Clean(lib)
+		if !filepath.IsAbs(lib) && libPath != "." && libPath != ".." && !strings.HasPrefix(libPath, "../") {
+			paths = append(paths, libPath)
+		}
+	}
+	// The submodules are listed in a file at the root of the repo
+	if q.Repo.IsSubmodulesEnabled() {
+		paths = append(paths, ".gitmodules")
+	}
+	return paths
+}
+
+// updateSubmodules checks out the submodules of the checked out revision recursively. Each submodule
+// is fetched with the credential template matching its URL, or else with the credentials of the repo.
+func updateSubmodules(gitClient git.Client, repo *v1alpha1.Repository, creds []*v1alpha1.RepoCreds) error {
+	submodules, err := gitClient.Submodules()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to initialize submodules: %v", err)
+	}
+	for _, submodule := range submodules {
+		submoduleRepo := &v1alpha1.Repository{Repo: submodule.URL}
+		if template := getSubmoduleCredsTemplate(submodule.URL, creds); template != nil {
+			submoduleRepo.Username = template.Username
+			submoduleRepo.Password = template.Password
+			submoduleRepo.SSHPrivateKey = template.SSHPrivateKey
+			submoduleRepo.TLSClientCertData = template.TLSClientCertData
+			submoduleRepo.TLSClientCertKey = template.TLSClientCertKey
+			submoduleRepo.Insecure = repo.IsInsecure()
+		} else {
+			submoduleRepo.CopyCredentialsFrom(repo)
+		}
+		err = gitClient.UpdateSubmodule(submodule.Path, argo.GetRepoCreds(submoduleRepo))
+		if err != nil {
+			return status.Errorf(codes.Internal, "Failed to update submodule %s: %v", submodule.Path, err)
+		}
+	}
+	return nil
+}
+
+// getSubmoduleCredsTemplate returns the credential template with the longest URL that is a prefix of
+// the submodule URL, if any
+func getSubmoduleCredsTemplate(submoduleURL string, creds []*v1alpha1.RepoCreds) *v1alpha1.RepoCreds {
+	submoduleURL = git.NormalizeGitURL(submoduleURL)
+	var template *v1alpha1.RepoCreds
+	for _, c := range creds {
+		credsURL := git.NormalizeGitURL(c.URL)
+		if strings.HasPrefix(submoduleURL, credsURL) && (template == nil || len(credsURL) > len(git.NormalizeGitURL(template.URL))) {
+			template = c
+		}
+	}
+	return template
+}
+
+// verifyRevisionSignature verifies that either the commit of a revision, or the annotated tag the
+// revision refers to, is signed with one of the given ASCII armored public keys
+func verifyRevisionSignature(gitClient git.Client, revision string, commitSHA string, keys []string) error {
+	objectType, commit, err := gitClient.CatFile(commitSHA)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to read commit %s: %v", commitSHA, err)
+	}
+	if objectType != "commit" {
+		return status.Errorf(codes.Internal, "Object %s is a %s, not a commit", commitSHA, objectType)
+	}
+	keyID, err := gpg.VerifyCommitSignature(commit, keys)
+	if err == nil {
+		log.Infof("commit %s is signed with trusted key %s", commitSHA, keyID)
+		return nil
+	}
+	if revision != "" && !git.IsCommitSHA(revision) {
+		tagRef := revision
+		if !strings.HasPrefix(tagRef, "refs/") {
+			tagRef = "refs/tags/" + tagRef
+		}
+		tagType, tag, tagErr := gitClient.CatFile(tagRef)
+		if tagErr == nil && tagType == "tag" && gpg.GetTagObject(tag) == commitSHA {
+			keyID, tagErr = gpg.VerifyTagSignature(tag, keys)
+			if tagErr == nil {
+				log.Infof("tag %s of commit %s is signed with trusted key %s", revision, commitSHA, keyID)
+				return nil
+			}
+			err = fmt.Errorf("%v; tag %s: %v", err, revision, tagErr)
+		}
+	}
+	return status.Errorf(codes.FailedPrecondition, "revision %s is not signed with a trusted key: %v", commitSHA, err)
+}
+
 // ksShow runs `ks show` in an app directory after setting any component parameter overrides
 func ksShow(appLabelKey, appPath string, ksonnetOpts *v1alpha1.ApplicationSourceKsonnet) ([]*unstructured.Unstructured, *v1alpha1.ApplicationDestination, error) {
 	ksApp, err := ksonnet.NewKsonnetApp(appPath)
@@ -408,28 +1111,52 @@ func ksShow(appLabelKey, appPath string, ksonnetOpts *v1alpha1.ApplicationSource
 
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
-// findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(appPath string, directory v1alpha1.ApplicationSourceDirectory) ([]*unstructured.Unstructured, error) {
+// envVarRef matches the references to variables which are substituted in the manifests of directory sources
+var envVarRef = regexp.MustCompile(`\$(\$?)\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects. If
+// substitution is enabled, the variables of the given build environment are substituted in the YAML and JSON files.
+func findManifests(root, appPath string, directory v1alpha1.ApplicationSourceDirectory, jsonnetLibs []string, env v1alpha1.Env) ([]*unstructured.Unstructured, error) {
+	for _, pattern := range append(append([]string{}, directory.Exclude...), directory.Include...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid glob pattern %q of the directory: %v", pattern, err)
+		}
+	}
 	var objs []*unstructured.Unstructured
 	err := filepath.Walk(appPath, func(path string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == appPath {
+			return nil
+		}
+		relPath, err := filepath.Rel(appPath, path)
 		if err != nil {
 			return err
 		}
 		if f.IsDir() {
-			if path != appPath && !directory.Recurse {
+			if !directory.Recurse || matchesAnyDirectoryPattern(directory.Exclude, relPath) {
 				return filepath.SkipDir
-			} else {
-				return nil
 			}
+			return nil
 		}
 
 		if !manifestFile.MatchString(f.Name()) {
 			return nil
 		}
+		if matchesAnyDirectoryPattern(directory.Exclude, relPath) {
+			return nil
+		}
+		if len(directory.Include) > 0 && !matchesAnyDirectoryPattern(directory.Include, relPath) {
+			return nil
+		}
 		out, err := utfutil.ReadFile(path, utfutil.UTF8)
 		if err != nil {
 			return err
 		}
+		if directory.Substitute && !strings.HasSuffix(f.Name(), ".jsonnet") {
+			out = []byte(substituteEnv(string(out), env))
+		}
 		if strings.HasSuffix(f.Name(), ".json") {
 			var obj unstructured.Unstructured
 			err = json.Unmarshal(out, &obj)
@@ -438,10 +1165,10 @@ func findManifests(appPath string, directory v1alpha1.ApplicationSourceDirectory
 			}
 			objs = append(objs, &obj)
 		} else if strings.HasSuffix(f.Name(), ".jsonnet") {
-			vm := makeJsonnetVm(directory.Jsonnet)
-			vm.Importer(&jsonnet.FileImporter{
-				JPaths: []string{appPath},
-			})
+			vm, err := makeJsonnetVm(root, appPath, directory.Jsonnet, jsonnetLibs)
+			if err != nil {
+				return err
+			}
 			jsonStr, err := vm.EvaluateSnippet(f.Name(), string(out))
 			if err != nil {
 				return status.Errorf(codes.FailedPrecondition, "Failed to evaluate jsonnet %q: %v", f.Name(), err)
@@ -482,25 +1209,131 @@ func findManifests(appPath string, directory v1alpha1.ApplicationSourceDirectory
 	return objs, nil
 }
 
-func makeJsonnetVm(sourceJsonnet v1alpha1.ApplicationSourceJsonnet) *jsonnet.VM {
+// buildEnv returns the build environment of the manifests, which plugins have access to, and which is substituted in
+// the manifests of directory sources with substitution
+func buildEnv(q *apiclient.ManifestRequest) v1alpha1.Env {
+	return v1alpha1.Env{
+		{Name: PluginEnvAppName, Value: q.AppLabelValue},
+		{Name: PluginEnvAppNamespace, Value: q.Namespace},
+		{Name: PluginEnvAppRevision, Value: q.Revision},
+		{Name: PluginEnvAppDestServer, Value: q.DestServer},
+	}
+}
+
+// substituteEnv replaces the references of the form ${NAME} to the variables of the environment with their values.
+// References to other variables are kept, and $${NAME} is replaced by ${NAME}.
+func substituteEnv(data string, env v1alpha1.Env) string {
+	values := make(map[string]string)
+	for _, e := range env {
+		values[e.Name] = e.Value
+	}
+	return envVarRef.ReplaceAllStringFunc(data, func(ref string) string {
+		match := envVarRef.FindStringSubmatch(ref)
+		if match[1] != "" {
+			return ref[1:]
+		}
+		if value, ok := values[match[2]]; ok {
+			return value
+		}
+		return ref
+	})
+}
+
+// matchesAnyDirectoryPattern returns whether a file or directory matches any of the glob patterns of a directory source,
+// given its path relative to the application path. Patterns without a slash match the name of the file or directory,
+// other patterns match its relative path.
+func matchesAnyDirectoryPattern(patterns []string, relPath string) bool {
+	for _, pattern := range patterns {
+		name := relPath
+		if !strings.Contains(pattern, "/") {
+			name = filepath.Base(relPath)
+		}
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// makeJsonnetVm returns a jsonnet VM with the variables of the application, which imports the libraries of the
+// application path, the library search dirs of the application and the library search dirs of all applications.
+// The library search dirs of all applications which do not exist in the repository are ignored.
+func makeJsonnetVm(root, appPath string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, jsonnetLibs []string) (*jsonnet.VM, error) {
 	vm := jsonnet.MakeVM()
 
 	for _, arg := range sourceJsonnet.TLAs {
+		value, err := jsonnetVarValue(root, appPath, arg)
+		if err != nil {
+			return nil, err
+		}
 		if arg.Code {
-			vm.TLACode(arg.Name, arg.Value)
+			vm.TLACode(arg.Name, value)
 		} else {
-			vm.TLAVar(arg.Name, arg.Value)
+			vm.TLAVar(arg.Name, value)
 		}
 	}
 	for _, extVar := range sourceJsonnet.ExtVars {
+		value, err := jsonnetVarValue(root, appPath, extVar)
+		if err != nil {
+			return nil, err
+		}
 		if extVar.Code {
-			vm.ExtCode(extVar.Name, extVar.Value)
+			vm.ExtCode(extVar.Name, value)
 		} else {
-			vm.ExtVar(extVar.Name, extVar.Value)
+			vm.ExtVar(extVar.Name, value)
+		}
+	}
+
+	jPaths := []string{appPath}
+	for _, lib := range sourceJsonnet.Libs {
+		libPath, err := jsonnetLibPath(root, lib)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "jsonnet library %s: %v", lib, err)
 		}
+		jPaths = append(jPaths, libPath)
 	}
+	for _, lib := range jsonnetLibs {
+		libPath, err := jsonnetLibPath(root, lib)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "jsonnet library %s: %v", lib, err)
+		}
+		jPaths = append(jPaths, libPath)
+	}
+	vm.Importer(&jsonnet.FileImporter{
+		JPaths: jPaths,
+	})
 
-	return vm
+	return vm, nil
+}
+
+// jsonnetLibPath returns the resolved path of a library search dir, which is relative to the root of the repository
+func jsonnetLibPath(root, lib string) (string, error) {
+	if filepath.IsAbs(lib) {
+		return "", fmt.Errorf("path %s must be relative to the root of the repository", lib)
+	}
+	return resolveRepoPath(root, root, lib)
+}
+
+// jsonnetVarValue returns the value of a jsonnet variable, which is read from a file of the repository if the
+// variable is a file variable
+func jsonnetVarValue(root, appPath string, v v1alpha1.JsonnetVar) (string, error) {
+	if !v.File {
+		return v.Value, nil
+	}
+	if filepath.IsAbs(v.Value) {
+		return "", status.Errorf(codes.InvalidArgument, "jsonnet variable %s: path %s must be relative to the application path", v.Name, v.Value)
+	}
+	path, err := resolveRepoPath(root, appPath, v.Value)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "jsonnet variable %s: %v", v.Name, err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "jsonnet variable %s: %v", v.Name, err)
+	}
+	return string(data), nil
 }
 
 // pathExists reports whether the file or directory at the named concatenation of paths exists.
@@ -530,7 +1363,7 @@ func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision s
 
 func (s *Service) newClient(repo *v1alpha1.Repository) (git.Client, error) {
 	appPath := tempRepoPath(git.NormalizeGitURL(repo.Repo))
-	return s.gitFactory.NewClient(repo.Repo, appPath, argo.GetRepoCreds(repo), repo.IsInsecure(), repo.EnableLFS)
+	return s.gitFactory.NewClient(repo.Repo, appPath, argo.GetRepoCreds(repo), repo.IsInsecure(), repo.EnableLFS, repo.Proxy)
 }
 
 func runCommand(command v1alpha1.Command, path string, env []string) (string, error) {
@@ -543,6 +1376,33 @@ func runCommand(command v1alpha1.Command, path string, env []string) (string, er
 	return argoexec.RunCommandExt(cmd, config.CmdOpts())
 }
 
+// findHelmBinaryPath returns the path of the helm binary of the named version, or an empty path for the bundled helm
+func findHelmBinaryPath(name string, versions []*v1alpha1.HelmVersion) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	for _, version := range versions {
+		if version.Name == name {
+			return version.Path, nil
+		}
+	}
+	return "", status.Errorf(codes.InvalidArgument, "helm version %s is not registered in the settings", name)
+}
+
+// findKustomizeBinaryPath returns the path of the kustomize binary of the version the application selects, or an
+// empty path for the bundled kustomize
+func findKustomizeBinaryPath(opts *v1alpha1.ApplicationSourceKustomize, versions []*v1alpha1.KustomizeVersion) (string, error) {
+	if opts == nil || opts.Version == "" {
+		return "", nil
+	}
+	for _, version := range versions {
+		if version.Name == opts.Version {
+			return version.Path, nil
+		}
+	}
+	return "", status.Errorf(codes.InvalidArgument, "kustomize version %s is not registered in the settings", opts.Version)
+}
+
 func findPlugin(plugins []*v1alpha1.ConfigManagementPlugin, name string) *v1alpha1.ConfigManagementPlugin {
 	for _, plugin := range plugins {
 		if plugin.Name == name {
@@ -552,12 +1412,21 @@ func findPlugin(plugins []*v1alpha1.ConfigManagementPlugin, name string) *v1alph
 	return nil
 }
 
-func runConfigManagementPlugin(appPath string, q *apiclient.ManifestRequest, creds git.Creds) ([]*unstructured.Unstructured, error) {
-	plugin := findPlugin(q.Plugins, q.ApplicationSource.Plugin.Name)
+// runConfigManagementPlugin generates the manifests with the plugin of the settings of the given name, or else with
+// the plugin sidecar of the given name
+func runConfigManagementPlugin(root, appPath string, q *apiclient.ManifestRequest, creds git.Creds) ([]*unstructured.Unstructured, error) {
+	var pluginName string
+	if q.ApplicationSource.Plugin != nil {
+		pluginName = q.ApplicationSource.Plugin.Name
+	}
+	var plugin *v1alpha1.ConfigManagementPlugin
+	if pluginName != "" {
+		plugin = findPlugin(q.Plugins, pluginName)
+	}
 	if plugin == nil {
-		return nil, fmt.Errorf("Config management plugin with name '%s' is not supported.", q.ApplicationSource.Plugin.Name)
+		return runConfigManagementPluginSidecar(root, appPath, pluginName, q)
 	}
-	env := append(os.Environ(), fmt.Sprintf("%s=%s", PluginEnvAppName, q.AppLabelValue), fmt.Sprintf("%s=%s", PluginEnvAppNamespace, q.Namespace))
+	env := append(os.Environ(), buildEnv(q).Environ(nil)...)
 	if creds != nil {
 		closer, environ, err := creds.Environ()
 		if err != nil {
@@ -566,7 +1435,7 @@ func runConfigManagementPlugin(appPath string, q *apiclient.ManifestRequest, cre
 		defer func() { _ = closer.Close() }()
 		env = append(env, environ...)
 	}
-	env = append(env, q.ApplicationSource.Plugin.Env.Environ()...)
+	env = append(env, q.ApplicationSource.Plugin.Env.Environ(q.EnvFromSecrets)...)
 	if plugin.Init != nil {
 		_, err := runCommand(*plugin.Init, appPath, env)
 		if err != nil {
@@ -580,6 +1449,139 @@ func runConfigManagementPlugin(appPath string, q *apiclient.ManifestRequest, cre
 	return kube.SplitYAML(out)
 }
 
+// runConfigManagementPluginSidecar generates the manifests with the plugin sidecar of the given name, or with the first
+// sidecar whose discovery rule matches the application if no name is given. The sidecars share the temp dir of the
+// repo server, so they read the repository from the same path.
+func runConfigManagementPluginSidecar(root, appPath, pluginName string, q *apiclient.ManifestRequest) ([]*unstructured.Unstructured, error) {
+	if pluginName == "" {
+		if pluginName = discoverPlugin(appPath, pluginDiscoveryCacheKey(q.Repo, q.Revision, root, appPath)); pluginName == "" {
+			return nil, fmt.Errorf("No config management plugin supports the application.")
+		}
+	}
+	// the name must not lead out of the directory of the sockets
+	if strings.ContainsAny(pluginName, `/\`) || strings.Contains(pluginName, "..") {
+		return nil, fmt.Errorf("Config management plugin name '%s' is invalid.", pluginName)
+	}
+	socketPath := filepath.Join(pluginclient.GetPluginSockFilePath(), pluginName+".sock")
+	if !pathExists(socketPath) {
+		return nil, fmt.Errorf("Config management plugin with name '%s' is not supported.", pluginName)
+	}
+	closer, client, err := pluginclient.NewConfigManagementPluginClientSet(socketPath).NewConfigManagementPluginClient()
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(closer)
+
+	req := &pluginclient.ManifestRequest{
+		AppPath:  appPath,
+		RepoPath: root,
+	}
+	for _, e := range buildEnv(q) {
+		req.Env = append(req.Env, &pluginclient.EnvEntry{Name: e.Name, Value: e.Value})
+	}
+	if plugin := q.ApplicationSource.Plugin; plugin != nil {
+		for _, e := range plugin.Env.Environ(q.EnvFromSecrets) {
+			parts := strings.SplitN(e, "=", 2)
+			req.Env = append(req.Env, &pluginclient.EnvEntry{Name: parts[0], Value: parts[1]})
+		}
+		for _, p := range plugin.Parameters {
+			req.Parameters = append(req.Parameters, &pluginclient.Parameter{Name: p.Name, Value: p.Value})
+		}
+	}
+	stream, err := client.GenerateManifest(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	var objs []*unstructured.Unstructured
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal([]byte(res.Manifest), &obj); err != nil {
+			return nil, fmt.Errorf("Failed to unmarshal the manifest generated by plugin %s: %v", pluginName, err)
+		}
+		objs = append(objs, &obj)
+	}
+	return objs, nil
+}
+
+// discoverPlugin returns the name of the first plugin sidecar, in the order of their names, whose discovery rule
+// matches the application, or an empty string if no sidecar matches it. Unless the cache key is empty, the result is
+// cached for the running sidecars, so that discovery runs again once sidecars are added or removed.
+func discoverPlugin(appPath string, cacheKey string) string {
+	sockets, err := filepath.Glob(filepath.Join(pluginclient.GetPluginSockFilePath(), "*.sock"))
+	if err != nil || len(sockets) == 0 {
+		return ""
+	}
+	sort.Strings(sockets)
+	if cacheKey != "" {
+		cacheKey = cacheKey + "|" + strings.Join(sockets, ",")
+		if name, ok := pluginDiscoveryCache.Get(cacheKey); ok {
+			return name.(string)
+		}
+	}
+	failed := false
+	for _, socketPath := range sockets {
+		name := strings.TrimSuffix(filepath.Base(socketPath), ".sock")
+		if !pluginclient.IsAcceptingConnections(socketPath) {
+			log.Debugf("Skipping config management plugin %s, which does not accept connections", name)
+			continue
+		}
+		supported, err := matchRepository(socketPath, appPath)
+		if err != nil {
+			log.Warnf("Failed to run the discovery rule of config management plugin %s: %v", name, err)
+			failed = true
+			continue
+		}
+		if supported {
+			if cacheKey != "" {
+				pluginDiscoveryCache.SetDefault(cacheKey, name)
+			}
+			return name
+		}
+	}
+	// A sidecar which failed may support the application once it recovers
+	if cacheKey != "" && !failed {
+		pluginDiscoveryCache.SetDefault(cacheKey, "")
+	}
+	return ""
+}
+
+// pluginDiscoveryCacheKey returns the key the plugin sidecar discovered for the application at appPath in the
+// checkout of the given revision at root is cached under, or an empty string if the revision is unknown
+func pluginDiscoveryCacheKey(repo *v1alpha1.Repository, revision, root, appPath string) string {
+	if revision == "" {
+		return ""
+	}
+	path, err := filepath.Rel(root, appPath)
+	if err != nil {
+		return ""
+	}
+	repoURL := ""
+	if repo != nil {
+		repoURL = repo.Repo
+	}
+	return repoURL + "|" + revision + "|" + path
+}
+
+func matchRepository(socketPath, appPath string) (bool, error) {
+	closer, client, err := pluginclient.NewConfigManagementPluginClientSet(socketPath).NewConfigManagementPluginClient()
+	if err != nil {
+		return false, err
+	}
+	defer util.Close(closer)
+	res, err := client.MatchRepository(context.Background(), &pluginclient.RepositoryRequest{AppPath: appPath})
+	if err != nil {
+		return false, err
+	}
+	return res.IsSupported, nil
+}
+
 func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppDetailsQuery) (*apiclient.RepoAppDetailsResponse, error) {
 	revision := q.Revision
 	if revision == "" {
@@ -614,14 +1616,21 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 		return cached, nil
 	}
 
-	commitSHA, err = checkoutRevision(gitClient, commitSHA)
+	commitSHA, err = checkoutRevision(gitClient, commitSHA, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if q.Repo.IsSubmodulesEnabled() {
+		err = updateSubmodules(gitClient, q.Repo, q.SubmoduleCreds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	appPath := filepath.Join(gitClient.Root(), q.Path)
 
-	appSourceType, err := GetAppSourceType(&v1alpha1.ApplicationSource{}, appPath)
+	appSourceType, err := getAppSourceType(&v1alpha1.ApplicationSource{}, appPath, pluginDiscoveryCacheKey(q.Repo, commitSHA, gitClient.Root(), appPath))
 	if err != nil {
 		return nil, err
 	}
@@ -671,7 +1680,15 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 				res.Helm.ValueFiles = append(res.Helm.ValueFiles, fName)
 			}
 		}
-		h := helm.NewHelmApp(appPath, q.HelmRepos)
+		var helmVersion string
+		if q.Helm != nil {
+			helmVersion = q.Helm.Version
+		}
+		binaryPath, err := findHelmBinaryPath(helmVersion, q.HelmVersions)
+		if err != nil {
+			return nil, err
+		}
+		h := helm.NewHelmApp(appPath, q.HelmRepos, binaryPath)
 		err = h.Init()
 		if err != nil {
 			return nil, err
@@ -682,10 +1699,14 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 			return nil, err
 		}
 		res.Helm.Parameters = params
+		res.Helm.Version, err = h.Version()
+		if err != nil {
+			return nil, err
+		}
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		res.Kustomize = &apiclient.KustomizeAppSpec{}
 		res.Kustomize.Path = q.Path
-		k := kustomize.NewKustomizeApp(appPath, argo.GetRepoCreds(q.Repo), q.Repo.Repo)
+		k := kustomize.NewKustomizeApp(appPath, argo.GetRepoCreds(q.Repo), q.Repo.Repo, "", nil)
 		_, imageTags, images, err := k.Build(nil)
 		if err != nil {
 			return nil, err
@@ -707,7 +1728,7 @@ func (s *Service) getRevisionMetadata(repoURL *v1alpha1.Repository, revision str
 	if err != nil {
 		return nil, err
 	}
-	err = client.Fetch()
+	err = client.Fetch(commitSHA)
 	if err != nil {
 		return nil, err
 	}
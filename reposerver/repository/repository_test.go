@@ -1,10 +1,17 @@
 package repository
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -13,22 +20,32 @@ import (
 	"github.com/argoproj/pkg/exec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/argoproj/argo-cd/cmpserver"
+	"github.com/argoproj/argo-cd/cmpserver/plugin"
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/reposerver/metrics"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/git"
 	gitmocks "github.com/argoproj/argo-cd/util/git/mocks"
+	"github.com/argoproj/argo-cd/util/helm"
 )
 
 func newMockRepoServerService(root string) *Service {
+	factory := newFakeGitClientFactory(root)
 	return &Service{
-		repoLock:   util.NewKeyLock(),
-		gitFactory: newFakeGitClientFactory(root),
-		cache:      cache.NewCache(cache.NewInMemoryCache(time.Hour)),
+		repoLock:      util.NewKeyLock(),
+		gitFactory:    factory,
+		metricsServer: metrics.NewMetricsServer(factory),
+		cache:         cache.NewCache(cache.NewInMemoryCache(time.Hour)),
 	}
 }
 
@@ -44,9 +61,23 @@ type fakeGitClientFactory struct {
 	root             string
 	revision         string
 	revisionMetadata *git.RevisionMetadata
+	// raw Git objects by name, as returned by CatFile
+	objects map[string]gitObject
+	// the paths of the worktrees which were checked out
+	worktrees []string
+	// submodules of the revision, and the credentials they were updated with by path
+	submodules        []git.Submodule
+	updatedSubmodules map[string]git.Creds
+	// paths of the last sparse checkout
+	sparsePaths []string
+}
+
+type gitObject struct {
+	objectType string
+	content    string
 }
 
-func (f *fakeGitClientFactory) NewClient(repoURL string, path string, creds git.Creds, insecureIgnoreHostKey bool, enableLfs bool) (git.Client, error) {
+func (f *fakeGitClientFactory) NewClient(repoURL string, path string, creds git.Creds, insecureIgnoreHostKey bool, enableLfs bool, proxy string) (git.Client, error) {
 	mockClient := gitmocks.Client{}
 	root := "./testdata"
 	if f.root != "" {
@@ -56,13 +87,499 @@ func (f *fakeGitClientFactory) NewClient(repoURL string, path string, creds git.
 	mockClient.On("Init").Return(nil)
 	mockClient.On("Fetch", mock.Anything).Return(nil)
 	mockClient.On("Checkout", mock.Anything).Return(nil)
+	mockClient.On("SparseCheckout", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		f.sparsePaths = args.Get(1).([]string)
+	})
 	mockClient.On("LsRemote", mock.Anything).Return(f.revision, nil)
 	mockClient.On("LsFiles", mock.Anything).Return([]string{}, nil)
 	mockClient.On("CommitSHA", mock.Anything).Return(f.revision, nil)
 	mockClient.On("RevisionMetadata", f.revision).Return(f.revisionMetadata, nil)
+	for name, object := range f.objects {
+		mockClient.On("CatFile", name).Return(object.objectType, object.content, nil)
+	}
+	mockClient.On("CatFile", mock.Anything).Return("", "", fmt.Errorf("not found"))
+	mockClient.On("Submodules").Return(f.submodules, nil)
+	mockClient.On("UpdateSubmodule", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		if f.updatedSubmodules == nil {
+			f.updatedSubmodules = make(map[string]git.Creds)
+		}
+		f.updatedSubmodules[args.String(0)] = args.Get(1).(git.Creds)
+	})
+	// The worktrees share the checkout of the fake repo
+	mockClient.On("Worktree", mock.Anything, mock.Anything).Return(&mockClient, nil).Run(func(args mock.Arguments) {
+		f.worktrees = append(f.worktrees, args.String(0))
+	})
 	return &mockClient, nil
 }
 
+type fakeHelmClient struct {
+	index        *helm.Index
+	extractCalls int
+}
+
+func (f *fakeHelmClient) GetIndex() (*helm.Index, error) {
+	return f.index, nil
+}
+
+func (f *fakeHelmClient) ExtractChart(chart string, version string) (string, io.Closer, error) {
+	f.extractCalls++
+	// The chart's manifests are plain YAML, which doesn't need the helm binary
+	return "./testdata/concatenated", ioutil.NopCloser(nil), nil
+}
+
+func TestGenerateManifestHelmChart(t *testing.T) {
+	helmClient := &fakeHelmClient{index: &helm.Index{Entries: map[string]helm.Entries{
+		"mychart": {{Version: "0.1.0"}, {Version: "0.2.0"}},
+	}}}
+	service := newMockRepoServerService("")
+	service.newHelmClient = func(repoURL string, creds helm.Creds) helm.Client {
+		return helmClient
+	}
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "https://charts.example.com", Type: argoappv1.RepositoryTypeHelm},
+		Revision:          "0.2.*",
+		ApplicationSource: &argoappv1.ApplicationSource{Chart: "mychart", TargetRevision: "0.2.*"},
+	}
+	res, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.2.0", res.Revision)
+	assert.Len(t, res.Manifests, 3)
+
+	// The resolved version is cached
+	res, err = service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.2.0", res.Revision)
+	assert.Equal(t, 1, helmClient.extractCalls)
+
+	q.Revision = "0.3.*"
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.EqualError(t, err, "no chart version matches '0.3.*'")
+
+	q.ApplicationSource.Chart = "otherchart"
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.EqualError(t, err, "chart 'otherchart' not found in index")
+}
+
+type fakeOCIClient struct {
+	digests   map[string]string
+	extracted []string
+}
+
+func (f *fakeOCIClient) TestAccess() error {
+	return nil
+}
+
+func (f *fakeOCIClient) ResolveDigest(chart string, reference string) (string, error) {
+	digest, ok := f.digests[reference]
+	if !ok {
+		return "", fmt.Errorf("tag '%s' not found", reference)
+	}
+	return digest, nil
+}
+
+func (f *fakeOCIClient) ExtractChart(chart string, digest string) (string, io.Closer, error) {
+	f.extracted = append(f.extracted, digest)
+	return "./testdata/concatenated", ioutil.NopCloser(nil), nil
+}
+
+// Returns the ASCII armored public key of a new key pair and an ASCII armored detached signature of
+// the given payload made with it
+func signPayload(t *testing.T, payload string) (string, string) {
+	entity, err := openpgp.NewEntity("Alice", "", "alice@example.com", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.SerializePrivate(ioutil.Discard, nil))
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PublicKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.Serialize(w))
+	assert.NoError(t, w.Close())
+	var signature bytes.Buffer
+	assert.NoError(t, openpgp.ArmoredDetachSign(&signature, entity, strings.NewReader(payload), nil))
+	return key.String(), signature.String() + "\n"
+}
+
+func TestGenerateManifestVerifySignature(t *testing.T) {
+	commit := "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor Alice <alice@example.com> 1570000000 +0200\n\nInitial commit\n"
+	tag := "object aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd\ntype commit\ntag v1.0.0\ntagger Alice <alice@example.com> 1570000000 +0200\n\nRelease v1.0.0\n"
+	key, signature := signPayload(t, tag)
+	otherKey, _ := signPayload(t, tag)
+
+	service := newMockRepoServerService("")
+	factory := newFakeGitClientFactory("")
+	factory.objects = map[string]gitObject{
+		factory.revision:   {objectType: "commit", content: commit},
+		"refs/tags/v1.0.0": {objectType: "tag", content: tag + signature},
+	}
+	service.gitFactory = factory
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		Revision:          "v1.0.0",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+		VerifySignature:   true,
+		SignatureKeys:     []string{key},
+	}
+
+	// The commit isn't signed, but the annotated tag of the revision is
+	res, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+
+	// Cached manifests are not returned for untrusted revisions
+	q.SignatureKeys = []string{otherKey}
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "revision aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd is not signed with a trusted key")
+
+	q.Revision = "master"
+	q.SignatureKeys = []string{key}
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "commit is not signed")
+
+	q.VerifySignature = false
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+
+	q.VerifySignature = true
+	q.Repo = &argoappv1.Repository{Repo: "https://charts.example.com", Type: argoappv1.RepositoryTypeHelm}
+	q.ApplicationSource = &argoappv1.ApplicationSource{Chart: "mychart", TargetRevision: "0.2.0"}
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signature verification is only supported for Git repositories")
+}
+
+func TestGenerateManifestSubmodules(t *testing.T) {
+	service := newMockRepoServerService("")
+	factory := newFakeGitClientFactory("")
+	factory.submodules = []git.Submodule{
+		{Path: "vendor/charts", URL: "https://git.example.com/org/charts.git"},
+		{Path: "vendor/bases", URL: "https://git.example.com/other/bases.git"},
+	}
+	service.gitFactory = factory
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "https://git.example.com/org/repo.git", Username: "alice", Password: "secret"},
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+		SubmoduleCreds: []*argoappv1.RepoCreds{
+			{URL: "https://git.example.com", Username: "bob", Password: "secret"},
+			{URL: "https://git.example.com/org", Username: "carol", Password: "secret"},
+		},
+	}
+
+	// Submodules are only updated if they are enabled
+	_, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Len(t, factory.updatedSubmodules, 0)
+
+	q.NoCache = true
+	q.Repo.EnableSubmodules = true
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	// The most specific template matching the submodule URL is used
+	assert.Equal(t, git.NewHTTPSCreds("carol", "secret", "", "", false), factory.updatedSubmodules["vendor/charts"])
+	assert.Equal(t, git.NewHTTPSCreds("bob", "secret", "", "", false), factory.updatedSubmodules["vendor/bases"])
+
+	// Without a matching template, the credentials of the repo are used
+	q.SubmoduleCreds = nil
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Equal(t, git.NewHTTPSCreds("alice", "secret", "", "", false), factory.updatedSubmodules["vendor/bases"])
+}
+
+func manifestCacheRequests(t *testing.T, service *Service) string {
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	service.metricsServer.GetHandler().ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var lines []string
+	for _, line := range strings.Split(rr.Body.String(), "\n") {
+		if strings.HasPrefix(line, "argocd_repo_manifest_cache_request_total{") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestGenerateManifestCache(t *testing.T) {
+	service := newMockRepoServerService("")
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "https://github.com/fakeorg/fakerepo.git"},
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+	}
+
+	_, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	res, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.Equal(t, `argocd_repo_manifest_cache_request_total{repo="https://github.com/fakeorg/fakerepo.git",result="hit"} 1
+argocd_repo_manifest_cache_request_total{repo="https://github.com/fakeorg/fakerepo.git",result="miss"} 1`, manifestCacheRequests(t, service))
+
+	// the manifests are regenerated if the settings of the request change
+	q.JsonnetLibs = []string{"vendor"}
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Contains(t, manifestCacheRequests(t, service), `result="miss"} 2`)
+
+	// a hard refresh bypasses the cache
+	q.NoCache = true
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Equal(t, `argocd_repo_manifest_cache_request_total{repo="https://github.com/fakeorg/fakerepo.git",result="hit"} 1
+argocd_repo_manifest_cache_request_total{repo="https://github.com/fakeorg/fakerepo.git",result="miss"} 2`, manifestCacheRequests(t, service))
+}
+
+func Test_settingsCacheKey(t *testing.T) {
+	q := &apiclient.ManifestRequest{}
+	assert.Equal(t, "", settingsCacheKey(q))
+	q.Plugins = []*argoappv1.ConfigManagementPlugin{{Name: "foo", Generate: argoappv1.Command{Command: []string{"foo"}}}}
+	key := settingsCacheKey(q)
+	assert.NotEqual(t, "", key)
+	assert.Equal(t, key, settingsCacheKey(q))
+	q.Plugins[0].Generate.Command = []string{"bar"}
+	assert.NotEqual(t, key, settingsCacheKey(q))
+}
+
+func TestGenerateManifestSparseCheckout(t *testing.T) {
+	service := newMockRepoServerService("")
+	factory := newFakeGitClientFactory("")
+	service.gitFactory = factory
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+		NoCache:           true,
+	}
+
+	// Sparse checkouts are disabled by default
+	_, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Nil(t, factory.sparsePaths)
+
+	service.sparseCheckout = true
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"concatenated"}, factory.sparsePaths)
+}
+
+func TestGenerateManifestWorktrees(t *testing.T) {
+	service := newMockRepoServerService("")
+	factory := newFakeGitClientFactory("")
+	service.gitFactory = factory
+	service.sparseCheckout = true
+	service.worktrees = newWorktreeSlots(2)
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "concatenated"},
+		NoCache:           true,
+	}
+
+	res, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	// the slots of the worktrees are used in turn, and worktrees are checked out completely
+	for i := 0; i < 2; i++ {
+		_, err = service.GenerateManifest(context.Background(), &q)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []string{"./testdata-worktree-0", "./testdata-worktree-1", "./testdata-worktree-0"}, factory.worktrees)
+	assert.Nil(t, factory.sparsePaths)
+}
+
+func Test_worktreeSlots(t *testing.T) {
+	worktrees := newWorktreeSlots(2)
+	slot, err := worktrees.acquire(context.Background(), "/tmp/repo")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, slot)
+	slot, err = worktrees.acquire(context.Background(), "/tmp/repo")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, slot)
+
+	// the slots of other repos are independent
+	_, err = worktrees.acquire(context.Background(), "/tmp/other-repo")
+	assert.NoError(t, err)
+
+	// requests wait for a free slot of the repo
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = worktrees.acquire(ctx, "/tmp/repo")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	worktrees.release("/tmp/repo", 0)
+	slot, err = worktrees.acquire(context.Background(), "/tmp/repo")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, slot)
+}
+
+func Test_getSparseCheckoutPaths(t *testing.T) {
+	service := newMockRepoServerService("")
+	service.sparseCheckout = true
+	getPaths := func(source argoappv1.ApplicationSource, repo argoappv1.Repository) []string {
+		return service.getSparseCheckoutPaths(&apiclient.ManifestRequest{Repo: &repo, ApplicationSource: &source})
+	}
+
+	assert.Equal(t, []string{"apps/guestbook"}, getPaths(argoappv1.ApplicationSource{Path: "apps/guestbook/"}, argoappv1.Repository{}))
+	// Value files within the repo are checked out as well
+	assert.Equal(t, []string{"apps/guestbook", "apps/guestbook/values-prod.yaml", "values/common.yaml"}, getPaths(argoappv1.ApplicationSource{
+		Path: "apps/guestbook",
+		Helm: &argoappv1.ApplicationSourceHelm{ValueFiles: []string{"values-prod.yaml", "../../values/common.yaml", "../../../outside.yaml", "https://example.com/values.yaml", "$values/prod.yaml"}},
+	}, argoappv1.Repository{}))
+	// So are the files of file parameters
+	assert.Equal(t, []string{"apps/guestbook", "certs/ca.crt"}, getPaths(argoappv1.ApplicationSource{
+		Path: "apps/guestbook",
+		Helm: &argoappv1.ApplicationSourceHelm{FileParameters: []argoappv1.HelmFileParameter{{Name: "ca", Path: "../../certs/ca.crt"}, {Name: "key", Path: "/etc/key"}}},
+	}, argoappv1.Repository{}))
+	// And Kustomize components
+	assert.Equal(t, []string{"apps/guestbook", "components/ha"}, getPaths(argoappv1.ApplicationSource{
+		Path:      "apps/guestbook",
+		Kustomize: &argoappv1.ApplicationSourceKustomize{Components: []string{"../../components/ha", "https://github.com/example/components//ha"}},
+	}, argoappv1.Repository{}))
+	// And Jsonnet variable files and libraries, which are relative to the root of the repo
+	assert.Equal(t, []string{"apps/guestbook", "apps/guestbook/name.txt", "apps/guestbook/config.libsonnet", "vendor"}, getPaths(argoappv1.ApplicationSource{
+		Path: "apps/guestbook",
+		Directory: &argoappv1.ApplicationSourceDirectory{Jsonnet: argoappv1.ApplicationSourceJsonnet{
+			ExtVars: []argoappv1.JsonnetVar{{Name: "name", Value: "name.txt", File: true}, {Name: "env", Value: "prod"}},
+			TLAs:    []argoappv1.JsonnetVar{{Name: "config", Value: "config.libsonnet", Code: true, File: true}},
+			Libs:    []string{"vendor", "../outside"},
+		}},
+	}, argoappv1.Repository{}))
+	assert.Equal(t, []string{"apps/guestbook", ".gitmodules"}, getPaths(argoappv1.ApplicationSource{Path: "apps/guestbook"}, argoappv1.Repository{EnableSubmodules: true}))
+	// Apps at the root of the repo need a full checkout
+	assert.Nil(t, getPaths(argoappv1.ApplicationSource{Path: "."}, argoappv1.Repository{}))
+	assert.Nil(t, getPaths(argoappv1.ApplicationSource{Path: ""}, argoappv1.Repository{}))
+
+	service.sparseCheckout = false
+	assert.Nil(t, getPaths(argoappv1.ApplicationSource{Path: "apps/guestbook"}, argoappv1.Repository{}))
+}
+
+func TestCheckHelmFileParameters(t *testing.T) {
+	check := func(path string) error {
+		return checkHelmFileParameters("./testdata", "./testdata/invalid-helm", &argoappv1.ApplicationSourceHelm{
+			FileParameters: []argoappv1.HelmFileParameter{{Name: "config", Path: path}},
+		})
+	}
+	assert.NoError(t, check("Chart.yaml"))
+	assert.NoError(t, check("../file.txt"))
+	assert.EqualError(t, check("../../repository.go"), "rpc error: code = InvalidArgument desc = file parameter config: path ../../repository.go is outside of the repository")
+	assert.EqualError(t, check("/etc/passwd"), "rpc error: code = InvalidArgument desc = file parameter config: path /etc/passwd must be relative to the application path")
+	assert.Error(t, check("missing.txt"))
+	assert.NoError(t, checkHelmFileParameters("./testdata", "./testdata/invalid-helm", nil))
+}
+
+func TestFindKustomizeBinaryPath(t *testing.T) {
+	versions := []*argoappv1.KustomizeVersion{{Name: "v3.5.4", Path: "/custom-tools/kustomize_3_5_4"}}
+	path, err := findKustomizeBinaryPath(nil, versions)
+	assert.NoError(t, err)
+	assert.Equal(t, "", path)
+	path, err = findKustomizeBinaryPath(&argoappv1.ApplicationSourceKustomize{Version: "v3.5.4"}, versions)
+	assert.NoError(t, err)
+	assert.Equal(t, "/custom-tools/kustomize_3_5_4", path)
+	_, err = findKustomizeBinaryPath(&argoappv1.ApplicationSourceKustomize{Version: "v4.0.0"}, versions)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = kustomize version v4.0.0 is not registered in the settings")
+}
+
+func TestFindHelmBinaryPath(t *testing.T) {
+	versions := []*argoappv1.HelmVersion{{Name: "v3.1.2", Path: "/custom-tools/helm_3_1_2"}}
+	path, err := findHelmBinaryPath("", versions)
+	assert.NoError(t, err)
+	assert.Equal(t, "", path)
+	path, err = findHelmBinaryPath("v3.1.2", versions)
+	assert.NoError(t, err)
+	assert.Equal(t, "/custom-tools/helm_3_1_2", path)
+	_, err = findHelmBinaryPath("v3.2.0", versions)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = helm version v3.2.0 is not registered in the settings")
+}
+
+func TestGenerateManifestRefSource(t *testing.T) {
+	service := newMockRepoServerService("")
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "https://github.com/example/values"},
+		Revision:          "master",
+		ApplicationSource: &argoappv1.ApplicationSource{RepoURL: "https://github.com/example/values", Ref: "values"},
+	}
+	// Ref sources only resolve their revision
+	res, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Empty(t, res.Manifests)
+	assert.Equal(t, "aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd", res.Revision)
+
+	q.ApplicationSource.Chart = "mychart"
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = source with ref 'values' must be a Git repository")
+}
+
+func TestCopyRefValueFiles(t *testing.T) {
+	service := newMockRepoServerService("")
+	valuesRepo := &argoappv1.Repository{Repo: "https://github.com/example/values"}
+	q := &apiclient.ManifestRequest{
+		Repo: &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "chart", Helm: &argoappv1.ApplicationSourceHelm{
+			ValueFiles: []string{"values.yaml", "$values/concatenated/concatenated.yaml", "$values/../../../file.txt"},
+		}},
+		RefSources: map[string]*apiclient.RefTarget{"values": {Repo: valuesRepo, Revision: "master"}},
+	}
+	revisions, err := service.resolveRefRevisions(q)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"values": "aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd"}, revisions)
+	assert.Equal(t, "+values=aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd", refRevisionsCacheKey(revisions))
+
+	genQ, cleanup, err := service.copyRefValueFiles(q, revisions)
+	assert.NoError(t, err)
+	defer cleanup()
+	valueFiles := genQ.ApplicationSource.Helm.ValueFiles
+	assert.Equal(t, "values.yaml", valueFiles[0])
+	expected, err := ioutil.ReadFile("./testdata/concatenated/concatenated.yaml")
+	assert.NoError(t, err)
+	copied, err := ioutil.ReadFile(valueFiles[1])
+	assert.NoError(t, err)
+	assert.Equal(t, expected, copied)
+	// Paths cannot leave the ref source
+	expected, err = ioutil.ReadFile("./testdata/file.txt")
+	assert.NoError(t, err)
+	copied, err = ioutil.ReadFile(valueFiles[2])
+	assert.NoError(t, err)
+	assert.Equal(t, expected, copied)
+	// The original request is unchanged
+	assert.Equal(t, "$values/concatenated/concatenated.yaml", q.ApplicationSource.Helm.ValueFiles[1])
+
+	q.ApplicationSource.Helm.ValueFiles = []string{"$other/values.yaml"}
+	_, err = service.resolveRefRevisions(q)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = value file $other/values.yaml refers to unknown source '$other'")
+	q.ApplicationSource.Helm.ValueFiles = []string{"$values"}
+	_, err = service.resolveRefRevisions(q)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = value file $values must be a path within the source '$values'")
+}
+
+func TestGenerateManifestOCIHelmChart(t *testing.T) {
+	digest := "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	otherDigest := "sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9"
+	ociClient := &fakeOCIClient{digests: map[string]string{"0.2.0": digest}}
+	service := newMockRepoServerService("")
+	service.newOCIClient = func(repoURL string, creds helm.Creds) helm.OCIClient {
+		return ociClient
+	}
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{Repo: "oci://registry.example.com/charts", Type: argoappv1.RepositoryTypeHelm},
+		Revision:          "0.2.0",
+		ApplicationSource: &argoappv1.ApplicationSource{Chart: "mychart", TargetRevision: "0.2.0"},
+	}
+	// Tags are resolved to the digest of the chart, which is the revision of the manifests
+	res, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Equal(t, digest, res.Revision)
+	assert.Len(t, res.Manifests, 3)
+
+	// Digests are used as they are
+	q.Revision = otherDigest
+	res, err = service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Equal(t, otherDigest, res.Revision)
+	assert.Equal(t, []string{digest, otherDigest}, ociClient.extracted)
+
+	q.Revision = "0.3.0"
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.EqualError(t, err, "tag '0.3.0' not found")
+}
+
 func TestGenerateYamlManifestInDir(t *testing.T) {
 	// update this value if we add/remove manifests
 	const countOfManifests = 25
@@ -90,6 +607,90 @@ func TestRecurseManifestsInDir(t *testing.T) {
 	assert.Equal(t, 2, len(res1.Manifests))
 }
 
+func TestIncludeExcludeManifestsInDir(t *testing.T) {
+	generate := func(directory *argoappv1.ApplicationSourceDirectory) ([]string, error) {
+		res, err := GenerateManifests("./testdata", "recurse", &apiclient.ManifestRequest{
+			ApplicationSource: &argoappv1.ApplicationSource{Directory: directory},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, manifest := range res.Manifests {
+			var obj unstructured.Unstructured
+			assert.NoError(t, json.Unmarshal([]byte(manifest), &obj))
+			names = append(names, obj.GetName())
+		}
+		return names, nil
+	}
+
+	all, err := generate(&argoappv1.ApplicationSourceDirectory{Recurse: true})
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	// excluded directories are skipped
+	names, err := generate(&argoappv1.ApplicationSourceDirectory{Recurse: true, Exclude: []string{"foo"}})
+	assert.NoError(t, err)
+	assert.Len(t, names, 1)
+
+	// patterns without a slash match the names of files at any depth
+	names, err = generate(&argoappv1.ApplicationSourceDirectory{Recurse: true, Include: []string{"bar.yaml"}})
+	assert.NoError(t, err)
+	assert.Len(t, names, 1)
+
+	// patterns with a slash match the relative paths of files
+	names, err = generate(&argoappv1.ApplicationSourceDirectory{Recurse: true, Include: []string{"foo/*.yaml"}})
+	assert.NoError(t, err)
+	assert.Len(t, names, 1)
+	names, err = generate(&argoappv1.ApplicationSourceDirectory{Recurse: true, Include: []string{"*/baz.yaml"}})
+	assert.NoError(t, err)
+	assert.Len(t, names, 0)
+
+	// exclude patterns take precedence over include patterns
+	names, err = generate(&argoappv1.ApplicationSourceDirectory{Recurse: true, Include: []string{"*.yaml"}, Exclude: []string{"baz.*"}})
+	assert.NoError(t, err)
+	assert.Len(t, names, 1)
+
+	_, err = generate(&argoappv1.ApplicationSourceDirectory{Include: []string{"[a-"}})
+	assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = invalid glob pattern "[a-" of the directory: syntax error in pattern`)
+}
+
+func TestSubstituteManifestsInDir(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		AppLabelValue:     "guestbook",
+		Namespace:         "default",
+		Revision:          "aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd",
+		DestServer:        "https://kubernetes.default.svc",
+		ApplicationSource: &argoappv1.ApplicationSource{Directory: &argoappv1.ApplicationSourceDirectory{Substitute: true}},
+	}
+	res, err := GenerateManifests("./testdata", "substitute", &q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 2)
+
+	var configMap unstructured.Unstructured
+	assert.NoError(t, json.Unmarshal([]byte(res.Manifests[0]), &configMap))
+	assert.Equal(t, "guestbook-config", configMap.GetName())
+	assert.Equal(t, "default", configMap.GetNamespace())
+	data, _, _ := unstructured.NestedStringMap(configMap.Object, "data")
+	assert.Equal(t, map[string]string{
+		"revision": "aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd",
+		"server":   "https://kubernetes.default.svc",
+		"home":     "${HOME}",
+		"escaped":  "${ARGOCD_APP_NAME}",
+		"shell":    "$ARGOCD_APP_NAME",
+	}, data)
+	var service unstructured.Unstructured
+	assert.NoError(t, json.Unmarshal([]byte(res.Manifests[1]), &service))
+	assert.Equal(t, "guestbook", service.GetName())
+
+	// substitution is opt-in
+	q.ApplicationSource.Directory = nil
+	res, err = GenerateManifests("./testdata", "substitute", &q)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal([]byte(res.Manifests[0]), &configMap))
+	assert.Equal(t, "${ARGOCD_APP_NAME}-config", configMap.GetName())
+}
+
 func TestNonExistentPath(t *testing.T) {
 	_, err := GenerateManifests("./testdata", "does-not-exist", nil)
 	assert.EqualError(t, err, "does-not-exist: app path does not exist")
@@ -116,6 +717,38 @@ func TestGenerateJsonnetManifestInDir(t *testing.T) {
 	assert.Equal(t, 2, len(res1.Manifests))
 }
 
+func TestGenerateJsonnetManifestWithFilesAndLibs(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Directory: &argoappv1.ApplicationSourceDirectory{
+				Jsonnet: argoappv1.ApplicationSourceJsonnet{
+					ExtVars: []argoappv1.JsonnetVar{{Name: "name", Value: "name.txt", File: true}},
+					TLAs:    []argoappv1.JsonnetVar{{Name: "config", Value: "config.libsonnet", Code: true, File: true}},
+					Libs:    []string{"jsonnet-libs/lib"},
+				},
+			},
+		},
+		// library search dirs of all applications which are not in the repo are ignored
+		JsonnetLibs: []string{"vendor"},
+	}
+	res, err := GenerateManifests("./testdata", "jsonnet-libs/app", &q)
+	assert.NoError(t, err)
+	if assert.Len(t, res.Manifests, 1) {
+		assert.Contains(t, res.Manifests[0], `"name":"guestbook"`)
+		assert.Contains(t, res.Manifests[0], `"labels":{"app":"guestbook"}`)
+		assert.Contains(t, res.Manifests[0], `"replicas":"2"`)
+	}
+
+	q.ApplicationSource.Directory.Jsonnet.ExtVars[0].Value = "../../../repository.go"
+	_, err = GenerateManifests("./testdata", "jsonnet-libs/app", &q)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = jsonnet variable name: path ../../../repository.go is outside of the repository")
+
+	q.ApplicationSource.Directory.Jsonnet.ExtVars[0].Value = "name.txt"
+	q.ApplicationSource.Directory.Jsonnet.Libs = []string{"/usr/share/jsonnet"}
+	_, err = GenerateManifests("./testdata", "jsonnet-libs/app", &q)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = jsonnet library /usr/share/jsonnet: path /usr/share/jsonnet must be relative to the root of the repository")
+}
+
 func TestGenerateHelmChartWithDependencies(t *testing.T) {
 	helmHome, err := ioutil.TempDir("", "")
 	assert.NoError(t, err)
@@ -172,6 +805,8 @@ func TestRunCustomTool(t *testing.T) {
 	res, err := GenerateManifests(".", ".", &apiclient.ManifestRequest{
 		AppLabelValue: "test-app",
 		Namespace:     "test-namespace",
+		Revision:      "aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd",
+		DestServer:    "https://kubernetes.default.svc",
 		ApplicationSource: &argoappv1.ApplicationSource{
 			Plugin: &argoappv1.ApplicationSourcePlugin{
 				Name: "test",
@@ -181,7 +816,7 @@ func TestRunCustomTool(t *testing.T) {
 			Name: "test",
 			Generate: argoappv1.Command{
 				Command: []string{"sh", "-c"},
-				Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\", \"namespace\": \"$ARGOCD_APP_NAMESPACE\", \"annotations\": {\"GIT_ASKPASS\": \"$GIT_ASKPASS\", \"GIT_USERNAME\": \"$GIT_USERNAME\", \"GIT_PASSWORD\": \"$GIT_PASSWORD\"}}}"`},
+				Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\", \"namespace\": \"$ARGOCD_APP_NAMESPACE\", \"annotations\": {\"GIT_ASKPASS\": \"$GIT_ASKPASS\", \"GIT_USERNAME\": \"$GIT_USERNAME\", \"GIT_PASSWORD\": \"$GIT_PASSWORD\", \"REVISION\": \"$ARGOCD_APP_REVISION\", \"SERVER\": \"$ARGOCD_APP_DEST_SERVER\"}}}"`},
 			},
 		}},
 		Repo: &argoappv1.Repository{
@@ -200,6 +835,193 @@ func TestRunCustomTool(t *testing.T) {
 	assert.Equal(t, "git-ask-pass.sh", obj.GetAnnotations()["GIT_ASKPASS"])
 	assert.Equal(t, "foo", obj.GetAnnotations()["GIT_USERNAME"])
 	assert.Equal(t, "bar", obj.GetAnnotations()["GIT_PASSWORD"])
+	assert.Equal(t, "aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd", obj.GetAnnotations()["REVISION"])
+	assert.Equal(t, "https://kubernetes.default.svc", obj.GetAnnotations()["SERVER"])
+}
+
+// startPluginSidecar serves the given plugin on a socket in a temp dir, and points the repo server to the temp dir
+func startPluginSidecar(t *testing.T, config *plugin.PluginConfig) func() {
+	sockDir, err := ioutil.TempDir("", "cmp")
+	assert.NoError(t, err)
+	listener, err := net.Listen("unix", filepath.Join(sockDir, config.Metadata.Name+".sock"))
+	assert.NoError(t, err)
+	server := cmpserver.NewServer(config).CreateGRPC()
+	go func() { _ = server.Serve(listener) }()
+	_ = os.Setenv(common.EnvVarPluginSockFilePath, sockDir)
+	return func() {
+		_ = os.Unsetenv(common.EnvVarPluginSockFilePath)
+		server.Stop()
+		_ = os.RemoveAll(sockDir)
+	}
+}
+
+func TestRunCustomToolSidecar(t *testing.T) {
+	defer startPluginSidecar(t, &plugin.PluginConfig{
+		Metadata: metav1.ObjectMeta{Name: "test"},
+		Spec: plugin.PluginConfigSpec{
+			Generate: argoappv1.Command{
+				Command: []string{"sh", "-c"},
+				Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\", \"namespace\": \"$ARGOCD_APP_NAMESPACE\", \"annotations\": {\"FOO\": \"$FOO\", \"REPLICAS\": \"$PARAM_REPLICAS\", \"GIT_PASSWORD\": \"$GIT_PASSWORD\"}}}"`},
+			},
+			Discover:   &plugin.Discover{FileName: "*.cue"},
+			Parameters: []plugin.ParameterSpec{{Name: "replicas", Type: plugin.ParameterTypeNumber, Default: "1"}},
+		},
+	})()
+
+	t.Run("Named", func(t *testing.T) {
+		res, err := GenerateManifests(".", ".", &apiclient.ManifestRequest{
+			AppLabelValue: "test-app",
+			Namespace:     "test-namespace",
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Plugin: &argoappv1.ApplicationSourcePlugin{
+					Name:       "test",
+					Env:        argoappv1.Env{{Name: "FOO", Value: "bar"}},
+					Parameters: []argoappv1.PluginParameter{{Name: "replicas", Value: "3"}},
+				},
+			},
+			Repo: &argoappv1.Repository{
+				Username: "foo", Password: "bar",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res.Manifests))
+
+		obj := &unstructured.Unstructured{}
+		assert.Nil(t, json.Unmarshal([]byte(res.Manifests[0]), obj))
+		assert.Equal(t, "test-app", obj.GetName())
+		assert.Equal(t, "test-namespace", obj.GetNamespace())
+		assert.Equal(t, "bar", obj.GetAnnotations()["FOO"])
+		assert.Equal(t, "3", obj.GetAnnotations()["REPLICAS"])
+		// the credentials of the repository are not passed to sidecars
+		assert.Equal(t, "", obj.GetAnnotations()["GIT_PASSWORD"])
+	})
+
+	t.Run("InvalidParameter", func(t *testing.T) {
+		_, err := GenerateManifests(".", ".", &apiclient.ManifestRequest{
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Plugin: &argoappv1.ApplicationSourcePlugin{
+					Name:       "test",
+					Parameters: []argoappv1.PluginParameter{{Name: "replicas", Value: "three"}},
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not a number")
+	})
+
+	t.Run("NotSupported", func(t *testing.T) {
+		_, err := GenerateManifests(".", ".", &apiclient.ManifestRequest{
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Plugin: &argoappv1.ApplicationSourcePlugin{Name: "other"},
+			},
+		})
+		assert.EqualError(t, err, "Config management plugin with name 'other' is not supported.")
+	})
+
+	t.Run("PathInName", func(t *testing.T) {
+		// the name resolves to the socket of the sidecar, but paths must not be followed
+		sockDir := os.Getenv(common.EnvVarPluginSockFilePath)
+		for _, name := range []string{"../" + filepath.Base(sockDir) + "/test", `..\test`, "..test", sockDir + "/test"} {
+			_, err := GenerateManifests(".", ".", &apiclient.ManifestRequest{
+				ApplicationSource: &argoappv1.ApplicationSource{
+					Plugin: &argoappv1.ApplicationSourcePlugin{Name: name},
+				},
+			})
+			assert.EqualError(t, err, fmt.Sprintf("Config management plugin name '%s' is invalid.", name))
+		}
+	})
+
+	t.Run("Discovered", func(t *testing.T) {
+		sourceType, err := GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/cue")
+		assert.NoError(t, err)
+		assert.Equal(t, argoappv1.ApplicationSourceTypePlugin, sourceType)
+
+		sourceType, err = GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/recurse")
+		assert.NoError(t, err)
+		assert.Equal(t, argoappv1.ApplicationSourceTypeDirectory, sourceType)
+
+		res, err := GenerateManifests("./testdata", "cue", &apiclient.ManifestRequest{
+			AppLabelValue:     "test-app",
+			ApplicationSource: &argoappv1.ApplicationSource{},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res.Manifests))
+		assert.Contains(t, res.Manifests[0], `"REPLICAS":"1"`)
+	})
+}
+
+func TestDiscoverPlugin(t *testing.T) {
+	defer startPluginSidecar(t, &plugin.PluginConfig{
+		Metadata: metav1.ObjectMeta{Name: "test"},
+		Spec:     plugin.PluginConfigSpec{Discover: &plugin.Discover{FileName: "*.cue"}},
+	})()
+	// the socket of a sidecar which is gone, which is tried first
+	listener, err := net.Listen("unix", filepath.Join(os.Getenv(common.EnvVarPluginSockFilePath), "stale.sock"))
+	assert.NoError(t, err)
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	assert.NoError(t, listener.Close())
+
+	start := time.Now()
+	assert.Equal(t, "test", discoverPlugin("./testdata/cue", "repo|1234|cue"))
+	assert.True(t, time.Since(start) < time.Second, "stale sockets are skipped without waiting for them")
+
+	// the result is cached for the revision and path
+	assert.Equal(t, "test", discoverPlugin("./testdata/recurse", "repo|1234|cue"))
+	assert.Equal(t, "", discoverPlugin("./testdata/recurse", "repo|1234|recurse"))
+	assert.Equal(t, "", discoverPlugin("./testdata/recurse", ""))
+}
+
+func TestRunCustomToolEnvFromSecrets(t *testing.T) {
+	res, err := GenerateManifests(".", ".", &apiclient.ManifestRequest{
+		AppLabelValue: "test-app",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Plugin: &argoappv1.ApplicationSourcePlugin{
+				Name: "test",
+				Env: argoappv1.Env{
+					{Name: "TOKEN", ValueFrom: &argoappv1.EnvVarSource{SecretKeyRef: &argoappv1.SecretKeySelector{Name: "tokens", Key: "token"}}},
+					{Name: "OTHER", ValueFrom: &argoappv1.EnvVarSource{SecretKeyRef: &argoappv1.SecretKeySelector{Name: "tokens", Key: "other"}}},
+				},
+			},
+		},
+		Plugins: []*argoappv1.ConfigManagementPlugin{{
+			Name: "test",
+			Generate: argoappv1.Command{
+				Command: []string{"sh", "-c"},
+				Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\", \"annotations\": {\"TOKEN\": \"$TOKEN\", \"OTHER\": \"${OTHER-unset}\"}}}"`},
+			},
+		}},
+		EnvFromSecrets: map[string]string{"TOKEN": "secret"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Manifests))
+
+	obj := &unstructured.Unstructured{}
+	assert.Nil(t, json.Unmarshal([]byte(res.Manifests[0]), obj))
+	assert.Equal(t, "secret", obj.GetAnnotations()["TOKEN"])
+	assert.Equal(t, "unset", obj.GetAnnotations()["OTHER"])
+}
+
+func TestCheckEnvFromSecrets(t *testing.T) {
+	assert.NoError(t, checkEnvFromSecrets(nil, nil))
+	assert.NoError(t, checkEnvFromSecrets(map[string]string{"GITHUB_TOKEN": "a", "VAULT_TOKEN": "b"}, []string{"GITHUB_TOKEN", "VAULT_*"}))
+	assert.EqualError(t, checkEnvFromSecrets(map[string]string{"AWS_SECRET_ACCESS_KEY": "a"}, []string{"GITHUB_TOKEN", "VAULT_*"}),
+		"rpc error: code = PermissionDenied desc = environment variable AWS_SECRET_ACCESS_KEY from a secret is not allowed by the repo server")
+
+	service := newMockRepoServerService("../..")
+	_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "manifests/base"},
+		EnvFromSecrets:    map[string]string{"GITHUB_TOKEN": "a"},
+	})
+	assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = environment variable GITHUB_TOKEN from a secret is not allowed by the repo server")
+}
+
+func TestEnvFromSecretsCacheKey(t *testing.T) {
+	assert.Equal(t, "", envFromSecretsCacheKey(nil))
+	key := envFromSecretsCacheKey(map[string]string{"GITHUB_TOKEN": "a", "VAULT_TOKEN": "b"})
+	assert.Equal(t, key, envFromSecretsCacheKey(map[string]string{"VAULT_TOKEN": "b", "GITHUB_TOKEN": "a"}))
+	assert.NotEqual(t, key, envFromSecretsCacheKey(map[string]string{"GITHUB_TOKEN": "a", "VAULT_TOKEN": "c"}))
+	assert.NotContains(t, key, "a")
 }
 
 func TestGenerateFromUTF16(t *testing.T) {
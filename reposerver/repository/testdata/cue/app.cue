@@ -0,0 +1,3 @@
+kind: "ConfigMap"
+apiVersion: "v1"
+metadata: name: "my-map"
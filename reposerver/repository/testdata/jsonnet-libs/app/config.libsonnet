@@ -0,0 +1,3 @@
+{
+   replicas: 2,
+}
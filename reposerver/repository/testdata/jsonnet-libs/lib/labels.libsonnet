@@ -0,0 +1,5 @@
+{
+   labels(name):: {
+      app: name,
+   },
+}
@@ -5,10 +5,10 @@ import (
 
 	versionpkg "github.com/argoproj/argo-cd/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/reposerver/metrics"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/server/version"
 	"github.com/argoproj/argo-cd/util/cache"
-	"github.com/argoproj/argo-cd/util/git"
 	grpc_util "github.com/argoproj/argo-cd/util/grpc"
 	tlsutil "github.com/argoproj/argo-cd/util/tls"
 
@@ -22,15 +22,18 @@ import (
 
 // ArgoCDRepoServer is the repo server implementation
 type ArgoCDRepoServer struct {
-	log              *log.Entry
-	gitFactory       git.ClientFactory
-	cache            *cache.Cache
-	opts             []grpc.ServerOption
-	parallelismLimit int64
+	log                     *log.Entry
+	metricsServer           *metrics.MetricsServer
+	cache                   *cache.Cache
+	opts                    []grpc.ServerOption
+	parallelismLimit        int64
+	parallelismLimitPerRepo int64
+	sparseCheckout          bool
+	envFromSecretsAllowlist []string
 }
 
 // NewServer returns a new instance of the Argo CD Repo server
-func NewServer(gitFactory git.ClientFactory, cache *cache.Cache, tlsConfCustomizer tlsutil.ConfigCustomizer, parallelismLimit int64) (*ArgoCDRepoServer, error) {
+func NewServer(metricsServer *metrics.MetricsServer, cache *cache.Cache, tlsConfCustomizer tlsutil.ConfigCustomizer, parallelismLimit int64, parallelismLimitPerRepo int64, sparseCheckout bool, envFromSecretsAllowlist []string) (*ArgoCDRepoServer, error) {
 	// generate TLS cert
 	hosts := []string{
 		"localhost",
@@ -54,10 +57,13 @@ func NewServer(gitFactory git.ClientFactory, cache *cache.Cache, tlsConfCustomiz
 	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_logrus.UnaryServerInterceptor(serverLog), grpc_util.PanicLoggerUnaryServerInterceptor(serverLog)}
 
 	return &ArgoCDRepoServer{
-		log:              serverLog,
-		gitFactory:       gitFactory,
-		cache:            cache,
-		parallelismLimit: parallelismLimit,
+		log:                     serverLog,
+		metricsServer:           metricsServer,
+		cache:                   cache,
+		parallelismLimit:        parallelismLimit,
+		parallelismLimitPerRepo: parallelismLimitPerRepo,
+		sparseCheckout:          sparseCheckout,
+		envFromSecretsAllowlist: envFromSecretsAllowlist,
 		opts: []grpc.ServerOption{
 			grpc.Creds(credentials.NewTLS(tlsConfig)),
 			grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
@@ -70,7 +76,7 @@ func NewServer(gitFactory git.ClientFactory, cache *cache.Cache, tlsConfCustomiz
 func (a *ArgoCDRepoServer) CreateGRPC() *grpc.Server {
 	server := grpc.NewServer(a.opts...)
 	versionpkg.RegisterVersionServiceServer(server, &version.Server{})
-	manifestService := repository.NewService(a.gitFactory, a.cache, a.parallelismLimit)
+	manifestService := repository.NewService(a.metricsServer, a.cache, a.parallelismLimit, a.parallelismLimitPerRepo, a.sparseCheckout, a.envFromSecretsAllowlist)
 	apiclient.RegisterRepoServerServiceServer(server, manifestService)
 
 	// Register reflection service on gRPC server.
@@ -80,7 +80,7 @@ func TestLuaResourceActionsScript(t *testing.T) {
 				obj := getObj(filepath.Join(dir, test.InputPath))
 				action, err := vm.GetResourceAction(obj, test.Action)
 				errors.CheckError(err)
-				result, err := vm.ExecuteResourceAction(obj, action.ActionLua)
+				result, err := vm.ExecuteResourceAction(obj, action.ActionLua, nil)
 				errors.CheckError(err)
 				expectedObj := getObj(filepath.Join(dir, test.ExpectedOutputPath))
 				// Ideally, we would use a assert.Equal to detect the difference, but the Lua VM returns a object with float64 instead of the originial int32.  As a result, the assert.Equal is never true despite that the change has been applied.
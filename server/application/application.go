@@ -4,8 +4,12 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -18,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
@@ -36,6 +41,7 @@ import (
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/lua"
 	"github.com/argoproj/argo-cd/util/rbac"
@@ -165,19 +171,31 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return nil, err
 	}
-	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
-	if err != nil {
-		return nil, err
+	sources := a.Spec.GetSources()
+	var revisions []string
+	if q.HistoryID != nil {
+		deploymentInfo, err := findRevisionHistory(a, *q.HistoryID)
+		if err != nil {
+			return nil, err
+		}
+		if len(deploymentInfo.Sources) > 0 {
+			sources = deploymentInfo.Sources
+			revisions = deploymentInfo.Revisions
+		} else {
+			sources = []appv1.ApplicationSource{deploymentInfo.Source}
+			revisions = []string{deploymentInfo.Revision}
+		}
+	} else if q.Revision != "" {
+		if a.Spec.HasMultipleSources() {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot get the manifests of application '%s' at a revision, because it has multiple sources", a.Name)
+		}
+		revisions = []string{q.Revision}
 	}
 	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
 	if err != nil {
 		return nil, err
 	}
 	defer util.Close(conn)
-	revision := a.Spec.Source.TargetRevision
-	if q.Revision != "" {
-		revision = q.Revision
-	}
 	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return nil, err
@@ -196,21 +214,116 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	for i := range plugins {
 		tools[i] = &plugins[i]
 	}
-	manifestInfo, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
-		Repo:              repo,
-		Revision:          revision,
-		AppLabelKey:       appInstanceLabelKey,
-		AppLabelValue:     a.Name,
-		Namespace:         a.Spec.Destination.Namespace,
-		ApplicationSource: &a.Spec.Source,
-		HelmRepos:         helmRepos,
-		Plugins:           tools,
-	})
+	kustomizeVersions, err := s.settingsMgr.GetKustomizeVersions()
 	if err != nil {
 		return nil, err
 	}
+	kustomizeVersionRefs := make([]*appv1.KustomizeVersion, len(kustomizeVersions))
+	for i := range kustomizeVersions {
+		kustomizeVersionRefs[i] = &kustomizeVersions[i]
+	}
 
-	return manifestInfo, nil
+	helmVersions, err := s.settingsMgr.GetHelmVersions()
+	if err != nil {
+		return nil, err
+	}
+	helmVersionRefs := make([]*appv1.HelmVersion, len(helmVersions))
+	for i := range helmVersions {
+		helmVersionRefs[i] = &helmVersions[i]
+	}
+
+	jsonnetLibs, err := s.settingsMgr.GetJsonnetLibs()
+	if err != nil {
+		return nil, err
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(a.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	verifySignature, signatureKeys, err := argo.GetSignatureKeys(ctx, proj, s.db)
+	if err != nil {
+		return nil, err
+	}
+	generateManifest := func(i int, refSources map[string]*apiclient.RefTarget) (*apiclient.ManifestResponse, *appv1.Repository, error) {
+		source := sources[i]
+		revision := source.TargetRevision
+		if i < len(revisions) && revisions[i] != "" {
+			revision = revisions[i]
+		}
+		repo, err := s.db.GetRepository(ctx, source.RepoURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		enableSubmodules, submoduleCreds, err := s.db.GetSubmoduleCredentials(ctx, repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		repo.EnableSubmodules = enableSubmodules
+		refSources, err = argo.GetRefSources(ctx, &source, refSources, s.db)
+		if err != nil {
+			return nil, nil, err
+		}
+		envFromSecrets, err := argo.GetEnvFromSecrets(s.kubeclientset, s.ns, a.Spec.GetProject(), &source)
+		if err != nil {
+			return nil, nil, err
+		}
+		manifestInfo, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
+			Repo:              repo,
+			Revision:          revision,
+			AppLabelKey:       appInstanceLabelKey,
+			AppLabelValue:     a.Name,
+			Namespace:         a.Spec.Destination.Namespace,
+			DestServer:        a.Spec.Destination.Server,
+			ApplicationSource: &source,
+			HelmRepos:         helmRepos,
+			Plugins:           tools,
+			VerifySignature:   verifySignature,
+			SignatureKeys:     signatureKeys,
+			SubmoduleCreds:    submoduleCreds,
+			RefSources:        refSources,
+			KustomizeVersions: kustomizeVersionRefs,
+			HelmVersions:      helmVersionRefs,
+			JsonnetLibs:       jsonnetLibs,
+			EnvFromSecrets:    envFromSecrets,
+		})
+		return manifestInfo, repo, err
+	}
+	if len(sources) == 1 {
+		manifestInfo, _, err := generateManifest(0, nil)
+		return manifestInfo, err
+	}
+
+	// The manifests of an application with multiple sources are the manifests of all sources which are not refs,
+	// and the response has the revision and source type of the first one
+	refSources := make(map[string]*apiclient.RefTarget)
+	for i, source := range sources {
+		if source.IsRef() {
+			manifestInfo, repo, err := generateManifest(i, nil)
+			if err != nil {
+				return nil, err
+			}
+			refSources[source.Ref] = &apiclient.RefTarget{Repo: repo, Revision: manifestInfo.Revision}
+		}
+	}
+	var res *apiclient.ManifestResponse
+	for i, source := range sources {
+		if source.IsRef() {
+			continue
+		}
+		manifestInfo, _, err := generateManifest(i, refSources)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			res = manifestInfo
+		} else {
+			res.Manifests = append(res.Manifests, manifestInfo.Manifests...)
+		}
+	}
+	if res == nil {
+		res = &apiclient.ManifestResponse{Manifests: []string{}}
+	}
+	return res, nil
 }
 
 // Get returns an application by name
@@ -550,7 +663,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 			return err
 		}
 	}
-	conditions, appSourceType, err := argo.ValidateRepo(ctx, &app.Spec, s.repoClientset, s.db)
+	conditions, appSourceType, err := argo.ValidateRepo(ctx, &app.Spec, s.repoClientset, s.db, s.kubeclientset, s.ns)
 	if err != nil {
 		return err
 	}
@@ -717,7 +830,83 @@ func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return nil, err
 	}
-	return s.getAppResources(q)
+	if q.Limit < 0 || q.Offset < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	if _, err := filepath.Match(q.Name, ""); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name pattern '%s': %v", q.Name, err)
+	}
+	tree, err := s.getAppResources(q)
+	if err != nil {
+		return nil, err
+	}
+	return filterAppTree(tree, q), nil
+}
+
+// filterAppTree returns the nodes of the tree which match the filters of the query. If the query has a limit, the nodes
+// are sorted and the page of nodes at the offset is returned, where the orphaned nodes follow the application nodes.
+func filterAppTree(tree *appv1.ApplicationTree, q *application.ResourcesQuery) *appv1.ApplicationTree {
+	if q.Kind == "" && q.Health == "" && q.Name == "" && q.Limit == 0 && q.Offset == 0 {
+		return tree
+	}
+	filter := func(nodes []appv1.ResourceNode) []appv1.ResourceNode {
+		var filtered []appv1.ResourceNode
+		for _, node := range nodes {
+			if q.Kind != "" && !strings.EqualFold(node.Kind, q.Kind) {
+				continue
+			}
+			if q.Health != "" && (node.Health == nil || !strings.EqualFold(node.Health.Status, q.Health)) {
+				continue
+			}
+			if q.Name != "" {
+				if ok, _ := filepath.Match(q.Name, node.Name); !ok {
+					continue
+				}
+			}
+			filtered = append(filtered, node)
+		}
+		return filtered
+	}
+	filtered := &appv1.ApplicationTree{Nodes: filter(tree.Nodes), OrphanedNodes: filter(tree.OrphanedNodes)}
+	if q.Limit == 0 && q.Offset == 0 {
+		return filtered
+	}
+	// pages are only stable if the nodes are sorted
+	for _, nodes := range [][]appv1.ResourceNode{filtered.Nodes, filtered.OrphanedNodes} {
+		sort.Slice(nodes, func(i, j int) bool {
+			return resourceNodeKey(nodes[i]) < resourceNodeKey(nodes[j])
+		})
+	}
+	page := func(nodes []appv1.ResourceNode, offset int64, limit int64) []appv1.ResourceNode {
+		count := int64(len(nodes))
+		start := offset
+		if start > count {
+			start = count
+		}
+		end := count
+		if limit > 0 && start+limit < end {
+			end = start + limit
+		}
+		return nodes[start:end]
+	}
+	nodes := page(filtered.Nodes, q.Offset, q.Limit)
+	orphanedOffset := q.Offset - int64(len(filtered.Nodes))
+	if orphanedOffset < 0 {
+		orphanedOffset = 0
+	}
+	orphanedLimit := q.Limit
+	if q.Limit > 0 {
+		orphanedLimit = q.Limit - int64(len(nodes))
+	}
+	var orphanedNodes []appv1.ResourceNode
+	if q.Limit == 0 || orphanedLimit > 0 {
+		orphanedNodes = page(filtered.OrphanedNodes, orphanedOffset, orphanedLimit)
+	}
+	return &appv1.ApplicationTree{Nodes: nodes, OrphanedNodes: orphanedNodes}
+}
+
+func resourceNodeKey(node appv1.ResourceNode) string {
+	return strings.Join([]string{node.Group, node.Kind, node.Namespace, node.Name}, "/")
 }
 
 func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
@@ -774,25 +963,135 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		return err
 	}
 
-	var sinceSeconds, tailLines *int64
-	if q.SinceSeconds > 0 {
-		sinceSeconds = &q.SinceSeconds
+	stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(*q.PodName, podLogOptions(q.Container, q.SinceSeconds, q.SinceTime, q.TailLines, q.Follow)).Stream()
+	if err != nil {
+		return err
+	}
+	sendPodLogs(ws.Context(), stream, *q.PodName, ws.Send, log.WithField("application", q.Name))
+	return nil
+}
+
+// ResourceLogs returns stream of log entries for the pods of the application, or of one of its resources
+func (s *Server) ResourceLogs(q *application.ApplicationResourceLogsQuery, ws application.ApplicationService_ResourceLogsServer) error {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
+		return err
+	}
+	tree, err := s.getAppResources(&application.ResourcesQuery{ApplicationName: &a.Name})
+	if err != nil {
+		return err
+	}
+	var resource *appv1.ResourceNode
+	if q.Kind != "" {
+		resource = tree.FindNode(q.Group, q.Kind, q.Namespace, q.ResourceName)
+		if resource == nil {
+			return status.Errorf(codes.InvalidArgument, "%s %s %s not found as part of application %s", q.Kind, q.Group, q.ResourceName, *q.Name)
+		}
 	}
-	if q.TailLines > 0 {
-		tailLines = &q.TailLines
+	pods := getPods(tree, resource)
+	if len(pods) == 0 {
+		return status.Errorf(codes.NotFound, "no pods found as part of application %s", *q.Name)
 	}
-	stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(*q.PodName, &v1.PodLogOptions{
-		Container:    q.Container,
-		Follow:       q.Follow,
-		Timestamps:   true,
-		SinceSeconds: sinceSeconds,
-		SinceTime:    q.SinceTime,
-		TailLines:    tailLines,
-	}).Stream()
+
+	config, _, err := s.getApplicationClusterConfig(*q.Name)
 	if err != nil {
 		return err
 	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	streams := make([]io.ReadCloser, len(pods))
+	for i, pod := range pods {
+		streams[i], err = kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, podLogOptions(q.Container, q.SinceSeconds, q.SinceTime, q.TailLines, q.Follow)).Stream()
+		if err != nil {
+			for _, stream := range streams[:i] {
+				util.Close(stream)
+			}
+			return fmt.Errorf("failed to get the logs of pod %s: %v", pod.Name, err)
+		}
+	}
+
 	logCtx := log.WithField("application", q.Name)
+	// the entries of all pods are sent to the same stream
+	var lock sync.Mutex
+	send := func(entry *application.LogEntry) error {
+		lock.Lock()
+		defer lock.Unlock()
+		return ws.Send(entry)
+	}
+	var wg sync.WaitGroup
+	for i := range pods {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sendPodLogs(ws.Context(), streams[i], pods[i].Name, send, logCtx.WithField("pod", pods[i].Name))
+		}(i)
+	}
+	wg.Wait()
+	return nil
+}
+
+// getPods returns the pods of the tree which are the given resource or its descendants, or all pods if the resource is
+// nil. Orphaned pods do not belong to the app, so they are never returned.
+func getPods(tree *appv1.ApplicationTree, resource *appv1.ResourceNode) []appv1.ResourceNode {
+	nodes := tree.Nodes
+	if resource == nil {
+		pods := make([]appv1.ResourceNode, 0)
+		for _, node := range nodes {
+			if node.Group == "" && node.Kind == kube.PodKind {
+				pods = append(pods, node)
+			}
+		}
+		return pods
+	}
+	refKey := func(ref appv1.ResourceRef) kube.ResourceKey {
+		return kube.NewResourceKey(ref.Group, ref.Kind, ref.Namespace, ref.Name)
+	}
+	children := make(map[kube.ResourceKey][]appv1.ResourceNode)
+	for _, node := range nodes {
+		for _, parent := range node.ParentRefs {
+			children[refKey(parent)] = append(children[refKey(parent)], node)
+		}
+	}
+	pods := make([]appv1.ResourceNode, 0)
+	visited := make(map[kube.ResourceKey]bool)
+	queue := []appv1.ResourceNode{*resource}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if key := refKey(node.ResourceRef); !visited[key] {
+			visited[key] = true
+			if node.Group == "" && node.Kind == kube.PodKind {
+				pods = append(pods, node)
+			}
+			queue = append(queue, children[key]...)
+		}
+	}
+	return pods
+}
+
+func podLogOptions(container string, sinceSeconds int64, sinceTime *metav1.Time, tailLines int64, follow bool) *v1.PodLogOptions {
+	opts := &v1.PodLogOptions{
+		Container:  container,
+		Follow:     follow,
+		Timestamps: true,
+		SinceTime:  sinceTime,
+	}
+	if sinceSeconds > 0 {
+		opts.SinceSeconds = &sinceSeconds
+	}
+	if tailLines > 0 {
+		opts.TailLines = &tailLines
+	}
+	return opts
+}
+
+// sendPodLogs sends the entries of a stream of pod logs until the stream ends or the context is done
+func sendPodLogs(ctx context.Context, stream io.ReadCloser, podName string, send func(*application.LogEntry) error, logCtx *log.Entry) {
 	defer util.Close(stream)
 	done := make(chan bool)
 	gracefulExit := false
@@ -807,9 +1106,10 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 				lines := strings.Join(parts[1:], " ")
 				for _, line := range strings.Split(lines, "\r") {
 					if line != "" {
-						err = ws.Send(&application.LogEntry{
+						err = send(&application.LogEntry{
 							Content:   line,
 							TimeStamp: metaLogTime,
+							PodName:   podName,
 						})
 						if err != nil {
 							logCtx.Warnf("Unable to send stream message: %v", err)
@@ -828,12 +1128,11 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		close(done)
 	}()
 	select {
-	case <-ws.Context().Done():
+	case <-ctx.Done():
 		logCtx.Info("client pod logs grpc context closed")
 		gracefulExit = true
 	case <-done:
 	}
-	return nil
 }
 
 func (s *Server) getApplicationDestination(name string) (string, string, error) {
@@ -866,31 +1165,56 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if a.Spec.HasMultipleSources() && syncReq.Revision != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot sync to %s: application has multiple sources, which are synced to their target revisions", syncReq.Revision)
+	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		if syncReq.Revision != "" && syncReq.Revision != util.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD") {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.Revision, a.Spec.Source.TargetRevision)
 		}
 	}
+	if err := s.checkSyncWindows(a); err != nil {
+		return nil, err
+	}
 
-	commitSHA, displayRevision, err := s.resolveRevision(ctx, a, syncReq)
-	if err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	if syncReq.LabelSelector != "" {
+		if _, err := labels.Parse(syncReq.LabelSelector); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid label selector '%s': %v", syncReq.LabelSelector, err)
+		}
+	}
+
+	if syncReq.RetryStrategy != nil {
+		if err := syncReq.RetryStrategy.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid retry strategy: %v", err)
+		}
+	}
+
+	// the target revisions of multiple sources are resolved by the controller, which syncs them together
+	commitSHA, displayRevision := "", "the target revisions of the sources"
+	if !a.Spec.HasMultipleSources() {
+		commitSHA, displayRevision, err = s.resolveRevision(ctx, a, syncReq)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
 	}
 
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:     commitSHA,
-			Prune:        syncReq.Prune,
-			DryRun:       syncReq.DryRun,
-			SyncStrategy: syncReq.Strategy,
-			Resources:    syncReq.Resources,
-			Manifests:    syncReq.Manifests,
+			Revision:      commitSHA,
+			Prune:         syncReq.Prune,
+			DryRun:        syncReq.DryRun,
+			SyncStrategy:  syncReq.Strategy,
+			Resources:     syncReq.Resources,
+			Manifests:     syncReq.Manifests,
+			LabelSelector: syncReq.LabelSelector,
 		},
+		Retry:       syncReq.RetryStrategy,
+		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 	}
 	a, err = argo.SetAppOperation(appIf, *syncReq.Name, &op)
 	if err == nil {
 		partial := ""
-		if len(syncReq.Resources) > 0 {
+		if len(syncReq.Resources) > 0 || syncReq.LabelSelector != "" {
 			partial = "partial "
 		}
 		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated %ssync to %s", partial, displayRevision))
@@ -898,6 +1222,18 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	return a, err
 }
 
+// checkSyncWindows returns an error if the sync windows of the application's project currently deny its sync
+func (s *Server) checkSyncWindows(a *appv1.Application) error {
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(a.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !proj.Spec.SyncWindows.Matches(a).CanSync(time.Now()) {
+		return status.Errorf(codes.PermissionDenied, "Cannot sync: blocked by sync window")
+	}
+	return nil
+}
+
 func (s *Server) Rollback(ctx context.Context, rollbackReq *application.ApplicationRollbackRequest) (*appv1.Application, error) {
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
 	a, err := appIf.Get(*rollbackReq.Name, metav1.GetOptions{})
@@ -913,47 +1249,94 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
 	}
+	if err := s.checkSyncWindows(a); err != nil {
+		return nil, err
+	}
 
-	var deploymentInfo *appv1.RevisionHistory
-	for _, info := range a.Status.History {
-		if info.ID == rollbackReq.ID {
-			deploymentInfo = &info
-			break
+	var revision, target string
+	var source appv1.ApplicationSource
+	var revisions []string
+	var sources appv1.ApplicationSources
+	if rollbackReq.Revision != "" {
+		if a.Spec.HasMultipleSources() {
+			return nil, status.Errorf(codes.InvalidArgument, "application '%s' has multiple sources, and can only be rolled back to a history ID", a.Name)
 		}
-	}
-	if deploymentInfo == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.Name, rollbackReq.ID)
-	}
-	if deploymentInfo.Source.IsZero() {
-		// Since source type was introduced to history starting with v0.12, and is now required for
-		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+		// a rollback to a revision of the current source
+		source = a.Spec.Source
+		source.TargetRevision = rollbackReq.Revision
+		revision = rollbackReq.Revision
+		target = fmt.Sprintf("revision %s", rollbackReq.Revision)
+	} else {
+		deploymentInfo, err := findRevisionHistory(a, rollbackReq.ID)
+		if err != nil {
+			return nil, err
+		}
+		if a.Spec.HasMultipleSources() != (len(deploymentInfo.Sources) > 0) {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback application '%s' to %d, because it was deployed with a different kind of source", a.Name, rollbackReq.ID)
+		}
+		source = deploymentInfo.Source
+		revision = deploymentInfo.Revision
+		sources = deploymentInfo.Sources
+		revisions = deploymentInfo.Revisions
+		target = fmt.Sprintf("%d", rollbackReq.ID)
 	}
 
 	// Rollback is just a convenience around Sync
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
+			Revision:     revision,
 			DryRun:       rollbackReq.DryRun,
 			Prune:        rollbackReq.Prune,
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
+			Source:       &source,
+			Rollback:     true,
 		},
+		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
+	}
+	if len(sources) > 0 {
+		op.Sync.Source = nil
+		op.Sync.Sources = sources
+		op.Sync.Revisions = revisions
 	}
 	a, err = argo.SetAppOperation(appIf, *rollbackReq.Name, &op)
 	if err == nil {
-		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.ID))
+		s.logEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %s", target))
 	}
 	return a, err
 }
 
+// findRevisionHistory returns the history entry of the application with the given ID, which can be rolled back to
+func findRevisionHistory(a *appv1.Application, id int64) (*appv1.RevisionHistory, error) {
+	var deploymentInfo *appv1.RevisionHistory
+	for i := range a.Status.History {
+		if a.Status.History[i].ID == id {
+			deploymentInfo = &a.Status.History[i]
+			break
+		}
+	}
+	if deploymentInfo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.Name, id)
+	}
+	if deploymentInfo.Source.IsZero() && len(deploymentInfo.Sources) == 0 {
+		// Since source type was introduced to history starting with v0.12, and is now required for
+		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+	}
+	return deploymentInfo, nil
+}
+
 // resolveRevision resolves the git revision specified either in the sync request, or the
 // application source, into a concrete commit SHA that will be used for a sync operation.
+// For Helm charts, the revision is resolved into a concrete chart version instead, or into the digest
+// of the chart's manifest for charts of OCI registries.
 func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, syncReq *application.ApplicationSyncRequest) (string, string, error) {
 	ambiguousRevision := syncReq.Revision
 	if ambiguousRevision == "" {
 		ambiguousRevision = app.Spec.Source.TargetRevision
 	}
+	if app.Spec.Source.IsHelmChart() {
+		return s.resolveChartVersion(ctx, app, ambiguousRevision)
+	}
 	if git.IsCommitSHA(ambiguousRevision) {
 		// If it's already a commit SHA, then no need to look it up
 		return ambiguousRevision, ambiguousRevision, nil
@@ -962,7 +1345,7 @@ func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, sy
 	if err != nil {
 		return "", "", err
 	}
-	gitClient, err := s.gitFactory.NewClient(repo.Repo, "", argoutil.GetRepoCreds(repo), repo.IsInsecure(), repo.IsLFSEnabled())
+	gitClient, err := s.gitFactory.NewClient(repo.Repo, "", argoutil.GetRepoCreds(repo), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy)
 	if err != nil {
 		return "", "", err
 	}
@@ -974,6 +1357,39 @@ func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, sy
 	return commitSHA, displayRevision, nil
 }
 
+func (s *Server) resolveChartVersion(ctx context.Context, app *appv1.Application, ambiguousRevision string) (string, string, error) {
+	repo, err := s.db.GetRepository(ctx, app.Spec.Source.RepoURL)
+	if err != nil {
+		return "", "", err
+	}
+	if helm.IsOCIURL(repo.Repo) {
+		if helm.IsDigest(ambiguousRevision) {
+			return ambiguousRevision, ambiguousRevision, nil
+		}
+		digest, err := helm.NewOCIClient(repo.Repo, argoutil.GetHelmCreds(repo)).ResolveDigest(app.Spec.Source.Chart, ambiguousRevision)
+		if err != nil {
+			return "", "", err
+		}
+		return digest, fmt.Sprintf("%s (%s)", ambiguousRevision, digest), nil
+	}
+	index, err := helm.NewClient(repo.Repo, argoutil.GetHelmCreds(repo)).GetIndex()
+	if err != nil {
+		return "", "", err
+	}
+	entries, err := index.GetEntries(app.Spec.Source.Chart)
+	if err != nil {
+		return "", "", status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	entry, err := entries.Resolve(ambiguousRevision)
+	if err != nil {
+		return "", "", status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if entry.Version == ambiguousRevision {
+		return entry.Version, entry.Version, nil
+	}
+	return entry.Version, fmt.Sprintf("%s (%s)", ambiguousRevision, entry.Version), nil
+}
+
 func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.OperationTerminateRequest) (*application.OperationTerminateResponse, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*termOpReq.Name, metav1.GetOptions{})
 	if err != nil {
@@ -1008,7 +1424,7 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 
 func (s *Server) logEvent(a *appv1.Application, ctx context.Context, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
-	user := session.Username(ctx)
+	user := session.AuditUsername(ctx)
 	if user == "" {
 		user = "Unknown user"
 	}
@@ -1088,7 +1504,7 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	if err != nil {
 		return nil, err
 	}
-	if len(filteredAvailableActions) == 0 {
+	if len(filteredAvailableActions) != 1 || filteredAvailableActions[0].Name != q.Action {
 		return nil, status.Errorf(codes.InvalidArgument, "action not available on resource")
 	}
 
@@ -1100,7 +1516,17 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		return nil, err
 	}
 
-	newObj, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua)
+	// parameters declared by the discovery script take precedence over the ones of the action definition
+	declaredParams := filteredAvailableActions[0].Params
+	if len(declaredParams) == 0 {
+		declaredParams = action.Params
+	}
+	params, err := lua.ValidateResourceActionParams(declaredParams, q.Params)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parameters of action '%s': %v", q.Action, err)
+	}
+
+	newObj, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, params)
 	if err != nil {
 		return nil, err
 	}
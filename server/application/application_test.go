@@ -314,6 +314,22 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, appsv1.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestSyncBlockedBySyncWindow(t *testing.T) {
+	ctx := context.Background()
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+
+	projIf := appServer.appclientset.ArgoprojV1alpha1().AppProjects(appServer.ns)
+	proj, err := projIf.Get("default", metav1.GetOptions{})
+	assert.Nil(t, err)
+	proj.Spec.SyncWindows = appsv1.SyncWindows{{Kind: appsv1.SyncWindowKindDeny, Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}}}
+	_, err = projIf.Update(proj)
+	assert.Nil(t, err)
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []appsv1.RevisionHistory{{
@@ -334,6 +350,71 @@ func TestRollbackApp(t *testing.T) {
 	assert.NotNil(t, updatedApp.Operation.Sync)
 	assert.NotNil(t, updatedApp.Operation.Sync.Source)
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
+	assert.True(t, updatedApp.Operation.Sync.Rollback)
+}
+
+func TestRollbackAppMultipleSources(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Spec.Sources = appsv1.ApplicationSources{
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Ref: "values"},
+		{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "helm-guestbook"},
+	}
+	testApp.Status.History = []appsv1.RevisionHistory{{
+		ID:        1,
+		Revisions: []string{"abc", "def"},
+		Sources:   testApp.Spec.Sources,
+	}, {
+		ID:       2,
+		Revision: "abc",
+		Source:   *testApp.Spec.Source.DeepCopy(),
+	}}
+	appServer := newTestAppServer(testApp)
+
+	updatedApp, err := appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{
+		Name: &testApp.Name,
+		ID:   1,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, updatedApp.Operation.Sync.Source)
+	assert.Equal(t, testApp.Spec.Sources, updatedApp.Operation.Sync.Sources)
+	assert.Equal(t, []string{"abc", "def"}, updatedApp.Operation.Sync.Revisions)
+
+	// Applications with multiple sources can neither be rolled back to a revision, nor to a single source
+	testApp.Name = "other-app"
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Create(testApp)
+	assert.Nil(t, err)
+	_, err = appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{Name: &testApp.Name, Revision: "abc"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{Name: &testApp.Name, ID: 2})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = appServer.Sync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name, Revision: "abc"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRollbackAppToRevision(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+
+	updatedApp, err := appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{
+		Name:     &testApp.Name,
+		Revision: "def",
+	})
+
+	assert.Nil(t, err)
+
+	assert.NotNil(t, updatedApp.Operation)
+	assert.NotNil(t, updatedApp.Operation.Sync)
+	assert.Equal(t, "def", updatedApp.Operation.Sync.Revision)
+	assert.Equal(t, "def", updatedApp.Operation.Sync.Source.TargetRevision)
+	assert.Equal(t, testApp.Spec.Source.RepoURL, updatedApp.Operation.Sync.Source.RepoURL)
+	assert.True(t, updatedApp.Operation.Sync.Rollback)
+
+	_, err = appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{
+		Name: &testApp.Name,
+		ID:   1,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestUpdateAppProject(t *testing.T) {
@@ -420,3 +501,78 @@ func TestAppMergePatch(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "foo", app.Spec.Source.Path)
 }
+
+func TestGetPods(t *testing.T) {
+	deployment := appsv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}
+	replicaSet := appsv1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-1"}
+	pod1 := appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "guestbook-1-a"}
+	pod2 := appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "guestbook-1-b"}
+	other := appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "other"}
+	tree := &appsv1.ApplicationTree{
+		Nodes: []appsv1.ResourceNode{
+			{ResourceRef: deployment},
+			{ResourceRef: replicaSet, ParentRefs: []appsv1.ResourceRef{deployment}},
+			{ResourceRef: pod1, ParentRefs: []appsv1.ResourceRef{replicaSet}},
+			{ResourceRef: pod2, ParentRefs: []appsv1.ResourceRef{replicaSet}},
+		},
+		OrphanedNodes: []appsv1.ResourceNode{{ResourceRef: other}},
+	}
+	names := func(pods []appsv1.ResourceNode) []string {
+		result := make([]string, 0)
+		for _, pod := range pods {
+			result = append(result, pod.Name)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"guestbook-1-a", "guestbook-1-b"}, names(getPods(tree, nil)))
+	assert.Equal(t, []string{"guestbook-1-a", "guestbook-1-b"}, names(getPods(tree, tree.FindNode("apps", "Deployment", "default", "guestbook"))))
+	assert.Equal(t, []string{"guestbook-1-b"}, names(getPods(tree, tree.FindNode("", "Pod", "default", "guestbook-1-b"))))
+	assert.Equal(t, []string{}, names(getPods(tree, &appsv1.ResourceNode{ResourceRef: appsv1.ResourceRef{Kind: "Service", Name: "guestbook"}})))
+}
+
+func TestFilterAppTree(t *testing.T) {
+	healthy := &appsv1.HealthStatus{Status: appsv1.HealthStatusHealthy}
+	degraded := &appsv1.HealthStatus{Status: appsv1.HealthStatusDegraded}
+	tree := &appsv1.ApplicationTree{
+		Nodes: []appsv1.ResourceNode{
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "guestbook-b"}, Health: degraded},
+			{ResourceRef: appsv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}, Health: healthy},
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "guestbook-a"}, Health: healthy},
+			{ResourceRef: appsv1.ResourceRef{Kind: "Service", Namespace: "default", Name: "guestbook"}},
+		},
+		OrphanedNodes: []appsv1.ResourceNode{{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "other"}}},
+	}
+	names := func(nodes []appsv1.ResourceNode) []string {
+		result := make([]string, 0)
+		for _, node := range nodes {
+			result = append(result, node.Name)
+		}
+		return result
+	}
+	appName := "guestbook"
+
+	assert.Equal(t, tree, filterAppTree(tree, &application.ResourcesQuery{ApplicationName: &appName}))
+
+	filtered := filterAppTree(tree, &application.ResourcesQuery{ApplicationName: &appName, Kind: "pod"})
+	assert.Equal(t, []string{"guestbook-b", "guestbook-a"}, names(filtered.Nodes))
+	assert.Equal(t, []string{"other"}, names(filtered.OrphanedNodes))
+
+	filtered = filterAppTree(tree, &application.ResourcesQuery{ApplicationName: &appName, Health: "Healthy", Name: "guestbook*"})
+	assert.Equal(t, []string{"guestbook", "guestbook-a"}, names(filtered.Nodes))
+	assert.Equal(t, []string{}, names(filtered.OrphanedNodes))
+
+	// pages are sorted and the orphaned nodes follow the application nodes
+	filtered = filterAppTree(tree, &application.ResourcesQuery{ApplicationName: &appName, Limit: 2})
+	assert.Equal(t, []string{"guestbook-a", "guestbook-b"}, names(filtered.Nodes))
+	assert.Equal(t, []string{}, names(filtered.OrphanedNodes))
+	filtered = filterAppTree(tree, &application.ResourcesQuery{ApplicationName: &appName, Limit: 2, Offset: 2})
+	assert.Equal(t, []string{"guestbook", "guestbook"}, names(filtered.Nodes))
+	assert.Equal(t, []string{}, names(filtered.OrphanedNodes))
+	filtered = filterAppTree(tree, &application.ResourcesQuery{ApplicationName: &appName, Limit: 2, Offset: 3})
+	assert.Equal(t, []string{"guestbook"}, names(filtered.Nodes))
+	assert.Equal(t, []string{"other"}, names(filtered.OrphanedNodes))
+	filtered = filterAppTree(tree, &application.ResourcesQuery{ApplicationName: &appName, Limit: 2, Offset: 6})
+	assert.Equal(t, []string{}, names(filtered.Nodes))
+	assert.Equal(t, []string{}, names(filtered.OrphanedNodes))
+}
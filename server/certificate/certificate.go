@@ -1,15 +1,23 @@
 package certificate
 
 import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/util/cache"
+	certutil "github.com/argoproj/argo-cd/util/cert"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // Server provides a Certificate service
@@ -18,6 +26,7 @@ type Server struct {
 	repoClientset apiclient.Clientset
 	enf           *rbac.Enforcer
 	cache         *cache.Cache
+	settingsMgr   *settings.SettingsManager
 }
 
 // NewServer returns a new instance of the Certificate service
@@ -26,12 +35,14 @@ func NewServer(
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 	cache *cache.Cache,
+	settingsMgr *settings.SettingsManager,
 ) *Server {
 	return &Server{
 		db:            db,
 		repoClientset: repoClientset,
 		enf:           enf,
 		cache:         cache,
+		settingsMgr:   settingsMgr,
 	}
 }
 
@@ -48,6 +59,7 @@ func (s *Server) ListCertificates(ctx context.Context, q *certificatepkg.Reposit
 		HostNamePattern: q.GetHostNamePattern(),
 		CertType:        q.GetCertType(),
 		CertSubType:     q.GetCertSubType(),
+		Fingerprint:     q.GetFingerprint(),
 	})
 	if err != nil {
 		return nil, err
@@ -55,12 +67,94 @@ func (s *Server) ListCertificates(ctx context.Context, q *certificatepkg.Reposit
 	return certList, nil
 }
 
+// Returns the number of configured certificates that match the query, by type
+func (s *Server) CountCertificates(ctx context.Context, q *certificatepkg.RepositoryCertificateQuery) (*certificatepkg.RepositoryCertificateCountResponse, error) {
+	certList, err := s.ListCertificates(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return certificatepkg.CountCertificates(certList.Items), nil
+}
+
+// Returns the status of the certificate store
+func (s *Server) GetCertificateStoreStatus(ctx context.Context, q *certificatepkg.RepositoryCertificateStoreStatusQuery) (*certificatepkg.RepositoryCertificateStoreStatus, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceCertificates, rbacpolicy.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	syncedAt, err := s.settingsMgr.GetCertificatesSyncedAt()
+	if err != nil {
+		return nil, err
+	}
+	status := &certificatepkg.RepositoryCertificateStoreStatus{}
+	if !syncedAt.IsZero() {
+		reconciledAt := metav1.NewTime(syncedAt)
+		status.LastReconciledAt = &reconciledAt
+	}
+	return status, nil
+}
+
+// Scans the SSH server given in the query for its host keys, from within the
+// network of the API server. The keys are returned for confirmation by the
+// caller, but are not stored. Since this is the first step of adding keys,
+// it requires the permission to create certificates.
+//
+// As this lets users connect the API server to arbitrary addresses, it must
+// be enabled in argocd-cm, and the reason of a failed scan is only logged.
+func (s *Server) ScanSSHHostKeys(ctx context.Context, q *certificatepkg.SSHHostKeyScanRequest) (*appsv1.RepositoryCertificateList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceCertificates, rbacpolicy.ActionCreate, ""); err != nil {
+		return nil, err
+	}
+	enabled, err := s.settingsMgr.GetSSHHostKeyScanEnabled()
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "Scanning SSH host keys from the API server is disabled")
+	}
+	if q.Address == "" || strings.ContainsAny(q.Address, " \t\n") {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid address '%s': must be host[:port]", q.Address)
+	}
+	entries, err := certutil.ScanSSHHostKeys(q.Address, certutil.SSHScanDefaultTimeout)
+	if err != nil {
+		log.Warnf("Failed to scan SSH host keys of '%s': %v", q.Address, err)
+		return nil, status.Errorf(codes.Unavailable, "Unable to scan SSH host keys of '%s'", q.Address)
+	}
+	certList := &appsv1.RepositoryCertificateList{Items: make([]appsv1.RepositoryCertificate, 0, len(entries))}
+	for _, entry := range entries {
+		hostname, subType, data, err := certutil.TokenizeSSHKnownHostsEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		_, key, err := certutil.KnownHostsLineToPublicKey(entry)
+		if err != nil {
+			return nil, err
+		}
+		certList.Items = append(certList.Items, appsv1.RepositoryCertificate{
+			ServerName:      hostname,
+			CertType:        "ssh",
+			CertSubType:     subType,
+			CertData:        data,
+			CertFingerprint: certutil.SSHFingerprintSHA256(key),
+		})
+	}
+	return certList, nil
+}
+
 // Batch creates certificates for verifying repositories
 func (s *Server) CreateCertificate(ctx context.Context, q *certificatepkg.RepositoryCertificateCreateRequest) (*appsv1.RepositoryCertificateList, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceCertificates, rbacpolicy.ActionCreate, ""); err != nil {
 		return nil, err
 	}
-	certs, err := s.db.CreateRepoCertificate(ctx, q.Certificates, q.Upsert)
+	if err := dedupeTLSCertificates(q.Certificates); err != nil {
+		return nil, err
+	}
+	var certs *appsv1.RepositoryCertificateList
+	var err error
+	if q.DryRun {
+		certs, err = s.db.ValidateRepoCertificate(ctx, q.Certificates, q.Upsert)
+	} else {
+		certs, err = s.db.CreateRepoCertificate(ctx, q.Certificates, q.Upsert)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +162,32 @@ func (s *Server) CreateCertificate(ctx context.Context, q *certificatepkg.Reposi
 	return certs, nil
 }
 
+// Removes exact duplicates, identified by their fingerprint, from the data of
+// each https certificate in the list. Clients may send the same certificate
+// more than once in a bundle, which should not end up in the store twice.
+func dedupeTLSCertificates(certs *appsv1.RepositoryCertificateList) error {
+	if certs == nil {
+		return nil
+	}
+	for i, cert := range certs.Items {
+		if cert.CertType != "https" {
+			continue
+		}
+		pemData, err := certutil.ParseTLSCertificateInputFromData(string(cert.CertData))
+		if err != nil {
+			return err
+		}
+		unique, duplicates, err := certutil.DedupeTLSCertificates(pemData)
+		if err != nil {
+			return err
+		}
+		if len(duplicates) > 0 {
+			certs.Items[i].CertData = []byte(strings.Join(unique, "\n"))
+		}
+	}
+	return nil
+}
+
 // Batch deletes a list of certificates that match the query
 func (s *Server) DeleteCertificate(ctx context.Context, q *certificatepkg.RepositoryCertificateQuery) (*appsv1.RepositoryCertificateList, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceCertificates, rbacpolicy.ActionDelete, ""); err != nil {
@@ -77,6 +197,7 @@ func (s *Server) DeleteCertificate(ctx context.Context, q *certificatepkg.Reposi
 		HostNamePattern: q.GetHostNamePattern(),
 		CertType:        q.GetCertType(),
 		CertSubType:     q.GetCertSubType(),
+		Fingerprint:     q.GetFingerprint(),
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,231 @@
+package certificate
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
+
+const testSSHKnownHostsData = `
+github.com ssh-rsa AAAAB3NzaC1yc2EAAAABIwAAAQEAq2A7hRGmdnm9tUDbO9IDSwBK6TbQa+PXYPCPy6rbTrTtw7PHkccKrpp0yVhp5HdEIcKr6pLlVDBfOLX9QUsyCOV0wzfjIJNlGEYsdlLJizHhbn2mUjvSAHQqZETYP81eFzLQNnPHt4EVVUh7VfDESU84KezmD5QlWpXLmvU31/yMf+Se8xhHTvKSCZIFImWwoG6mbUoWf9nzpIoaSjB+weqqUUmpaaasXVal72J+UX2B+2RPW3RcT0eOzQgqlJL3RKrTJvdsjE3JEAvGq3lGHSZXy28G3skua2SmVi/w4yCE6gbODqnTWlg7+wC604ydGXA8VJiS5ap43JXiUFFAaQ==
+gitlab.com ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBFSMqzJeV9rUzU4kWitGjeR4PWSa29SPqJ1fVkhtj3Hw9xjLVXVYrU9QlYWrOLXBpQ6KWjbjTDTdDkoohFzgbEY=
+gitlab.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf
+`
+
+func newTestServer(t *testing.T) *Server {
+	return newTestServerWithConfig(t, nil)
+}
+
+func newTestServerWithConfig(t *testing.T, argoCDCMData map[string]string) *Server {
+	tlsCert, err := ioutil.ReadFile("../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: argoCDCMData,
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-ssh-known-hosts-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"ssh_known_hosts": testSSHKnownHostsData,
+		},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-tls-certs-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"localhost": string(tlsCert),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	_ = enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enforcer.SetDefaultRole("role:admin")
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return true
+	})
+	return NewServer(nil, db.NewDB(testNamespace, settingsMgr, kubeclientset), enforcer, nil, settingsMgr)
+}
+
+func TestCountCertificates(t *testing.T) {
+	server := newTestServer(t)
+
+	t.Run("AllTypes", func(t *testing.T) {
+		counts, err := server.CountCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), counts.Ssh)
+		assert.Equal(t, int64(1), counts.Https)
+		assert.Equal(t, int64(4), counts.Total)
+
+		// The response must only carry the counts, not the certificate data
+		data, err := counts.Marshal()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "BEGIN CERTIFICATE")
+		assert.NotContains(t, string(data), "AAAA")
+	})
+
+	t.Run("FilterByType", func(t *testing.T) {
+		counts, err := server.CountCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{CertType: "ssh"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), counts.Ssh)
+		assert.Equal(t, int64(0), counts.Https)
+		assert.Equal(t, int64(3), counts.Total)
+	})
+
+	t.Run("FilterByHostName", func(t *testing.T) {
+		counts, err := server.CountCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "gitlab.*"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), counts.Ssh)
+		assert.Equal(t, int64(0), counts.Https)
+		assert.Equal(t, int64(2), counts.Total)
+	})
+}
+
+func TestGetCertificateStoreStatus(t *testing.T) {
+	server := newTestServer(t)
+	before := time.Now()
+	status, err := server.GetCertificateStoreStatus(context.Background(), &certificatepkg.RepositoryCertificateStoreStatusQuery{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, status.LastReconciledAt) {
+		assert.False(t, status.LastReconciledAt.Time.Before(before.Add(-time.Second)))
+	}
+}
+
+func TestExpiryCollector(t *testing.T) {
+	server := newTestServer(t)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewExpiryCollector(server.db))
+
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	if assert.Len(t, families, 1) {
+		assert.Equal(t, "argocd_cert_expiry_seconds", families[0].GetName())
+		// Only the TLS certificate has an expiry, SSH known hosts entries don't
+		if assert.Len(t, families[0].Metric, 1) {
+			metric := families[0].Metric[0]
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			assert.Equal(t, "localhost", labels["servername"])
+			assert.Contains(t, labels["fingerprint"], "SHA256:")
+
+			certList, err := server.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{CertType: "https"})
+			assert.NoError(t, err)
+			assert.Equal(t, float64(certList.Items[0].NotAfter.Unix()), metric.GetGauge().GetValue())
+		}
+	}
+}
+
+func TestCreateCertificateDedupesByFingerprint(t *testing.T) {
+	server := newTestServer(t)
+	tlsCert, err := ioutil.ReadFile("../../test/fixture/certs/argocd-test-server.crt")
+	assert.NoError(t, err)
+	caCert, err := ioutil.ReadFile("../../test/fixture/certs/argocd-test-ca.crt")
+	assert.NoError(t, err)
+
+	bundle := strings.Join([]string{string(tlsCert), string(caCert), string(tlsCert)}, "\n")
+	created, err := server.CreateCertificate(context.Background(), &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{
+			Items: []appsv1.RepositoryCertificate{{ServerName: "git.example.com", CertType: "https", CertData: []byte(bundle)}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, created.Items, 2)
+
+	certList, err := server.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "git.example.com", CertType: "https"})
+	assert.NoError(t, err)
+	assert.Len(t, certList.Items, 2)
+}
+
+func TestCreateCertificateDryRun(t *testing.T) {
+	server := newTestServer(t)
+	caCert, err := ioutil.ReadFile("../../test/fixture/certs/argocd-test-ca.crt")
+	assert.NoError(t, err)
+
+	created, err := server.CreateCertificate(context.Background(), &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{
+			Items: []appsv1.RepositoryCertificate{{ServerName: "git.example.com", CertType: "https", CertData: caCert}},
+		},
+		DryRun: true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, created.Items, 1)
+
+	certList, err := server.ListCertificates(context.Background(), &certificatepkg.RepositoryCertificateQuery{HostNamePattern: "git.example.com"})
+	assert.NoError(t, err)
+	assert.Len(t, certList.Items, 0)
+
+	// Conflicts with existing entries are reported without upsert
+	_, err = server.CreateCertificate(context.Background(), &certificatepkg.RepositoryCertificateCreateRequest{
+		Certificates: &appsv1.RepositoryCertificateList{
+			Items: []appsv1.RepositoryCertificate{{ServerName: "localhost", CertType: "https", CertData: caCert}},
+		},
+		DryRun: true,
+	})
+	assert.Error(t, err)
+}
+
+func TestScanSSHHostKeysDisabled(t *testing.T) {
+	server := newTestServer(t)
+	_, err := server.ScanSSHHostKeys(context.Background(), &certificatepkg.SSHHostKeyScanRequest{Address: "git.example.com"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestScanSSHHostKeysUnreachable(t *testing.T) {
+	// Get an address on which nothing is listening
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := listener.Addr().String()
+	assert.NoError(t, listener.Close())
+
+	server := newTestServerWithConfig(t, map[string]string{"certificates.sshScan.enabled": "true"})
+	_, err = server.ScanSSHHostKeys(context.Background(), &certificatepkg.SSHHostKeyScanRequest{Address: address})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	// The reason is not returned, so the scan does not tell the caller about the network
+	assert.Equal(t, "Unable to scan SSH host keys of '"+address+"'", status.Convert(err).Message())
+}
+
+func TestScanSSHHostKeysInvalidAddress(t *testing.T) {
+	server := newTestServerWithConfig(t, map[string]string{"certificates.sshScan.enabled": "true"})
+	for _, address := range []string{"", "git.example.com extra"} {
+		_, err := server.ScanSSHHostKeys(context.Background(), &certificatepkg.SSHHostKeyScanRequest{Address: address})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -0,0 +1,54 @@
+package certificate
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	certutil "github.com/argoproj/argo-cd/util/cert"
+	"github.com/argoproj/argo-cd/util/db"
+)
+
+var (
+	descCertExpiry = prometheus.NewDesc(
+		"argocd_cert_expiry_seconds",
+		"Expiry time in unix timestamp of a configured TLS certificate for a repository server.",
+		[]string{"servername", "fingerprint"},
+		nil,
+	)
+)
+
+type expiryCollector struct {
+	db db.ArgoDB
+}
+
+// NewExpiryCollector returns a prometheus collector for the expiry of the
+// configured TLS certificates
+func NewExpiryCollector(db db.ArgoDB) prometheus.Collector {
+	return &expiryCollector{db: db}
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *expiryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descCertExpiry
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *expiryCollector) Collect(ch chan<- prometheus.Metric) {
+	certList, err := c.db.ListRepoCertificates(context.Background(), &db.CertificateListSelector{CertType: "https"})
+	if err != nil {
+		log.Warnf("Failed to collect certificates: %v", err)
+		return
+	}
+	for _, cert := range certList.Items {
+		if cert.NotAfter == nil {
+			continue
+		}
+		x509Cert, err := certutil.DecodePEMCertificateToX509(string(cert.CertData))
+		if err != nil {
+			continue
+		}
+		fingerprint := "SHA256:" + certutil.TLSCertificateFingerprintSHA256(x509Cert)
+		ch <- prometheus.MustNewConstMetric(descCertExpiry, prometheus.GaugeValue, float64(cert.NotAfter.Unix()), cert.ServerName, fingerprint)
+	}
+}
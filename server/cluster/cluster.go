@@ -21,24 +21,45 @@ import (
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // Server provides a Cluster service
 type Server struct {
-	db    db.ArgoDB
-	enf   *rbac.Enforcer
-	cache *cache.Cache
+	db          db.ArgoDB
+	enf         *rbac.Enforcer
+	cache       *cache.Cache
+	settingsMgr *settings.SettingsManager
 }
 
 // NewServer returns a new instance of the Cluster service
-func NewServer(db db.ArgoDB, enf *rbac.Enforcer, cache *cache.Cache) *Server {
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer, cache *cache.Cache, settingsMgr *settings.SettingsManager) *Server {
 	return &Server{
-		db:    db,
-		enf:   enf,
-		cache: cache,
+		db:          db,
+		enf:         enf,
+		cache:       cache,
+		settingsMgr: settingsMgr,
 	}
 }
 
+// validateExecProvider checks that the command of the exec provider of a cluster is one of the commands allowed in
+// argocd-cm, since the command is run by the API server and the application controller with their privileges
+func (s *Server) validateExecProvider(clust *appv1.Cluster) error {
+	if clust.Config.ExecProviderConfig == nil {
+		return nil
+	}
+	commands, err := s.settingsMgr.GetExecProviderCommands()
+	if err != nil {
+		return err
+	}
+	for _, command := range commands {
+		if command == clust.Config.ExecProviderConfig.Command {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "exec provider command '%s' is not allowed, the allowed commands are configured by cluster.execProviderCommands in argocd-cm", clust.Config.ExecProviderConfig.Command)
+}
+
 func (s *Server) getConnectionState(cluster appv1.Cluster, errorMessage string) appv1.ConnectionState {
 	if connectionState, err := s.cache.GetClusterConnectionState(cluster.Server); err == nil {
 		return connectionState
@@ -117,6 +138,9 @@ func (s *Server) Create(ctx context.Context, q *cluster.ClusterCreateRequest) (*
 		return nil, err
 	}
 	c := q.Cluster
+	if err := s.validateExecProvider(c); err != nil {
+		return nil, err
+	}
 	err := kube.TestConfig(q.Cluster.RESTConfig())
 	if err != nil {
 		return nil, err
@@ -158,6 +182,9 @@ func (s *Server) Update(ctx context.Context, q *cluster.ClusterUpdateRequest) (*
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, q.Cluster.Server); err != nil {
 		return nil, err
 	}
+	if err := s.validateExecProvider(q.Cluster); err != nil {
+		return nil, err
+	}
 	err := kube.TestConfig(q.Cluster.RESTConfig())
 	if err != nil {
 		return nil, err
@@ -202,18 +229,12 @@ func (s *Server) RotateAuth(ctx context.Context, q *cluster.ClusterQuery) (*clus
 	if err != nil {
 		return nil, err
 	}
-	// we are using token auth, make sure we don't store client-cert information
-	clust.Config.KeyData = nil
-	clust.Config.CertData = nil
-	clust.Config.BearerToken = string(newSecret.Data["token"])
-
-	// Test the token we just created before persisting it
-	err = kube.TestConfig(clust.RESTConfig())
-	if err != nil {
-		return nil, err
-	}
-	_, err = s.db.UpdateCluster(ctx, clust)
+	err = s.switchBearerToken(ctx, q.Server, restCfg.BearerToken, string(newSecret.Data["token"]))
 	if err != nil {
+		// the cluster still uses the old token, so the unused new token is revoked
+		if deleteErr := kubeclientset.CoreV1().Secrets(claims.Namespace).Delete(newSecret.Name, &v1.DeleteOptions{}); deleteErr != nil {
+			logCtx.Warnf("Failed to delete unused token secret %s: %v", newSecret.Name, deleteErr)
+		}
 		return nil, err
 	}
 	err = clusterauth.RotateServiceAccountSecrets(kubeclientset, claims, newSecret)
@@ -224,6 +245,31 @@ func (s *Server) RotateAuth(ctx context.Context, q *cluster.ClusterQuery) (*clus
 	return &cluster.ClusterResponse{}, nil
 }
 
+// switchBearerToken tests the connection to the cluster with the new bearer token, and then stores the new token in the
+// cluster secret. The cluster is read again before it is updated, so other changes of the cluster since the rotation
+// started are kept, and the rotation is aborted if the token of the cluster was changed meanwhile.
+func (s *Server) switchBearerToken(ctx context.Context, server string, oldToken string, newToken string) error {
+	clust, err := s.db.GetCluster(ctx, server)
+	if err != nil {
+		return err
+	}
+	if clust.Config.BearerToken != oldToken {
+		return status.Errorf(codes.Aborted, "The bearer token of cluster '%s' was changed during the rotation", server)
+	}
+	// we are using token auth, make sure we don't store client-cert information
+	clust.Config.KeyData = nil
+	clust.Config.CertData = nil
+	clust.Config.BearerToken = newToken
+
+	// Test the token we just created before persisting it
+	err = kube.TestConfig(clust.RESTConfig())
+	if err != nil {
+		return err
+	}
+	_, err = s.db.UpdateCluster(ctx, clust)
+	return err
+}
+
 func redact(clust *appv1.Cluster) *appv1.Cluster {
 	if clust == nil {
 		return nil
@@ -231,5 +277,13 @@ func redact(clust *appv1.Cluster) *appv1.Cluster {
 	clust.Config.Password = ""
 	clust.Config.BearerToken = ""
 	clust.Config.TLSClientConfig.KeyData = nil
+	if clust.Config.ExecProviderConfig != nil {
+		// the environment of the exec provider may hold credentials, e.g. AWS_SECRET_ACCESS_KEY, so only its keys are kept
+		env := make(map[string]string, len(clust.Config.ExecProviderConfig.Env))
+		for key := range clust.Config.ExecProviderConfig.Env {
+			env[key] = ""
+		}
+		clust.Config.ExecProviderConfig.Env = env
+	}
 	return clust
 }
@@ -0,0 +1,122 @@
+package cluster
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apiclient/cluster"
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "argocd"
+
+func TestSwitchBearerToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"major": "1", "minor": "14"}`))
+	}))
+	defer ts.Close()
+
+	kubeclientset := fake.NewSimpleClientset()
+	argoDB := db.NewDB(testNamespace, settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace), kubeclientset)
+	_, err := argoDB.CreateCluster(context.Background(), &appv1.Cluster{
+		Server: ts.URL,
+		Config: appv1.ClusterConfig{BearerToken: "old-token", TLSClientConfig: appv1.TLSClientConfig{CertData: []byte("cert")}},
+	})
+	assert.NoError(t, err)
+	server := NewServer(argoDB, nil, nil, nil)
+
+	getToken := func() string {
+		clust, err := argoDB.GetCluster(context.Background(), ts.URL)
+		assert.NoError(t, err)
+		return clust.Config.BearerToken
+	}
+
+	err = server.switchBearerToken(context.Background(), ts.URL, "other-token", "new-token")
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Equal(t, "old-token", getToken())
+
+	err = server.switchBearerToken(context.Background(), ts.URL, "old-token", "invalid-token")
+	assert.Error(t, err)
+	assert.Equal(t, "old-token", getToken())
+
+	err = server.switchBearerToken(context.Background(), ts.URL, "old-token", "new-token")
+	assert.NoError(t, err)
+	assert.Equal(t, "new-token", getToken())
+	clust, err := argoDB.GetCluster(context.Background(), ts.URL)
+	assert.NoError(t, err)
+	assert.Nil(t, clust.Config.CertData)
+}
+
+func TestRedact(t *testing.T) {
+	clust := redact(&appv1.Cluster{
+		Server: "https://my-eks-cluster",
+		Config: appv1.ClusterConfig{
+			Password:        "password",
+			BearerToken:     "token",
+			TLSClientConfig: appv1.TLSClientConfig{KeyData: []byte("key"), CAData: []byte("ca")},
+			ExecProviderConfig: &appv1.ExecProviderConfig{
+				Command: "aws",
+				Args:    []string{"eks", "get-token"},
+				Env:     map[string]string{"AWS_ACCESS_KEY_ID": "key-id", "AWS_SECRET_ACCESS_KEY": "secret"},
+			},
+		},
+	})
+	assert.Empty(t, clust.Config.Password)
+	assert.Empty(t, clust.Config.BearerToken)
+	assert.Nil(t, clust.Config.KeyData)
+	assert.Equal(t, []byte("ca"), clust.Config.CAData)
+	assert.Equal(t, []string{"eks", "get-token"}, clust.Config.ExecProviderConfig.Args)
+	assert.Equal(t, map[string]string{"AWS_ACCESS_KEY_ID": "", "AWS_SECRET_ACCESS_KEY": ""}, clust.Config.ExecProviderConfig.Env)
+
+	assert.Nil(t, redact(nil))
+}
+
+func TestValidateExecProvider(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		Data:       map[string]string{"cluster.execProviderCommands": "- aws\n"},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDRBACConfigMapName, Namespace: testNamespace},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enf.SetDefaultRole("role:admin")
+	server := NewServer(db.NewDB(testNamespace, settingsMgr, kubeclientset), enf, nil, settingsMgr)
+
+	assert.NoError(t, server.validateExecProvider(&appv1.Cluster{Server: "https://mycluster"}))
+	assert.NoError(t, server.validateExecProvider(&appv1.Cluster{Server: "https://mycluster", Config: appv1.ClusterConfig{ExecProviderConfig: &appv1.ExecProviderConfig{Command: "aws"}}}))
+
+	dir, err := ioutil.TempDir("", "exec-provider")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	marker := filepath.Join(dir, "executed")
+	forbidden := &appv1.Cluster{Server: "https://mycluster", Config: appv1.ClusterConfig{ExecProviderConfig: &appv1.ExecProviderConfig{Command: "touch", Args: []string{marker}}}}
+	_, err = server.Create(context.Background(), &cluster.ClusterCreateRequest{Cluster: forbidden})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.Update(context.Background(), &cluster.ClusterUpdateRequest{Cluster: forbidden})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = os.Stat(marker)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,84 @@
+package gpgkey
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpgkeypkg "github.com/argoproj/argo-cd/pkg/apiclient/gpgkey"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+)
+
+// Server provides a service of type GPGKeyService
+type Server struct {
+	db  db.ArgoDB
+	enf *rbac.Enforcer
+}
+
+// NewServer returns a new instance of the service with type GPGKeyService
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer) *Server {
+	return &Server{
+		db:  db,
+		enf: enf,
+	}
+}
+
+// List returns a list of all GnuPG public keys of the configuration, or the
+// one matching the key ID of the query
+func (s *Server) List(ctx context.Context, q *gpgkeypkg.GnuPGPublicKeyQuery) (*appsv1.GnuPGPublicKeyList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	keys, err := s.db.ListGPGPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if q.KeyID == "" {
+		return keys, nil
+	}
+	matching := &appsv1.GnuPGPublicKeyList{Items: make([]appsv1.GnuPGPublicKey, 0)}
+	for _, key := range keys.Items {
+		if key.KeyID == strings.ToUpper(q.KeyID) {
+			matching.Items = append(matching.Items, key)
+		}
+	}
+	return matching, nil
+}
+
+// Get returns the GnuPG public key with the key ID of the query
+func (s *Server) Get(ctx context.Context, q *gpgkeypkg.GnuPGPublicKeyQuery) (*appsv1.GnuPGPublicKey, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	return s.db.GetGPGPublicKey(ctx, strings.ToUpper(q.KeyID))
+}
+
+// Create adds the GnuPG public keys contained in the key data of the request
+// to the configuration
+func (s *Server) Create(ctx context.Context, q *gpgkeypkg.GnuPGPublicKeyCreateRequest) (*appsv1.GnuPGPublicKeyList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionCreate, ""); err != nil {
+		return nil, err
+	}
+	if q.Publickey == nil || q.Publickey.KeyData == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Submitted key data is empty")
+	}
+	return s.db.AddGPGPublicKeys(ctx, q.Publickey.KeyData, q.Upsert)
+}
+
+// Delete removes the GnuPG public key with the key ID of the query from the
+// configuration
+func (s *Server) Delete(ctx context.Context, q *gpgkeypkg.GnuPGPublicKeyQuery) (*gpgkeypkg.GnuPGPublicKeyResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionDelete, ""); err != nil {
+		return nil, err
+	}
+	err := s.db.DeleteGPGPublicKey(ctx, strings.ToUpper(q.KeyID))
+	if err != nil {
+		return nil, err
+	}
+	return &gpgkeypkg.GnuPGPublicKeyResponse{}, nil
+}
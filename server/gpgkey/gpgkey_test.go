@@ -0,0 +1,105 @@
+package gpgkey
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	gpgkeypkg "github.com/argoproj/argo-cd/pkg/apiclient/gpgkey"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
+
+func newTestServer(defaultRole string) *Server {
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	_ = enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enforcer.SetDefaultRole(defaultRole)
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return enforcer.Enforce(append([]interface{}{defaultRole}, rvals...)...)
+	})
+	return NewServer(db.NewDB(testNamespace, settingsMgr, kubeclientset), enforcer)
+}
+
+func newTestPublicKey(t *testing.T) (string, string) {
+	entity, err := openpgp.NewEntity("Alice", "", "alice@example.com", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.SerializePrivate(ioutil.Discard, nil))
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.Serialize(w))
+	assert.NoError(t, w.Close())
+	return entity.PrimaryKey.KeyIdString(), buf.String()
+}
+
+func TestGPGKeys(t *testing.T) {
+	server := newTestServer("role:admin")
+	keyID, keyData := newTestPublicKey(t)
+
+	keys, err := server.Create(context.Background(), &gpgkeypkg.GnuPGPublicKeyCreateRequest{Publickey: &appsv1.GnuPGPublicKey{KeyData: keyData}})
+	assert.NoError(t, err)
+	if assert.Len(t, keys.Items, 1) {
+		assert.Equal(t, keyID, keys.Items[0].KeyID)
+	}
+
+	_, err = server.Create(context.Background(), &gpgkeypkg.GnuPGPublicKeyCreateRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Key IDs are matched case-insensitively
+	key, err := server.Get(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{KeyID: strings.ToLower(keyID)})
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice <alice@example.com>", key.Owner)
+
+	keys, err = server.List(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{})
+	assert.NoError(t, err)
+	assert.Len(t, keys.Items, 1)
+	keys, err = server.List(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{KeyID: "0000000000000000"})
+	assert.NoError(t, err)
+	assert.Len(t, keys.Items, 0)
+
+	_, err = server.Delete(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{KeyID: keyID})
+	assert.NoError(t, err)
+	_, err = server.Get(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{KeyID: keyID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGPGKeysReadOnly(t *testing.T) {
+	server := newTestServer("role:readonly")
+	_, keyData := newTestPublicKey(t)
+
+	_, err := server.List(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{})
+	assert.NoError(t, err)
+
+	_, err = server.Create(context.Background(), &gpgkeypkg.GnuPGPublicKeyCreateRequest{Publickey: &appsv1.GnuPGPublicKey{KeyData: keyData}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.Delete(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{KeyID: "0000000000000000"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
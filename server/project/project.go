@@ -220,7 +220,7 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	var dstValidatedApps []v1alpha1.Application
 
 	for _, a := range argo.FilterByProjects(appsList.Items, []string{q.Project.Name}) {
-		if oldProj.IsSourcePermitted(a.Spec.Source) {
+		if oldProj.IsSourcesPermitted(a.Spec) {
 			srcValidatedApps = append(srcValidatedApps, a)
 		}
 		if oldProj.IsDestinationPermitted(a.Spec.Destination) {
@@ -232,7 +232,7 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	invalidDstCount := 0
 
 	for _, a := range srcValidatedApps {
-		if !q.Project.IsSourcePermitted(a.Spec.Source) {
+		if !q.Project.IsSourcesPermitted(a.Spec) {
 			invalidSrcCount++
 		}
 	}
@@ -310,7 +310,7 @@ func (s *Server) ListEvents(ctx context.Context, q *project.ProjectQuery) (*v1.E
 
 func (s *Server) logEvent(a *v1alpha1.AppProject, ctx context.Context, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
-	user := session.Username(ctx)
+	user := session.AuditUsername(ctx)
 	if user == "" {
 		user = "Unknown user"
 	}
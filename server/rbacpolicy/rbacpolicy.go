@@ -18,13 +18,17 @@ const (
 	ResourceApplications = "applications"
 	ResourceRepositories = "repositories"
 	ResourceCertificates = "certificates"
-
-	ActionGet      = "get"
-	ActionCreate   = "create"
-	ActionUpdate   = "update"
-	ActionDelete   = "delete"
-	ActionSync     = "sync"
-	ActionOverride = "override"
+	ResourceGPGKeys      = "gpgkeys"
+	ResourceUsers        = "users"
+	ResourceGroups       = "groups"
+
+	ActionGet         = "get"
+	ActionCreate      = "create"
+	ActionUpdate      = "update"
+	ActionDelete      = "delete"
+	ActionSync        = "sync"
+	ActionOverride    = "override"
+	ActionImpersonate = "impersonate"
 )
 
 var (
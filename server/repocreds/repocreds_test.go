@@ -0,0 +1,94 @@
+package repocreds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	repocredspkg "github.com/argoproj/argo-cd/pkg/apiclient/repocreds"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/assets"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
+
+func newTestServer() *Server {
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"repository.credentials": `
+- url: https://github.com/argoproj
+  usernameSecret:
+    name: creds-secret
+    key: username
+  passwordSecret:
+    name: creds-secret
+    key: password
+`,
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "creds-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("test-username"),
+			"password": []byte("test-password"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	_ = enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enforcer.SetDefaultRole("role:admin")
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return true
+	})
+	return NewServer(db.NewDB(testNamespace, settingsMgr, kubeclientset), enforcer)
+}
+
+func TestListRepositoryCredentials(t *testing.T) {
+	server := newTestServer()
+	list, err := server.ListRepositoryCredentials(context.Background(), &repocredspkg.RepoCredsQuery{})
+	assert.NoError(t, err)
+	// The secret parts of the credentials must never be returned
+	assert.Equal(t, []appsv1.RepoCreds{{URL: "https://github.com/argoproj", Username: "test-username"}}, list.Items)
+
+	list, err = server.ListRepositoryCredentials(context.Background(), &repocredspkg.RepoCredsQuery{Url: "https://gitlab.com"})
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 0)
+}
+
+func TestCreateRepositoryCredentials(t *testing.T) {
+	server := newTestServer()
+
+	// Creating an identical template is idempotent
+	_, err := server.CreateRepositoryCredentials(context.Background(), &repocredspkg.RepoCredsCreateRequest{
+		Creds: &appsv1.RepoCreds{URL: "https://github.com/argoproj", Username: "test-username", Password: "test-password"},
+	})
+	assert.NoError(t, err)
+
+	_, err = server.CreateRepositoryCredentials(context.Background(), &repocredspkg.RepoCredsCreateRequest{
+		Creds: &appsv1.RepoCreds{URL: "https://github.com/argoproj", Username: "other-username", Password: "other-password"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.CreateRepositoryCredentials(context.Background(), &repocredspkg.RepoCredsCreateRequest{Creds: &appsv1.RepoCreds{}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	repositorypkg "github.com/argoproj/argo-cd/pkg/apiclient/repository"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/util/cert"
+	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/helm"
+)
+
+// Stages of the connection diagnostics, in the order they are performed
+const (
+	StageDNS  = "DNS"
+	StageTCP  = "TCP"
+	StageTLS  = "TLS"
+	StageAuth = "Auth"
+)
+
+// Timeout of each network stage of the connection diagnostics
+const diagnosticsTimeout = 10 * time.Second
+
+// Host and port a repository is connected to
+type repoEndpoint struct {
+	scheme string
+	host   string
+	port   string
+}
+
+func (e *repoEndpoint) address() string {
+	return net.JoinHostPort(e.host, e.port)
+}
+
+// Returns the endpoint of a repository URL, which is either an HTTP(S), SSH or OCI URL
+func getRepoEndpoint(repoURL string) (*repoEndpoint, error) {
+	if helm.IsOCIURL(repoURL) {
+		// OCI registries are always accessed using HTTPS
+		repoURL = "https://" + repoURL[len("oci://"):]
+	}
+	if isSSH, _ := git.IsSSHURL(repoURL); isSSH && !strings.Contains(repoURL, "://") {
+		// scp-like syntax, e.g. git@github.com:argoproj/argo-cd.git
+		host := repoURL[strings.Index(repoURL, "@")+1:]
+		if i := strings.Index(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		if host == "" {
+			return nil, fmt.Errorf("URL '%s' has no host", repoURL)
+		}
+		return &repoEndpoint{scheme: "ssh", host: host, port: "22"}, nil
+	}
+	parsedURL, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL '%s': %v", repoURL, err)
+	}
+	if parsedURL.Hostname() == "" {
+		return nil, fmt.Errorf("URL '%s' has no host", repoURL)
+	}
+	endpoint := &repoEndpoint{scheme: strings.ToLower(parsedURL.Scheme), host: parsedURL.Hostname(), port: parsedURL.Port()}
+	defaultPort := ""
+	switch endpoint.scheme {
+	case "https":
+		defaultPort = "443"
+	case "http":
+		defaultPort = "80"
+	case "ssh":
+		defaultPort = "22"
+	case "git":
+		defaultPort = "9418"
+	default:
+		return nil, fmt.Errorf("URL '%s' has unsupported scheme '%s'", repoURL, parsedURL.Scheme)
+	}
+	if endpoint.port == "" {
+		endpoint.port = defaultPort
+	}
+	return endpoint, nil
+}
+
+// Removes the password from the user info of a URL, e.g. of a proxy
+func redactURL(rawURL string) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || parsedURL.User == nil {
+		return rawURL
+	}
+	if _, ok := parsedURL.User.Password(); ok {
+		parsedURL.User = url.UserPassword(parsedURL.User.Username(), "xxxxx")
+	}
+	return parsedURL.String()
+}
+
+// Describes which credentials the repository is accessed with
+func describeCredentials(repo *appsv1.Repository) string {
+	var creds []string
+	if repo.Username != "" || repo.Password != "" {
+		creds = append(creds, "username and password")
+	}
+	if repo.SSHPrivateKey != "" {
+		creds = append(creds, "SSH private key")
+	}
+	if repo.TLSClientCertData != "" && repo.TLSClientCertKey != "" {
+		creds = append(creds, "TLS client certificate")
+	}
+	if len(creds) == 0 {
+		return "anonymously"
+	}
+	return "using " + strings.Join(creds, ", ")
+}
+
+// diagnoseConnection checks every stage of connecting to the repository, i.e. resolving its host,
+// opening a TCP connection, the TLS handshake and authentication, which is checked by testRepo.
+// Stages after a failed stage are skipped.
+func diagnoseConnection(ctx context.Context, repo *appsv1.Repository, testRepo func(repo *appsv1.Repository) error) []*repositorypkg.RepoConnectionCheck {
+	var checks []*repositorypkg.RepoConnectionCheck
+	failed := false
+	skip := func(stage string, message string) {
+		checks = append(checks, &repositorypkg.RepoConnectionCheck{Stage: stage, Skipped: true, Message: message})
+	}
+	run := func(stage string, check func() (string, error)) {
+		if failed {
+			skip(stage, "skipped because a previous stage failed")
+			return
+		}
+		message, err := check()
+		if err != nil {
+			failed = true
+			message = err.Error()
+		}
+		checks = append(checks, &repositorypkg.RepoConnectionCheck{Stage: stage, Successful: err == nil, Message: message})
+	}
+
+	endpoint, err := getRepoEndpoint(repo.Repo)
+	// HTTP(S) repositories are only connected to through the proxy, if there is one
+	dialed, via := endpoint, ""
+	if err == nil && repo.Proxy != "" && (endpoint.scheme == "http" || endpoint.scheme == "https") {
+		via = fmt.Sprintf("proxy %s: ", redactURL(repo.Proxy))
+		dialed, err = getRepoEndpoint(repo.Proxy)
+		if err != nil {
+			err = fmt.Errorf("invalid proxy %s", redactURL(repo.Proxy))
+		}
+	}
+	// Messages of checks of the proxy start with the proxy's URL
+	viaProxy := func(message string, err error) (string, error) {
+		if err != nil && via != "" {
+			return "", fmt.Errorf("%s%v", via, err)
+		}
+		return via + message, err
+	}
+	run(StageDNS, func() (string, error) {
+		if err != nil {
+			return "", err
+		}
+		return viaProxy(checkDNS(ctx, dialed.host))
+	})
+	run(StageTCP, func() (string, error) { return viaProxy(checkTCP(dialed.address())) })
+	switch {
+	case failed:
+		run(StageTLS, nil)
+	case endpoint.scheme != "https":
+		skip(StageTLS, "repository is not accessed using TLS")
+	case via != "":
+		skip(StageTLS, "TLS handshake with the repository is tunneled through the proxy, and checked during authentication")
+	default:
+		run(StageTLS, func() (string, error) { return checkTLS(endpoint, repo) })
+	}
+	run(StageAuth, func() (string, error) {
+		err := testRepo(repo)
+		if err != nil {
+			return "", fmt.Errorf("failed to access repository %s: %v", describeCredentials(repo), err)
+		}
+		return fmt.Sprintf("repository is accessible %s", describeCredentials(repo)), nil
+	})
+	return checks
+}
+
+func checkDNS(ctx context.Context, host string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, diagnosticsTimeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve host '%s': %v", host, err)
+	}
+	return fmt.Sprintf("host '%s' resolves to %s", host, strings.Join(addrs, ", ")), nil
+}
+
+func checkTCP(address string) (string, error) {
+	conn, err := net.DialTimeout("tcp", address, diagnosticsTimeout)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to %s: %v", address, err)
+	}
+	_ = conn.Close()
+	return fmt.Sprintf("connected to %s", address), nil
+}
+
+// Performs a TLS handshake with the repository using the certificates configured for its host, or the
+// system's certificates if there are none, and the repository's TLS client certificate
+func checkTLS(endpoint *repoEndpoint, repo *appsv1.Repository) (string, error) {
+	tlsConfig := &tls.Config{ServerName: endpoint.host, InsecureSkipVerify: repo.IsInsecure()}
+	verification := "verified against the system's certificates"
+	if repo.IsInsecure() {
+		verification = "not verified, because the repository is insecure"
+	} else {
+		serverCertificatePem, err := certutil.GetCertificateForConnect(endpoint.host)
+		if err != nil {
+			log.Warnf("Could not get certificates for host '%s': %v", endpoint.host, err)
+		} else if len(serverCertificatePem) > 0 {
+			tlsConfig.RootCAs = certutil.GetCertPoolFromPEMData(serverCertificatePem)
+			verification = fmt.Sprintf("verified against %d certificate(s) configured for host '%s'", len(serverCertificatePem), endpoint.host)
+		}
+	}
+	if repo.TLSClientCertData != "" && repo.TLSClientCertKey != "" {
+		cert, err := tls.X509KeyPair([]byte(repo.TLSClientCertData), []byte(repo.TLSClientCertKey))
+		if err != nil {
+			return "", fmt.Errorf("invalid TLS client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: diagnosticsTimeout}, "tcp", endpoint.address(), tlsConfig)
+	if err != nil {
+		return "", fmt.Errorf("TLS handshake with %s failed, server certificate %s: %v", endpoint.address(), verification, err)
+	}
+	defer func() { _ = conn.Close() }()
+	peerCertificates := conn.ConnectionState().PeerCertificates
+	if len(peerCertificates) == 0 {
+		return fmt.Sprintf("TLS handshake with %s successful", endpoint.address()), nil
+	}
+	return fmt.Sprintf("TLS handshake with %s successful, server certificate %s %s", endpoint.address(), describeCertificate(peerCertificates[0]), verification), nil
+}
+
+func describeCertificate(cert *x509.Certificate) string {
+	return fmt.Sprintf("'%s' issued by '%s', valid until %s,", cert.Subject.CommonName, cert.Issuer.CommonName, cert.NotAfter.UTC().Format(time.RFC3339))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/kustomize"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // Server provides a Repository service
@@ -32,6 +33,7 @@ type Server struct {
 	repoClientset apiclient.Clientset
 	enf           *rbac.Enforcer
 	cache         *cache.Cache
+	settingsMgr   *settings.SettingsManager
 }
 
 // NewServer returns a new instance of the Repository service
@@ -40,12 +42,14 @@ func NewServer(
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 	cache *cache.Cache,
+	settingsMgr *settings.SettingsManager,
 ) *Server {
 	return &Server{
 		db:            db,
 		repoClientset: repoClientset,
 		enf:           enf,
 		cache:         cache,
+		settingsMgr:   settingsMgr,
 	}
 }
 
@@ -60,7 +64,7 @@ func (s *Server) getConnectionState(ctx context.Context, url string) appsv1.Conn
 	}
 	repo, err := s.db.GetRepository(ctx, url)
 	if err == nil {
-		err = git.TestRepo(repo.Repo, argo.GetRepoCreds(repo), repo.IsInsecure(), repo.EnableLFS)
+		err = argo.TestRepo(repo)
 	}
 	if err != nil {
 		connectionState.Status = appsv1.ConnectionStatusFailed
@@ -87,10 +91,13 @@ func (s *Server) List(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.
 				return nil, err
 			}
 			items = append(items, appsv1.Repository{
-				Repo:      url,
-				Username:  repo.Username,
-				Insecure:  repo.IsInsecure(),
-				EnableLFS: repo.EnableLFS,
+				Repo:             url,
+				Type:             repo.Type,
+				Name:             repo.Name,
+				Username:         repo.Username,
+				Insecure:         repo.IsInsecure(),
+				EnableLFS:        repo.EnableLFS,
+				EnableSubmodules: repo.EnableSubmodules,
 			})
 		}
 	}
@@ -104,6 +111,55 @@ func (s *Server) List(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.
 	return &appsv1.RepositoryList{Items: items}, nil
 }
 
+// Returns the repository with the given URL, if it is registered
+func (s *Server) getRegisteredRepository(ctx context.Context, repoURL string) (*appsv1.Repository, error) {
+	urls, err := s.db.ListRepoURLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, url := range urls {
+		if git.SameURL(url, repoURL) {
+			return s.db.GetRepository(ctx, url)
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "repository '%s' not found", repoURL)
+}
+
+// Get returns a repository, without its credentials
+func (s *Server) Get(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.Repository, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, q.Repo); err != nil {
+		return nil, err
+	}
+	repo, err := s.getRegisteredRepository(ctx, q.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.Repository{
+		Repo:             repo.Repo,
+		Type:             repo.Type,
+		Name:             repo.Name,
+		Username:         repo.Username,
+		Insecure:         repo.IsInsecure(),
+		EnableLFS:        repo.EnableLFS,
+		EnableSubmodules: repo.EnableSubmodules,
+		Proxy:            redactURL(repo.Proxy),
+		ConnectionState:  s.getConnectionState(ctx, repo.Repo),
+	}, nil
+}
+
+// GetConnectionDiagnostics checks each stage of connecting to a repository, i.e. DNS resolution,
+// the TCP connection, the TLS handshake and authentication
+func (s *Server) GetConnectionDiagnostics(ctx context.Context, q *repositorypkg.RepoQuery) (*repositorypkg.RepoConnectionDiagnostics, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, q.Repo); err != nil {
+		return nil, err
+	}
+	repo, err := s.getRegisteredRepository(ctx, q.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return &repositorypkg.RepoConnectionDiagnostics{Checks: diagnoseConnection(ctx, repo, argo.TestRepo)}, nil
+}
+
 func (s *Server) listAppsPaths(
 	ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *appsv1.Repository, revision string, subPath string) (map[string]appsv1.ApplicationSourceType, error) {
 
@@ -217,13 +273,28 @@ func (s *Server) GetAppDetails(ctx context.Context, q *repositorypkg.RepoAppDeta
 	if err != nil {
 		return nil, err
 	}
+	enableSubmodules, submoduleCreds, err := s.db.GetSubmoduleCredentials(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	repo.EnableSubmodules = enableSubmodules
+	helmVersions, err := s.settingsMgr.GetHelmVersions()
+	if err != nil {
+		return nil, err
+	}
+	helmVersionRefs := make([]*appsv1.HelmVersion, len(helmVersions))
+	for i := range helmVersions {
+		helmVersionRefs[i] = &helmVersions[i]
+	}
 	return repoClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-		Repo:      repo,
-		Revision:  q.Revision,
-		Path:      q.Path,
-		HelmRepos: helmRepos,
-		Helm:      q.Helm,
-		Ksonnet:   q.Ksonnet,
+		Repo:           repo,
+		Revision:       q.Revision,
+		Path:           q.Path,
+		HelmRepos:      helmRepos,
+		Helm:           q.Helm,
+		Ksonnet:        q.Ksonnet,
+		SubmoduleCreds: submoduleCreds,
+		HelmVersions:   helmVersionRefs,
 	})
 }
 
@@ -233,7 +304,7 @@ func (s *Server) Create(ctx context.Context, q *repositorypkg.RepoCreateRequest)
 		return nil, err
 	}
 	r := q.Repo
-	err := git.TestRepo(r.Repo, argo.GetRepoCreds(r), r.IsInsecure(), r.EnableLFS)
+	err := argo.TestRepo(r)
 	if err != nil {
 		return nil, err
 	}
@@ -292,6 +363,10 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 	}
 
 	repo := &appsv1.Repository{
+		Repo:              q.Repo,
+		Type:              q.Type,
+		Name:              q.Name,
+		Proxy:             q.Proxy,
 		Username:          q.Username,
 		Password:          q.Password,
 		SSHPrivateKey:     q.SshPrivateKey,
@@ -299,7 +374,7 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 		TLSClientCertData: q.TlsClientCertData,
 		TLSClientCertKey:  q.TlsClientCertKey,
 	}
-	err := git.TestRepo(q.Repo, argo.GetRepoCreds(repo), q.Insecure, false)
+	err := argo.TestRepo(repo)
 	if err != nil {
 		return nil, err
 	}
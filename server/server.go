@@ -26,6 +26,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/soheilhy/cmux"
@@ -49,7 +50,9 @@ import (
 	applicationpkg "github.com/argoproj/argo-cd/pkg/apiclient/application"
 	certificatepkg "github.com/argoproj/argo-cd/pkg/apiclient/certificate"
 	clusterpkg "github.com/argoproj/argo-cd/pkg/apiclient/cluster"
+	gpgkeypkg "github.com/argoproj/argo-cd/pkg/apiclient/gpgkey"
 	projectpkg "github.com/argoproj/argo-cd/pkg/apiclient/project"
+	repocredspkg "github.com/argoproj/argo-cd/pkg/apiclient/repocreds"
 	repositorypkg "github.com/argoproj/argo-cd/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/pkg/apiclient/settings"
@@ -63,8 +66,10 @@ import (
 	"github.com/argoproj/argo-cd/server/badge"
 	"github.com/argoproj/argo-cd/server/certificate"
 	"github.com/argoproj/argo-cd/server/cluster"
+	"github.com/argoproj/argo-cd/server/gpgkey"
 	"github.com/argoproj/argo-cd/server/project"
 	"github.com/argoproj/argo-cd/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/server/repocreds"
 	"github.com/argoproj/argo-cd/server/repository"
 	"github.com/argoproj/argo-cd/server/session"
 	"github.com/argoproj/argo-cd/server/settings"
@@ -213,7 +218,7 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 	} else {
 		httpS = a.newHTTPServer(ctx, port, grpcWebS)
 	}
-	metricsServ := newAPIServerMetricsServer(metricsPort)
+	metricsServ := newAPIServerMetricsServer(metricsPort, certificate.NewExpiryCollector(db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)))
 
 	// Start listener
 	var conn net.Listener
@@ -413,13 +418,15 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 		grpc.ConnectionTimeout(300 * time.Second),
 	}
 	sensitiveMethods := map[string]bool{
-		"/cluster.ClusterService/Create":                true,
-		"/cluster.ClusterService/Update":                true,
-		"/session.SessionService/Create":                true,
-		"/account.AccountService/UpdatePassword":        true,
-		"/repository.RepositoryService/Create":          true,
-		"/repository.RepositoryService/Update":          true,
-		"/application.ApplicationService/PatchResource": true,
+		"/cluster.ClusterService/Create":                          true,
+		"/cluster.ClusterService/Update":                          true,
+		"/session.SessionService/Create":                          true,
+		"/account.AccountService/UpdatePassword":                  true,
+		"/repository.RepositoryService/Create":                    true,
+		"/repository.RepositoryService/Update":                    true,
+		"/repocreds.RepoCredsService/CreateRepositoryCredentials": true,
+		"/repocreds.RepoCredsService/UpdateRepositoryCredentials": true,
+		"/application.ApplicationService/PatchResource":           true,
 	}
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
@@ -448,24 +455,28 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	)))
 	grpcS := grpc.NewServer(sOpts...)
 	db := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)
-	clusterService := cluster.NewServer(db, a.enf, a.Cache)
-	repoService := repository.NewServer(a.RepoClientset, db, a.enf, a.Cache)
+	clusterService := cluster.NewServer(db, a.enf, a.Cache, a.settingsMgr)
+	repoService := repository.NewServer(a.RepoClientset, db, a.enf, a.Cache, a.settingsMgr)
+	repoCredsService := repocreds.NewServer(db, a.enf)
 	sessionService := session.NewServer(a.sessionMgr)
 	projectLock := util.NewKeyLock()
 	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, a.Cache, kube.KubectlCmd{}, db, a.enf, projectLock, a.settingsMgr)
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr)
 	settingsService := settings.NewServer(a.settingsMgr)
 	accountService := account.NewServer(a.sessionMgr, a.settingsMgr)
-	certificateService := certificate.NewServer(a.RepoClientset, db, a.enf, a.Cache)
+	certificateService := certificate.NewServer(a.RepoClientset, db, a.enf, a.Cache, a.settingsMgr)
+	gpgkeyService := gpgkey.NewServer(db, a.enf)
 	versionpkg.RegisterVersionServiceServer(grpcS, &version.Server{})
 	clusterpkg.RegisterClusterServiceServer(grpcS, clusterService)
 	applicationpkg.RegisterApplicationServiceServer(grpcS, applicationService)
 	repositorypkg.RegisterRepositoryServiceServer(grpcS, repoService)
+	repocredspkg.RegisterRepoCredsServiceServer(grpcS, repoCredsService)
 	sessionpkg.RegisterSessionServiceServer(grpcS, sessionService)
 	settingspkg.RegisterSettingsServiceServer(grpcS, settingsService)
 	projectpkg.RegisterProjectServiceServer(grpcS, projectService)
 	accountpkg.RegisterAccountServiceServer(grpcS, accountService)
 	certificatepkg.RegisterCertificateServiceServer(grpcS, certificateService)
+	gpgkeypkg.RegisterGPGKeyServiceServer(grpcS, gpgkeyService)
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcS)
 	grpc_prometheus.Register(grpcS)
@@ -539,10 +550,12 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	mustRegisterGWHandler(clusterpkg.RegisterClusterServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(applicationpkg.RegisterApplicationServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(repositorypkg.RegisterRepositoryServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
+	mustRegisterGWHandler(repocredspkg.RegisterRepoCredsServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(sessionpkg.RegisterSessionServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(settingspkg.RegisterSettingsServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(projectpkg.RegisterProjectServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(certificatepkg.RegisterCertificateServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
+	mustRegisterGWHandler(gpgkeypkg.RegisterGPGKeyServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 
 	// Swagger UI
 	swagger.ServeSwaggerUI(mux, assets.SwaggerJSON, "/swagger-ui")
@@ -650,10 +663,16 @@ func indexFilePath(srcPath string, baseHRef string) (string, error) {
 	return filePath, nil
 }
 
-// newAPIServerMetricsServer returns HTTP server which serves prometheus metrics on gRPC requests
-func newAPIServerMetricsServer(port int) *http.Server {
+// newAPIServerMetricsServer returns HTTP server which serves prometheus metrics on gRPC requests,
+// along with the metrics of the given collectors
+func newAPIServerMetricsServer(port int, collectors ...prometheus.Collector) *http.Server {
+	// The collectors are registered with a registry of their own, since the
+	// server is re-created after settings changes, and registering them
+	// again with the default registry would fail.
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors...)
 	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/metrics", promhttp.HandlerFor(prometheus.Gatherers{prometheus.DefaultGatherer, registry}, promhttp.HandlerOpts{}))
 	return &http.Server{
 		Addr:    fmt.Sprintf("0.0.0.0:%d", port),
 		Handler: mux,
@@ -713,6 +732,10 @@ func (a *ArgoCDServer) authenticate(ctx context.Context) (context.Context, error
 			return ctx, claimsErr
 		}
 	} else {
+		claims, err := a.impersonate(ctx, claims)
+		if err != nil {
+			return ctx, err
+		}
 		// Add claims to the context to inspect for RBAC
 		ctx = context.WithValue(ctx, "claims", claims)
 	}
@@ -720,6 +743,50 @@ func (a *ArgoCDServer) authenticate(ctx context.Context) (context.Context, error
 	return ctx, nil
 }
 
+// impersonate returns the claims of the user and groups which the request is performed on behalf of, if the request
+// carries impersonation metadata, or else the claims of the caller. The caller must be allowed to impersonate the user
+// and each of the groups. The caller is kept in the impersonator claim, so it is recorded in the audit events.
+func (a *ArgoCDServer) impersonate(ctx context.Context, claims jwt.Claims) (jwt.Claims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return claims, nil
+	}
+	var user string
+	if users := md.Get(apiclient.MetaDataImpersonateUserKey); len(users) > 0 {
+		user = users[0]
+	}
+	var groups []string
+	for _, value := range md.Get(apiclient.MetaDataImpersonateGroupsKey) {
+		for _, group := range strings.Split(value, ",") {
+			if group = strings.TrimSpace(group); group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+	if user == "" && len(groups) == 0 {
+		return claims, nil
+	}
+	if user == "" {
+		return nil, status.Error(codes.InvalidArgument, "impersonating groups requires impersonating a user")
+	}
+	if err := a.enf.EnforceErr(claims, rbacpolicy.ResourceUsers, rbacpolicy.ActionImpersonate, user); err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if err := a.enf.EnforceErr(claims, rbacpolicy.ResourceGroups, rbacpolicy.ActionImpersonate, group); err != nil {
+			return nil, err
+		}
+	}
+	impersonator := util_session.Username(context.WithValue(ctx, "claims", claims))
+	log.WithFields(log.Fields{"impersonator": impersonator, "user": user, "groups": groups}).Info("Impersonating user")
+	return jwt.MapClaims{
+		"iss":                             util_session.SessionManagerClaimsIssuer,
+		"sub":                             user,
+		"groups":                          groups,
+		util_session.ImpersonatorClaimKey: impersonator,
+	}, nil
+}
+
 func (a *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -782,6 +849,7 @@ var pathPatters = []*regexp.Regexp{
 	regexp.MustCompile(`/api/v1/repositories/[^/]+`),
 	regexp.MustCompile(`/api/v1/repositories/[^/]+/apps`),
 	regexp.MustCompile(`/api/v1/repositories/[^/]+/apps/[^/]+`),
+	regexp.MustCompile(`/api/v1/repocreds/[^/]+`),
 }
 
 func (bf *bug21955Workaround) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -824,6 +892,18 @@ func bug21955WorkaroundInterceptor(ctx context.Context, req interface{}, _ *grpc
 			return nil, err
 		}
 		ru.Repo.Repo = repo
+	} else if rcq, ok := req.(*repocredspkg.RepoCredsDeleteRequest); ok {
+		credsURL, err := url.QueryUnescape(rcq.Url)
+		if err != nil {
+			return nil, err
+		}
+		rcq.Url = credsURL
+	} else if rcu, ok := req.(*repocredspkg.RepoCredsUpdateRequest); ok && rcu.Creds != nil {
+		credsURL, err := url.QueryUnescape(rcu.Creds.URL)
+		if err != nil {
+			return nil, err
+		}
+		rcu.Creds.URL = credsURL
 	} else if cq, ok := req.(*clusterpkg.ClusterQuery); ok {
 		server, err := url.QueryUnescape(cq.Server)
 		if err != nil {
@@ -8,7 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/dgrijalva/jwt-go"
 	log "github.com/sirupsen/logrus"
@@ -25,6 +27,7 @@ import (
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util/assets"
 	"github.com/argoproj/argo-cd/util/rbac"
+	util_session "github.com/argoproj/argo-cd/util/session"
 )
 
 func fakeServer() *ArgoCDServer {
@@ -461,3 +464,46 @@ func TestAuthenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestImpersonate(t *testing.T) {
+	cm := test.NewFakeConfigMap()
+	kubeclientset := fake.NewSimpleClientset(cm, test.NewFakeSecret())
+	argocd := NewServer(context.Background(), ArgoCDServerOpts{
+		Namespace:     test.FakeArgoCDNamespace,
+		KubeClientset: kubeclientset,
+		AppClientset:  apps.NewSimpleClientset(),
+	})
+	_ = argocd.enf.SetUserPolicy(`
+p, role:ops, users, impersonate, team-a-*, allow
+p, role:ops, groups, impersonate, team-a, allow
+g, operator, role:ops
+`)
+	newContext := func(sub string, pairs ...string) context.Context {
+		token, err := argocd.sessionMgr.Create(sub, 0)
+		assert.NoError(t, err)
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(append([]string{apiclient.MetaDataTokenKey, token}, pairs...)...))
+	}
+
+	ctx, err := argocd.authenticate(newContext("operator", apiclient.MetaDataImpersonateUserKey, "team-a-dev", apiclient.MetaDataImpersonateGroupsKey, "team-a"))
+	assert.NoError(t, err)
+	assert.Equal(t, "team-a-dev", util_session.Username(ctx))
+	assert.Equal(t, "team-a-dev (impersonated by operator)", util_session.AuditUsername(ctx))
+	claims := ctx.Value("claims").(jwt.MapClaims)
+	assert.Equal(t, []string{"team-a"}, claims["groups"])
+
+	_, err = argocd.authenticate(newContext("operator", apiclient.MetaDataImpersonateUserKey, "team-b-dev"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = argocd.authenticate(newContext("operator", apiclient.MetaDataImpersonateUserKey, "team-a-dev", apiclient.MetaDataImpersonateGroupsKey, "team-a,admins"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = argocd.authenticate(newContext("operator", apiclient.MetaDataImpersonateGroupsKey, "team-a"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = argocd.authenticate(newContext("nobody", apiclient.MetaDataImpersonateUserKey, "team-a-dev"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx, err = argocd.authenticate(newContext("operator"))
+	assert.NoError(t, err)
+	assert.Equal(t, "operator", util_session.AuditUsername(ctx))
+}
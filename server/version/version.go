@@ -2,11 +2,14 @@ package version
 
 import (
 	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apiclient/version"
+	"github.com/argoproj/argo-cd/util/helm"
 	ksutil "github.com/argoproj/argo-cd/util/ksonnet"
+	"github.com/argoproj/argo-cd/util/kustomize"
 )
 
 type Server struct{}
@@ -18,16 +21,27 @@ func (s *Server) Version(context.Context, *empty.Empty) (*version.VersionMessage
 	if err != nil {
 		return nil, err
 	}
+	// The versions of the other tools are informational, so they don't fail the request
+	helmVersion, err := helm.Version()
+	if err != nil {
+		log.Warn(err)
+	}
+	kustomizeVersion, err := kustomize.Version()
+	if err != nil {
+		log.Warn(err)
+	}
 	return &version.VersionMessage{
-		Version:        vers.Version,
-		BuildDate:      vers.BuildDate,
-		GitCommit:      vers.GitCommit,
-		GitTag:         vers.GitTag,
-		GitTreeState:   vers.GitTreeState,
-		GoVersion:      vers.GoVersion,
-		Compiler:       vers.Compiler,
-		Platform:       vers.Platform,
-		KsonnetVersion: ksonnetVersion,
+		Version:          vers.Version,
+		BuildDate:        vers.BuildDate,
+		GitCommit:        vers.GitCommit,
+		GitTag:           vers.GitTag,
+		GitTreeState:     vers.GitTreeState,
+		GoVersion:        vers.GoVersion,
+		Compiler:         vers.Compiler,
+		Platform:         vers.Platform,
+		KsonnetVersion:   ksonnetVersion,
+		HelmVersion:      helmVersion,
+		KustomizeVersion: kustomizeVersion,
 	}, nil
 }
 
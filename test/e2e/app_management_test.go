@@ -560,6 +560,26 @@ func TestSyncAsync(t *testing.T) {
 		Expect(SyncStatusIs(SyncStatusCodeSynced))
 }
 
+func TestExpectSynced(t *testing.T) {
+	var start time.Time
+	Given(t).
+		Path(guestbookPath).
+		When().
+		Create().
+		Sync().
+		And(func() {
+			start = time.Now()
+		}).
+		ExpectSynced().
+		And(func() {
+			// the app is synced already, so we must return without waiting for
+			// the next poll, which would take another 3 seconds
+			assert.True(t, time.Since(start) < 3*time.Second)
+		}).
+		Then().
+		Expect(SyncStatusIs(SyncStatusCodeSynced))
+}
+
 func TestPermissions(t *testing.T) {
 	fixture.EnsureCleanState(t)
 	appName := fixture.Name()
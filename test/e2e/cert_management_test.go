@@ -0,0 +1,44 @@
+package e2e
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/argoproj/argo-cd/errors"
+	"github.com/argoproj/argo-cd/test/e2e/fixture"
+	. "github.com/argoproj/argo-cd/test/e2e/fixture/app"
+	"github.com/argoproj/argo-cd/test/e2e/fixture/certs"
+)
+
+const declarativeCertServerName = "declarative.example.com"
+
+// make sure a cert pinned in the TLS certs config map is listed by the CLI, and
+// that it is pinned again once the config map is reconciled after removing it
+// using the CLI
+func TestDeclarativeTLSCertPin(t *testing.T) {
+	caCert, err := ioutil.ReadFile("../fixture/certs/argocd-test-ca.crt")
+	CheckError(err)
+	pins := map[string]string{declarativeCertServerName: string(caCert)}
+
+	Given(t).
+		When().
+		PatchTLSCertsConfigMap(pins).
+		And(func() {
+			serverNames, err := certs.ListedServerNames("https")
+			assert.NoError(t, err)
+			assert.Contains(t, serverNames, declarativeCertServerName)
+
+			FailOnErr(fixture.RunCli("cert", "rm", declarativeCertServerName, "--cert-type", "https"))
+			serverNames, err = certs.ListedServerNames("https")
+			assert.NoError(t, err)
+			assert.NotContains(t, serverNames, declarativeCertServerName)
+		}).
+		PatchTLSCertsConfigMap(pins).
+		And(func() {
+			serverNames, err := certs.ListedServerNames("https")
+			assert.NoError(t, err)
+			assert.Contains(t, serverNames, declarativeCertServerName)
+		})
+}
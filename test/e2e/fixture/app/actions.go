@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,6 +11,7 @@ import (
 	"github.com/argoproj/argo-cd/errors"
 	. "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/test/e2e/fixture"
+	"github.com/argoproj/argo-cd/test/e2e/fixture/certs"
 	"github.com/argoproj/argo-cd/util/json"
 )
 
@@ -176,6 +178,43 @@ func (a *Actions) Sync() *Actions {
 	return a
 }
 
+// ExpectSynced waits until the app is synced, failing the test on timeout. It
+// is a shortcut for Then().Expect(SyncStatusIs(SyncStatusCodeSynced)) which
+// allows to carry on with further actions.
+func (a *Actions) ExpectSynced() *Actions {
+	a.context.t.Helper()
+	a.Then().Expect(SyncStatusIs(SyncStatusCodeSynced))
+	return a
+}
+
+// PatchTLSCertsConfigMap declaratively pins the given server name to PEM data
+// entries in the TLS certs config map, and waits until they are reconciled.
+func (a *Actions) PatchTLSCertsConfigMap(pins map[string]string) *Actions {
+	a.context.t.Helper()
+	fixture.PatchTLSCerts(pins)
+	serverNames := make([]string, 0, len(pins))
+	for serverName := range pins {
+		serverNames = append(serverNames, serverName)
+	}
+	certs.WaitForCertificates("https", serverNames...)
+	return a
+}
+
+// PatchSSHKnownHostsConfigMap declaratively pins the given known hosts lines in
+// the SSH known hosts config map, and waits until they are reconciled.
+func (a *Actions) PatchSSHKnownHostsConfigMap(entries ...string) *Actions {
+	a.context.t.Helper()
+	fixture.PatchSSHKnownHosts(entries...)
+	serverNames := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if fields := strings.Fields(entry); len(fields) > 0 {
+			serverNames = append(serverNames, fields[0])
+		}
+	}
+	certs.WaitForCertificates("ssh", serverNames...)
+	return a
+}
+
 func (a *Actions) TerminateOp() *Actions {
 	a.runCli("app", "terminate-op", a.context.name)
 	return a
@@ -1,12 +1,18 @@
 package certs
 
 import (
+	"encoding/json"
+	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/argoproj/argo-cd/errors"
 	"github.com/argoproj/argo-cd/test/e2e/fixture"
 )
 
+// How long to wait for the API server to pick up changes to the cert config maps
+const reconcileTimeout = 30 * time.Second
+
 func AddCustomCACert() {
 	caCertPath, err := filepath.Abs("../fixture/certs/argocd-test-ca.crt")
 	errors.CheckError(err)
@@ -15,3 +21,53 @@ func AddCustomCACert() {
 	args = []string{"cert", "add-tls", "127.0.0.1", "--from", caCertPath}
 	errors.FailOnErr(fixture.RunCli(args...))
 }
+
+// ListedServerNames returns the server names of all certificates of certType
+// listed by `argocd cert list`
+func ListedServerNames(certType string) ([]string, error) {
+	output, err := fixture.RunCli("cert", "list", "--cert-type", certType, "-o", "json")
+	if err != nil {
+		return nil, err
+	}
+	var certificates []struct {
+		ServerName string `json:"servername"`
+	}
+	if err := json.Unmarshal([]byte(output), &certificates); err != nil {
+		return nil, err
+	}
+	serverNames := make([]string, 0, len(certificates))
+	for _, cert := range certificates {
+		serverNames = append(serverNames, cert.ServerName)
+	}
+	return serverNames, nil
+}
+
+// WaitForCertificates waits until certificates of certType are listed for all
+// of the given server names, i.e. until a change to the cert config maps has
+// been reconciled.
+func WaitForCertificates(certType string, serverNames ...string) {
+	var missing []string
+	for start := time.Now(); time.Since(start) < reconcileTimeout; time.Sleep(time.Second) {
+		listed, err := ListedServerNames(certType)
+		errors.CheckError(err)
+		missing = missingServerNames(listed, serverNames)
+		if len(missing) == 0 {
+			return
+		}
+	}
+	errors.CheckError(fmt.Errorf("timeout waiting for %s certificates of %v to be listed", certType, missing))
+}
+
+func missingServerNames(listed []string, serverNames []string) []string {
+	found := make(map[string]bool)
+	for _, name := range listed {
+		found[name] = true
+	}
+	var missing []string
+	for _, name := range serverNames {
+		if !found[name] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -280,6 +280,32 @@ func SetSSHKnownHosts() {
 	})
 }
 
+// PatchTLSCerts merges the given server name to PEM data entries into the TLS
+// certs config map, leaving all other entries in place
+func PatchTLSCerts(certs map[string]string) {
+	updateTLSCertsConfigMap(func(cm *corev1.ConfigMap) error {
+		for serverName, data := range certs {
+			cm.Data[serverName] = data
+		}
+		return nil
+	})
+}
+
+// PatchSSHKnownHosts appends the given known hosts lines to the SSH known hosts
+// config map, unless they are already present
+func PatchSSHKnownHosts(entries ...string) {
+	updateSSHKnownHostsConfigMap(func(cm *corev1.ConfigMap) error {
+		knownHosts := cm.Data["ssh_known_hosts"]
+		for _, entry := range entries {
+			if !strings.Contains(knownHosts, entry) {
+				knownHosts = strings.TrimSuffix(knownHosts, "\n") + "\n" + entry + "\n"
+			}
+		}
+		cm.Data["ssh_known_hosts"] = strings.TrimPrefix(knownHosts, "\n")
+		return nil
+	})
+}
+
 func SetHelmRepoCredential(creds settings.HelmRepoCredentials) {
 	updateSettingConfigMap(func(cm *corev1.ConfigMap) error {
 		yamlBytes, err := yaml.Marshal(creds)
@@ -37,6 +37,12 @@ export interface RollbackOperation {
 export interface Operation {
     sync: SyncOperation;
     rollback: RollbackOperation;
+    initiatedBy?: OperationInitiator;
+}
+
+export interface OperationInitiator {
+    username?: string;
+    automated?: boolean;
 }
 
 export type OperationPhase = 'Running' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating';
@@ -73,6 +79,7 @@ export interface RevisionMetadata {
 export interface SyncOperationResult {
     resources: ResourceResult[];
     revision: string;
+    revisions?: string[];
 }
 
 export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped';
@@ -150,17 +157,54 @@ export interface ApplicationSource {
     ksonnet?: ApplicationSourceKsonnet;
 
     directory?: ApplicationSourceDirectory;
+
+    /**
+     * Ref is the name of a source of an application with multiple sources, which only provides value files to its other sources.
+     */
+    ref?: string;
 }
 
 export interface ApplicationSourceHelm {
     valueFiles: string[];
     parameters: HelmParameter[];
+    valueFilesRepos?: HelmValueFilesRepo[];
+    values?: string;
+    fileParameters?: HelmFileParameter[];
+    version?: string;
+}
+
+export interface HelmValueFilesRepo {
+    ref: string;
+    repoURL: string;
+    targetRevision?: string;
 }
 
 export interface ApplicationSourceKustomize {
     namePrefix: string;
     imageTags: KustomizeImageTag[];
     images: string[];
+    components?: string[];
+    patches?: KustomizePatch[];
+    replicas?: KustomizeReplica[];
+    version?: string;
+}
+
+export interface KustomizePatch {
+    patch: string;
+    target?: {
+        group?: string;
+        version?: string;
+        kind?: string;
+        name?: string;
+        namespace?: string;
+        labelSelector?: string;
+        annotationSelector?: string;
+    };
+}
+
+export interface KustomizeReplica {
+    name: string;
+    count: number;
 }
 
 export interface ApplicationSourceKsonnet {
@@ -170,6 +214,9 @@ export interface ApplicationSourceKsonnet {
 
 export interface ApplicationSourceDirectory {
     recurse: boolean;
+    include?: string[];
+    exclude?: string[];
+    substitute?: boolean;
 }
 
 export interface SyncPolicy {
@@ -184,6 +231,10 @@ export interface Info {
 export interface ApplicationSpec {
     project: string;
     source: ApplicationSource;
+    /**
+     * Sources are used instead of the source of applications with multiple sources.
+     */
+    sources?: ApplicationSource[];
     destination: ApplicationDestination;
     syncPolicy?: SyncPolicy;
     info?: Info[];
@@ -195,8 +246,15 @@ export interface ApplicationSpec {
 export interface RevisionHistory {
     id: number;
     revision: string;
+    revisions?: string[];
     source: ApplicationSource;
+    sources?: ApplicationSource[];
     deployedAt: models.Time;
+    rollback?: boolean;
+    initiatedBy?: OperationInitiator;
+    syncOptions?: string[];
+    phase?: OperationPhase;
+    message?: string;
 }
 
 export type SyncStatusCode = 'Unknown' | 'Synced' | 'OutOfSync';
@@ -285,6 +343,7 @@ export interface SyncStatus {
     comparedTo: ApplicationSource;
     status: SyncStatusCode;
     revision: string;
+    revisions?: string[];
 }
 
 export interface ApplicationCondition {
@@ -366,6 +425,8 @@ export interface Cluster {
     name: string;
     server: string;
     connectionState: ConnectionState;
+    shard?: number;
+    namespaces?: string[];
 }
 
 export interface ClusterList extends ItemsList<Cluster> { }
@@ -408,6 +469,12 @@ export interface AppInfo {
 export interface HelmParameter {
     name: string;
     value: string;
+    forceString?: boolean;
+}
+
+export interface HelmFileParameter {
+    name: string;
+    path: string;
 }
 
 export interface HelmAppSpec {
@@ -415,6 +482,7 @@ export interface HelmAppSpec {
     path: string;
     valueFiles: string[];
     parameters: HelmParameter[];
+    version?: string;
 }
 
 export interface KustomizeImageTag {
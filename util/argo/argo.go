@@ -13,12 +13,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -30,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/util/cert"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/kustomize"
@@ -160,12 +163,87 @@ func GetRepoCreds(repo *argoappv1.Repository) git.Creds {
 	return git.NopCreds{}
 }
 
+// GetHelmCreds returns the credentials for accessing a Helm chart repository
+func GetHelmCreds(repo *argoappv1.Repository) helm.Creds {
+	if repo == nil {
+		return helm.Creds{}
+	}
+	return helm.Creds{
+		Username:           repo.Username,
+		Password:           repo.Password,
+		CertData:           []byte(repo.TLSClientCertData),
+		KeyData:            []byte(repo.TLSClientCertKey),
+		InsecureSkipVerify: repo.IsInsecure(),
+		Proxy:              repo.Proxy,
+	}
+}
+
+// TestRepo tests access to a repository, either a Git repository, a Helm chart repository or an OCI registry of
+// Helm charts
+func TestRepo(repo *argoappv1.Repository) error {
+	if repo.IsHelm() && helm.IsOCIURL(repo.Repo) {
+		return helm.NewOCIClient(repo.Repo, GetHelmCreds(repo)).TestAccess()
+	}
+	if repo.IsHelm() {
+		_, err := helm.NewClient(repo.Repo, GetHelmCreds(repo)).GetIndex()
+		return err
+	}
+	return git.TestRepo(repo.Repo, GetRepoCreds(repo), repo.IsInsecure(), repo.EnableLFS, repo.Proxy)
+}
+
 // ValidateRepo validates the repository specified in application spec. Following is checked:
 // * the git repository is accessible
 // * the git path contains valid manifests
 // * there are parameters of only one app source type
 // * ksonnet: the specified environment exists
-func ValidateRepo(ctx context.Context, spec *argoappv1.ApplicationSpec, repoClientset apiclient.Clientset, db db.ArgoDB) ([]argoappv1.ApplicationCondition, argoappv1.ApplicationSourceType, error) {
+// * helm chart: the chart and a version matching the target revision exist in the Helm chart repository
+// * the environment variables sourced from secrets can be resolved
+// Each source of an application with multiple sources is validated, and the source type of its first source
+// which is not a ref is returned. Ref sources are only checked to be accessible.
+func ValidateRepo(ctx context.Context, spec *argoappv1.ApplicationSpec, repoClientset apiclient.Clientset, db db.ArgoDB, kubeclientset kubernetes.Interface, namespace string) ([]argoappv1.ApplicationCondition, argoappv1.ApplicationSourceType, error) {
+	if !spec.HasMultipleSources() {
+		return validateSourceRepo(ctx, spec, repoClientset, db, kubeclientset, namespace)
+	}
+	conditions := make([]argoappv1.ApplicationCondition, 0)
+	var appSourceType argoappv1.ApplicationSourceType
+	for i, source := range spec.Sources {
+		var sourceConditions []argoappv1.ApplicationCondition
+		if source.IsRef() {
+			repoRes, err := db.GetRepository(ctx, source.RepoURL)
+			if err != nil {
+				return nil, "", err
+			}
+			if err := TestRepo(repoRes); err != nil {
+				sourceConditions = append(sourceConditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("repository not accessible: %v", err),
+				})
+			}
+		} else {
+			// the source is validated as the source of an application with only this source
+			sourceSpec := spec.DeepCopy()
+			sourceSpec.Source = source
+			sourceSpec.Sources = nil
+			var sourceType argoappv1.ApplicationSourceType
+			var err error
+			sourceConditions, sourceType, err = validateSourceRepo(ctx, sourceSpec, repoClientset, db, kubeclientset, namespace)
+			if err != nil {
+				return nil, "", err
+			}
+			spec.Destination = sourceSpec.Destination
+			if appSourceType == "" {
+				appSourceType = sourceType
+			}
+		}
+		for _, condition := range sourceConditions {
+			condition.Message = fmt.Sprintf("spec.sources[%d]: %s", i, condition.Message)
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions, appSourceType, nil
+}
+
+func validateSourceRepo(ctx context.Context, spec *argoappv1.ApplicationSpec, repoClientset apiclient.Clientset, db db.ArgoDB, kubeclientset kubernetes.Interface, namespace string) ([]argoappv1.ApplicationCondition, argoappv1.ApplicationSourceType, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
 
 	// Test the repo
@@ -180,7 +258,19 @@ func ValidateRepo(ctx context.Context, spec *argoappv1.ApplicationSpec, repoClie
 		return nil, "", err
 	}
 
-	err = git.TestRepo(repoRes.Repo, GetRepoCreds(repoRes), repoRes.IsInsecure(), repoRes.EnableLFS)
+	envFromSecrets, err := GetEnvFromSecrets(kubeclientset, namespace, spec.GetProject(), &spec.Source)
+	if err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("Unable to resolve environment variables from secrets: %v", err),
+		})
+	}
+
+	if spec.Source.IsHelmChart() {
+		return append(conditions, verifyHelmRepoChart(repoRes, spec)...), argoappv1.ApplicationSourceTypeHelm, nil
+	}
+
+	err = TestRepo(repoRes)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
@@ -227,8 +317,13 @@ func ValidateRepo(ctx context.Context, spec *argoappv1.ApplicationSpec, repoClie
 				if len(helmConditions) > 0 {
 					conditions = append(conditions, helmConditions...)
 				}
-			case argoappv1.ApplicationSourceTypeDirectory, argoappv1.ApplicationSourceTypeKustomize:
-				mainDirConditions := verifyGenerateManifests(ctx, repoRes, []*argoappv1.HelmRepository{}, spec, repoClient)
+			case argoappv1.ApplicationSourceTypeDirectory, argoappv1.ApplicationSourceTypeKustomize, argoappv1.ApplicationSourceTypePlugin:
+				enableSubmodules, submoduleCreds, err := db.GetSubmoduleCredentials(ctx, repoRes)
+				if err != nil {
+					return nil, "", err
+				}
+				repoRes.EnableSubmodules = enableSubmodules
+				mainDirConditions := verifyGenerateManifests(ctx, repoRes, []*argoappv1.HelmRepository{}, submoduleCreds, envFromSecrets, spec, repoClient)
 				if len(mainDirConditions) > 0 {
 					conditions = append(conditions, mainDirConditions...)
 				}
@@ -241,21 +336,43 @@ func ValidateRepo(ctx context.Context, spec *argoappv1.ApplicationSpec, repoClie
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
-	if spec.Source.RepoURL == "" || spec.Source.Path == "" {
+	if spec.HasMultipleSources() {
+		if sourcesConditions := validateSources(spec); len(sourcesConditions) > 0 {
+			return append(conditions, sourcesConditions...), nil
+		}
+	} else if spec.Source.RepoURL == "" || (spec.Source.Path == "" && spec.Source.Chart == "") {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "spec.source.repoURL and spec.source.path are required",
+			Message: "spec.source.repoURL and either spec.source.path or spec.source.chart are required",
 		})
 		return conditions, nil
-	}
-
-	if !proj.IsSourcePermitted(spec.Source) {
+	} else if spec.Source.Path != "" && spec.Source.Chart != "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.Source.RepoURL, spec.Project),
+			Message: "only one of spec.source.path and spec.source.chart can be specified",
 		})
+		return conditions, nil
+	}
+
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Retry != nil {
+		if err := spec.SyncPolicy.Retry.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid sync retry strategy: %v", err),
+			})
+		}
 	}
 
+	for _, source := range spec.GetSources() {
+		if !proj.IsSourcePermitted(source) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, spec.Project),
+			})
+		}
+	}
+	conditions = append(conditions, validateValueFileRefs(spec, proj)...)
+
 	if spec.Destination.Server != "" && spec.Destination.Namespace != "" {
 		if !proj.IsDestinationPermitted(spec.Destination) {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -264,7 +381,7 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			})
 		}
 		// Ensure the k8s cluster the app is referencing, is configured in Argo CD
-		_, err := db.GetCluster(ctx, spec.Destination.Server)
+		cluster, err := db.GetCluster(ctx, spec.Destination.Server)
 		if err != nil {
 			if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.NotFound {
 				conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -274,6 +391,11 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			} else {
 				return nil, err
 			}
+		} else if !cluster.IsNamespaceAllowed(spec.Destination.Namespace) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("namespace '%s' is not accessible by the credentials of cluster '%s', which are restricted to the namespaces: %s", spec.Destination.Namespace, spec.Destination.Server, strings.Join(cluster.Namespaces, ", ")),
+			})
 		}
 	} else {
 		conditions = append(conditions, argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: errDestinationMissing})
@@ -281,11 +403,207 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	return conditions, nil
 }
 
+// validateSources validates the sources of an application with multiple sources
+func validateSources(spec *argoappv1.ApplicationSpec) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	invalid := func(format string, args ...interface{}) {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	if !spec.Source.IsZero() {
+		invalid("only one of spec.source and spec.sources can be specified")
+	}
+	refs := make(map[string]bool)
+	for i, source := range spec.Sources {
+		switch {
+		case source.RepoURL == "":
+			invalid("spec.sources[%d].repoURL is required", i)
+		case source.IsRef() && strings.ContainsAny(source.Ref, "$/"):
+			invalid("spec.sources[%d].ref '%s' must not contain '$' or '/'", i, source.Ref)
+		case source.IsRef() && refs[source.Ref]:
+			invalid("spec.sources[%d].ref '%s' is not unique", i, source.Ref)
+		case source.IsRef() && (source.Path != "" || source.Chart != ""):
+			invalid("spec.sources[%d] has a ref, and cannot specify a path or chart", i)
+		case !source.IsRef() && source.Path == "" && source.Chart == "":
+			invalid("either spec.sources[%d].path or spec.sources[%d].chart is required", i, i)
+		case source.Path != "" && source.Chart != "":
+			invalid("only one of spec.sources[%d].path and spec.sources[%d].chart can be specified", i, i)
+		}
+		if source.IsRef() {
+			refs[source.Ref] = true
+		}
+	}
+	return conditions
+}
+
+// validateValueFileRefs validates the value files repos of the Helm sources of an application, and that the value
+// files which refer to the files of a ref source or a value files repo, e.g. $values/prod.yaml, refer to a known one
+func validateValueFileRefs(spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	invalid := func(format string, args ...interface{}) {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	sourceRefs := make(map[string]bool)
+	for _, source := range spec.GetSources() {
+		if source.IsRef() {
+			sourceRefs[source.Ref] = true
+		}
+	}
+	for _, source := range spec.GetSources() {
+		if source.Helm == nil {
+			continue
+		}
+		refs := make(map[string]bool)
+		for ref := range sourceRefs {
+			refs[ref] = true
+		}
+		for _, valuesRepo := range source.Helm.ValueFilesRepos {
+			switch {
+			case valuesRepo.Ref == "" || valuesRepo.RepoURL == "":
+				invalid("value files repos of the source %s require a ref and a repoURL", source.RepoURL)
+			case strings.ContainsAny(valuesRepo.Ref, "$/"):
+				invalid("ref '%s' of the value files repo %s must not contain '$' or '/'", valuesRepo.Ref, valuesRepo.RepoURL)
+			case refs[valuesRepo.Ref]:
+				invalid("ref '%s' of the value files repo %s is not unique", valuesRepo.Ref, valuesRepo.RepoURL)
+			case !proj.IsSourcePermitted(argoappv1.ApplicationSource{RepoURL: valuesRepo.RepoURL}):
+				invalid("value files repo %s is not permitted in project '%s'", valuesRepo.RepoURL, spec.Project)
+			}
+			refs[valuesRepo.Ref] = true
+		}
+		for _, valueFile := range source.Helm.ValueFiles {
+			if !strings.HasPrefix(valueFile, "$") {
+				continue
+			}
+			ref := strings.SplitN(strings.TrimPrefix(valueFile, "$"), "/", 2)[0]
+			if !refs[ref] {
+				invalid("value file %s of the source %s refers to unknown source '$%s'", valueFile, source.RepoURL, ref)
+			}
+		}
+	}
+	return conditions
+}
+
+// GetRefSources returns the ref sources the value files of a source may refer to, which are the given ref sources of an
+// application with multiple sources and the value files repos of the Helm options of the source
+func GetRefSources(ctx context.Context, source *argoappv1.ApplicationSource, refSources map[string]*apiclient.RefTarget, db db.ArgoDB) (map[string]*apiclient.RefTarget, error) {
+	if source.Helm == nil || len(source.Helm.ValueFilesRepos) == 0 {
+		return refSources, nil
+	}
+	result := make(map[string]*apiclient.RefTarget)
+	for ref, target := range refSources {
+		result[ref] = target
+	}
+	for _, valuesRepo := range source.Helm.ValueFilesRepos {
+		if _, ok := result[valuesRepo.Ref]; ok {
+			return nil, fmt.Errorf("Ref '%s' of the value files repo %s is not unique", valuesRepo.Ref, valuesRepo.RepoURL)
+		}
+		repo, err := db.GetRepository(ctx, valuesRepo.RepoURL)
+		if err != nil {
+			return nil, err
+		}
+		result[valuesRepo.Ref] = &apiclient.RefTarget{Repo: repo, Revision: util.FirstNonEmpty(valuesRepo.TargetRevision, "HEAD")}
+	}
+	return result, nil
+}
+
+// GetEnvFromSecrets returns the values of the environment variables of the plugin, Helm and Kustomize options of a
+// source which are sourced from secrets, by the names of the variables. The secrets must be in the given namespace, have
+// the label argocd.argoproj.io/secret-type=env, and list the project of the application in their
+// argocd.argoproj.io/env-projects annotation.
+func GetEnvFromSecrets(kubeclientset kubernetes.Interface, namespace string, project string, source *argoappv1.ApplicationSource) (map[string]string, error) {
+	var envs []argoappv1.Env
+	if source.Plugin != nil {
+		envs = append(envs, source.Plugin.Env)
+	}
+	if source.Helm != nil {
+		envs = append(envs, source.Helm.Env)
+	}
+	if source.Kustomize != nil {
+		envs = append(envs, source.Kustomize.Env)
+	}
+	refs := make(map[string]*argoappv1.SecretKeySelector)
+	for _, env := range envs {
+		for name, ref := range env.SecretRefs() {
+			if other, ok := refs[name]; ok && *other != *ref {
+				return nil, fmt.Errorf("Environment variable %s refers to different secret keys", name)
+			}
+			refs[name] = ref
+		}
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	secrets := make(map[string]*v1.Secret)
+	values := make(map[string]string)
+	for name, ref := range refs {
+		secret, ok := secrets[ref.Name]
+		if !ok {
+			var err error
+			secret, err = kubeclientset.CoreV1().Secrets(namespace).Get(ref.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			if secret.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeEnv {
+				return nil, fmt.Errorf("Secret %s of environment variable %s does not have the label %s=%s", ref.Name, name, common.LabelKeySecretType, common.LabelValueSecretTypeEnv)
+			}
+			if !envSecretAllowsProject(secret, project) {
+				return nil, fmt.Errorf("Secret %s of environment variable %s does not allow project %s in its annotation %s", ref.Name, name, project, common.AnnotationKeyEnvProjects)
+			}
+			secrets[ref.Name] = secret
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("Secret %s of environment variable %s has no key %s", ref.Name, name, ref.Key)
+		}
+		values[name] = string(value)
+	}
+	return values, nil
+}
+
+// envSecretAllowsProject returns whether the env-projects annotation of a secret lists the given project
+func envSecretAllowsProject(secret *v1.Secret, project string) bool {
+	for _, allowed := range strings.Split(secret.Annotations[common.AnnotationKeyEnvProjects], ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" || allowed == project {
+			return true
+		}
+	}
+	return false
+}
+
 // GetAppProject returns a project from an application
 func GetAppProject(spec *argoappv1.ApplicationSpec, projLister applicationsv1.AppProjectLister, ns string) (*argoappv1.AppProject, error) {
 	return projLister.AppProjects(ns).Get(spec.GetProject())
 }
 
+// GetSignatureKeys returns whether a project requires the signature of revisions to be verified, and
+// the ASCII armored data of the configured GnuPG public keys the project trusts
+func GetSignatureKeys(ctx context.Context, proj *argoappv1.AppProject, db db.ArgoDB) (bool, []string, error) {
+	if len(proj.Spec.SignatureKeys) == 0 {
+		return false, nil, nil
+	}
+	keys, err := db.ListGPGPublicKeys(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	trusted := make(map[string]bool)
+	for _, keyID := range proj.Spec.SignatureKeyIDs() {
+		trusted[keyID] = true
+	}
+	signatureKeys := make([]string, 0)
+	for _, key := range keys.Items {
+		if trusted[key.KeyID] {
+			signatureKeys = append(signatureKeys, key.KeyData)
+		}
+	}
+	return true, signatureKeys, nil
+}
+
 func queryAppSourceType(ctx context.Context, spec *argoappv1.ApplicationSpec, repoRes *argoappv1.Repository, repoClient apiclient.RepoServerServiceClient) (argoappv1.ApplicationSourceType, error) {
 	req := apiclient.ListDirRequest{
 		Repo: &argoappv1.Repository{
@@ -386,8 +704,41 @@ func verifyHelmChart(ctx context.Context, repoRes *argoappv1.Repository, spec *a
 }
 
 // verifyGenerateManifests verifies a repo path can generate manifests
+// verifyHelmRepoChart verifies that the Helm chart repository is accessible and contains a version of the
+// chart which matches the target revision. For OCI registries the target revision is the tag or digest of the chart.
+func verifyHelmRepoChart(repoRes *argoappv1.Repository, spec *argoappv1.ApplicationSpec) []argoappv1.ApplicationCondition {
+	if helm.IsOCIURL(repoRes.Repo) {
+		_, err := helm.NewOCIClient(repoRes.Repo, GetHelmCreds(repoRes)).ResolveDigest(spec.Source.Chart, spec.Source.TargetRevision)
+		if err != nil {
+			return []argoappv1.ApplicationCondition{{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("Unable to resolve chart: %v", err),
+			}}
+		}
+		return nil
+	}
+	index, err := helm.NewClient(repoRes.Repo, GetHelmCreds(repoRes)).GetIndex()
+	if err != nil {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("repository not accessible: %v", err),
+		}}
+	}
+	entries, err := index.GetEntries(spec.Source.Chart)
+	if err == nil {
+		_, err = entries.Resolve(spec.Source.TargetRevision)
+	}
+	if err != nil {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("Unable to resolve chart: %v", err),
+		}}
+	}
+	return nil
+}
+
 func verifyGenerateManifests(
-	ctx context.Context, repoRes *argoappv1.Repository, helmRepos []*argoappv1.HelmRepository, spec *argoappv1.ApplicationSpec, repoClient apiclient.RepoServerServiceClient) []argoappv1.ApplicationCondition {
+	ctx context.Context, repoRes *argoappv1.Repository, helmRepos []*argoappv1.HelmRepository, submoduleCreds []*argoappv1.RepoCreds, envFromSecrets map[string]string, spec *argoappv1.ApplicationSpec, repoClient apiclient.RepoServerServiceClient) []argoappv1.ApplicationCondition {
 
 	var conditions []argoappv1.ApplicationCondition
 	if spec.Destination.Server == "" || spec.Destination.Namespace == "" {
@@ -403,9 +754,13 @@ func verifyGenerateManifests(
 		HelmRepos:         helmRepos,
 		Revision:          spec.Source.TargetRevision,
 		Namespace:         spec.Destination.Namespace,
+		DestServer:        spec.Destination.Server,
 		ApplicationSource: &spec.Source,
+		SubmoduleCreds:    submoduleCreds,
+		EnvFromSecrets:    envFromSecrets,
 	}
 	req.Repo.CopyCredentialsFrom(repoRes)
+	req.Repo.EnableSubmodules = repoRes.EnableSubmodules
 
 	// Only check whether we can access the application's path,
 	// and not whether it actually contains any manifests.
@@ -447,13 +802,23 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 }
 
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
+// A resource is contained if it matches any of the sync operation resources which are not exclusions, or, if all
+// of them are exclusions, if it matches none of them.
 func ContainsSyncResource(name string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
+	included := false
+	onlyExclusions := len(rr) > 0
 	for _, r := range rr {
+		if !r.Exclude {
+			onlyExclusions = false
+		}
 		if r.HasIdentity(name, gvk) {
-			return true
+			if r.Exclude {
+				return false
+			}
+			included = true
 		}
 	}
-	return false
+	return included || onlyExclusions
 }
 
 // NormalizeApplicationSpec will normalize an application spec to a preferred state. This is used
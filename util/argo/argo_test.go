@@ -2,21 +2,33 @@ package argo
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
 	testcore "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-cd/common"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/pkg/client/informers/externalversions/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/reposerver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 func TestRefreshApp(t *testing.T) {
@@ -96,6 +108,11 @@ func TestContainsSyncResource(t *testing.T) {
 		{&blankUnstructured, []argoappv1.SyncOperationResource{}, false},
 		{&blankUnstructured, []argoappv1.SyncOperationResource{blankResource}, true},
 		{&blankUnstructured, []argoappv1.SyncOperationResource{helloResource}, false},
+		{&blankUnstructured, []argoappv1.SyncOperationResource{{Name: "*"}}, true},
+		{&blankUnstructured, []argoappv1.SyncOperationResource{{Name: "hello", Exclude: true}}, true},
+		{&blankUnstructured, []argoappv1.SyncOperationResource{{Name: "*", Exclude: true}}, false},
+		{&blankUnstructured, []argoappv1.SyncOperationResource{blankResource, {Name: "*", Exclude: true}}, false},
+		{&blankUnstructured, []argoappv1.SyncOperationResource{helloResource, {Name: "hello", Exclude: true}}, false},
 	}
 
 	for _, table := range tables {
@@ -146,3 +163,212 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server and/or namespace missing from app spec"}})
 }
+
+func TestValidatePermissionsClusterNamespaces(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	argoDB := db.NewDB("argocd", settings.NewSettingsManager(context.Background(), kubeclientset, "argocd"), kubeclientset)
+	_, err := argoDB.CreateCluster(context.Background(), &argoappv1.Cluster{Server: "https://mycluster", Namespaces: []string{"ns1", "ns2"}})
+	assert.NoError(t, err)
+	proj := &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	spec := func(namespace string) *argoappv1.ApplicationSpec {
+		return &argoappv1.ApplicationSpec{
+			Source:      argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+			Destination: argoappv1.ApplicationDestination{Server: "https://mycluster", Namespace: namespace},
+		}
+	}
+
+	conditions, err := ValidatePermissions(context.Background(), spec("ns2"), proj, argoDB)
+	assert.NoError(t, err)
+	assert.Len(t, conditions, 0)
+
+	conditions, err = ValidatePermissions(context.Background(), spec("default"), proj, argoDB)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{
+		Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		Message: "namespace 'default' is not accessible by the credentials of cluster 'https://mycluster', which are restricted to the namespaces: ns1, ns2",
+	}})
+}
+
+func TestValidateValueFileRefs(t *testing.T) {
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/argoproj/*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	spec := func(helm *argoappv1.ApplicationSourceHelm) *argoappv1.ApplicationSpec {
+		return &argoappv1.ApplicationSpec{
+			Project: "default",
+			Source:  argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: ".", Helm: helm},
+		}
+	}
+	messages := func(helm *argoappv1.ApplicationSourceHelm) []string {
+		var messages []string
+		for _, condition := range validateValueFileRefs(spec(helm), proj) {
+			messages = append(messages, condition.Message)
+		}
+		return messages
+	}
+
+	assert.Empty(t, messages(&argoappv1.ApplicationSourceHelm{
+		ValueFiles:      []string{"values.yaml", "$values/prod.yaml"},
+		ValueFilesRepos: []argoappv1.HelmValueFilesRepo{{Ref: "values", RepoURL: "https://github.com/argoproj/config"}},
+	}))
+	assert.Equal(t, []string{"value file $values/prod.yaml of the source https://github.com/argoproj/argo-cd refers to unknown source '$values'"},
+		messages(&argoappv1.ApplicationSourceHelm{ValueFiles: []string{"$values/prod.yaml"}}))
+	assert.Equal(t, []string{"value files repo https://gitlab.com/example/config is not permitted in project 'default'"},
+		messages(&argoappv1.ApplicationSourceHelm{
+			ValueFilesRepos: []argoappv1.HelmValueFilesRepo{{Ref: "values", RepoURL: "https://gitlab.com/example/config"}},
+		}))
+	assert.Equal(t, []string{"ref 'values' of the value files repo https://github.com/argoproj/other is not unique"},
+		messages(&argoappv1.ApplicationSourceHelm{
+			ValueFilesRepos: []argoappv1.HelmValueFilesRepo{
+				{Ref: "values", RepoURL: "https://github.com/argoproj/config"},
+				{Ref: "values", RepoURL: "https://github.com/argoproj/other"},
+			},
+		}))
+	assert.Equal(t, []string{"value files repos of the source https://github.com/argoproj/argo-cd require a ref and a repoURL"},
+		messages(&argoappv1.ApplicationSourceHelm{
+			ValueFilesRepos: []argoappv1.HelmValueFilesRepo{{RepoURL: "https://github.com/argoproj/config"}},
+		}))
+}
+
+func TestGetEnvFromSecrets(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tokens",
+			Namespace:   "argocd",
+			Labels:      map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeEnv},
+			Annotations: map[string]string{common.AnnotationKeyEnvProjects: "default, team-a"},
+		},
+		Data: map[string][]byte{"github": []byte("gh-token"), "vault": []byte("vault-token")},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "shared-tokens",
+			Namespace:   "argocd",
+			Labels:      map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeEnv},
+			Annotations: map[string]string{common.AnnotationKeyEnvProjects: "*"},
+		},
+		Data: map[string][]byte{"github": []byte("shared-gh-token")},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"},
+		Data:       map[string][]byte{"admin.password": []byte("password")},
+	})
+	secretEnv := func(name, secret, key string) *argoappv1.EnvEntry {
+		return &argoappv1.EnvEntry{Name: name, ValueFrom: &argoappv1.EnvVarSource{SecretKeyRef: &argoappv1.SecretKeySelector{Name: secret, Key: key}}}
+	}
+
+	values, err := GetEnvFromSecrets(kubeclientset, "argocd", "default", &argoappv1.ApplicationSource{})
+	assert.NoError(t, err)
+	assert.Nil(t, values)
+
+	values, err = GetEnvFromSecrets(kubeclientset, "argocd", "default", &argoappv1.ApplicationSource{
+		Plugin: &argoappv1.ApplicationSourcePlugin{Env: argoappv1.Env{{Name: "FOO", Value: "bar"}, secretEnv("GITHUB_TOKEN", "tokens", "github")}},
+		Helm:   &argoappv1.ApplicationSourceHelm{Env: argoappv1.Env{secretEnv("VAULT_TOKEN", "tokens", "vault"), secretEnv("GITHUB_TOKEN", "tokens", "github")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"GITHUB_TOKEN": "gh-token", "VAULT_TOKEN": "vault-token"}, values)
+
+	_, err = GetEnvFromSecrets(kubeclientset, "argocd", "default", &argoappv1.ApplicationSource{
+		Plugin: &argoappv1.ApplicationSourcePlugin{Env: argoappv1.Env{secretEnv("TOKEN", "tokens", "github")}},
+		Helm:   &argoappv1.ApplicationSourceHelm{Env: argoappv1.Env{secretEnv("TOKEN", "tokens", "vault")}},
+	})
+	assert.EqualError(t, err, "Environment variable TOKEN refers to different secret keys")
+
+	_, err = GetEnvFromSecrets(kubeclientset, "argocd", "default", &argoappv1.ApplicationSource{
+		Kustomize: &argoappv1.ApplicationSourceKustomize{Env: argoappv1.Env{secretEnv("PASSWORD", "argocd-secret", "admin.password")}},
+	})
+	assert.EqualError(t, err, "Secret argocd-secret of environment variable PASSWORD does not have the label argocd.argoproj.io/secret-type=env")
+
+	_, err = GetEnvFromSecrets(kubeclientset, "argocd", "default", &argoappv1.ApplicationSource{
+		Kustomize: &argoappv1.ApplicationSourceKustomize{Env: argoappv1.Env{secretEnv("TOKEN", "tokens", "gitlab")}},
+	})
+	assert.EqualError(t, err, "Secret tokens of environment variable TOKEN has no key gitlab")
+
+	_, err = GetEnvFromSecrets(kubeclientset, "default", "default", &argoappv1.ApplicationSource{
+		Kustomize: &argoappv1.ApplicationSourceKustomize{Env: argoappv1.Env{secretEnv("TOKEN", "tokens", "github")}},
+	})
+	assert.Error(t, err)
+
+	values, err = GetEnvFromSecrets(kubeclientset, "argocd", "team-a", &argoappv1.ApplicationSource{
+		Plugin: &argoappv1.ApplicationSourcePlugin{Env: argoappv1.Env{secretEnv("GITHUB_TOKEN", "tokens", "github")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"GITHUB_TOKEN": "gh-token"}, values)
+
+	_, err = GetEnvFromSecrets(kubeclientset, "argocd", "team-b", &argoappv1.ApplicationSource{
+		Plugin: &argoappv1.ApplicationSourcePlugin{Env: argoappv1.Env{secretEnv("GITHUB_TOKEN", "tokens", "github")}},
+	})
+	assert.EqualError(t, err, "Secret tokens of environment variable GITHUB_TOKEN does not allow project team-b in its annotation argocd.argoproj.io/env-projects")
+
+	values, err = GetEnvFromSecrets(kubeclientset, "argocd", "team-b", &argoappv1.ApplicationSource{
+		Plugin: &argoappv1.ApplicationSourcePlugin{Env: argoappv1.Env{secretEnv("GITHUB_TOKEN", "shared-tokens", "github")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"GITHUB_TOKEN": "shared-gh-token"}, values)
+}
+
+func TestValidateRepoEnvFromSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "validate-repo-env-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	cmd := exec.Command("sh", "-c", "git init && git commit --allow-empty -m 'Initial commit'")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "HOME=/dev/null", "GIT_AUTHOR_NAME=Alice", "GIT_AUTHOR_EMAIL=alice@example.com", "GIT_COMMITTER_NAME=Alice", "GIT_COMMITTER_EMAIL=alice@example.com")
+	assert.NoError(t, cmd.Run())
+
+	kubeclientset := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "plugin-tokens",
+			Namespace:   "argocd",
+			Labels:      map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeEnv},
+			Annotations: map[string]string{common.AnnotationKeyEnvProjects: "default"},
+		},
+		Data: map[string][]byte{"github": []byte("gh-token")},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd"},
+	}, &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-cm", Namespace: "argocd", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+	})
+	argoDB := db.NewDB("argocd", settings.NewSettingsManager(context.Background(), kubeclientset, "argocd"), kubeclientset)
+
+	// the plugin only generates manifests if it gets the token
+	repoClient := &mocks.RepoServerServiceClient{}
+	repoClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+		return req.EnvFromSecrets["GITHUB_TOKEN"] == "gh-token"
+	})).Return(&apiclient.ManifestResponse{}, nil)
+	repoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("GITHUB_TOKEN is not set"))
+	repoClientset := &mocks.Clientset{}
+	repoClientset.On("NewRepoServerClient").Return(ioutil.NopCloser(nil), repoClient, nil)
+
+	spec := func(secretName string) *argoappv1.ApplicationSpec {
+		return &argoappv1.ApplicationSpec{
+			Project:     "default",
+			Destination: argoappv1.ApplicationDestination{Server: common.KubernetesInternalAPIServerAddr, Namespace: "default"},
+			Source: argoappv1.ApplicationSource{RepoURL: "file://" + dir, Path: ".", Plugin: &argoappv1.ApplicationSourcePlugin{
+				Name: "my-plugin",
+				Env: argoappv1.Env{{Name: "GITHUB_TOKEN", ValueFrom: &argoappv1.EnvVarSource{
+					SecretKeyRef: &argoappv1.SecretKeySelector{Name: secretName, Key: "github"},
+				}}},
+			}},
+		}
+	}
+
+	conditions, sourceType, err := ValidateRepo(context.Background(), spec("plugin-tokens"), repoClientset, argoDB, kubeclientset, "argocd")
+	assert.NoError(t, err)
+	assert.Empty(t, conditions)
+	assert.Equal(t, argoappv1.ApplicationSourceTypePlugin, sourceType)
+
+	conditions, _, err = ValidateRepo(context.Background(), spec("missing-tokens"), repoClientset, argoDB, kubeclientset, "argocd")
+	assert.NoError(t, err)
+	if assert.Len(t, conditions, 2) {
+		assert.Contains(t, conditions[0].Message, "Unable to resolve environment variables from secrets")
+		assert.Equal(t, "Unable to generate manifests in .: GITHUB_TOKEN is not set", conditions[1].Message)
+	}
+}
@@ -2,6 +2,7 @@ package argo
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -11,6 +12,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -29,8 +31,8 @@ type overrideIgnoreDiff struct {
 	JSONPointers []string `yaml:"jsonPointers"`
 }
 
-// NewDiffNormalizer creates diff normalizer which removes ignored fields according to given application spec and resource overrides
-func NewDiffNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride) (diff.Normalizer, error) {
+// withResourceOverrides returns the ignored differences of the application followed by the ones configured in the resource overrides
+func withResourceOverrides(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride) ([]v1alpha1.ResourceIgnoreDifferences, error) {
 	for key, override := range overrides {
 		parts := strings.Split(key, "/")
 		if len(parts) < 2 {
@@ -52,6 +54,22 @@ func NewDiffNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides ma
 			})
 		}
 	}
+	return ignore, nil
+}
+
+// matches returns whether the ignored differences apply to the given resource
+func matches(ignore v1alpha1.ResourceIgnoreDifferences, un *unstructured.Unstructured) bool {
+	return un.GroupVersionKind().GroupKind() == schema.GroupKind{Group: ignore.Group, Kind: ignore.Kind} &&
+		(ignore.Name == "" || ignore.Name == un.GetName()) &&
+		(ignore.Namespace == "" || ignore.Namespace == un.GetNamespace())
+}
+
+// NewDiffNormalizer creates diff normalizer which removes ignored fields according to given application spec and resource overrides
+func NewDiffNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride) (diff.Normalizer, error) {
+	ignore, err := withResourceOverrides(ignore, overrides)
+	if err != nil {
+		return nil, err
+	}
 	patches := make([]normalizerPatch, 0)
 	for i := range ignore {
 		for _, path := range ignore[i].JSONPointers {
@@ -111,3 +129,118 @@ func (n *normalizer) Normalize(un *unstructured.Unstructured) error {
 	}
 	return nil
 }
+
+// IgnoredFieldsRetainer keeps the live values of ignored fields in the target state of resources, so that applying
+// the target state does not revert them
+type IgnoredFieldsRetainer struct {
+	ignore []v1alpha1.ResourceIgnoreDifferences
+}
+
+// NewIgnoredFieldsRetainer creates a retainer of the fields ignored according to given application spec and resource overrides
+func NewIgnoredFieldsRetainer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride) (*IgnoredFieldsRetainer, error) {
+	ignore, err := withResourceOverrides(ignore, overrides)
+	if err != nil {
+		return nil, err
+	}
+	return &IgnoredFieldsRetainer{ignore: ignore}, nil
+}
+
+// Retain sets the ignored fields of the target resource to their live values, or removes them if they are not set in
+// the live resource
+func (r *IgnoredFieldsRetainer) Retain(target, live *unstructured.Unstructured) {
+	for _, ignore := range r.ignore {
+		if !matches(ignore, target) {
+			continue
+		}
+		for _, pointer := range ignore.JSONPointers {
+			tokens := parseJSONPointer(pointer)
+			if len(tokens) == 0 {
+				continue
+			}
+			if value, ok := getJSONPointer(live.Object, tokens); ok {
+				setJSONPointer(target.Object, tokens, runtime.DeepCopyJSONValue(value))
+			} else {
+				removeJSONPointer(target.Object, tokens)
+			}
+		}
+	}
+}
+
+// parseJSONPointer returns the unescaped reference tokens of a JSON pointer, e.g. "/spec/replicas"
+func parseJSONPointer(pointer string) []string {
+	if !strings.HasPrefix(pointer, "/") {
+		return nil
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(tokens[i], "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens
+}
+
+// child returns the child of a JSON object or array referenced by the token
+func child(parent interface{}, token string) (interface{}, bool) {
+	switch parent := parent.(type) {
+	case map[string]interface{}:
+		value, ok := parent[token]
+		return value, ok
+	case []interface{}:
+		if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(parent) {
+			return parent[i], true
+		}
+	}
+	return nil, false
+}
+
+func getJSONPointer(obj map[string]interface{}, tokens []string) (interface{}, bool) {
+	var value interface{} = obj
+	for _, token := range tokens {
+		var ok bool
+		if value, ok = child(value, token); !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// setJSONPointer sets the referenced value, creating the missing objects on the way to it
+func setJSONPointer(obj map[string]interface{}, tokens []string, value interface{}) {
+	var parent interface{} = obj
+	for i, token := range tokens {
+		last := i == len(tokens)-1
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			if last {
+				p[token] = value
+				return
+			}
+			if _, ok := child(p, token); !ok {
+				p[token] = map[string]interface{}{}
+			}
+			parent = p[token]
+		case []interface{}:
+			j, err := strconv.Atoi(token)
+			if err != nil || j < 0 || j >= len(p) {
+				return
+			}
+			if last {
+				p[j] = value
+				return
+			}
+			parent = p[j]
+		default:
+			return
+		}
+	}
+}
+
+// removeJSONPointer removes the referenced field of an object; items of arrays are not removed
+func removeJSONPointer(obj map[string]interface{}, tokens []string) {
+	parent, ok := getJSONPointer(obj, tokens[:len(tokens)-1])
+	if !ok {
+		return
+	}
+	if p, ok := parent.(map[string]interface{}); ok {
+		delete(p, tokens[len(tokens)-1])
+	}
+}
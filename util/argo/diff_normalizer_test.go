@@ -116,3 +116,42 @@ func TestNormalizeMissingJsonPointer(t *testing.T) {
 	err = normalizer.Normalize(&crd)
 	assert.NoError(t, err)
 }
+
+func TestRetainIgnoredFields(t *testing.T) {
+	retainer, err := NewIgnoredFieldsRetainer([]v1alpha1.ResourceIgnoreDifferences{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		JSONPointers: []string{"/spec/replicas", "/metadata/annotations/example.com~1injected", "/spec/template/spec/containers/0/image"},
+	}, {
+		Group:        "",
+		Kind:         "Service",
+		JSONPointers: []string{"/spec"},
+	}}, map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {
+			IgnoreDifferences: `jsonPointers: ["/spec/paused"]`,
+		},
+	})
+	assert.NoError(t, err)
+
+	target := kube.MustToUnstructured(test.DemoDeployment())
+	assert.NoError(t, unstructured.SetNestedField(target.Object, true, "spec", "paused"))
+	live := target.DeepCopy()
+	assert.NoError(t, unstructured.SetNestedField(live.Object, int64(5), "spec", "replicas"))
+	live.SetAnnotations(map[string]string{"example.com/injected": "value"})
+	unstructured.RemoveNestedField(live.Object, "spec", "paused")
+	containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+	containers[0].(map[string]interface{})["image"] = "live-image"
+	assert.NoError(t, unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers"))
+
+	retainer.Retain(target, live)
+
+	replicas, _, _ := unstructured.NestedInt64(target.Object, "spec", "replicas")
+	assert.Equal(t, int64(5), replicas)
+	assert.Equal(t, map[string]string{"example.com/injected": "value"}, target.GetAnnotations())
+	_, hasPaused, _ := unstructured.NestedBool(target.Object, "spec", "paused")
+	assert.False(t, hasPaused)
+	containers, _, _ = unstructured.NestedSlice(target.Object, "spec", "template", "spec", "containers")
+	assert.Equal(t, "live-image", containers[0].(map[string]interface{})["image"])
+	// fields which are not ignored are kept
+	assert.Equal(t, "demo", containers[0].(map[string]interface{})["name"])
+}
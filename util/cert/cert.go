@@ -4,18 +4,28 @@ package cert
 
 import (
 	"bufio"
+	"bytes"
 	"crypto/sha256"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
 
 	"github.com/argoproj/argo-cd/common"
 )
@@ -61,8 +71,71 @@ const (
 	CertificateMaxLines = 128
 	// Maximum number of certificates or known host entries in a stream
 	CertificateMaxEntriesPerStream = 256
+	// Default port to connect to when scanning SSH host keys
+	SSHScanDefaultPort = "22"
+	// Default timeout for a single connection attempt when scanning SSH host keys
+	SSHScanDefaultTimeout = 10 * time.Second
+	// Default port to connect to when fetching the certificates of a TLS server
+	TLSFetchDefaultPort = "443"
+	// Maximum number of intermediate certificates to fetch when completing a chain
+	TLSChainMaxFetches = 8
+	// Annotation holding the date a certificate should be rotated by
+	CertificateAnnotationRotateBy = "rotate-by"
+	// Annotation of a SSH known hosts entry pinned during a host key rotation,
+	// holding the comma separated SHA256 fingerprints of the keys it replaces
+	CertificateAnnotationRekeyReplaces = "rekey-replaces"
+	// Annotation holding the time a SSH host key rotation was started
+	CertificateAnnotationRekeyStartedAt = "rekey-started-at"
 )
 
+// Layouts accepted for the rotate-by date of a certificate
+var rotateByLayouts = []string{
+	"2006-01-02",
+	time.RFC3339,
+}
+
+// Regular expression the host name of a server for TLS certificates must
+// match, unless it is an IP address. Underscores are allowed, since they are
+// common in the names of internal hosts.
+var validTLSServerNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]([-a-zA-Z0-9_]*[a-zA-Z0-9_])?(\.[a-zA-Z0-9_]([-a-zA-Z0-9_]*[a-zA-Z0-9_])?)*$`)
+
+// The SSH public key algorithms of host keys which can be parsed, and thus be
+// used in SSH known hosts entries
+var SupportedSSHKeyAlgorithms = []string{
+	ssh.KeyAlgoRSA,
+	ssh.KeyAlgoDSA,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoED25519,
+}
+
+// The public key algorithms of TLS certificates which can be parsed, and thus
+// be used as TLS certificates for repository servers
+var SupportedTLSPublicKeyAlgorithms = []x509.PublicKeyAlgorithm{
+	x509.RSA,
+	x509.DSA,
+	x509.ECDSA,
+}
+
+// The SSH public key algorithms we ask a server for when scanning its host
+// keys. Each algorithm is requested in a dedicated connection, because the
+// server will only ever present one host key per key exchange.
+var SSHScanKeyAlgorithms = []string{
+	ssh.KeyAlgoRSA,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoED25519,
+}
+
+// Error returned when a private key was found in data supposed to hold
+// certificates only
+var ErrPrivateKeyData = errors.New("Input looks like a private key. Private keys must never be stored as certificates, do not paste keys here.")
+
+// Error used to abort the SSH handshake once we got hold of the host key
+var errSSHScanHostKeyReceived = errors.New("host key received")
+
 // Get the configured path to where TLS certificates are stored on the local
 // filesystem. If ARGOCD_TLS_DATA_PATH environment is set, path is taken from
 // there, otherwise the default will be returned.
@@ -100,6 +173,77 @@ func DecodePEMCertificateToX509(pemData string) (*x509.Certificate, error) {
 	return x509Cert, nil
 }
 
+// A cache of decoded X509 certificates, keyed by their PEM data. It is meant
+// to be used for the duration of a single operation which needs to look at
+// the same certificates multiple times, so every certificate is decoded only
+// once. The returned certificates are shared and must not be modified.
+type X509CertificateCache struct {
+	lock    sync.Mutex
+	entries map[string]x509CertificateCacheEntry
+	decodes int
+}
+
+type x509CertificateCacheEntry struct {
+	cert *x509.Certificate
+	err  error
+}
+
+// Returns a new, empty cache for decoded X509 certificates
+func NewX509CertificateCache() *X509CertificateCache {
+	return &X509CertificateCache{entries: make(map[string]x509CertificateCacheEntry)}
+}
+
+// Decode a certificate in PEM format to X509 data structure, reusing the
+// result of a previous decode of the same data. Errors are cached as well.
+func (c *X509CertificateCache) DecodePEMCertificateToX509(pemData string) (*x509.Certificate, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if entry, ok := c.entries[pemData]; ok {
+		return entry.cert, entry.err
+	}
+	x509Cert, err := DecodePEMCertificateToX509(pemData)
+	c.entries[pemData] = x509CertificateCacheEntry{cert: x509Cert, err: err}
+	c.decodes++
+	return x509Cert, err
+}
+
+// Returns the number of times certificate data was actually decoded
+func (c *X509CertificateCache) Decodes() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.decodes
+}
+
+// Encode a X509 certificate to a string holding its PEM representation
+func EncodeX509CertificateToPEM(x509Cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: x509Cert.Raw}))
+}
+
+// Parse a single TLS certificate given as base64 encoded DER data without PEM
+// armor, and return it in PEM format. Whitespace within the data is ignored,
+// so line wrapped output of base64 tools can be used as-is.
+func ParseBase64DERCertificateFromData(data string) (string, error) {
+	if strings.Contains(data, "-----BEGIN ") {
+		return "", errors.New("Input is PEM encoded, not base64 encoded DER data.")
+	}
+	data = strings.Join(strings.Fields(data), "")
+	if data == "" {
+		return "", errors.New("No base64 encoded DER data given.")
+	}
+	derData, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		derData, err = base64.RawStdEncoding.DecodeString(data)
+		if err != nil {
+			return "", errors.New("Could not decode base64 data from input.")
+		}
+	}
+	x509Cert, err := x509.ParseCertificate(derData)
+	if err != nil {
+		return "", errors.New("Could not parse X509 data from input.")
+	}
+	return EncodeX509CertificateToPEM(x509Cert), nil
+}
+
 // Parse TLS certificates from a multiline string
 func ParseTLSCertificatesFromData(data string) ([]string, error) {
 	return ParseTLSCertificatesFromStream(strings.NewReader(data))
@@ -120,6 +264,49 @@ func ParseTLSCertificatesFromPath(sourceFile string) ([]string, error) {
 // in the returned slice, so the length of the slice indicates how many
 // certificates have been found.
 func ParseTLSCertificatesFromStream(stream io.Reader) ([]string, error) {
+	return parseTLSCertificatesFromStream(stream, false)
+}
+
+// Parse TLS certificates supplied by a user from a multiline string. Other
+// than ParseTLSCertificatesFromData, ErrPrivateKeyData is returned if the
+// data contains a private key.
+func ParseTLSCertificateInputFromData(data string) ([]string, error) {
+	return parseTLSCertificatesFromStream(strings.NewReader(data), true)
+}
+
+// Parse TLS certificates supplied by a user from a file. Other than
+// ParseTLSCertificatesFromPath, ErrPrivateKeyData is returned if the file
+// contains a private key. The file may also hold a single certificate in
+// binary DER format, as exported by most browsers, which is detected by its
+// content regardless of the file name.
+func ParseTLSCertificateInputFromPath(sourceFile string) ([]string, error) {
+	data, err := ioutil.ReadFile(sourceFile)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Contains(data, []byte("-----BEGIN ")) {
+		if x509Cert, err := x509.ParseCertificate(data); err == nil {
+			return []string{EncodeX509CertificateToPEM(x509Cert)}, nil
+		}
+	}
+	return parseTLSCertificatesFromStream(bytes.NewReader(data), true)
+}
+
+// Parse TLS certificates supplied by a user from a data stream. Other than
+// ParseTLSCertificatesFromStream, ErrPrivateKeyData is returned if the stream
+// contains a private key.
+func ParseTLSCertificateInputFromStream(stream io.Reader) ([]string, error) {
+	return parseTLSCertificatesFromStream(stream, true)
+}
+
+// Returns true if line starts a PEM block holding a private key, e.g. of type
+// PRIVATE KEY, RSA PRIVATE KEY or EC PRIVATE KEY.
+func isPrivateKeyBeginMarker(line string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasPrefix(line, "-----BEGIN ") && strings.HasSuffix(line, "PRIVATE KEY-----")
+}
+
+func parseTLSCertificatesFromStream(stream io.Reader, rejectPrivateKeys bool) ([]string, error) {
 	scanner := bufio.NewScanner(stream)
 	inCertData := false
 	pemData := ""
@@ -133,6 +320,11 @@ func ParseTLSCertificatesFromStream(stream io.Reader) ([]string, error) {
 
 	for scanner.Scan() {
 		curLine += 1
+		// The error must not include any of the data, so we never leak
+		// parts of the key.
+		if rejectPrivateKeys && isPrivateKeyBeginMarker(scanner.Text()) {
+			return nil, ErrPrivateKeyData
+		}
 		if !inCertData {
 			if strings.HasPrefix(scanner.Text(), CertificateBeginMarker) {
 				certLine = 1
@@ -234,6 +426,20 @@ func TokenizedDataToPublicKey(hostname string, subType string, rawKeyData string
 	return hostnames, keyData, nil
 }
 
+// Escapes all special characters of a host name pattern in hostname, so the
+// pattern matches only hostname itself.
+func EscapeHostNamePattern(hostname string) string {
+	var sb strings.Builder
+	for _, r := range hostname {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
 // We do not use full fledged regular expression for matching the hostname.
 // Instead, we use a less expensive file system glob, which should be fully
 // sufficient for our use case.
@@ -251,11 +457,111 @@ func MatchHostName(hostname, pattern string) bool {
 
 // base64 sha256 hash with the trailing equal sign removed
 func SSHFingerprintSHA256(key ssh.PublicKey) string {
-	hash := sha256.Sum256(key.Marshal())
+	return fingerprintSHA256(key.Marshal())
+}
+
+// base64 sha256 hash of the DER encoded certificate with the trailing equal
+// sign removed
+func TLSCertificateFingerprintSHA256(cert *x509.Certificate) string {
+	return fingerprintSHA256(cert.Raw)
+}
+
+// Returns the key under which the annotations of a SSH known hosts entry are
+// stored in the certificate-annotations annotation of its ConfigMap
+func SSHKnownHostsAnnotationsKey(host, subType string) string {
+	return fmt.Sprintf("%s %s", host, subType)
+}
+
+// Returns the key under which the annotations of a SSH known hosts entry are
+// stored if its host has several entries of the same key type, as pinned
+// during a host key rotation
+func SSHKnownHostsFingerprintAnnotationsKey(host, subType, fingerprint string) string {
+	return fmt.Sprintf("%s %s SHA256:%s", host, subType, fingerprint)
+}
+
+// Removes exact duplicates from the given PEM encoded certificates, which are
+// identified by the SHA256 fingerprint of their DER encoding. Different
+// certificates with the same subject, e.g. a renewed one, are all kept.
+// Returns the remaining certificates in their original order, along with the
+// duplicates which were removed.
+func DedupeTLSCertificates(pemCerts []string) ([]string, []*x509.Certificate, error) {
+	seen := make(map[string]bool)
+	unique := make([]string, 0, len(pemCerts))
+	var duplicates []*x509.Certificate
+	for _, entry := range pemCerts {
+		x509Cert, err := DecodePEMCertificateToX509(entry)
+		if err != nil {
+			return nil, nil, err
+		}
+		fingerprint := TLSCertificateFingerprintSHA256(x509Cert)
+		if seen[fingerprint] {
+			duplicates = append(duplicates, x509Cert)
+			continue
+		}
+		seen[fingerprint] = true
+		unique = append(unique, entry)
+	}
+	return unique, duplicates, nil
+}
+
+func fingerprintSHA256(data []byte) string {
+	hash := sha256.Sum256(data)
 	b64hash := base64.StdEncoding.EncodeToString(hash[:])
 	return strings.TrimRight(b64hash, "=")
 }
 
+// Returns true if the host name of a SSH known hosts entry is hashed, i.e.
+// it was written by ssh-keygen -H or with HashKnownHosts enabled.
+func IsHashedSSHKnownHostsName(hostname string) bool {
+	return strings.HasPrefix(hostname, "|1|")
+}
+
+// Returns true if cert would be accepted for a connection to serverName at
+// the given time, i.e. it is within its validity period and the name is
+// covered by its subject alternative names. For certificates without any
+// subject alternative names, the common name is matched instead.
+func IsTLSCertificateValidForHost(cert *x509.Certificate, serverName string, now time.Time) bool {
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return false
+	}
+	host := ServerNameWithoutPort(serverName)
+	if len(cert.DNSNames) == 0 && len(cert.IPAddresses) == 0 && len(cert.EmailAddresses) == 0 && len(cert.URIs) == 0 {
+		return strings.EqualFold(cert.Subject.CommonName, host)
+	}
+	return cert.VerifyHostname(host) == nil
+}
+
+// Returns true if serverName can be used as the name of a server to store TLS
+// certificates for, i.e. it is a host name or an IP address, optionally
+// followed by a port. IPv6 addresses may be enclosed in brackets, which they
+// must be if followed by a port.
+func IsValidTLSServerName(serverName string) bool {
+	host := serverName
+	if h, port, err := net.SplitHostPort(serverName); err == nil {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return false
+		}
+		host = h
+	} else if strings.HasPrefix(serverName, "[") && strings.HasSuffix(serverName, "]") {
+		host = serverName[1 : len(serverName)-1]
+	}
+	if net.ParseIP(host) != nil {
+		return true
+	}
+	return !strings.HasPrefix(serverName, "[") && len(host) <= 253 && validTLSServerNameRegexp.MatchString(host)
+}
+
+// Parse the rotate-by date of a certificate, which may be given either as a
+// plain date (YYYY-MM-DD) or as RFC3339 timestamp.
+func ParseRotateByDate(value string) (time.Time, error) {
+	for _, layout := range rotateByLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("Invalid rotate-by date '%s', must be YYYY-MM-DD or RFC3339", value)
+}
+
 // Remove possible port number from hostname and return just the FQDN
 func ServerNameWithoutPort(serverName string) string {
 	return strings.Split(serverName, ":")[0]
@@ -305,3 +611,205 @@ func GetCertPoolFromPEMData(pemData []string) *x509.CertPool {
 	}
 	return certPool
 }
+
+// Parse a list of hosts to scan for SSH host keys from a file. The file must
+// contain one host per line, optionally followed by a port in the form of
+// host:port. Empty lines and lines starting with '#' are ignored.
+func ParseSSHScanHostsFromPath(sourceFile string) ([]string, error) {
+	fileHandle, err := os.Open(sourceFile)
+	if err != nil {
+		return nil, err
+	}
+	defer fileHandle.Close()
+	return ParseSSHScanHostsFromStream(fileHandle)
+}
+
+// Parse a list of hosts to scan for SSH host keys from a stream.
+func ParseSSHScanHostsFromStream(stream io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(stream)
+	hosts := make([]string, 0)
+	for scanner.Scan() {
+		host := strings.TrimSpace(scanner.Text())
+		if len(host) == 0 || host[0] == '#' {
+			continue
+		}
+		if strings.ContainsAny(host, " \t") {
+			return nil, fmt.Errorf("Invalid host specification '%s': must be host[:port]", host)
+		}
+		hosts = append(hosts, host)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(hosts) > CertificateMaxEntriesPerStream {
+		return nil, fmt.Errorf("Maximum number of %d hosts exceeded.", CertificateMaxEntriesPerStream)
+	}
+	return hosts, nil
+}
+
+// Connect to the SSH server at address (host[:port]) and retrieve the server's
+// host keys for all supported key types. The keys are returned as entries in
+// known_hosts format. Key types not offered by the server are silently skipped,
+// but it is considered an error if the server does not offer any key at all.
+func ScanSSHHostKeys(address string, timeout time.Duration) ([]string, error) {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, SSHScanDefaultPort)
+	}
+
+	knownHostsEntries := make([]string, 0)
+	var lastErr error
+
+	for _, keyAlgo := range SSHScanKeyAlgorithms {
+		var hostKey ssh.PublicKey
+		config := &ssh.ClientConfig{
+			User:              "argocd",
+			HostKeyAlgorithms: []string{keyAlgo},
+			HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+				hostKey = key
+				return errSSHScanHostKeyReceived
+			},
+			Timeout: timeout,
+		}
+		conn, err := ssh.Dial("tcp", address, config)
+		if conn != nil {
+			_ = conn.Close()
+		}
+		if hostKey == nil {
+			// If we cannot connect to the server at all, there's no point in
+			// trying the remaining key types.
+			if _, ok := err.(*net.OpError); ok {
+				return nil, err
+			}
+			if err != nil {
+				lastErr = err
+			}
+			continue
+		}
+		knownHostsEntries = append(knownHostsEntries, knownhosts.Line([]string{knownhosts.Normalize(address)}, hostKey))
+	}
+
+	if len(knownHostsEntries) == 0 {
+		if lastErr != nil {
+			return nil, lastErr
+		}
+		return nil, fmt.Errorf("No SSH host keys received from %s", address)
+	}
+
+	return knownHostsEntries, nil
+}
+
+// Connect to the TLS server at address (host[:port]) and return the chain of
+// certificates it presents, leaf certificate first. The chain is not verified,
+// since we want to inspect what the server presents, not whether we trust it.
+func FetchTLSCertificates(address string, timeout time.Duration) ([]*x509.Certificate, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+		address = net.JoinHostPort(address, TLSFetchDefaultPort)
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("No certificates received from %s", address)
+	}
+	return certs, nil
+}
+
+// An intermediate certificate fetched while completing a certificate chain
+type FetchedCertificate struct {
+	// The fetched certificate
+	Certificate *x509.Certificate
+	// The URL the certificate was fetched from
+	URL string
+}
+
+// Complete the chain of certs, whose first element must be the leaf
+// certificate. Missing intermediate certificates are fetched from the
+// Authority Information Access URLs of the certificates, until the chain can
+// be verified against roots. If roots is nil, the system trust store is used.
+// Returns the full chain from the leaf up to and including the root, and the
+// intermediate certificates that had to be fetched.
+func CompleteTLSCertificateChain(certs []*x509.Certificate, roots *x509.CertPool, timeout time.Duration) ([]*x509.Certificate, []FetchedCertificate, error) {
+	if len(certs) == 0 {
+		return nil, nil, fmt.Errorf("No certificates given.")
+	}
+	if roots == nil {
+		var err error
+		roots, err = x509.SystemCertPool()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	leaf := certs[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	client := &http.Client{Timeout: timeout}
+	fetched := make([]FetchedCertificate, 0)
+	// The certificate whose issuer we need to fetch next
+	current := certs[len(certs)-1]
+
+	for {
+		chains, err := leaf.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		if err == nil {
+			return chains[0], fetched, nil
+		}
+		if len(fetched) >= TLSChainMaxFetches {
+			return nil, nil, fmt.Errorf("Could not complete certificate chain after fetching %d certificates: %v", len(fetched), err)
+		}
+		if len(current.IssuingCertificateURL) == 0 {
+			return nil, nil, fmt.Errorf("Could not complete certificate chain, certificate '%s' has no issuer URL: %v", current.Subject.String(), err)
+		}
+
+		var issuer *x509.Certificate
+		var fetchErr error
+		for _, url := range current.IssuingCertificateURL {
+			issuer, fetchErr = fetchCertificate(client, url)
+			if fetchErr == nil {
+				fetched = append(fetched, FetchedCertificate{Certificate: issuer, URL: url})
+				break
+			}
+		}
+		if issuer == nil {
+			return nil, nil, fmt.Errorf("Could not fetch issuer of certificate '%s': %v", current.Subject.String(), fetchErr)
+		}
+		intermediates.AddCert(issuer)
+		current = issuer
+	}
+}
+
+// Fetch a single DER or PEM encoded certificate from url
+func fetchCertificate(client *http.Client, url string) (*x509.Certificate, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(data); block != nil {
+		data = block.Bytes
+	}
+	return x509.ParseCertificate(data)
+}
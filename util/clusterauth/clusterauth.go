@@ -35,6 +35,16 @@ var ArgoCDManagerPolicyRules = []rbacv1.PolicyRule{
 	},
 }
 
+// ArgoCDManagerNamespacePolicyRules are the policies to give argocd-manager in each namespace, if its access is
+// restricted to some namespaces
+var ArgoCDManagerNamespacePolicyRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"*"},
+		Resources: []string{"*"},
+		Verbs:     []string{"*"},
+	},
+}
+
 // CreateServiceAccount creates a service account in a given namespace
 func CreateServiceAccount(
 	clientset kubernetes.Interface,
@@ -137,28 +147,129 @@ func CreateClusterRoleBinding(
 	return nil
 }
 
-// InstallClusterManagerRBAC installs RBAC resources for a cluster manager to operate a cluster. Returns a token
-func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string) (string, error) {
-
-	err := CreateServiceAccount(clientset, ArgoCDManagerServiceAccount, ns)
+// CreateRole creates a role in a given namespace
+func CreateRole(
+	clientset kubernetes.Interface,
+	roleName string,
+	rules []rbacv1.PolicyRule,
+	namespace string,
+) error {
+	role := rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "Role",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleName,
+			Namespace: namespace,
+		},
+		Rules: rules,
+	}
+	rclient := clientset.RbacV1().Roles(namespace)
+	_, err := rclient.Create(&role)
 	if err != nil {
-		return "", err
+		if !apierr.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create Role %q in namespace %q: %v", roleName, namespace, err)
+		}
+		_, err = rclient.Update(&role)
+		if err != nil {
+			return fmt.Errorf("Failed to update Role %q in namespace %q: %v", roleName, namespace, err)
+		}
+		log.Infof("Role %q updated in namespace %q", roleName, namespace)
+	} else {
+		log.Infof("Role %q created in namespace %q", roleName, namespace)
 	}
+	return nil
+}
 
-	err = CreateClusterRole(clientset, ArgoCDManagerClusterRole, ArgoCDManagerPolicyRules)
+// CreateRoleBinding create a RoleBinding in a given namespace, which binds a role to a service account of the
+// service account namespace
+func CreateRoleBinding(
+	clientset kubernetes.Interface,
+	roleBindingName,
+	serviceAccountName,
+	roleName string,
+	serviceAccountNamespace string,
+	namespace string,
+) error {
+	roleBinding := rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "RoleBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleBindingName,
+			Namespace: namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     roleName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccountName,
+				Namespace: serviceAccountNamespace,
+			},
+		},
+	}
+	_, err := clientset.RbacV1().RoleBindings(namespace).Create(&roleBinding)
 	if err != nil {
-		return "", err
+		if !apierr.IsAlreadyExists(err) {
+			return fmt.Errorf("Failed to create RoleBinding %s in namespace %q: %v", roleBindingName, namespace, err)
+		}
+		log.Infof("RoleBinding %q already exists in namespace %q", roleBindingName, namespace)
+		return nil
 	}
+	log.Infof("RoleBinding %q created in namespace %q, bound %q to %q", roleBindingName, namespace, serviceAccountName, roleName)
+	return nil
+}
+
+// InstallClusterManagerRBAC installs RBAC resources for a cluster manager to operate a cluster. If namespaces are
+// given, the cluster manager is only granted access to these namespaces, using a role and role binding in each of them.
+// Returns a token
+func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string, namespaces []string) (string, error) {
 
-	err = CreateClusterRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerServiceAccount, ArgoCDManagerClusterRole, ns)
+	err := CreateServiceAccount(clientset, ArgoCDManagerServiceAccount, ns)
 	if err != nil {
 		return "", err
 	}
 
+	if len(namespaces) == 0 {
+		err = CreateClusterRole(clientset, ArgoCDManagerClusterRole, ArgoCDManagerPolicyRules)
+		if err != nil {
+			return "", err
+		}
+
+		err = CreateClusterRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerServiceAccount, ArgoCDManagerClusterRole, ns)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for _, namespace := range namespaces {
+		err = CreateRole(clientset, ArgoCDManagerClusterRole, ArgoCDManagerNamespacePolicyRules, namespace)
+		if err != nil {
+			return "", err
+		}
+
+		err = CreateRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerServiceAccount, ArgoCDManagerClusterRole, ns, namespace)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return GetServiceAccountBearerToken(clientset, ns, ArgoCDManagerServiceAccount)
+}
+
+// GetServiceAccountBearerToken returns the bearer token of a service account, waiting for its token secret to be created
+func GetServiceAccountBearerToken(clientset kubernetes.Interface, ns string, sa string) (string, error) {
 	var serviceAccount *corev1.ServiceAccount
 	var secretName string
-	err = wait.Poll(500*time.Millisecond, 30*time.Second, func() (bool, error) {
-		serviceAccount, err = clientset.CoreV1().ServiceAccounts(ns).Get(ArgoCDManagerServiceAccount, metav1.GetOptions{})
+	err := wait.Poll(500*time.Millisecond, 30*time.Second, func() (bool, error) {
+		var err error
+		serviceAccount, err = clientset.CoreV1().ServiceAccounts(ns).Get(sa, metav1.GetOptions{})
 		if err != nil {
 			return false, err
 		}
@@ -177,7 +288,7 @@ func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string) (strin
 	}
 	token, ok := secret.Data["token"]
 	if !ok {
-		return "", fmt.Errorf("Secret %q for service account %q did not have a token", secretName, serviceAccount)
+		return "", fmt.Errorf("Secret %q for service account %q did not have a token", secretName, sa)
 	}
 	return string(token), nil
 }
@@ -100,3 +100,37 @@ func TestRotateServiceAccountSecrets(t *testing.T) {
 	_, err = secretsClient.Get(testClaims.SecretName, metav1.GetOptions{})
 	assert.True(t, apierr.IsNotFound(err))
 }
+
+func TestInstallClusterManagerRBACNamespaces(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(newServiceAccount(), newServiceAccountSecret())
+
+	token, err := InstallClusterManagerRBAC(kubeclientset, "kube-system", []string{"ns1", "ns2"})
+	assert.NoError(t, err)
+	assert.Equal(t, testToken, token)
+
+	for _, ns := range []string{"ns1", "ns2"} {
+		role, err := kubeclientset.RbacV1().Roles(ns).Get(ArgoCDManagerClusterRole, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, ArgoCDManagerNamespacePolicyRules, role.Rules)
+		roleBinding, err := kubeclientset.RbacV1().RoleBindings(ns).Get(ArgoCDManagerClusterRoleBinding, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "kube-system", roleBinding.Subjects[0].Namespace)
+	}
+	_, err = kubeclientset.RbacV1().ClusterRoles().Get(ArgoCDManagerClusterRole, metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestGetServiceAccountBearerToken(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(newServiceAccount(), newServiceAccountSecret())
+
+	token, err := GetServiceAccountBearerToken(kubeclientset, "kube-system", "argocd-manager")
+	assert.NoError(t, err)
+	assert.Equal(t, testToken, token)
+
+	clusterRoles, err := kubeclientset.RbacV1().ClusterRoles().List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, clusterRoles.Items, 0)
+
+	_, err = GetServiceAccountBearerToken(kubeclientset, "kube-system", "missing")
+	assert.Error(t, err)
+}
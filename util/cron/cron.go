@@ -0,0 +1,177 @@
+// Parsing of cron schedules, which describe the times sync windows begin at
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a cron schedule in the standard format, which consists of the five fields minute,
+// hour, day of month, month and day of week
+type Schedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// Whether the day of month or the day of week are restricted, i.e. not "*"
+	dayOfMonthRestricted, dayOfWeekRestricted bool
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday
+	dayOfWeekField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Schedules which may be given by their descriptor instead of the five fields
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron schedule, e.g. "0 22 * * 1-5" or "@daily". Each field may be "*", a
+// number, a range or a list of them, optionally with a step, e.g. "*/15" or "1-5,10-20/2".
+// Months and days of week may also be given by the first three letters of their name.
+func Parse(spec string) (*Schedule, error) {
+	if descriptor, ok := descriptors[strings.ToLower(strings.TrimSpace(spec))]; ok {
+		spec = descriptor
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron schedule '%s' must have 5 fields, but has %d", spec, len(fields))
+	}
+	var s Schedule
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if s.dayOfMonth, err = dayOfMonthField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek, err = dayOfWeekField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek |= 1
+	}
+	s.dayOfMonthRestricted = fields[2] != "*"
+	s.dayOfWeekRestricted = fields[4] != "*"
+	return &s, nil
+}
+
+// Parses a field into a bit set of the values it contains
+func (f field) parse(value string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		rangeAndStep := strings.SplitN(item, "/", 2)
+		start, end := f.min, f.max
+		if rangeAndStep[0] != "*" {
+			bounds := strings.SplitN(rangeAndStep[0], "-", 2)
+			var err error
+			if start, err = f.parseValue(bounds[0]); err != nil {
+				return 0, err
+			}
+			end = start
+			if len(bounds) == 2 {
+				if end, err = f.parseValue(bounds[1]); err != nil {
+					return 0, err
+				}
+			} else if len(rangeAndStep) == 2 {
+				// a start with a step, e.g. "10/5", extends to the maximum
+				end = f.max
+			}
+			if end < start {
+				return 0, fmt.Errorf("invalid %s range '%s'", f.name, rangeAndStep[0])
+			}
+		}
+		step := 1
+		if len(rangeAndStep) == 2 {
+			var err error
+			if step, err = strconv.Atoi(rangeAndStep[1]); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid %s step '%s'", f.name, rangeAndStep[1])
+			}
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func (f field) parseValue(value string) (int, error) {
+	if i, ok := f.names[strings.ToLower(value)]; ok {
+		return i, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil || i < f.min || i > f.max {
+		return 0, fmt.Errorf("invalid %s '%s', must be between %d and %d", f.name, value, f.min, f.max)
+	}
+	return i, nil
+}
+
+func contains(bits uint64, i int) bool {
+	return bits&(1<<uint(i)) != 0
+}
+
+// Returns whether the day of the given time matches the schedule. As in standard cron, a day
+// matches if either the day of month or the day of week match if both are restricted.
+func (s *Schedule) matchesDay(t time.Time) bool {
+	if !contains(s.month, int(t.Month())) {
+		return false
+	}
+	dayOfMonth := contains(s.dayOfMonth, t.Day())
+	dayOfWeek := contains(s.dayOfWeek, int(t.Weekday()))
+	if s.dayOfMonthRestricted && s.dayOfWeekRestricted {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+// Matches returns whether the schedule is activated in the minute of the given time
+func (s *Schedule) Matches(t time.Time) bool {
+	return s.matchesDay(t) && contains(s.hour, t.Hour()) && contains(s.minute, t.Minute())
+}
+
+// Prev returns the latest time the schedule was activated at, at or before the given time and
+// not before the given earliest time. Returns false if it was not activated in between.
+func (s *Schedule) Prev(t time.Time, earliest time.Time) (time.Time, bool) {
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+	for !t.Before(earliest) {
+		switch {
+		case !s.matchesDay(t):
+			// continue with the last minute of the previous day
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Add(-time.Minute)
+		case !contains(s.hour, t.Hour()):
+			// continue with the last minute of the previous hour
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(-time.Minute)
+		case !contains(s.minute, t.Minute()):
+			t = t.Add(-time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
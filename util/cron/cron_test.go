@@ -0,0 +1,85 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	assert.NoError(t, err)
+	return parsed
+}
+
+func TestParse(t *testing.T) {
+	for _, spec := range []string{"* * * * *", "0 22 * * 1-5", "*/15 0-6,18-23 1,15 * *", "30 8 * jan-mar Mon,wed", "0 0 * * 7", "10/5 * * * *", "@daily", "@Hourly"} {
+		_, err := Parse(spec)
+		assert.NoError(t, err, spec)
+	}
+	for _, spec := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *", "@often"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestSchedule_Matches(t *testing.T) {
+	tests := []struct {
+		spec string
+		time string
+		want bool
+	}{
+		{"* * * * *", "2019-10-14T09:23:00Z", true},
+		{"0 22 * * *", "2019-10-14T22:00:59Z", true},
+		{"0 22 * * *", "2019-10-14T22:01:00Z", false},
+		{"*/15 * * * *", "2019-10-14T09:45:00Z", true},
+		{"*/15 * * * *", "2019-10-14T09:46:00Z", false},
+		{"0 0 * * 1-5", "2019-10-14T00:00:00Z", true},
+		{"0 0 * * 1-5", "2019-10-13T00:00:00Z", false},
+		{"0 0 * * 7", "2019-10-13T00:00:00Z", true},
+		{"0 0 * * sun", "2019-10-13T00:00:00Z", true},
+		{"0 0 * oct *", "2019-10-13T00:00:00Z", true},
+		{"0 0 * nov *", "2019-10-13T00:00:00Z", false},
+		// either the day of month or the day of week must match if both are restricted
+		{"0 0 1 * mon", "2019-10-14T00:00:00Z", true},
+		{"0 0 1 * mon", "2019-10-01T00:00:00Z", true},
+		{"0 0 1 * mon", "2019-10-02T00:00:00Z", false},
+		{"0 0 1 * *", "2019-10-14T00:00:00Z", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec+" "+tt.time, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Matches(mustParseTime(t, tt.time)))
+		})
+	}
+}
+
+func TestSchedule_Prev(t *testing.T) {
+	tests := []struct {
+		spec     string
+		time     string
+		earliest string
+		want     string
+	}{
+		{"* * * * *", "2019-10-14T09:23:30Z", "2019-10-14T00:00:00Z", "2019-10-14T09:23:00Z"},
+		{"0 22 * * *", "2019-10-14T09:23:30Z", "2019-10-13T00:00:00Z", "2019-10-13T22:00:00Z"},
+		{"0 22 * * *", "2019-10-14T09:23:30Z", "2019-10-13T22:01:00Z", ""},
+		{"30 1 * * 5", "2019-10-14T09:23:30Z", "2019-10-01T00:00:00Z", "2019-10-11T01:30:00Z"},
+		{"15 */6 1 * *", "2019-10-14T09:23:30Z", "2019-01-01T00:00:00Z", "2019-10-01T18:15:00Z"},
+		{"@yearly", "2019-10-14T09:23:30Z", "2019-01-01T00:00:00Z", "2019-01-01T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec+" "+tt.time, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			assert.NoError(t, err)
+			prev, ok := schedule.Prev(mustParseTime(t, tt.time), mustParseTime(t, tt.earliest))
+			if tt.want == "" {
+				assert.False(t, ok)
+			} else if assert.True(t, ok) {
+				assert.Equal(t, mustParseTime(t, tt.want), prev)
+			}
+		})
+	}
+}
@@ -2,13 +2,18 @@ package db
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/net/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/common"
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	certutil "github.com/argoproj/argo-cd/util/cert"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // A struct representing an entry in the list of SSH known hosts.
@@ -21,6 +26,8 @@ type SSHKnownHostsEntry struct {
 	Data string
 	// The SHA256 fingerprint of the key
 	Fingerprint string
+	// Annotations of the entry
+	Annotations map[string]string
 }
 
 // A representation of a TLS certificate
@@ -31,6 +38,8 @@ type TLSCertificate struct {
 	Issuer string
 	// Certificate data
 	Data string
+	// Annotations of the certificate
+	Annotations map[string]string
 }
 
 // Helper struct for certificate selection
@@ -41,6 +50,8 @@ type CertificateListSelector struct {
 	CertType string
 	// Subtype of certificate to match
 	CertSubType string
+	// SHA256 fingerprint of certificate to match, with or without SHA256: prefix
+	Fingerprint string
 }
 
 // Get a list of all configured repository certificates matching the given
@@ -63,20 +74,21 @@ func (db *db) ListRepoCertificates(ctx context.Context, selector *CertificateLis
 		}
 
 		for _, entry := range sshKnownHosts {
-			if certutil.MatchHostName(entry.Host, selector.HostNamePattern) && (selector.CertSubType == "" || selector.CertSubType == "*" || selector.CertSubType == entry.SubType) {
+			if matchSSHKnownHostsEntry(entry, selector) {
 				certificates = append(certificates, appsv1.RepositoryCertificate{
 					ServerName:      entry.Host,
 					CertType:        "ssh",
 					CertSubType:     entry.SubType,
 					CertData:        []byte(entry.Data),
 					CertFingerprint: entry.Fingerprint,
+					Annotations:     entry.Annotations,
 				})
 			}
 		}
 	}
 
 	// Get all TLS certificates
-	if selector.CertType == "" || selector.CertType == "*" || selector.CertType == "https" || selector.CertType == "tls" {
+	if selectsTLSCertificates(selector) {
 		tlsCertificates, err := db.getTLSCertificateData()
 		if err != nil {
 			return nil, err
@@ -88,11 +100,24 @@ func (db *db) ListRepoCertificates(ctx context.Context, selector *CertificateLis
 					continue
 				}
 				for _, pemEntry := range pemEntries {
-					certificates = append(certificates, appsv1.RepositoryCertificate{
-						ServerName: entry.Subject,
-						CertType:   "https",
-						CertData:   []byte(pemEntry),
-					})
+					if !matchTLSCertificateFingerprint(pemEntry, selector) {
+						continue
+					}
+					certificate := appsv1.RepositoryCertificate{
+						ServerName:  entry.Subject,
+						CertType:    "https",
+						CertData:    []byte(pemEntry),
+						Annotations: entry.Annotations,
+					}
+					// The validity period is informational only, so entries
+					// which cannot be decoded are still listed.
+					if x509Cert, err := certutil.DecodePEMCertificateToX509(pemEntry); err == nil {
+						notBefore := metav1.NewTime(x509Cert.NotBefore)
+						notAfter := metav1.NewTime(x509Cert.NotAfter)
+						certificate.NotBefore = &notBefore
+						certificate.NotAfter = &notAfter
+					}
+					certificates = append(certificates, certificate)
 				}
 			}
 		}
@@ -118,6 +143,7 @@ func (db *db) GetRepoCertificate(ctx context.Context, serverType string, serverN
 					CertSubType:     entry.SubType,
 					CertData:        []byte(entry.Data),
 					CertFingerprint: entry.Fingerprint,
+					Annotations:     entry.Annotations,
 				}
 				return repo, nil
 			}
@@ -131,6 +157,17 @@ func (db *db) GetRepoCertificate(ctx context.Context, serverType string, serverN
 // Create one or more repository certificates and returns a list of certificates
 // actually created.
 func (db *db) CreateRepoCertificate(ctx context.Context, certificates *appsv1.RepositoryCertificateList, upsert bool) (*appsv1.RepositoryCertificateList, error) {
+	return db.createRepoCertificate(ctx, certificates, upsert, true)
+}
+
+// Validates one or more repository certificates the same way they would be
+// validated on creation, and returns a list of certificates that would be
+// created. Nothing is persisted.
+func (db *db) ValidateRepoCertificate(ctx context.Context, certificates *appsv1.RepositoryCertificateList, upsert bool) (*appsv1.RepositoryCertificateList, error) {
+	return db.createRepoCertificate(ctx, certificates, upsert, false)
+}
+
+func (db *db) createRepoCertificate(ctx context.Context, certificates *appsv1.RepositoryCertificateList, upsert bool, persist bool) (*appsv1.RepositoryCertificateList, error) {
 	var (
 		saveSSHData bool = false
 		saveTLSData bool = false
@@ -158,44 +195,49 @@ func (db *db) CreateRepoCertificate(ctx context.Context, certificates *appsv1.Re
 			// Whether we have upserted an existing certificate entry
 			upserted := false
 
-			// Check whether known hosts entry already exists. Must match hostname
-			// and the key sub type (e.g. ssh-rsa). It is considered an error if we
-			// already have a corresponding key and upsert was not specified.
-			for _, entry := range sshKnownHostsList {
-				if entry.Host == certificate.ServerName && entry.SubType == certificate.CertSubType {
-					if !upsert && entry.Data != string(certificate.CertData) {
-						return nil, fmt.Errorf("Key for '%s' (subtype: '%s') already exist and upsert was not specified.", entry.Host, entry.SubType)
-					} else {
-						// Do not add an entry on upsert, but remember if we actual did an
-						// upsert.
-						newEntry = false
-						if entry.Data != string(certificate.CertData) {
-							entry.Data = string(certificate.CertData)
-							upserted = true
-						}
-						break
-					}
-				}
-			}
-
 			// Make sure that we received a valid public host key by parsing it
 			_, _, rawKeyData, _, _, err := ssh.ParseKnownHosts([]byte(fmt.Sprintf("%s %s %s", certificate.ServerName, certificate.CertSubType, certificate.CertData)))
 			if err != nil {
 				return nil, err
 			}
+			fingerprint := certutil.SSHFingerprintSHA256(rawKeyData)
+
+			// Check whether known hosts entry already exists. Must match hostname
+			// and the key sub type (e.g. ssh-rsa). It is considered an error if we
+			// already have a corresponding key and upsert was not specified.
+			if entry := findSSHKnownHostsEntry(sshKnownHostsList, certificate); entry != nil {
+				if len(certificate.Annotations) == 0 {
+					certificate.Annotations = keptAnnotations(entry.Annotations)
+				}
+				changed := !sshKnownHostsKeyEqual(entry.Host, entry.SubType, entry.Data, string(certificate.CertData)) || !annotationsEqual(entry.Annotations, certificate.Annotations)
+				if !upsert && changed {
+					return nil, fmt.Errorf("Key for '%s' (subtype: '%s') already exist and upsert was not specified.", entry.Host, entry.SubType)
+				}
+				// Do not add an entry on upsert, but remember if we actual did an
+				// upsert.
+				newEntry = false
+				if changed {
+					entry.Data = string(certificate.CertData)
+					entry.Fingerprint = fingerprint
+					entry.Annotations = certificate.Annotations
+					upserted = true
+				}
+			}
 
 			if newEntry {
 				sshKnownHostsList = append(sshKnownHostsList, &SSHKnownHostsEntry{
-					Host:    certificate.ServerName,
-					Data:    string(certificate.CertData),
-					SubType: certificate.CertSubType,
+					Host:        certificate.ServerName,
+					Data:        string(certificate.CertData),
+					SubType:     certificate.CertSubType,
+					Fingerprint: fingerprint,
+					Annotations: certificate.Annotations,
 				})
 			}
 
 			// If we created a new entry, or if we upserted an existing one, we need
 			// to save the data and notify the consumer about the operation.
 			if newEntry || upserted {
-				certificate.CertFingerprint = certutil.SSHFingerprintSHA256(rawKeyData)
+				certificate.CertFingerprint = fingerprint
 				created = append(created, certificate)
 				saveSSHData = true
 			}
@@ -210,7 +252,10 @@ func (db *db) CreateRepoCertificate(ctx context.Context, certificates *appsv1.Re
 				// We have an entry for this server already. Check for upsert.
 				if entry.Subject == certificate.ServerName {
 					newEntry = false
-					if entry.Data != string(certificate.CertData) {
+					if len(certificate.Annotations) == 0 {
+						certificate.Annotations = keptAnnotations(entry.Annotations)
+					}
+					if !tlsCertificateDataEqual(entry.Data, string(certificate.CertData)) || !annotationsEqual(entry.Annotations, certificate.Annotations) {
 						if !upsert {
 							return nil, fmt.Errorf("TLS certificate for server '%s' already exist and upsert was not specified.", entry.Subject)
 						}
@@ -222,7 +267,7 @@ func (db *db) CreateRepoCertificate(ctx context.Context, certificates *appsv1.Re
 			}
 
 			// Check for validity of data received
-			pemData, err := certutil.ParseTLSCertificatesFromData(string(certificate.CertData))
+			pemData, err := certutil.ParseTLSCertificateInputFromData(string(certificate.CertData))
 			if err != nil {
 				return nil, err
 			}
@@ -244,15 +289,17 @@ func (db *db) CreateRepoCertificate(ctx context.Context, certificates *appsv1.Re
 			// New certificate if pointer to existing cert is nil
 			if tlsCertificate == nil {
 				tlsCertificate = &TLSCertificate{
-					Subject: certificate.ServerName,
-					Data:    string(certificate.CertData),
+					Subject:     certificate.ServerName,
+					Data:        string(certificate.CertData),
+					Annotations: certificate.Annotations,
 				}
 				tlsCertificates = append(tlsCertificates, tlsCertificate)
 			} else {
 				// We have made sure the upsert flag was set above. Now just figure out
 				// again if we have to actually update the data in the existing cert.
-				if tlsCertificate.Data != string(certificate.CertData) {
+				if !tlsCertificateDataEqual(tlsCertificate.Data, string(certificate.CertData)) || !annotationsEqual(tlsCertificate.Annotations, certificate.Annotations) {
 					tlsCertificate.Data = string(certificate.CertData)
+					tlsCertificate.Annotations = certificate.Annotations
 					upserted = true
 				}
 			}
@@ -262,9 +309,10 @@ func (db *db) CreateRepoCertificate(ctx context.Context, certificates *appsv1.Re
 				// caller knows that we processed each single item.
 				for _, entry := range pemCreated {
 					created = append(created, appsv1.RepositoryCertificate{
-						ServerName: certificate.ServerName,
-						CertType:   "https",
-						CertData:   []byte(entry),
+						ServerName:  certificate.ServerName,
+						CertType:    "https",
+						CertData:    []byte(entry),
+						Annotations: certificate.Annotations,
 					})
 				}
 				saveTLSData = true
@@ -275,15 +323,19 @@ func (db *db) CreateRepoCertificate(ctx context.Context, certificates *appsv1.Re
 		}
 	}
 
+	if !persist {
+		return &appsv1.RepositoryCertificateList{Items: created}, nil
+	}
+
 	if saveSSHData {
-		err = db.settingsMgr.SaveSSHKnownHostsData(ctx, knownHostsDataToStrings(sshKnownHostsList))
+		err = db.settingsMgr.SaveSSHKnownHostsData(ctx, knownHostsDataToStrings(sshKnownHostsList), knownHostsDataToAnnotations(sshKnownHostsList))
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if saveTLSData {
-		err = db.settingsMgr.SaveTLSCertificateData(ctx, tlsCertificatesToMap(tlsCertificates))
+		err = db.settingsMgr.SaveTLSCertificateData(ctx, tlsCertificatesToMap(tlsCertificates), tlsCertificatesToAnnotations(tlsCertificates))
 		if err != nil {
 			return nil, err
 		}
@@ -299,6 +351,7 @@ func (db *db) RemoveRepoCertificates(ctx context.Context, selector *CertificateL
 		knownHostsNew      []*SSHKnownHostsEntry
 		tlsCertificatesOld []*TLSCertificate
 		tlsCertificatesNew []*TLSCertificate
+		tlsChanged         bool
 		err                error
 	)
 
@@ -320,6 +373,7 @@ func (db *db) RemoveRepoCertificates(ctx context.Context, selector *CertificateL
 					CertType:    "ssh",
 					CertSubType: entry.SubType,
 					CertData:    []byte(entry.Data),
+					Annotations: entry.Annotations,
 				})
 			} else {
 				knownHostsNew = append(knownHostsNew, entry)
@@ -327,7 +381,7 @@ func (db *db) RemoveRepoCertificates(ctx context.Context, selector *CertificateL
 		}
 	}
 
-	if selector.CertType == "" || selector.CertType == "*" || selector.CertType == "https" || selector.CertType == "tls" {
+	if selectsTLSCertificates(selector) {
 		tlsCertificatesOld, err = db.getTLSCertificateData()
 		if err != nil {
 			return nil, err
@@ -345,14 +399,32 @@ func (db *db) RemoveRepoCertificates(ctx context.Context, selector *CertificateL
 				if err != nil {
 					return nil, err
 				}
-				if len(pemCertificates) > 0 {
-					for _, pem := range pemCertificates {
-						removed.Items = append(removed.Items, appsv1.RepositoryCertificate{
-							ServerName: entry.Subject,
-							CertType:   "https",
-							CertData:   []byte(pem),
-						})
+				// When selecting by fingerprint, the remaining certificates of
+				// the server are kept.
+				remaining := make([]string, 0)
+				for _, pem := range pemCertificates {
+					if !matchTLSCertificateFingerprint(pem, selector) {
+						remaining = append(remaining, pem)
+						continue
 					}
+					removed.Items = append(removed.Items, appsv1.RepositoryCertificate{
+						ServerName:  entry.Subject,
+						CertType:    "https",
+						CertData:    []byte(pem),
+						Annotations: entry.Annotations,
+					})
+				}
+				if len(remaining) == len(pemCertificates) {
+					tlsCertificatesNew = append(tlsCertificatesNew, entry)
+					continue
+				}
+				tlsChanged = true
+				if len(remaining) > 0 {
+					tlsCertificatesNew = append(tlsCertificatesNew, &TLSCertificate{
+						Subject:     entry.Subject,
+						Data:        strings.Join(remaining, "\n"),
+						Annotations: entry.Annotations,
+					})
 				}
 			} else {
 				tlsCertificatesNew = append(tlsCertificatesNew, entry)
@@ -361,14 +433,14 @@ func (db *db) RemoveRepoCertificates(ctx context.Context, selector *CertificateL
 	}
 
 	if len(knownHostsNew) < len(knownHostsOld) {
-		err = db.settingsMgr.SaveSSHKnownHostsData(ctx, knownHostsDataToStrings(knownHostsNew))
+		err = db.settingsMgr.SaveSSHKnownHostsData(ctx, knownHostsDataToStrings(knownHostsNew), knownHostsDataToAnnotations(knownHostsNew))
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if len(tlsCertificatesNew) < len(tlsCertificatesOld) {
-		err = db.settingsMgr.SaveTLSCertificateData(ctx, tlsCertificatesToMap(tlsCertificatesNew))
+	if tlsChanged {
+		err = db.settingsMgr.SaveTLSCertificateData(ctx, tlsCertificatesToMap(tlsCertificatesNew), tlsCertificatesToAnnotations(tlsCertificatesNew))
 		if err != nil {
 			return nil, err
 		}
@@ -377,6 +449,17 @@ func (db *db) RemoveRepoCertificates(ctx context.Context, selector *CertificateL
 	return removed, nil
 }
 
+// Returns true if the selector may match TLS certificates. A host can have
+// both, SSH known hosts entries and TLS certificates, so the type must always
+// be considered. Only SSH known hosts entries have a sub type, so a selector
+// asking for a specific sub type never matches a TLS certificate.
+func selectsTLSCertificates(selector *CertificateListSelector) bool {
+	if selector.CertType != "" && selector.CertType != "*" && selector.CertType != "https" && selector.CertType != "tls" {
+		return false
+	}
+	return selector.CertSubType == "" || selector.CertSubType == "*"
+}
+
 // Converts list of known hosts data to array of strings, suitable for storing
 // in a known_hosts file for SSH.
 func knownHostsDataToStrings(knownHostsList []*SSHKnownHostsEntry) []string {
@@ -387,6 +470,162 @@ func knownHostsDataToStrings(knownHostsList []*SSHKnownHostsEntry) []string {
 	return knownHostsData
 }
 
+// Returns the known hosts entry in knownHostsList which certificate replaces,
+// or nil if it is a new entry. An entry of the same host and key sub type is
+// replaced, preferring one holding the same key. The new key of a host key
+// rotation is pinned alongside the old key of the same sub type, so it only
+// replaces an entry holding the same key.
+func findSSHKnownHostsEntry(knownHostsList []*SSHKnownHostsEntry, certificate appsv1.RepositoryCertificate) *SSHKnownHostsEntry {
+	var sameType *SSHKnownHostsEntry
+	for _, entry := range knownHostsList {
+		if entry.Host != certificate.ServerName || entry.SubType != certificate.CertSubType {
+			continue
+		}
+		if sshKnownHostsKeyEqual(entry.Host, entry.SubType, entry.Data, string(certificate.CertData)) {
+			return entry
+		}
+		if sameType == nil {
+			sameType = entry
+		}
+	}
+	if _, ok := certificate.Annotations[certutil.CertificateAnnotationRekeyReplaces]; ok {
+		return nil
+	}
+	return sameType
+}
+
+// Collects the annotations of the known hosts entries, keyed by the identity
+// of each entry, which is the host name and the key sub type.
+func knownHostsDataToAnnotations(knownHostsList []*SSHKnownHostsEntry) map[string]map[string]string {
+	certAnnotations := make(map[string]map[string]string)
+	keys := knownHostsAnnotationsKeys(knownHostsList)
+	for i, entry := range knownHostsList {
+		if len(entry.Annotations) > 0 {
+			certAnnotations[keys[i]] = entry.Annotations
+		}
+	}
+	return certAnnotations
+}
+
+// Returns the keys the annotations of the known hosts entries are stored
+// under, in the order of the entries. A host can have more than one entry of
+// the same key sub type while its host key is rotated, which are then told
+// apart by their fingerprint.
+func knownHostsAnnotationsKeys(knownHostsList []*SSHKnownHostsEntry) []string {
+	count := make(map[string]int)
+	for _, entry := range knownHostsList {
+		count[sshKnownHostsEntryKey(entry.Host, entry.SubType)]++
+	}
+	keys := make([]string, 0, len(knownHostsList))
+	for _, entry := range knownHostsList {
+		key := sshKnownHostsEntryKey(entry.Host, entry.SubType)
+		if count[key] > 1 {
+			key = certutil.SSHKnownHostsFingerprintAnnotationsKey(entry.Host, entry.SubType, entry.Fingerprint)
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Collects the annotations of the TLS certificates, keyed by the subject
+func tlsCertificatesToAnnotations(tlsCertificates []*TLSCertificate) map[string]map[string]string {
+	certAnnotations := make(map[string]map[string]string)
+	for _, entry := range tlsCertificates {
+		if len(entry.Annotations) > 0 {
+			certAnnotations[entry.Subject] = entry.Annotations
+		}
+	}
+	return certAnnotations
+}
+
+// Returns the key under which the annotations of a known hosts entry are stored
+func sshKnownHostsEntryKey(host, subType string) string {
+	return certutil.SSHKnownHostsAnnotationsKey(host, subType)
+}
+
+// Returns true if the given public host key data of a known hosts entry holds
+// the same key, i.e. it has the same fingerprint. Data which cannot be parsed
+// is compared literally.
+func sshKnownHostsKeyEqual(host, subType, a, b string) bool {
+	if a == b {
+		return true
+	}
+	_, keyA, errA := certutil.TokenizedDataToPublicKey(host, subType, a)
+	_, keyB, errB := certutil.TokenizedDataToPublicKey(host, subType, b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return certutil.SSHFingerprintSHA256(keyA) == certutil.SSHFingerprintSHA256(keyB)
+}
+
+// Returns true if the given PEM data holds the same TLS certificates in the
+// same order, i.e. they have the same fingerprints. Data which cannot be
+// parsed is compared literally.
+func tlsCertificateDataEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	fingerprintsA, errA := tlsCertificateFingerprints(a)
+	fingerprintsB, errB := tlsCertificateFingerprints(b)
+	if errA != nil || errB != nil || len(fingerprintsA) == 0 || len(fingerprintsA) != len(fingerprintsB) {
+		return false
+	}
+	for i := range fingerprintsA {
+		if fingerprintsA[i] != fingerprintsB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the fingerprints of the TLS certificates in the given PEM data
+func tlsCertificateFingerprints(pemData string) ([]string, error) {
+	certificates, err := certutil.ParseTLSCertificatesFromData(pemData)
+	if err != nil {
+		return nil, err
+	}
+	fingerprints := make([]string, 0, len(certificates))
+	for _, entry := range certificates {
+		x509Cert, err := certutil.DecodePEMCertificateToX509(entry)
+		if err != nil {
+			return nil, err
+		}
+		fingerprints = append(fingerprints, certutil.TLSCertificateFingerprintSHA256(x509Cert))
+	}
+	return fingerprints, nil
+}
+
+// Returns the annotations of a stored entry which are kept when the entry is
+// created again without annotations, e.g. the rotate-by date. The annotations
+// of a pending SSH host key rotation are not kept, so that finishing the
+// rotation clears them.
+func keptAnnotations(annotations map[string]string) map[string]string {
+	var kept map[string]string
+	for k, v := range annotations {
+		if k == certutil.CertificateAnnotationRekeyReplaces || k == certutil.CertificateAnnotationRekeyStartedAt {
+			continue
+		}
+		if kept == nil {
+			kept = make(map[string]string)
+		}
+		kept[k] = v
+	}
+	return kept
+}
+
+// Compares two sets of annotations, treating nil and empty sets as equal
+func annotationsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
 // Converts list of TLS certificates to a map whose key will be the certificate
 // subject and the data will be a string containing TLS certificate data as PEM
 func tlsCertificatesToMap(tlsCertificates []*TLSCertificate) map[string]string {
@@ -404,8 +643,12 @@ func (db *db) getTLSCertificateData() ([]*TLSCertificate, error) {
 	if err != nil {
 		return nil, err
 	}
+	certAnnotations, err := settings.GetCertificateAnnotations(certCM)
+	if err != nil {
+		return nil, err
+	}
 	for key, entry := range certCM.Data {
-		certificates = append(certificates, &TLSCertificate{Subject: key, Data: entry})
+		certificates = append(certificates, &TLSCertificate{Subject: key, Data: entry, Annotations: certAnnotations[key]})
 	}
 
 	return certificates, nil
@@ -420,6 +663,10 @@ func (db *db) getSSHKnownHostsData() ([]*SSHKnownHostsEntry, error) {
 	}
 
 	sshKnownHostsData := certCM.Data["ssh_known_hosts"]
+	certAnnotations, err := settings.GetCertificateAnnotations(certCM)
+	if err != nil {
+		return nil, err
+	}
 	entries := make([]*SSHKnownHostsEntry, 0)
 
 	// ssh_known_hosts data contains one key per line, so we must iterate over
@@ -438,16 +685,214 @@ func (db *db) getSSHKnownHostsData() ([]*SSHKnownHostsEntry, error) {
 		if err != nil {
 			return nil, err
 		}
-		entries = append(entries, &SSHKnownHostsEntry{
+		entry := &SSHKnownHostsEntry{
 			Host:    hostname,
 			SubType: subType,
 			Data:    string(keyData),
-		})
+		}
+		if _, key, err := certutil.TokenizedDataToPublicKey(hostname, subType, string(keyData)); err == nil {
+			entry.Fingerprint = certutil.SSHFingerprintSHA256(key)
+		}
+		// Annotations stored by fingerprint take precedence, as they belong
+		// to exactly one of several entries of the same sub type.
+		if annotations, ok := certAnnotations[certutil.SSHKnownHostsFingerprintAnnotationsKey(hostname, subType, entry.Fingerprint)]; ok {
+			entry.Annotations = annotations
+		} else {
+			entry.Annotations = certAnnotations[sshKnownHostsEntryKey(hostname, subType)]
+		}
+		entries = append(entries, entry)
 	}
 
 	return entries, nil
 }
 
 func matchSSHKnownHostsEntry(entry *SSHKnownHostsEntry, selector *CertificateListSelector) bool {
-	return certutil.MatchHostName(entry.Host, selector.HostNamePattern) && (selector.CertSubType == "" || selector.CertSubType == "*" || selector.CertSubType == entry.SubType)
+	if !certutil.MatchHostName(entry.Host, selector.HostNamePattern) || (selector.CertSubType != "" && selector.CertSubType != "*" && selector.CertSubType != entry.SubType) {
+		return false
+	}
+	if selector.Fingerprint == "" {
+		return true
+	}
+	_, key, err := certutil.TokenizedDataToPublicKey(entry.Host, entry.SubType, entry.Data)
+	return err == nil && matchFingerprint(certutil.SSHFingerprintSHA256(key), selector.Fingerprint)
+}
+
+// Returns whether the PEM encoded certificate matches the fingerprint of the
+// selector. Any certificate matches if the selector has no fingerprint.
+func matchTLSCertificateFingerprint(pemData string, selector *CertificateListSelector) bool {
+	if selector.Fingerprint == "" {
+		return true
+	}
+	x509Cert, err := certutil.DecodePEMCertificateToX509(pemData)
+	return err == nil && matchFingerprint(certutil.TLSCertificateFingerprintSHA256(x509Cert), selector.Fingerprint)
+}
+
+// Compares a fingerprint with one given by the user, which may carry the
+// SHA256: prefix we use when displaying fingerprints
+func matchFingerprint(fingerprint string, selected string) bool {
+	return fingerprint == strings.TrimRight(strings.TrimPrefix(selected, "SHA256:"), "=")
+}
+
+// Result of synchronizing a single certificate entry with SyncRepoCertificates
+type CertificateSyncResult struct {
+	// What has been done with the entry: created, updated, unchanged, pruned,
+	// or needs-pruning if pruning was not requested
+	Action string
+	// The entry, with the data of all certificates of a server joined for TLS
+	// entries
+	Certificate appsv1.RepositoryCertificate
+}
+
+// Key of the store entry a certificate is kept in. TLS certificates are
+// stored per server, SSH known hosts entries per server, key type and key, as
+// two keys of the same type are pinned for a host during a host key rotation.
+func certificateEntryKey(c appsv1.RepositoryCertificate) string {
+	if c.CertType == "ssh" {
+		if fingerprint := sshCertificateFingerprint(c); fingerprint != "" {
+			return certutil.SSHKnownHostsFingerprintAnnotationsKey(c.ServerName, c.CertSubType, fingerprint)
+		}
+		return sshKnownHostsEntryKey(c.ServerName, c.CertSubType)
+	}
+	return c.ServerName
+}
+
+// Returns the SHA256 fingerprint of the key of a SSH known hosts entry, or an
+// empty string if the key cannot be parsed
+func sshCertificateFingerprint(c appsv1.RepositoryCertificate) string {
+	_, _, key, _, _, err := ssh.ParseKnownHosts([]byte(fmt.Sprintf("%s %s %s", c.ServerName, c.CertSubType, c.CertData)))
+	if err != nil {
+		return ""
+	}
+	return certutil.SSHFingerprintSHA256(key)
+}
+
+// Synchronizes the configured certificates of the given type (ssh or https)
+// with desired, so the store holds exactly the desired entries afterwards.
+// Existing entries are updated, keeping their annotations, and entries not in
+// desired are removed if prune is set. A new SSH key of a host which keeps
+// another key of the same type is pinned alongside it, as the new key of a
+// host key rotation. Nothing is changed on a dry run, but all desired entries
+// are validated and the results are reported as if the changes had been made.
+func SyncRepoCertificates(ctx context.Context, argoDB ArgoDB, certType string, desired []appsv1.RepositoryCertificate, prune bool, dryRun bool) ([]CertificateSyncResult, error) {
+	live, err := argoDB.ListRepoCertificates(ctx, &CertificateListSelector{CertType: certType})
+	if err != nil {
+		return nil, err
+	}
+	liveEntries := make(map[string]appsv1.RepositoryCertificate)
+	for _, c := range live.Items {
+		key := certificateEntryKey(c)
+		if existing, ok := liveEntries[key]; !ok {
+			liveEntries[key] = c
+		} else if c.CertType == "https" {
+			// The certificates of a server are kept in one entry
+			existing.CertData = []byte(string(existing.CertData) + "\n" + string(c.CertData))
+			liveEntries[key] = existing
+		}
+	}
+
+	results := make([]CertificateSyncResult, 0, len(desired))
+	changed := make([]appsv1.RepositoryCertificate, 0)
+	desiredKeys := make(map[string]bool)
+	for _, c := range desired {
+		if c.CertType != certType {
+			return nil, fmt.Errorf("Certificate for '%s' is of type '%s', expected '%s'", c.ServerName, c.CertType, certType)
+		}
+		key := certificateEntryKey(c)
+		if desiredKeys[key] {
+			return nil, fmt.Errorf("Duplicate %s certificate entry for '%s'", certType, key)
+		}
+		desiredKeys[key] = true
+	}
+
+	// Live SSH keys which are replaced by a new key of the same type, rather
+	// than kept alongside it
+	replacedKeys := make(map[string]bool)
+	// SSH keys which are pinned after the sync, by host and key type
+	pinned := make(map[string][]string)
+	for _, c := range live.Items {
+		if key := certificateEntryKey(c); c.CertType == "ssh" && desiredKeys[key] {
+			hostKey := sshKnownHostsEntryKey(c.ServerName, c.CertSubType)
+			pinned[hostKey] = append(pinned[hostKey], "SHA256:"+sshCertificateFingerprint(c))
+		}
+	}
+	now := time.Now()
+	for _, c := range desired {
+		key := certificateEntryKey(c)
+		existing, exists := liveEntries[key]
+		if exists {
+			c.Annotations = existing.Annotations
+		} else if c.CertType == "ssh" {
+			hostKey := sshKnownHostsEntryKey(c.ServerName, c.CertSubType)
+			if len(pinned[hostKey]) > 0 {
+				fingerprints := append([]string{}, pinned[hostKey]...)
+				sort.Strings(fingerprints)
+				c.Annotations = map[string]string{
+					certutil.CertificateAnnotationRekeyReplaces:  strings.Join(fingerprints, ","),
+					certutil.CertificateAnnotationRekeyStartedAt: now.UTC().Format(time.RFC3339),
+				}
+			} else {
+				// The key replaces the first live key of the same type which
+				// is not desired, as the store does on upsert
+				for _, l := range live.Items {
+					if l.ServerName == c.ServerName && l.CertSubType == c.CertSubType && !replacedKeys[certificateEntryKey(l)] {
+						replacedKeys[certificateEntryKey(l)] = true
+						exists = true
+						break
+					}
+				}
+			}
+			pinned[hostKey] = append(pinned[hostKey], "SHA256:"+sshCertificateFingerprint(c))
+		}
+		wouldCreate, err := argoDB.ValidateRepoCertificate(ctx, &appsv1.RepositoryCertificateList{Items: []appsv1.RepositoryCertificate{c}}, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s certificate entry for '%s': %v", certType, key, err)
+		}
+		action := "unchanged"
+		if len(wouldCreate.Items) > 0 {
+			action = "created"
+			if exists {
+				action = "updated"
+			}
+			changed = append(changed, c)
+		}
+		results = append(results, CertificateSyncResult{Action: action, Certificate: c})
+	}
+
+	if len(changed) > 0 && !dryRun {
+		if _, err := argoDB.CreateRepoCertificate(ctx, &appsv1.RepositoryCertificateList{Items: changed}, true); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range live.Items {
+		key := certificateEntryKey(c)
+		if desiredKeys[key] || replacedKeys[key] {
+			continue
+		}
+		// Entries of multiple TLS certificates are reported and removed once
+		desiredKeys[key] = true
+		c = liveEntries[key]
+		if !prune {
+			results = append(results, CertificateSyncResult{Action: "needs-pruning", Certificate: c})
+			continue
+		}
+		if !dryRun {
+			selector := &CertificateListSelector{
+				HostNamePattern: certutil.EscapeHostNamePattern(c.ServerName),
+				CertType:        certType,
+				CertSubType:     c.CertSubType,
+			}
+			if certType == "ssh" {
+				// Other keys of the same type may be kept
+				selector.Fingerprint = sshCertificateFingerprint(c)
+			}
+			_, err := argoDB.RemoveRepoCertificates(ctx, selector)
+			if err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, CertificateSyncResult{Action: "pruned", Certificate: c})
+	}
+
+	return results, nil
 }
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,6 +12,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/util/cert"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
@@ -350,6 +352,22 @@ func Test_ListCertificate(t *testing.T) {
 	assert.Equal(t, 1, len(certList.Items))
 	assert.Equal(t, "gitlab.com", certList.Items[0].ServerName)
 	assert.Equal(t, "https", certList.Items[0].CertType)
+
+	// TLS certificates carry their validity period, SSH known hosts entries don't
+	x509Cert, err := certutil.DecodePEMCertificateToX509(string(certList.Items[0].CertData))
+	assert.NoError(t, err)
+	if assert.NotNil(t, certList.Items[0].NotBefore) && assert.NotNil(t, certList.Items[0].NotAfter) {
+		assert.True(t, x509Cert.NotBefore.Equal(certList.Items[0].NotBefore.Time))
+		assert.True(t, x509Cert.NotAfter.Equal(certList.Items[0].NotAfter.Time))
+	}
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{
+		HostNamePattern: "gitlab.com",
+		CertType:        "ssh",
+	})
+	assert.Nil(t, err)
+	for _, entry := range certList.Items {
+		assert.Nil(t, entry.NotAfter)
+	}
 }
 
 func Test_CreateSSHKnownHostEntries(t *testing.T) {
@@ -734,3 +752,510 @@ func Test_RemoveTLSCertificates(t *testing.T) {
 	assert.Equal(t, 0, len(certList.Items))
 
 }
+
+func Test_CertificateAnnotations(t *testing.T) {
+	clientset := getCertClientset()
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+	assert.NotNil(t, db)
+
+	annotations := map[string]string{"rotate-by": "2020-01-01"}
+
+	// New TLS certificate with annotations
+	// Expected: Annotations are returned for each PEM entry
+	certList, err := db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{
+			{
+				ServerName:  "foo.example.com",
+				CertType:    "https",
+				CertData:    []byte(Test_TLSValidSingleCert),
+				Annotations: annotations,
+			},
+		},
+	}, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(certList.Items))
+
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{
+		HostNamePattern: "foo.example.com",
+		CertType:        "https",
+	})
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, len(certList.Items)) {
+		assert.Equal(t, annotations, certList.Items[0].Annotations)
+	}
+
+	// Other certificates are not annotated
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{
+		HostNamePattern: "gitlab.com",
+	})
+	assert.Nil(t, err)
+	for _, entry := range certList.Items {
+		assert.Nil(t, entry.Annotations)
+	}
+
+	// Same data but different annotations without upsert
+	// Expected: Error
+	_, err = db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{
+			{
+				ServerName:  "foo.example.com",
+				CertType:    "https",
+				CertData:    []byte(Test_TLSValidSingleCert),
+				Annotations: map[string]string{"rotate-by": "2021-01-01"},
+			},
+		},
+	}, false)
+	assert.NotNil(t, err)
+
+	// Annotate existing SSH known hosts entry using upsert
+	// Expected: Only the entry with the matching sub type is annotated
+	certList, err = db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{
+			{
+				ServerName:  "gitlab.com",
+				CertType:    "ssh",
+				CertSubType: "ssh-ed25519",
+				CertData:    []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"),
+				Annotations: annotations,
+			},
+		},
+	}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(certList.Items))
+
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{
+		HostNamePattern: "gitlab.com",
+		CertType:        "ssh",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(certList.Items))
+	for _, entry := range certList.Items {
+		if entry.CertSubType == "ssh-ed25519" {
+			assert.Equal(t, annotations, entry.Annotations)
+		} else {
+			assert.Nil(t, entry.Annotations)
+		}
+	}
+
+	// Same certificates again without annotations
+	// Expected: No error, nothing changed and the annotations are kept
+	sshEntry := v1alpha1.RepositoryCertificate{
+		ServerName:  "gitlab.com",
+		CertType:    "ssh",
+		CertSubType: "ssh-ed25519",
+		CertData:    []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"),
+	}
+	certList, err = db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{
+			{
+				ServerName: "foo.example.com",
+				CertType:   "https",
+				CertData:   []byte(Test_TLSValidSingleCert),
+			},
+			sshEntry,
+		},
+	}, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(certList.Items))
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{
+		HostNamePattern: "*.com",
+	})
+	assert.Nil(t, err)
+	for _, entry := range certList.Items {
+		if entry.ServerName == "foo.example.com" || entry.CertSubType == "ssh-ed25519" {
+			assert.Equal(t, annotations, entry.Annotations)
+		}
+	}
+
+	// Upsert without annotations of an entry with a pending host key rotation
+	// Expected: The annotations of the rotation are removed, others are kept
+	rekeyEntry := sshEntry
+	rekeyEntry.Annotations = map[string]string{"rotate-by": "2020-01-01", "rekey-started-at": "2020-01-01T00:00:00Z", "rekey-replaces": "SHA256:foo"}
+	_, err = db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{Items: []v1alpha1.RepositoryCertificate{rekeyEntry}}, true)
+	assert.Nil(t, err)
+	certList, err = db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{Items: []v1alpha1.RepositoryCertificate{sshEntry}}, true)
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, len(certList.Items)) {
+		assert.Equal(t, annotations, certList.Items[0].Annotations)
+	}
+
+	// Removing the certificates also removes their annotations, which are
+	// returned with the removed certificates so they can be restored
+	certList, err = db.RemoveRepoCertificates(context.Background(), &CertificateListSelector{
+		HostNamePattern: "*",
+		CertType:        "*",
+	})
+	assert.Nil(t, err)
+	for _, entry := range certList.Items {
+		if entry.ServerName == "foo.example.com" || entry.CertSubType == "ssh-ed25519" {
+			assert.Equal(t, annotations, entry.Annotations, entry.ServerName)
+		} else {
+			assert.Nil(t, entry.Annotations, entry.ServerName)
+		}
+	}
+	for _, name := range []string{"argocd-tls-certs-cm", "argocd-ssh-known-hosts-cm"} {
+		cm, err := clientset.CoreV1().ConfigMaps(testNamespace).Get(name, metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.NotContains(t, cm.Annotations, "argocd.argoproj.io/certificate-annotations")
+	}
+}
+
+func Test_CertificatesOfBothTypesForHost(t *testing.T) {
+	// gitlab.com has SSH known hosts entries as well as a TLS certificate
+	// configured.
+	clientset := getCertClientset()
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	certList, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "gitlab.com"})
+	assert.Nil(t, err)
+	types := make(map[string]int)
+	for _, c := range certList.Items {
+		types[c.CertType]++
+	}
+	assert.Equal(t, map[string]int{"ssh": 3, "https": 1}, types)
+
+	// A sub type only applies to SSH known hosts entries
+	certList, err = db.RemoveRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "gitlab.com", CertSubType: "ssh-ed25519"})
+	assert.Nil(t, err)
+	if assert.Len(t, certList.Items, 1) {
+		assert.Equal(t, "ssh", certList.Items[0].CertType)
+	}
+
+	// Removing by type leaves the other type alone
+	certList, err = db.RemoveRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "gitlab.com", CertType: "https"})
+	assert.Nil(t, err)
+	if assert.Len(t, certList.Items, 1) {
+		assert.Equal(t, "https", certList.Items[0].CertType)
+	}
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "gitlab.com"})
+	assert.Nil(t, err)
+	assert.Len(t, certList.Items, 2)
+	for _, c := range certList.Items {
+		assert.Equal(t, "ssh", c.CertType)
+	}
+}
+
+func Test_CreateIdenticalCertificates(t *testing.T) {
+	clientset := getCertClientset()
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+	sshKey := "AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"
+	certificates := &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{
+			{ServerName: "foo.example.com", CertType: "https", CertData: []byte(Test_TLSValidSingleCert)},
+			{ServerName: "foo.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(sshKey)},
+		},
+	}
+	certList, err := db.CreateRepoCertificate(context.Background(), certificates, false)
+	assert.Nil(t, err)
+	assert.Len(t, certList.Items, 2)
+
+	// Submitting the same certificates again, even with differently formatted
+	// data, is a no-op and no error without upsert
+	resubmitted := &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{
+			{ServerName: "foo.example.com", CertType: "https", CertData: []byte("\n\n" + Test_TLSValidSingleCert + "\n\n")},
+			{ServerName: "foo.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(sshKey + "  ")},
+		},
+	}
+	for _, request := range []*v1alpha1.RepositoryCertificateList{certificates, resubmitted} {
+		certList, err = db.CreateRepoCertificate(context.Background(), request, false)
+		assert.Nil(t, err)
+		assert.Len(t, certList.Items, 0)
+	}
+
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "foo.example.com"})
+	assert.Nil(t, err)
+	if assert.Len(t, certList.Items, 2) {
+		for _, c := range certList.Items {
+			if c.CertType == "https" {
+				assert.Equal(t, strings.TrimSpace(Test_TLSValidSingleCert), strings.TrimSpace(string(c.CertData)))
+			} else {
+				assert.Equal(t, sshKey, string(c.CertData))
+			}
+		}
+	}
+}
+
+func Test_CreateSSHKnownHostsEntryDuringRekey(t *testing.T) {
+	clientset := getCertClientset()
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+	oldKey := v1alpha1.RepositoryCertificate{ServerName: "foo.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"), Annotations: map[string]string{"comment": "old"}}
+	_, key, err := certutil.TokenizedDataToPublicKey(oldKey.ServerName, oldKey.CertSubType, string(oldKey.CertData))
+	assert.Nil(t, err)
+	oldFingerprint := "SHA256:" + certutil.SSHFingerprintSHA256(key)
+	newKey := v1alpha1.RepositoryCertificate{ServerName: "foo.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAICalOEEnLR6jXHuLey9aSiFKJ4x4MAsugYpirpcAnpA2"), Annotations: map[string]string{
+		certutil.CertificateAnnotationRekeyReplaces: oldFingerprint,
+	}}
+	_, err = db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{Items: []v1alpha1.RepositoryCertificate{oldKey}}, false)
+	assert.Nil(t, err)
+
+	// A different key of the same type is a conflict, unless it is the new
+	// key of a host key rotation
+	_, err = db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{Items: []v1alpha1.RepositoryCertificate{{ServerName: newKey.ServerName, CertType: "ssh", CertSubType: newKey.CertSubType, CertData: newKey.CertData}}}, false)
+	assert.NotNil(t, err)
+	certList, err := db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{Items: []v1alpha1.RepositoryCertificate{newKey}}, false)
+	assert.Nil(t, err)
+	assert.Len(t, certList.Items, 1)
+
+	// Both entries keep their own annotations
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "foo.example.com"})
+	assert.Nil(t, err)
+	if assert.Len(t, certList.Items, 2) {
+		assert.Equal(t, oldKey.Annotations, certList.Items[0].Annotations)
+		assert.Equal(t, newKey.Annotations, certList.Items[1].Annotations)
+	}
+
+	// The old entry is removed by its fingerprint
+	removed, err := db.RemoveRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "foo.example.com", CertType: "ssh", Fingerprint: oldFingerprint})
+	assert.Nil(t, err)
+	assert.Len(t, removed.Items, 1)
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "foo.example.com"})
+	assert.Nil(t, err)
+	if assert.Len(t, certList.Items, 1) {
+		assert.Equal(t, string(newKey.CertData), string(certList.Items[0].CertData))
+		assert.Equal(t, newKey.Annotations, certList.Items[0].Annotations)
+	}
+}
+
+func Test_ValidateRepoCertificate(t *testing.T) {
+	clientset := getCertClientset()
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+	sshKey := "AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"
+	certificates := &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{
+			{ServerName: "foo.example.com", CertType: "https", CertData: []byte(Test_TLSValidSingleCert)},
+			{ServerName: "foo.example.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(sshKey)},
+		},
+	}
+
+	// New entries are reported, but not persisted
+	certList, err := db.ValidateRepoCertificate(context.Background(), certificates, false)
+	assert.Nil(t, err)
+	assert.Len(t, certList.Items, 2)
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "foo.example.com"})
+	assert.Nil(t, err)
+	assert.Len(t, certList.Items, 0)
+
+	// Invalid data is refused
+	_, err = db.ValidateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{{ServerName: "foo.example.com", CertType: "https", CertData: []byte(Test_TLSInvalidPEMData)}},
+	}, false)
+	assert.NotNil(t, err)
+
+	// Conflicts with existing entries are detected
+	_, err = db.CreateRepoCertificate(context.Background(), certificates, false)
+	assert.Nil(t, err)
+	certList, err = db.ValidateRepoCertificate(context.Background(), certificates, false)
+	assert.Nil(t, err)
+	assert.Len(t, certList.Items, 0)
+	changed := &v1alpha1.RepositoryCertificateList{
+		Items: []v1alpha1.RepositoryCertificate{{ServerName: "foo.example.com", CertType: "https", CertData: []byte(Test_TLSValidMultiCert)}},
+	}
+	_, err = db.ValidateRepoCertificate(context.Background(), changed, false)
+	assert.NotNil(t, err)
+	certList, err = db.ValidateRepoCertificate(context.Background(), changed, true)
+	assert.Nil(t, err)
+	assert.Len(t, certList.Items, 2)
+
+	// The upsert above has not been persisted either
+	certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "foo.example.com", CertType: "https"})
+	assert.Nil(t, err)
+	if assert.Len(t, certList.Items, 1) {
+		assert.Equal(t, strings.TrimSpace(Test_TLSValidSingleCert), strings.TrimSpace(string(certList.Items[0].CertData)))
+	}
+}
+
+func Test_RemoveCertificatesByFingerprint(t *testing.T) {
+	clientset := getCertClientset()
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	t.Run("TLS", func(t *testing.T) {
+		certList, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "test.example.com", CertType: "https"})
+		assert.Nil(t, err)
+		if !assert.Len(t, certList.Items, 2) {
+			return
+		}
+		x509Cert, err := certutil.DecodePEMCertificateToX509(string(certList.Items[0].CertData))
+		assert.Nil(t, err)
+		fingerprint := "SHA256:" + certutil.TLSCertificateFingerprintSHA256(x509Cert)
+
+		// Listing by fingerprint only returns the matching certificate
+		listed, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "test.example.com", Fingerprint: fingerprint})
+		assert.Nil(t, err)
+		assert.Len(t, listed.Items, 1)
+
+		removed, err := db.RemoveRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "test.example.com", CertType: "https", Fingerprint: fingerprint})
+		assert.Nil(t, err)
+		assert.Len(t, removed.Items, 1)
+
+		// The other certificate of the server is kept
+		remaining, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "test.example.com", CertType: "https"})
+		assert.Nil(t, err)
+		if assert.Len(t, remaining.Items, 1) {
+			assert.Equal(t, strings.TrimSpace(string(certList.Items[1].CertData)), strings.TrimSpace(string(remaining.Items[0].CertData)))
+		}
+	})
+
+	t.Run("SSH", func(t *testing.T) {
+		_, key, err := certutil.TokenizedDataToPublicKey("gitlab.com", "ssh-ed25519", "AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf")
+		assert.Nil(t, err)
+		removed, err := db.RemoveRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "gitlab.com", CertType: "ssh", Fingerprint: certutil.SSHFingerprintSHA256(key)})
+		assert.Nil(t, err)
+		if assert.Len(t, removed.Items, 1) {
+			assert.Equal(t, "ssh-ed25519", removed.Items[0].CertSubType)
+		}
+		remaining, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "gitlab.com", CertType: "ssh"})
+		assert.Nil(t, err)
+		assert.Len(t, remaining.Items, 2)
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		removed, err := db.RemoveRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "*", Fingerprint: "SHA256:doesnotexist"})
+		assert.Nil(t, err)
+		assert.Len(t, removed.Items, 0)
+		all, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{})
+		assert.Nil(t, err)
+		assert.Len(t, all.Items, Test_NumSSHKnownHostsExpected+Test_NumTLSCertificatesExpected-2)
+	})
+}
+
+func Test_SyncRepoCertificates(t *testing.T) {
+	sshKey := "AAAAC3NzaC1lZDI1NTE5AAAAIAfuCHKVTjquxvt6CM6tdG4SLp1Btn/nOeHHE5UOzRdf"
+	desiredTLS := []v1alpha1.RepositoryCertificate{
+		// Unchanged, same certificates in different format
+		{ServerName: "test.example.com", CertType: "https", CertData: []byte("\n" + Test_TLSValidMultiCert)},
+		// Updated, was the single cert before
+		{ServerName: "gitlab.com", CertType: "https", CertData: []byte(Test_TLSValidMultiCert)},
+		// Created
+		{ServerName: "new.example.com", CertType: "https", CertData: []byte(Test_TLSValidSingleCert)},
+	}
+	actions := func(results []CertificateSyncResult) map[string]string {
+		byName := make(map[string]string)
+		for _, r := range results {
+			byName[certificateEntryKey(r.Certificate)] = r.Action
+		}
+		return byName
+	}
+
+	t.Run("DryRun", func(t *testing.T) {
+		clientset := getCertClientset()
+		db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+		results, err := SyncRepoCertificates(context.Background(), db, "https", desiredTLS[1:], true, true)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"gitlab.com": "updated", "new.example.com": "created", "test.example.com": "pruned"}, actions(results))
+		certList, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{CertType: "https"})
+		assert.Nil(t, err)
+		assert.Len(t, certList.Items, Test_NumTLSCertificatesExpected)
+	})
+
+	t.Run("TLS", func(t *testing.T) {
+		clientset := getCertClientset()
+		db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+		_, err := db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{
+			Items: []v1alpha1.RepositoryCertificate{{ServerName: "old.example.com", CertType: "https", CertData: []byte(Test_TLSValidSingleCert)}},
+		}, false)
+		assert.Nil(t, err)
+
+		// Without prune, additional entries are only reported
+		results, err := SyncRepoCertificates(context.Background(), db, "https", desiredTLS, false, false)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"gitlab.com": "updated", "new.example.com": "created", "test.example.com": "unchanged", "old.example.com": "needs-pruning"}, actions(results))
+		certList, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{CertType: "https"})
+		assert.Nil(t, err)
+		assert.Len(t, certList.Items, 6)
+
+		results, err = SyncRepoCertificates(context.Background(), db, "https", desiredTLS, true, false)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"gitlab.com": "unchanged", "new.example.com": "unchanged", "test.example.com": "unchanged", "old.example.com": "pruned"}, actions(results))
+		certList, err = db.ListRepoCertificates(context.Background(), &CertificateListSelector{CertType: "https"})
+		assert.Nil(t, err)
+		assert.Len(t, certList.Items, 5)
+	})
+
+	t.Run("SSHKeepsAnnotations", func(t *testing.T) {
+		clientset := getCertClientset()
+		db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+		_, err := db.CreateRepoCertificate(context.Background(), &v1alpha1.RepositoryCertificateList{
+			Items: []v1alpha1.RepositoryCertificate{{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(sshKey), Annotations: map[string]string{"rotate-by": "2020-01-01"}}},
+		}, true)
+		assert.Nil(t, err)
+
+		desired := []v1alpha1.RepositoryCertificate{{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(sshKey)}}
+		results, err := SyncRepoCertificates(context.Background(), db, "ssh", desired, true, false)
+		assert.Nil(t, err)
+		assert.Len(t, results, Test_NumSSHKnownHostsExpected)
+		assert.Equal(t, "unchanged", actions(results)[certificateEntryKey(desired[0])])
+
+		certList, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{CertType: "ssh"})
+		assert.Nil(t, err)
+		if assert.Len(t, certList.Items, 1) {
+			assert.Equal(t, map[string]string{"rotate-by": "2020-01-01"}, certList.Items[0].Annotations)
+		}
+	})
+
+	t.Run("SSHHostKeyRotation", func(t *testing.T) {
+		clientset := getCertClientset()
+		db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+		oldKey := v1alpha1.RepositoryCertificate{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte(sshKey)}
+		newKey := v1alpha1.RepositoryCertificate{ServerName: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519", CertData: []byte("AAAAC3NzaC1lZDI1NTE5AAAAICalOEEnLR6jXHuLey9aSiFKJ4x4MAsugYpirpcAnpA2")}
+		ed25519Keys := func() []v1alpha1.RepositoryCertificate {
+			certList, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{HostNamePattern: "gitlab.com", CertType: "ssh", CertSubType: "ssh-ed25519"})
+			assert.Nil(t, err)
+			return certList.Items
+		}
+
+		// The new key is pinned alongside the old key of the same type
+		results, err := SyncRepoCertificates(context.Background(), db, "ssh", []v1alpha1.RepositoryCertificate{oldKey, newKey}, false, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "unchanged", actions(results)[certificateEntryKey(oldKey)])
+		assert.Equal(t, "created", actions(results)[certificateEntryKey(newKey)])
+		keys := ed25519Keys()
+		if assert.Len(t, keys, 2) {
+			assert.Equal(t, oldKey.CertData, keys[0].CertData)
+			assert.Equal(t, newKey.CertData, keys[1].CertData)
+			assert.Equal(t, "SHA256:"+sshCertificateFingerprint(oldKey), keys[1].Annotations["rekey-replaces"])
+		}
+
+		// Syncing the same file again changes nothing
+		results, err = SyncRepoCertificates(context.Background(), db, "ssh", []v1alpha1.RepositoryCertificate{oldKey, newKey}, false, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "unchanged", actions(results)[certificateEntryKey(oldKey)])
+		assert.Equal(t, "unchanged", actions(results)[certificateEntryKey(newKey)])
+		assert.Len(t, ed25519Keys(), 2)
+
+		// Once the old key is gone from the file, it is pruned and the new
+		// key is kept
+		results, err = SyncRepoCertificates(context.Background(), db, "ssh", []v1alpha1.RepositoryCertificate{newKey}, true, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "pruned", actions(results)[certificateEntryKey(oldKey)])
+		assert.Equal(t, "unchanged", actions(results)[certificateEntryKey(newKey)])
+		keys = ed25519Keys()
+		if assert.Len(t, keys, 1) {
+			assert.Equal(t, newKey.CertData, keys[0].CertData)
+		}
+
+		// A key which replaces the only key of its type is an update
+		results, err = SyncRepoCertificates(context.Background(), db, "ssh", []v1alpha1.RepositoryCertificate{oldKey}, true, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "updated", actions(results)[certificateEntryKey(oldKey)])
+		assert.NotContains(t, actions(results), certificateEntryKey(newKey))
+		keys = ed25519Keys()
+		if assert.Len(t, keys, 1) {
+			assert.Equal(t, oldKey.CertData, keys[0].CertData)
+		}
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		clientset := getCertClientset()
+		db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+		_, err := SyncRepoCertificates(context.Background(), db, "https", []v1alpha1.RepositoryCertificate{
+			desiredTLS[2],
+			{ServerName: "bad.example.com", CertType: "https", CertData: []byte(Test_TLSInvalidPEMData)},
+		}, true, false)
+		assert.NotNil(t, err)
+		// Nothing has been changed
+		certList, err := db.ListRepoCertificates(context.Background(), &CertificateListSelector{CertType: "https"})
+		assert.Nil(t, err)
+		assert.Len(t, certList.Items, Test_NumTLSCertificatesExpected)
+	})
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net/url"
+	"strconv"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -256,6 +258,12 @@ func clusterToData(c *appv1.Cluster) map[string][]byte {
 		panic(err)
 	}
 	data["config"] = configBytes
+	if c.Shard != nil {
+		data["shard"] = []byte(strconv.FormatInt(*c.Shard, 10))
+	}
+	if len(c.Namespaces) > 0 {
+		data["namespaces"] = []byte(strings.Join(c.Namespaces, ","))
+	}
 	return data
 }
 
@@ -266,10 +274,26 @@ func secretToCluster(s *apiv1.Secret) *appv1.Cluster {
 	if err != nil {
 		panic(err)
 	}
+	var shard *int64
+	if shardStr, ok := s.Data["shard"]; ok {
+		if value, err := strconv.ParseInt(string(shardStr), 10, 64); err == nil {
+			shard = &value
+		} else {
+			log.Warnf("Invalid shard %q of cluster secret %s: %v", string(shardStr), s.Name, err)
+		}
+	}
+	var namespaces []string
+	for _, ns := range strings.Split(string(s.Data["namespaces"]), ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
 	cluster := appv1.Cluster{
-		Server: string(s.Data["server"]),
-		Name:   string(s.Data["name"]),
-		Config: config,
+		Server:     string(s.Data["server"]),
+		Name:       string(s.Data["name"]),
+		Config:     config,
+		Shard:      shard,
+		Namespaces: namespaces,
 	}
 	return &cluster
 }
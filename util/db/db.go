@@ -35,6 +35,19 @@ type ArgoDB interface {
 	// DeleteRepository updates a repository
 	DeleteRepository(ctx context.Context, name string) error
 
+	// ListRepositoryCredentials lists URLs of credential templates
+	ListRepositoryCredentials(ctx context.Context) ([]string, error)
+	// GetRepositoryCredentials returns a credential template by URL
+	GetRepositoryCredentials(ctx context.Context, name string) (*appv1.RepoCreds, error)
+	// CreateRepositoryCredentials creates a credential template
+	CreateRepositoryCredentials(ctx context.Context, r *appv1.RepoCreds) (*appv1.RepoCreds, error)
+	// UpdateRepositoryCredentials updates a credential template
+	UpdateRepositoryCredentials(ctx context.Context, r *appv1.RepoCreds) (*appv1.RepoCreds, error)
+	// DeleteRepositoryCredentials deletes a credential template by URL
+	DeleteRepositoryCredentials(ctx context.Context, name string) error
+	// GetSubmoduleCredentials returns whether the submodules of a repository are initialized, and the credential templates used for them
+	GetSubmoduleCredentials(ctx context.Context, repo *appv1.Repository) (bool, []*appv1.RepoCreds, error)
+
 	// ListHelmRepoURLs lists configured helm repositories
 	ListHelmRepos(ctx context.Context) ([]*appv1.HelmRepository, error)
 
@@ -42,8 +55,19 @@ type ArgoDB interface {
 	ListRepoCertificates(ctx context.Context, selector *CertificateListSelector) (*appv1.RepositoryCertificateList, error)
 	// CreateRepoCertificate creates a new certificate entry
 	CreateRepoCertificate(ctx context.Context, certificate *appv1.RepositoryCertificateList, upsert bool) (*appv1.RepositoryCertificateList, error)
+	// ValidateRepoCertificate validates certificate entries like CreateRepoCertificate, without persisting them
+	ValidateRepoCertificate(ctx context.Context, certificate *appv1.RepositoryCertificateList, upsert bool) (*appv1.RepositoryCertificateList, error)
 	// CreateRepoCertificate creates a new certificate entry
 	RemoveRepoCertificates(ctx context.Context, selector *CertificateListSelector) (*appv1.RepositoryCertificateList, error)
+
+	// ListGPGPublicKeys lists all configured GnuPG public keys
+	ListGPGPublicKeys(ctx context.Context) (*appv1.GnuPGPublicKeyList, error)
+	// GetGPGPublicKey returns a GnuPG public key by its key ID
+	GetGPGPublicKey(ctx context.Context, keyID string) (*appv1.GnuPGPublicKey, error)
+	// AddGPGPublicKeys adds the GnuPG public keys contained in ASCII armored key data
+	AddGPGPublicKeys(ctx context.Context, keyData string, upsert bool) (*appv1.GnuPGPublicKeyList, error)
+	// DeleteGPGPublicKey deletes a GnuPG public key by its key ID
+	DeleteGPGPublicKey(ctx context.Context, keyID string) error
 }
 
 type db struct {
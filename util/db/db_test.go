@@ -258,6 +258,189 @@ func TestUpdateRepositoryWithManagedSecrets(t *testing.T) {
 	assert.Equal(t, "- url: https://github.com/argoproj/argocd-example-apps", strings.Trim(cm.Data["repositories"], "\n"))
 }
 
+func TestCreateRepositoryCredentials(t *testing.T) {
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	creds, err := db.CreateRepositoryCredentials(context.Background(), &v1alpha1.RepoCreds{
+		URL:      "https://github.com/argoproj",
+		Username: "test-username",
+		Password: "test-password",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.com/argoproj", creds.URL)
+
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get(credsURLToSecretName(creds.URL), metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, common.AnnotationValueManagedByArgoCD, secret.Annotations[common.AnnotationKeyManagedBy])
+	assert.Equal(t, "test-username", string(secret.Data[username]))
+	assert.Equal(t, "test-password", string(secret.Data[password]))
+
+	_, err = db.CreateRepositoryCredentials(context.Background(), &v1alpha1.RepoCreds{URL: "https://github.com/argoproj/"})
+	assert.Equal(t, codes.AlreadyExists, status.Convert(err).Code())
+
+	urls, err := db.ListRepositoryCredentials(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://github.com/argoproj"}, urls)
+}
+
+func TestGetRepositoryCredentials(t *testing.T) {
+	config := map[string]string{
+		"repositories": `
+- url: https://github.com/argoproj/argo-cd
+  usernameSecret:
+    name: unmanaged-secret
+    key: username
+  passwordSecret:
+    name: unmanaged-secret
+    key: password
+`,
+		"repository.credentials": `
+- url: https://github.com
+- url: https://github.com/argoproj
+  usernameSecret:
+    name: managed-secret
+    key: username
+  passwordSecret:
+    name: managed-secret
+    key: password
+`}
+	clientset := getClientset(config, newManagedSecret(), &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unmanaged-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			username: []byte("own-username"),
+			password: []byte("own-password"),
+		},
+	})
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	creds, err := db.GetRepositoryCredentials(context.Background(), "https://github.com/argoproj")
+	assert.NoError(t, err)
+	assert.Equal(t, &v1alpha1.RepoCreds{URL: "https://github.com/argoproj", Username: "test-username", Password: "test-password"}, creds)
+
+	_, err = db.GetRepositoryCredentials(context.Background(), "https://github.com/argoproj/argo-cd")
+	assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+	// The most specific template is inherited by repositories without
+	// credentials of their own
+	repo, err := db.GetRepository(context.Background(), "https://github.com/argoproj/argocd-example-apps")
+	assert.NoError(t, err)
+	assert.Equal(t, "test-username", repo.Username)
+	assert.Equal(t, "test-password", repo.Password)
+
+	repo, err = db.GetRepository(context.Background(), "https://github.com/argoproj/argo-cd")
+	assert.NoError(t, err)
+	assert.Equal(t, "own-username", repo.Username)
+	assert.Equal(t, "own-password", repo.Password)
+}
+
+func TestGetSubmoduleCredentials(t *testing.T) {
+	config := map[string]string{
+		"repositories": `
+- url: https://github.com/argoproj/argo-cd
+  enableSubmodules: true
+- url: https://github.com/argoproj/argocd-example-apps
+`,
+		"repository.credentials": `
+- url: https://github.com/argoproj
+  usernameSecret:
+    name: managed-secret
+    key: username
+  passwordSecret:
+    name: managed-secret
+    key: password
+`}
+	clientset := getClientset(config, newManagedSecret())
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	repo, err := db.GetRepository(context.Background(), "https://github.com/argoproj/argo-cd")
+	assert.NoError(t, err)
+	assert.True(t, repo.EnableSubmodules)
+	enabled, creds, err := db.GetSubmoduleCredentials(context.Background(), repo)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Equal(t, []*v1alpha1.RepoCreds{{URL: "https://github.com/argoproj", Username: "test-username", Password: "test-password"}}, creds)
+
+	repo, err = db.GetRepository(context.Background(), "https://github.com/argoproj/argocd-example-apps")
+	assert.NoError(t, err)
+	enabled, creds, err = db.GetSubmoduleCredentials(context.Background(), repo)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+	assert.Nil(t, creds)
+
+	// The global setting enables submodules for all Git repositories
+	config["submodules.enabled"] = "true"
+	clientset = getClientset(config, newManagedSecret())
+	db = NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+	enabled, _, err = db.GetSubmoduleCredentials(context.Background(), repo)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	enabled, _, err = db.GetSubmoduleCredentials(context.Background(), &v1alpha1.Repository{Repo: "https://charts.example.com", Type: v1alpha1.RepositoryTypeHelm})
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+}
+
+func TestUpdateRepositoryCredentials(t *testing.T) {
+	config := map[string]string{
+		"repository.credentials": `
+- url: https://github.com/argoproj
+  usernameSecret:
+    name: managed-secret
+    key: username
+  passwordSecret:
+    name: managed-secret
+    key: password
+`}
+	clientset := getClientset(config, newManagedSecret())
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	_, err := db.UpdateRepositoryCredentials(context.Background(), &v1alpha1.RepoCreds{
+		URL:      "https://github.com/argoproj",
+		Username: "other-username",
+		Password: "other-password",
+	})
+	assert.NoError(t, err)
+
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get("managed-secret", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "other-username", string(secret.Data[username]))
+	assert.Equal(t, "other-password", string(secret.Data[password]))
+
+	_, err = db.UpdateRepositoryCredentials(context.Background(), &v1alpha1.RepoCreds{URL: "https://gitlab.com"})
+	assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+}
+
+func TestDeleteRepositoryCredentials(t *testing.T) {
+	config := map[string]string{
+		"repository.credentials": `
+- url: https://github.com/argoproj
+  usernameSecret:
+    name: managed-secret
+    key: username
+  passwordSecret:
+    name: managed-secret
+    key: password
+`}
+	clientset := getClientset(config, newManagedSecret())
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	err := db.DeleteRepositoryCredentials(context.Background(), "https://github.com/argoproj")
+	assert.NoError(t, err)
+
+	_, err = clientset.CoreV1().Secrets(testNamespace).Get("managed-secret", metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+
+	cm, err := clientset.CoreV1().ConfigMaps(testNamespace).Get("argocd-cm", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "", cm.Data["repository.credentials"])
+
+	err = db.DeleteRepositoryCredentials(context.Background(), "https://github.com/argoproj")
+	assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+}
+
 func TestGetClusterSuccessful(t *testing.T) {
 	clusterURL := "https://mycluster"
 	clientset := getClientset(nil, &v1.Secret{
@@ -280,6 +463,54 @@ func TestGetClusterSuccessful(t *testing.T) {
 	assert.Equal(t, clusterURL, cluster.Server)
 }
 
+func TestClusterShard(t *testing.T) {
+	clusterURL := "https://mycluster"
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	shard := int64(2)
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: clusterURL,
+		Shard:  &shard,
+	})
+	assert.Nil(t, err)
+
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get("cluster-mycluster-3274446258", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", string(secret.Data["shard"]))
+
+	cluster := secretToCluster(secret)
+	if assert.NotNil(t, cluster.Shard) {
+		assert.Equal(t, int64(2), *cluster.Shard)
+	}
+
+	secret.Data["shard"] = []byte("invalid")
+	assert.Nil(t, secretToCluster(secret).Shard)
+}
+
+func TestClusterNamespaces(t *testing.T) {
+	clusterURL := "https://mycluster"
+	clientset := getClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server:     clusterURL,
+		Namespaces: []string{"ns1", "ns2"},
+	})
+	assert.Nil(t, err)
+
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get("cluster-mycluster-3274446258", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "ns1,ns2", string(secret.Data["namespaces"]))
+
+	cluster, err := db.GetCluster(context.Background(), clusterURL)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns1", "ns2"}, cluster.Namespaces)
+
+	delete(secret.Data, "namespaces")
+	assert.Nil(t, secretToCluster(secret).Namespaces)
+}
+
 func TestGetNonExistingCluster(t *testing.T) {
 	clusterURL := "https://mycluster"
 	clientset := getClientset(nil)
@@ -442,3 +673,40 @@ func TestListHelmRepositories(t *testing.T) {
 	assert.Equal(t, []byte("test-cert"), repo.CertData)
 	assert.Equal(t, []byte("test-key"), repo.KeyData)
 }
+
+func TestListHelmRepositories_HelmTypeRepositories(t *testing.T) {
+	config := map[string]string{
+		"repositories": `
+- url: https://github.com/argoproj/argocd-example-apps
+- url: https://kubernetes-charts.storage.googleapis.com
+  type: helm
+  name: stable
+- url: https://charts.example.com
+  type: helm
+  name: private
+  usernameSecret:
+    name: test-secret
+    key: username
+  passwordSecret:
+    name: test-secret
+    key: password
+`}
+	clientset := getClientset(config, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("test-username"),
+			"password": []byte("test-password"),
+		},
+	})
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	repos, err := db.ListHelmRepos(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []*v1alpha1.HelmRepository{
+		{URL: "https://kubernetes-charts.storage.googleapis.com", Name: "stable"},
+		{URL: "https://charts.example.com", Name: "private", Username: "test-username", Password: "test-password"},
+	}, repos)
+}
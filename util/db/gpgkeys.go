@@ -0,0 +1,113 @@
+package db
+
+import (
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-cd/common"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/gpg"
+)
+
+// Converts a parsed public key to its API representation
+func toGnuPGPublicKey(key *gpg.PublicKey) *appsv1.GnuPGPublicKey {
+	return &appsv1.GnuPGPublicKey{
+		KeyID:       key.KeyID,
+		Fingerprint: key.Fingerprint,
+		Owner:       key.Owner,
+		SubType:     key.SubType,
+		KeyData:     key.KeyData,
+	}
+}
+
+// Get the GnuPG public key data from the ConfigMap, keyed by key ID
+func (db *db) getGPGPublicKeyData() (map[string]string, error) {
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	if err != nil {
+		// The ConfigMap is created when the first key is added
+		if apierr.IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	keys := make(map[string]string)
+	for keyID, keyData := range keysCM.Data {
+		keys[keyID] = keyData
+	}
+	return keys, nil
+}
+
+// ListGPGPublicKeys returns all configured GnuPG public keys
+func (db *db) ListGPGPublicKeys(ctx context.Context) (*appsv1.GnuPGPublicKeyList, error) {
+	keyData, err := db.getGPGPublicKeyData()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]appsv1.GnuPGPublicKey, 0)
+	for keyID, data := range keyData {
+		parsed, err := gpg.ParsePublicKeys(data)
+		if err != nil || len(parsed) != 1 || parsed[0].KeyID != keyID {
+			log.Warnf("Ignoring invalid GnuPG public key %s in ConfigMap %s", keyID, common.ArgoCDGPGKeysConfigMapName)
+			continue
+		}
+		keys = append(keys, *toGnuPGPublicKey(parsed[0]))
+	}
+	return &appsv1.GnuPGPublicKeyList{Items: keys}, nil
+}
+
+// GetGPGPublicKey returns the GnuPG public key with the given key ID
+func (db *db) GetGPGPublicKey(ctx context.Context, keyID string) (*appsv1.GnuPGPublicKey, error) {
+	keys, err := db.ListGPGPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range keys.Items {
+		if keys.Items[i].KeyID == keyID {
+			return &keys.Items[i], nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "GnuPG public key '%s' not found", keyID)
+}
+
+// AddGPGPublicKeys adds the public keys contained in the given ASCII armored
+// data. Keys that are already configured with different data are only
+// replaced if upsert is true.
+func (db *db) AddGPGPublicKeys(ctx context.Context, keyData string, upsert bool) (*appsv1.GnuPGPublicKeyList, error) {
+	parsed, err := gpg.ParsePublicKeys(keyData)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	configured, err := db.getGPGPublicKeyData()
+	if err != nil {
+		return nil, err
+	}
+	added := make([]appsv1.GnuPGPublicKey, 0)
+	for _, key := range parsed {
+		if existing, ok := configured[key.KeyID]; ok && existing != key.KeyData && !upsert {
+			return nil, status.Errorf(codes.InvalidArgument, "GnuPG public key '%s' already exists with different data; use upsert flag to force update", key.KeyID)
+		}
+		configured[key.KeyID] = key.KeyData
+		added = append(added, *toGnuPGPublicKey(key))
+	}
+	err = db.settingsMgr.SaveGPGPublicKeyData(ctx, configured)
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.GnuPGPublicKeyList{Items: added}, nil
+}
+
+// DeleteGPGPublicKey deletes the GnuPG public key with the given key ID
+func (db *db) DeleteGPGPublicKey(ctx context.Context, keyID string) error {
+	configured, err := db.getGPGPublicKeyData()
+	if err != nil {
+		return err
+	}
+	if _, ok := configured[keyID]; !ok {
+		return status.Errorf(codes.NotFound, "GnuPG public key '%s' not found", keyID)
+	}
+	delete(configured, keyID)
+	return db.settingsMgr.SaveGPGPublicKeyData(ctx, configured)
+}
@@ -0,0 +1,127 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/gpg"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func getGPGKeysClientset(keys map[string]string) *fake.Clientset {
+	objects := []runtime.Object{&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	}}
+	if keys != nil {
+		objects = append(objects, &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDGPGKeysConfigMapName,
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/part-of": "argocd",
+				},
+			},
+			Data: keys,
+		})
+	}
+	return fake.NewSimpleClientset(objects...)
+}
+
+func newTestPublicKey(t *testing.T, name string) *gpg.PublicKey {
+	entity, err := openpgp.NewEntity(name, "", name+"@example.com", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.SerializePrivate(ioutil.Discard, nil))
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.Serialize(w))
+	assert.NoError(t, w.Close())
+	keys, err := gpg.ParsePublicKeys(buf.String())
+	assert.NoError(t, err)
+	return keys[0]
+}
+
+func Test_GPGPublicKeys(t *testing.T) {
+	alice := newTestPublicKey(t, "alice")
+	bob := newTestPublicKey(t, "bob")
+	clientset := getGPGKeysClientset(nil)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	// Without the ConfigMap, no keys are configured
+	keys, err := db.ListGPGPublicKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, keys.Items, 0)
+
+	added, err := db.AddGPGPublicKeys(context.Background(), alice.KeyData+bob.KeyData, false)
+	assert.NoError(t, err)
+	assert.Len(t, added.Items, 2)
+
+	keys, err = db.ListGPGPublicKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, keys.Items, 2)
+
+	key, err := db.GetGPGPublicKey(context.Background(), alice.KeyID)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice <alice@example.com>", key.Owner)
+	assert.Equal(t, alice.Fingerprint, key.Fingerprint)
+
+	// Adding the same key again is idempotent
+	_, err = db.AddGPGPublicKeys(context.Background(), alice.KeyData, false)
+	assert.NoError(t, err)
+
+	_, err = db.AddGPGPublicKeys(context.Background(), "invalid", false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = db.DeleteGPGPublicKey(context.Background(), bob.KeyID)
+	assert.NoError(t, err)
+	_, err = db.GetGPGPublicKey(context.Background(), bob.KeyID)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	err = db.DeleteGPGPublicKey(context.Background(), bob.KeyID)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	keys, err = db.ListGPGPublicKeys(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, keys.Items, 1) {
+		assert.Equal(t, alice.KeyID, keys.Items[0].KeyID)
+	}
+}
+
+func Test_AddGPGPublicKeysUpsert(t *testing.T) {
+	alice := newTestPublicKey(t, "alice")
+	bob := newTestPublicKey(t, "bob")
+	clientset := getGPGKeysClientset(map[string]string{alice.KeyID: bob.KeyData})
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	// Entries whose data does not match their key ID are ignored
+	keys, err := db.ListGPGPublicKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, keys.Items, 0)
+
+	_, err = db.AddGPGPublicKeys(context.Background(), alice.KeyData, false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = db.AddGPGPublicKeys(context.Background(), alice.KeyData, true)
+	assert.NoError(t, err)
+	key, err := db.GetGPGPublicKey(context.Background(), alice.KeyID)
+	assert.NoError(t, err)
+	assert.Equal(t, alice.KeyData, key.KeyData)
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/helm"
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
@@ -64,5 +65,27 @@ func (db *db) ListHelmRepos(ctx context.Context) ([]*appv1.HelmRepository, error
 		}
 		repos[i] = repo
 	}
+
+	// Helm chart repositories which are configured as repositories are also available by their name,
+	// except for OCI registries, which have no index
+	repositories, err := db.settingsMgr.GetRepositories()
+	if err != nil {
+		return nil, err
+	}
+	for _, repoInfo := range repositories {
+		if repoInfo.Type != appv1.RepositoryTypeHelm || repoInfo.Name == "" || helm.IsOCIURL(repoInfo.URL) || getHelmRepoCredIndex(helmRepositories, repoInfo.URL) >= 0 {
+			continue
+		}
+		repo, err := db.GetRepository(ctx, repoInfo.URL)
+		if err != nil {
+			return nil, err
+		}
+		helmRepo := &appv1.HelmRepository{URL: repo.Repo, Name: repo.Name, Username: repo.Username, Password: repo.Password}
+		if repo.TLSClientCertData != "" && repo.TLSClientCertKey != "" {
+			helmRepo.CertData = []byte(repo.TLSClientCertData)
+			helmRepo.KeyData = []byte(repo.TLSClientCertKey)
+		}
+		repos = append(repos, helmRepo)
+	}
 	return repos, nil
 }
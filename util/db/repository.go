@@ -74,8 +74,12 @@ func (db *db) CreateRepository(ctx context.Context, r *appsv1.Repository) (*apps
 		InsecureIgnoreHostKey: r.IsInsecure(),
 		Insecure:              r.IsInsecure(),
 		EnableLFS:             r.EnableLFS,
+		Type:                  r.Type,
+		Name:                  r.Name,
+		Proxy:                 r.Proxy,
+		EnableSubmodules:      r.EnableSubmodules,
 	}
-	err = db.updateSecrets(&repoInfo, r)
+	err = db.updateSecrets(&repoInfo, r, repoURLToSecretName(r.Repo))
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +136,10 @@ func (db *db) credentialsToRepository(repoInfo settings.RepoCredentials) (*appsv
 		InsecureIgnoreHostKey: repoInfo.InsecureIgnoreHostKey,
 		Insecure:              repoInfo.Insecure,
 		EnableLFS:             repoInfo.EnableLFS,
+		Type:                  repoInfo.Type,
+		Name:                  repoInfo.Name,
+		Proxy:                 repoInfo.Proxy,
+		EnableSubmodules:      repoInfo.EnableSubmodules,
 	}
 	err := db.unmarshalFromSecretsStr(map[*string]*apiv1.SecretKeySelector{
 		&repo.Username:          repoInfo.UsernameSecret,
@@ -157,7 +165,7 @@ func (db *db) UpdateRepository(ctx context.Context, r *appsv1.Repository) (*apps
 	}
 
 	repoInfo := repos[index]
-	err = db.updateSecrets(&repoInfo, r)
+	err = db.updateSecrets(&repoInfo, r, repoURLToSecretName(r.Repo))
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +174,10 @@ func (db *db) UpdateRepository(ctx context.Context, r *appsv1.Repository) (*apps
 	repoInfo.InsecureIgnoreHostKey = r.IsInsecure()
 	repoInfo.Insecure = r.IsInsecure()
 	repoInfo.EnableLFS = r.EnableLFS
+	repoInfo.Type = r.Type
+	repoInfo.Name = r.Name
+	repoInfo.Proxy = r.Proxy
+	repoInfo.EnableSubmodules = r.EnableSubmodules
 
 	repos[index] = repoInfo
 	err = db.settingsMgr.SaveRepositories(repos)
@@ -192,7 +204,7 @@ func (db *db) DeleteRepository(ctx context.Context, repoURL string) error {
 		Username:          "",
 		TLSClientCertData: "",
 		TLSClientCertKey:  "",
-	})
+	}, "")
 	if err != nil {
 		return err
 	}
@@ -200,13 +212,177 @@ func (db *db) DeleteRepository(ctx context.Context, repoURL string) error {
 	return db.settingsMgr.SaveRepositories(repos)
 }
 
-func (db *db) updateSecrets(repoInfo *settings.RepoCredentials, r *appsv1.Repository) error {
+// ListRepositoryCredentials returns the URLs of all credential templates
+func (db *db) ListRepositoryCredentials(ctx context.Context) ([]string, error) {
+	repoCredentials, err := db.settingsMgr.GetRepositoryCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]string, len(repoCredentials))
+	for i := range repoCredentials {
+		urls[i] = repoCredentials[i].URL
+	}
+	return urls, nil
+}
+
+// GetRepositoryCredentials returns the credential template with the given URL
+func (db *db) GetRepositoryCredentials(ctx context.Context, url string) (*appsv1.RepoCreds, error) {
+	repoCredentials, err := db.settingsMgr.GetRepositoryCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	index := getRepositoryCredentialsIndexByURL(repoCredentials, url)
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "repository credentials '%s' not found", url)
+	}
+	repo, err := db.credentialsToRepository(repoCredentials[index])
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.RepoCreds{
+		URL:               repo.Repo,
+		Username:          repo.Username,
+		Password:          repo.Password,
+		SSHPrivateKey:     repo.SSHPrivateKey,
+		TLSClientCertData: repo.TLSClientCertData,
+		TLSClientCertKey:  repo.TLSClientCertKey,
+	}, nil
+}
+
+// CreateRepositoryCredentials creates a credential template
+func (db *db) CreateRepositoryCredentials(ctx context.Context, c *appsv1.RepoCreds) (*appsv1.RepoCreds, error) {
+	repoCredentials, err := db.settingsMgr.GetRepositoryCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	index := getRepositoryCredentialsIndexByURL(repoCredentials, c.URL)
+	if index > -1 {
+		return nil, status.Errorf(codes.AlreadyExists, "repository credentials '%s' already exists", c.URL)
+	}
+
+	repoInfo := settings.RepoCredentials{URL: c.URL}
+	err = db.updateSecrets(&repoInfo, repoCredsToRepository(c), credsURLToSecretName(c.URL))
+	if err != nil {
+		return nil, err
+	}
+
+	repoCredentials = append(repoCredentials, repoInfo)
+	err = db.settingsMgr.SaveRepositoryCredentials(repoCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UpdateRepositoryCredentials updates a credential template
+func (db *db) UpdateRepositoryCredentials(ctx context.Context, c *appsv1.RepoCreds) (*appsv1.RepoCreds, error) {
+	repoCredentials, err := db.settingsMgr.GetRepositoryCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	index := getRepositoryCredentialsIndexByURL(repoCredentials, c.URL)
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "repository credentials '%s' not found", c.URL)
+	}
+
+	repoInfo := repoCredentials[index]
+	err = db.updateSecrets(&repoInfo, repoCredsToRepository(c), credsURLToSecretName(c.URL))
+	if err != nil {
+		return nil, err
+	}
+
+	repoCredentials[index] = repoInfo
+	err = db.settingsMgr.SaveRepositoryCredentials(repoCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DeleteRepositoryCredentials deletes a credential template
+func (db *db) DeleteRepositoryCredentials(ctx context.Context, url string) error {
+	repoCredentials, err := db.settingsMgr.GetRepositoryCredentials()
+	if err != nil {
+		return err
+	}
+
+	index := getRepositoryCredentialsIndexByURL(repoCredentials, url)
+	if index < 0 {
+		return status.Errorf(codes.NotFound, "repository credentials '%s' not found", url)
+	}
+	err = db.updateSecrets(&repoCredentials[index], &appsv1.Repository{}, "")
+	if err != nil {
+		return err
+	}
+	repoCredentials = append(repoCredentials[:index], repoCredentials[index+1:]...)
+	return db.settingsMgr.SaveRepositoryCredentials(repoCredentials)
+}
+
+// GetSubmoduleCredentials returns whether the submodules of the repository are initialized on
+// checkout, either because the repository or the global setting enables it, and the credential
+// templates to use for the submodule URLs
+func (db *db) GetSubmoduleCredentials(ctx context.Context, repo *appsv1.Repository) (bool, []*appsv1.RepoCreds, error) {
+	if repo.IsHelm() {
+		return false, nil, nil
+	}
+	enabled := repo.EnableSubmodules
+	if !enabled {
+		var err error
+		enabled, err = db.settingsMgr.GetSubmodulesEnabled()
+		if err != nil {
+			return false, nil, err
+		}
+	}
+	if !enabled {
+		return false, nil, nil
+	}
+	repoCredentials, err := db.settingsMgr.GetRepositoryCredentials()
+	if err != nil {
+		return false, nil, err
+	}
+	creds := make([]*appsv1.RepoCreds, 0)
+	for _, repoCredential := range repoCredentials {
+		credential, err := db.credentialsToRepository(repoCredential)
+		if err != nil {
+			return false, nil, err
+		}
+		creds = append(creds, &appsv1.RepoCreds{
+			URL:               credential.Repo,
+			Username:          credential.Username,
+			Password:          credential.Password,
+			SSHPrivateKey:     credential.SSHPrivateKey,
+			TLSClientCertData: credential.TLSClientCertData,
+			TLSClientCertKey:  credential.TLSClientCertKey,
+		})
+	}
+	return true, creds, nil
+}
+
+func repoCredsToRepository(c *appsv1.RepoCreds) *appsv1.Repository {
+	return &appsv1.Repository{
+		Repo:              c.URL,
+		Username:          c.Username,
+		Password:          c.Password,
+		SSHPrivateKey:     c.SSHPrivateKey,
+		TLSClientCertData: c.TLSClientCertData,
+		TLSClientCertKey:  c.TLSClientCertKey,
+	}
+}
+
+// updateSecrets stores the credentials of r in the secrets referenced by
+// repoInfo. Credentials which are not referenced yet are stored in a new
+// secret named secretName.
+func (db *db) updateSecrets(repoInfo *settings.RepoCredentials, r *appsv1.Repository, secretName string) error {
 	secretsData := make(map[string]map[string][]byte)
 
 	setSecretData := func(secretKey *apiv1.SecretKeySelector, value string, defaultKeyName string) *apiv1.SecretKeySelector {
 		if secretKey == nil && value != "" {
 			secretKey = &apiv1.SecretKeySelector{
-				LocalObjectReference: apiv1.LocalObjectReference{Name: repoURLToSecretName(r.Repo)},
+				LocalObjectReference: apiv1.LocalObjectReference{Name: secretName},
 				Key:                  defaultKeyName,
 			}
 		}
@@ -300,11 +476,26 @@ func getRepositoryIndex(repos []settings.RepoCredentials, repoURL string) int {
 	return -1
 }
 
+// getRepositoryCredentialIndex returns the index of the credential template
+// matching repoURL. If several templates match, the one with the longest URL
+// is used, so more specific templates take precedence.
 func getRepositoryCredentialIndex(repoCredentials []settings.RepoCredentials, repoURL string) int {
 	repoURL = git.NormalizeGitURL(repoURL)
+	index, length := -1, -1
 	for i, cred := range repoCredentials {
 		credUrl := git.NormalizeGitURL(cred.URL)
-		if strings.HasPrefix(repoURL, credUrl) {
+		if strings.HasPrefix(repoURL, credUrl) && len(credUrl) > length {
+			index, length = i, len(credUrl)
+		}
+	}
+	return index
+}
+
+// getRepositoryCredentialsIndexByURL returns the index of the credential
+// template with exactly the given URL
+func getRepositoryCredentialsIndexByURL(repoCredentials []settings.RepoCredentials, url string) int {
+	for i, cred := range repoCredentials {
+		if strings.TrimSuffix(cred.URL, "/") == strings.TrimSuffix(url, "/") {
 			return i
 		}
 	}
@@ -316,10 +507,20 @@ func getRepositoryCredentialIndex(repoCredentials []settings.RepoCredentials, re
 // NOTE: this formula should not be considered stable and may change in future releases.
 // Do NOT rely on this formula as a means of secret lookup, only secret creation.
 func repoURLToSecretName(repo string) string {
+	return urlToSecretName("repo", repo)
+}
+
+// credsURLToSecretName hashes the URL of a credential template to a secret name,
+// the same way repoURLToSecretName does for repositories.
+func credsURLToSecretName(url string) string {
+	return urlToSecretName("creds", url)
+}
+
+func urlToSecretName(prefix string, repo string) string {
 	h := fnv.New32a()
 	_, _ = h.Write([]byte(repo))
 	// Part of the original repo name is incorporated into the secret name for debugging purposes
 	parts := strings.Split(strings.TrimSuffix(repo, ".git"), "/")
 	shortName := strings.ToLower(strings.Replace(parts[len(parts)-1], "_", "-", -1))
-	return fmt.Sprintf("repo-%s-%v", shortName, h.Sum32())
+	return fmt.Sprintf("%s-%s-%v", prefix, shortName, h.Sum32())
 }
@@ -3,6 +3,8 @@ package db
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/argoproj/argo-cd/util/settings"
 )
 
@@ -23,8 +25,12 @@ func TestRepoURLToSecretName(t *testing.T) {
 	}
 }
 
+func TestCredsURLToSecretName(t *testing.T) {
+	assert.Equal(t, "creds-argoproj-2785452965", credsURLToSecretName("https://github.com/argoproj"))
+}
+
 func Test_getRepositoryCredentialIndex(t *testing.T) {
-	repositoryCredentials := []settings.RepoCredentials{{URL: "http://known"}}
+	repositoryCredentials := []settings.RepoCredentials{{URL: "http://known"}, {URL: "http://known/org"}, {URL: "http://other"}}
 	tests := []struct {
 		name    string
 		repoURL string
@@ -32,6 +38,7 @@ func Test_getRepositoryCredentialIndex(t *testing.T) {
 	}{
 		{"TestNotFound", "", -1},
 		{"TestFoundFound", "http://known/repo", 0},
+		{"TestLongestPrefix", "http://known/org/repo", 1},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3,10 +3,12 @@ package git
 import (
 	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -33,23 +35,49 @@ type RevisionMetadata struct {
 	Message string
 }
 
+// Submodule is a submodule of the checked out revision
+type Submodule struct {
+	// Path of the submodule, relative to the root of the repository
+	Path string
+	// URL of the submodule, with relative URLs resolved against the URL of the repository
+	URL string
+}
+
 // Client is a generic git client interface
 type Client interface {
 	Root() string
 	Init() error
-	Fetch() error
+	Fetch(revision string) error
 	Checkout(revision string) error
+	SparseCheckout(revision string, paths []string) error
 	LsRemote(revision string) (string, error)
 	LsFiles(path string) ([]string, error)
 	LsLargeFiles() ([]string, error)
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
+	CatFile(revision string) (string, string, error)
+	Submodules() ([]Submodule, error)
+	UpdateSubmodule(path string, creds Creds) error
+	Worktree(path string, revision string) (Client, error)
+}
+
+// FetchOptions control how much of the history and the content of repositories is fetched
+type FetchOptions struct {
+	// Number of commits of the history to fetch, or 0 to fetch the full history
+	Depth int
+	// Whether to omit the content of files until it is needed, if the server supports partial clones
+	PartialClone bool
+}
+
+// Returns whether only the commit of the revision to check out is fetched
+func (o FetchOptions) isRevisionSpecific() bool {
+	return o.Depth > 0 || o.PartialClone
 }
 
 // ClientFactory is a factory of Git Clients
 // Primarily used to support creation of mock git clients during unit testing
 type ClientFactory interface {
-	NewClient(rawRepoURL string, path string, creds Creds, insecure bool, enableLfs bool) (Client, error)
+	NewClient(rawRepoURL string, path string, creds Creds, insecure bool, enableLfs bool, proxy string) (Client, error)
 }
 
 // nativeGitClient implements Client interface using git CLI
@@ -64,21 +92,37 @@ type nativeGitClient struct {
 	insecure bool
 	// Whether the repository is LFS enabled
 	enableLfs bool
+	// URL of the HTTP(S) proxy used to access the repository, if any
+	proxy string
+	// Options of fetches from the repository
+	fetchOptions FetchOptions
 }
 
-type factory struct{}
+// Escapes the characters which have a special meaning in sparse checkout patterns
+var sparseCheckoutPatternEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`)
+
+type factory struct {
+	fetchOptions FetchOptions
+}
 
 func NewFactory() ClientFactory {
 	return &factory{}
 }
 
-func (f *factory) NewClient(rawRepoURL string, path string, creds Creds, insecure bool, enableLfs bool) (Client, error) {
+// NewFactoryWithFetchOptions returns a factory of clients which fetch repositories with the given options
+func NewFactoryWithFetchOptions(fetchOptions FetchOptions) ClientFactory {
+	return &factory{fetchOptions: fetchOptions}
+}
+
+func (f *factory) NewClient(rawRepoURL string, path string, creds Creds, insecure bool, enableLfs bool, proxy string) (Client, error) {
 	client := nativeGitClient{
-		repoURL:   rawRepoURL,
-		root:      path,
-		creds:     creds,
-		insecure:  insecure,
-		enableLfs: enableLfs,
+		repoURL:      rawRepoURL,
+		root:         path,
+		creds:        creds,
+		insecure:     insecure,
+		enableLfs:    enableLfs,
+		proxy:        proxy,
+		fetchOptions: f.fetchOptions,
 	}
 	return &client, nil
 }
@@ -91,9 +135,13 @@ func (f *factory) NewClient(rawRepoURL string, path string, creds Creds, insecur
 //   a client with those certificates in the list of root CAs used to verify
 //   the server's certificate.
 // - Otherwise (and on non-fatal errors), a default HTTP client is returned.
-func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds) *http.Client {
+// If proxy is set, the client uses it instead of the proxy configured by the environment.
+func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds, proxy string) *http.Client {
 	// Default HTTP client
 	var customHTTPClient *http.Client = &http.Client{}
+	if proxy != "" {
+		customHTTPClient.Transport = &http.Transport{Proxy: getProxyFunc(proxy)}
+	}
 
 	// Callback function to return any configured client certificate
 	// We never return err, but an empty cert instead.
@@ -123,6 +171,7 @@ func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds) *http.Client
 	if insecure {
 		customHTTPClient = &http.Client{
 			Transport: &http.Transport{
+				Proxy: getProxyFunc(proxy),
 				TLSClientConfig: &tls.Config{
 					InsecureSkipVerify:   true,
 					GetClientCertificate: clientCertFunc,
@@ -148,6 +197,7 @@ func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds) *http.Client
 			certPool := certutil.GetCertPoolFromPEMData(serverCertificatePem)
 			customHTTPClient = &http.Client{
 				Transport: &http.Transport{
+					Proxy: getProxyFunc(proxy),
 					TLSClientConfig: &tls.Config{
 						RootCAs:              certPool,
 						GetClientCertificate: clientCertFunc,
@@ -164,6 +214,7 @@ func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds) *http.Client
 			// else no custom certificate stored.
 			customHTTPClient = &http.Client{
 				Transport: &http.Transport{
+					Proxy: getProxyFunc(proxy),
 					TLSClientConfig: &tls.Config{
 						GetClientCertificate: clientCertFunc,
 					},
@@ -181,6 +232,17 @@ func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds) *http.Client
 	return customHTTPClient
 }
 
+// Returns a function which returns the proxy to use for a request: the given proxy if set, otherwise the
+// proxy configured by the environment
+func getProxyFunc(proxy string) func(*http.Request) (*url.URL, error) {
+	if proxy == "" {
+		return http.ProxyFromEnvironment
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		return url.Parse(proxy)
+	}
+}
+
 func newAuth(repoURL string, creds Creds) (transport.AuthMethod, error) {
 	switch creds := creds.(type) {
 	case SSHCreds:
@@ -242,22 +304,35 @@ func (m *nativeGitClient) IsLFSEnabled() bool {
 	return m.enableLfs
 }
 
-// Fetch fetches latest updates from origin
-func (m *nativeGitClient) Fetch() error {
-	_, err := m.runCredentialedCmd("git", "fetch", "origin", "--tags", "--force")
-	// When we have LFS support enabled, check for large files and fetch them too.
-	if err == nil && m.IsLFSEnabled() {
-		largeFiles, err := m.LsLargeFiles()
-		if err == nil && len(largeFiles) > 0 {
-			_, err = m.runCredentialedCmd("git", "lfs", "fetch", "--all")
-			if err != nil {
-				return err
-			}
+// Fetch fetches latest updates from origin. Shallow and partial fetches only fetch the commit of
+// the revision, if it is a commit SHA and the server allows fetching commits by SHA.
+func (m *nativeGitClient) Fetch(revision string) error {
+	if m.fetchOptions.isRevisionSpecific() && IsCommitSHA(revision) {
+		_, err := m.runCredentialedCmd("git", append([]string{"fetch", "origin", revision}, m.fetchFlags()...)...)
+		if err == nil {
+			return nil
 		}
+		log.Warnf("Failed to fetch revision %s of %s, fetching all refs instead: %v", revision, m.repoURL, err)
 	}
+	_, err := m.runCredentialedCmd("git", append([]string{"fetch", "origin", "--tags", "--force"}, m.fetchFlags()...)...)
 	return err
 }
 
+// Returns the flags of fetches limiting the fetched history and content
+func (m *nativeGitClient) fetchFlags() []string {
+	flags := make([]string, 0)
+	if m.fetchOptions.Depth > 0 {
+		flags = append(flags, fmt.Sprintf("--depth=%d", m.fetchOptions.Depth))
+	} else if _, err := os.Stat(filepath.Join(m.root, ".git", "shallow")); err == nil {
+		// The repository was fetched shallowly before the depth was removed
+		flags = append(flags, "--unshallow")
+	}
+	if m.fetchOptions.PartialClone {
+		flags = append(flags, "--filter=blob:none")
+	}
+	return flags
+}
+
 // LsFiles lists the local working tree, including only files that are under source control
 func (m *nativeGitClient) LsFiles(path string) ([]string, error) {
 	out, err := m.runCmd("ls-files", "--full-name", "-z", "--", path)
@@ -275,30 +350,71 @@ func (m *nativeGitClient) LsLargeFiles() ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The output is empty if the revision has no LFS references
+	if out == "" {
+		return []string{}, nil
+	}
 	ss := strings.Split(out, "\n")
 	return ss, nil
 }
 
 // Checkout checkout specified git sha
 func (m *nativeGitClient) Checkout(revision string) error {
+	return m.checkout(revision, nil)
+}
+
+// SparseCheckout checkouts specified git sha, restricting the working tree to the given paths and
+// their subdirectories
+func (m *nativeGitClient) SparseCheckout(revision string, paths []string) error {
+	return m.checkout(revision, paths)
+}
+
+func (m *nativeGitClient) checkout(revision string, paths []string) error {
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if _, err := m.runCmd("checkout", "--force", revision); err != nil {
+	sparseCheckoutFile := filepath.Join(m.root, ".git", "info", "sparse-checkout")
+	_, err := os.Stat(sparseCheckoutFile)
+	wasSparse := err == nil
+	if len(paths) > 0 || wasSparse {
+		if err := m.writeSparseCheckoutPatterns(sparseCheckoutFile, paths); err != nil {
+			return err
+		}
+	}
+	if _, err := m.runCheckoutCmd("checkout", "--force", revision); err != nil {
 		return err
 	}
-	// We must populate LFS content by using lfs checkout, if we have at least
-	// one LFS reference in the current revision.
+	if len(paths) > 0 || wasSparse {
+		// The checkout only applies the patterns to files that differ from the previous revision
+		if _, err := m.runCheckoutCmd("read-tree", "-mu", "HEAD"); err != nil {
+			return err
+		}
+	}
+	if len(paths) == 0 && wasSparse {
+		if _, err := m.runCmd("config", "core.sparseCheckout", "false"); err != nil {
+			return err
+		}
+		if err := os.Remove(sparseCheckoutFile); err != nil {
+			return err
+		}
+	}
+	// Since smudging is skipped by the checkout, we must populate LFS content
+	// by using lfs checkout, if we have at least one LFS reference in the
+	// current revision. The objects of the revision are fetched from the LFS
+	// endpoint of the remote first, using the credentials of the repository.
 	if m.IsLFSEnabled() {
-		if largeFiles, err := m.LsLargeFiles(); err == nil {
-			if len(largeFiles) > 0 {
-				if _, err := m.runCmd("lfs", "checkout"); err != nil {
-					return err
-				}
-			}
-		} else {
+		largeFiles, err := m.LsLargeFiles()
+		if err != nil {
 			return err
 		}
+		if len(largeFiles) > 0 {
+			if _, err := m.runCredentialedCmd("git", "lfs", "fetch", "origin", "HEAD"); err != nil {
+				return err
+			}
+			if _, err := m.runCmd("lfs", "checkout"); err != nil {
+				return err
+			}
+		}
 	}
 	if _, err := m.runCmd("clean", "-fdx"); err != nil {
 		return err
@@ -306,6 +422,33 @@ func (m *nativeGitClient) Checkout(revision string) error {
 	return nil
 }
 
+// Writes the sparse checkout patterns which include the given paths, or all files if there are none
+func (m *nativeGitClient) writeSparseCheckoutPatterns(sparseCheckoutFile string, paths []string) error {
+	patterns := make([]string, 0)
+	for _, path := range paths {
+		patterns = append(patterns, "/"+sparseCheckoutPatternEscaper.Replace(strings.Trim(filepath.ToSlash(path), "/")))
+	}
+	if len(patterns) == 0 {
+		patterns = append(patterns, "/*")
+	}
+	if _, err := m.runCmd("config", "core.sparseCheckout", "true"); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(sparseCheckoutFile), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(sparseCheckoutFile, []byte(strings.Join(patterns, "\n")+"\n"), 0644)
+}
+
+// runCheckoutCmd runs a git command which updates the working tree. The content of files omitted by
+// partial fetches is fetched by such commands, which requires the credentials of the repository.
+func (m *nativeGitClient) runCheckoutCmd(args ...string) (string, error) {
+	if m.fetchOptions.PartialClone {
+		return m.runCredentialedCmd("git", args...)
+	}
+	return m.runCmd(args...)
+}
+
 // LsRemote resolves the commit SHA of a specific branch, tag, or HEAD. If the supplied revision
 // does not resolve, and "looks" like a 7+ hexadecimal commit SHA, it return the revision string.
 // Otherwise, it returns an error indicating that the revision could not be resolved. This method
@@ -331,7 +474,7 @@ func (m *nativeGitClient) LsRemote(revision string) (string, error) {
 		return "", err
 	}
 	//refs, err := remote.List(&git.ListOptions{Auth: auth})
-	refs, err := listRemote(remote, &git.ListOptions{Auth: auth}, m.insecure, m.creds)
+	refs, err := listRemote(remote, &git.ListOptions{Auth: auth}, m.insecure, m.creds, m.proxy)
 	if err != nil {
 		return "", err
 	}
@@ -415,6 +558,96 @@ func (m *nativeGitClient) RevisionMetadata(revision string) (*RevisionMetadata,
 	return &RevisionMetadata{author, time.Unix(authorDateUnixTimestamp, 0), tags, message}, nil
 }
 
+// returns the type and the raw content of the object a revision refers to, e.g. a commit or an annotated tag
+func (m *nativeGitClient) CatFile(revision string) (string, string, error) {
+	// The batch output states the size of the object, so its content is returned exactly
+	cmd := exec.Command("git", "cat-file", "--batch")
+	cmd.Stdin = strings.NewReader(revision + "\n")
+	out, err := m.runCmdOutput(cmd)
+	if err != nil {
+		return "", "", err
+	}
+	header, content := out, ""
+	if i := strings.Index(out, "\n"); i >= 0 {
+		header, content = out[:i], out[i+1:]
+	}
+	fields := strings.Fields(header)
+	if len(fields) != 3 {
+		return "", "", fmt.Errorf("revision '%s' not found", revision)
+	}
+	size, err := strconv.Atoi(fields[2])
+	if err != nil || size > len(content) {
+		return "", "", fmt.Errorf("unexpected output of git cat-file: %s", header)
+	}
+	return fields[1], content[:size], nil
+}
+
+// Submodules initializes the submodules of the checked out revision and returns their paths and URLs
+func (m *nativeGitClient) Submodules() ([]Submodule, error) {
+	submodules := make([]Submodule, 0)
+	if _, err := os.Stat(filepath.Join(m.root, ".gitmodules")); os.IsNotExist(err) {
+		return submodules, nil
+	}
+	// The URLs of submodules that are already initialized may have changed in this revision
+	if _, err := m.runCmd("submodule", "sync", "--recursive"); err != nil {
+		return nil, err
+	}
+	// Initializing copies the URLs of the submodules to the config, resolving relative URLs
+	if _, err := m.runCmd("submodule", "init"); err != nil {
+		return nil, err
+	}
+	out, err := m.runCmd("config", "--file", ".gitmodules", "--list")
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(out, "\n") {
+		keyValue := strings.SplitN(line, "=", 2)
+		if len(keyValue) != 2 || !strings.HasPrefix(keyValue[0], "submodule.") || !strings.HasSuffix(keyValue[0], ".path") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(keyValue[0], "submodule."), ".path")
+		url, err := m.runCmd("config", "--get", fmt.Sprintf("submodule.%s.url", name))
+		if err != nil {
+			return nil, fmt.Errorf("submodule '%s' has no URL: %v", name, err)
+		}
+		submodules = append(submodules, Submodule{Path: keyValue[1], URL: strings.TrimSpace(url)})
+	}
+	return submodules, nil
+}
+
+// UpdateSubmodule checks out the submodule at the given path recursively, fetching it with the given
+// credentials. Nested submodules are fetched with the same credentials.
+func (m *nativeGitClient) UpdateSubmodule(path string, creds Creds) error {
+	_, err := m.runCmdWithCreds(creds, "git", "submodule", "update", "--init", "--recursive", "--force", "--", path)
+	return err
+}
+
+// Worktree returns a client of the linked worktree of the repository at the given path, which shares the objects of
+// the repository but has its own checkout. The worktree is added with the revision checked out if it does not exist,
+// or if it no longer belongs to the repository, e.g. since the repository was initialized again.
+func (m *nativeGitClient) Worktree(path string, revision string) (Client, error) {
+	worktree := *m
+	worktree.root = path
+	// The .git of a linked worktree is a file which refers to the repository
+	if info, err := os.Stat(filepath.Join(path, ".git")); err == nil && info.Mode().IsRegular() {
+		if _, err := worktree.runCmd("rev-parse", "--git-dir"); err == nil {
+			return &worktree, nil
+		}
+	}
+	log.Infof("Adding worktree of %s at %s", m.repoURL, path)
+	if err := os.RemoveAll(path); err != nil {
+		return nil, err
+	}
+	// Forget the worktrees whose directories were removed
+	if _, err := m.runCmd("worktree", "prune"); err != nil {
+		return nil, err
+	}
+	if _, err := m.runCheckoutCmd("worktree", "add", "--force", "--detach", path, revision); err != nil {
+		return nil, err
+	}
+	return &worktree, nil
+}
+
 // runCmd is a convenience function to run a command in a given directory and return its output
 func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 	cmd := exec.Command("git", args...)
@@ -423,8 +656,13 @@ func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 func (m *nativeGitClient) runCredentialedCmd(command string, args ...string) (string, error) {
+	return m.runCmdWithCreds(m.creds, command, args...)
+}
+
+// runCmdWithCreds runs a command with the given credentials instead of the ones of the repository
+func (m *nativeGitClient) runCmdWithCreds(creds Creds, command string, args ...string) (string, error) {
 	cmd := exec.Command(command, args...)
-	closer, environ, err := m.creds.Environ()
+	closer, environ, err := creds.Environ()
 	if err != nil {
 		return "", err
 	}
@@ -443,6 +681,11 @@ func (m *nativeGitClient) runCmdOutput(cmd *exec.Cmd) (string, error) {
 	// Skip LFS for most Git operations except when explicitly requested
 	cmd.Env = append(cmd.Env, "GIT_LFS_SKIP_SMUDGE=1")
 
+	// The proxy of the repository overrides the proxy configured by the environment
+	if m.proxy != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("http_proxy=%s", m.proxy), fmt.Sprintf("https_proxy=%s", m.proxy))
+	}
+
 	// For HTTPS repositories, we need to consider insecure repositories as well
 	// as custom CA bundles from the cert database.
 	if IsHTTPSURL(m.repoURL) {
@@ -78,8 +78,8 @@ func IsHTTPSURL(url string) bool {
 }
 
 // TestRepo tests if a repo exists and is accessible with the given credentials
-func TestRepo(repo string, creds Creds, insecure bool, enableLfs bool) error {
-	clnt, err := NewFactory().NewClient(repo, "", creds, insecure, enableLfs)
+func TestRepo(repo string, creds Creds, insecure bool, enableLfs bool, proxy string) error {
+	clnt, err := NewFactory().NewClient(repo, "", creds, insecure, enableLfs, proxy)
 	if err != nil {
 		return err
 	}
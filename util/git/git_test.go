@@ -5,7 +5,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -128,7 +130,7 @@ func TestCustomHTTPClient(t *testing.T) {
 
 	// Get HTTPSCreds with client cert creds specified, and insecure connection
 	creds := NewHTTPSCreds("test", "test", string(certData), string(keyData), false)
-	client := GetRepoHTTPClient("https://localhost:9443/foo/bar", false, creds)
+	client := GetRepoHTTPClient("https://localhost:9443/foo/bar", false, creds, "")
 	assert.NotNil(t, client)
 	assert.NotNil(t, client.Transport)
 	if client.Transport != nil {
@@ -151,7 +153,7 @@ func TestCustomHTTPClient(t *testing.T) {
 
 	// Get HTTPSCreds without client cert creds, but insecure connection
 	creds = NewHTTPSCreds("test", "test", "", "", true)
-	client = GetRepoHTTPClient("https://localhost:9443/foo/bar", true, creds)
+	client = GetRepoHTTPClient("https://localhost:9443/foo/bar", true, creds, "")
 	assert.NotNil(t, client)
 	assert.NotNil(t, client.Transport)
 	if client.Transport != nil {
@@ -171,10 +173,23 @@ func TestCustomHTTPClient(t *testing.T) {
 			}
 		}
 	}
+
+	// Get a client using the proxy of the repository
+	client = GetRepoHTTPClient("https://localhost:9443/foo/bar", true, creds, "http://proxy.example.com:3128")
+	assert.NotNil(t, client)
+	assert.NotNil(t, client.Transport)
+	if client.Transport != nil {
+		httpClient := client.Transport.(*http.Transport)
+		req, err := http.NewRequest(http.MethodGet, "https://localhost:9443/foo/bar", nil)
+		assert.NoError(t, err)
+		proxyURL, err := httpClient.Proxy(req)
+		assert.NoError(t, err)
+		assert.Equal(t, "http://proxy.example.com:3128", proxyURL.String())
+	}
 }
 
 func TestLsRemote(t *testing.T) {
-	clnt, err := NewFactory().NewClient("https://github.com/argoproj/argo-cd.git", "/tmp", NopCreds{}, false, false)
+	clnt, err := NewFactory().NewClient("https://github.com/argoproj/argo-cd.git", "/tmp", NopCreds{}, false, false, "")
 	assert.NoError(t, err)
 	xpass := []string{
 		"HEAD",
@@ -217,7 +232,7 @@ func TestLFSClient(t *testing.T) {
 		defer func() { _ = os.RemoveAll(tempDir) }()
 	}
 
-	client, err := NewFactory().NewClient("https://github.com/argoproj-labs/argocd-testrepo-lfs", tempDir, NopCreds{}, false, true)
+	client, err := NewFactory().NewClient("https://github.com/argoproj-labs/argocd-testrepo-lfs", tempDir, NopCreds{}, false, true, "")
 	assert.NoError(t, err)
 
 	commitSHA, err := client.LsRemote("HEAD")
@@ -227,7 +242,7 @@ func TestLFSClient(t *testing.T) {
 	err = client.Init()
 	assert.NoError(t, err)
 
-	err = client.Fetch()
+	err = client.Fetch(commitSHA)
 	assert.NoError(t, err)
 
 	err = client.Checkout(commitSHA)
@@ -276,7 +291,7 @@ func TestNewFactory(t *testing.T) {
 		assert.NoError(t, err)
 		defer func() { _ = os.RemoveAll(dirName) }()
 
-		client, err := NewFactory().NewClient(tt.args.url, dirName, NopCreds{}, tt.args.insecureIgnoreHostKey, false)
+		client, err := NewFactory().NewClient(tt.args.url, dirName, NopCreds{}, tt.args.insecureIgnoreHostKey, false, "")
 		assert.NoError(t, err)
 		commitSHA, err := client.LsRemote("HEAD")
 		assert.NoError(t, err)
@@ -284,11 +299,11 @@ func TestNewFactory(t *testing.T) {
 		err = client.Init()
 		assert.NoError(t, err)
 
-		err = client.Fetch()
+		err = client.Fetch(commitSHA)
 		assert.NoError(t, err)
 
 		// Do a second fetch to make sure we can treat `already up-to-date` error as not an error
-		err = client.Fetch()
+		err = client.Fetch(commitSHA)
 		assert.NoError(t, err)
 
 		err = client.Checkout(commitSHA)
@@ -308,3 +323,310 @@ func TestNewFactory(t *testing.T) {
 		assert.Equal(t, commitSHA, commitSHA2)
 	}
 }
+
+func TestCatFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-cat-file-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	runGit := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "HOME=/dev/null", "GIT_AUTHOR_NAME=Alice", "GIT_AUTHOR_EMAIL=alice@example.com", "GIT_COMMITTER_NAME=Alice", "GIT_COMMITTER_EMAIL=alice@example.com")
+		out, err := cmd.Output()
+		assert.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+	runGit("init")
+	runGit("commit", "--allow-empty", "-m", "Initial commit")
+	runGit("tag", "-a", "v1.0.0", "-m", "Release v1.0.0")
+	commitSHA := runGit("rev-parse", "HEAD")
+
+	client, err := NewFactory().NewClient("https://git.example.com/repo.git", dir, NopCreds{}, false, false, "")
+	assert.NoError(t, err)
+
+	objectType, content, err := client.CatFile(commitSHA)
+	assert.NoError(t, err)
+	assert.Equal(t, "commit", objectType)
+	assert.True(t, strings.HasPrefix(content, "tree "))
+	assert.True(t, strings.HasSuffix(content, "\n\nInitial commit\n"))
+
+	objectType, content, err = client.CatFile("refs/tags/v1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "tag", objectType)
+	assert.True(t, strings.HasPrefix(content, "object "+commitSHA+"\n"))
+
+	_, _, err = client.CatFile("refs/tags/v2.0.0")
+	assert.EqualError(t, err, "revision 'refs/tags/v2.0.0' not found")
+}
+
+// TestLFSCheckout tests the checkout of LFS content, using a script in place of git-lfs, which logs
+// its invocations and the credentials passed to it
+func TestLFSCheckout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-lfs-checkout-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	binDir := filepath.Join(dir, "bin")
+	repoDir := filepath.Join(dir, "repo")
+	logFile := filepath.Join(dir, "lfs.log")
+	assert.NoError(t, os.MkdirAll(binDir, 0755))
+	assert.NoError(t, os.MkdirAll(repoDir, 0755))
+	script := `#!/bin/sh
+echo "$* $GIT_USERNAME" >> ` + logFile + `
+case "$1" in
+  ls-files) echo manifest.yaml ;;
+  checkout) echo "kind: ConfigMap" > manifest.yaml ;;
+esac
+`
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(binDir, "git-lfs"), []byte(script), 0755))
+	defer func(path string) { _ = os.Setenv("PATH", path) }(os.Getenv("PATH"))
+	assert.NoError(t, os.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH")))
+
+	runGit := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		cmd.Env = append(os.Environ(), "HOME=/dev/null", "GIT_AUTHOR_NAME=Alice", "GIT_AUTHOR_EMAIL=alice@example.com", "GIT_COMMITTER_NAME=Alice", "GIT_COMMITTER_EMAIL=alice@example.com")
+		out, err := cmd.Output()
+		assert.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+	runGit("init")
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(repoDir, "manifest.yaml"), []byte("version https://git-lfs.github.com/spec/v1\n"), 0644))
+	runGit("add", "manifest.yaml")
+	runGit("commit", "-m", "Initial commit")
+	commitSHA := runGit("rev-parse", "HEAD")
+
+	client, err := NewFactory().NewClient("https://git.example.com/repo.git", repoDir, NewHTTPSCreds("alice", "secret", "", "", false), false, true, "")
+	assert.NoError(t, err)
+	assert.NoError(t, client.Checkout(commitSHA))
+
+	data, err := ioutil.ReadFile(filepath.Join(repoDir, "manifest.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap\n", string(data))
+	// The LFS objects are fetched with the credentials of the repository
+	data, err = ioutil.ReadFile(logFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "ls-files -n \nfetch origin HEAD alice\ncheckout \n", string(data))
+
+	// Without LFS support, pointer files are left as they are
+	assert.NoError(t, os.Remove(logFile))
+	client, err = NewFactory().NewClient("https://git.example.com/repo.git", repoDir, NopCreds{}, false, false, "")
+	assert.NoError(t, err)
+	assert.NoError(t, client.Checkout(commitSHA))
+	data, err = ioutil.ReadFile(filepath.Join(repoDir, "manifest.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "version https://git-lfs.github.com/spec/v1\n", string(data))
+	_, err = os.Stat(logFile)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestSubmodules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-submodules-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	// Local submodule URLs must be allowed explicitly by recent versions of Git
+	for key, value := range map[string]string{"GIT_CONFIG_COUNT": "1", "GIT_CONFIG_KEY_0": "protocol.file.allow", "GIT_CONFIG_VALUE_0": "always"} {
+		defer func(key, value string) { _ = os.Setenv(key, value) }(key, os.Getenv(key))
+		assert.NoError(t, os.Setenv(key, value))
+	}
+
+	runGit := func(repoDir string, args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		cmd.Env = append(os.Environ(), "HOME=/dev/null", "GIT_AUTHOR_NAME=Alice", "GIT_AUTHOR_EMAIL=alice@example.com", "GIT_COMMITTER_NAME=Alice", "GIT_COMMITTER_EMAIL=alice@example.com")
+		out, err := cmd.Output()
+		assert.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+	var commitSHA string
+	for _, name := range []string{"sub", "parent"} {
+		repoDir := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(repoDir, 0755))
+		runGit(repoDir, "init")
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(repoDir, name+".yaml"), []byte("kind: ConfigMap\n"), 0644))
+		runGit(repoDir, "add", name+".yaml")
+		if name == "parent" {
+			runGit(repoDir, "submodule", "add", "../sub", "vendor/sub")
+		}
+		runGit(repoDir, "commit", "-m", "Initial commit")
+		commitSHA = runGit(repoDir, "rev-parse", "HEAD")
+	}
+
+	client, err := NewFactory().NewClient(filepath.Join(dir, "parent"), filepath.Join(dir, "checkout"), NopCreds{}, false, false, "")
+	assert.NoError(t, err)
+	assert.NoError(t, client.Init())
+	assert.NoError(t, client.Fetch(commitSHA))
+	assert.NoError(t, client.Checkout(commitSHA))
+
+	submodules, err := client.Submodules()
+	assert.NoError(t, err)
+	// The relative URL is resolved against the URL of the repository
+	assert.Equal(t, []Submodule{{Path: "vendor/sub", URL: filepath.Join(dir, "sub")}}, submodules)
+	assert.NoError(t, client.UpdateSubmodule("vendor/sub", NopCreds{}))
+	_, err = os.Stat(filepath.Join(dir, "checkout", "vendor", "sub", "sub.yaml"))
+	assert.NoError(t, err)
+}
+
+func TestShallowPartialFetch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-shallow-fetch-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	serverDir := filepath.Join(dir, "server")
+	repoDir := filepath.Join(dir, "repo")
+	assert.NoError(t, os.MkdirAll(serverDir, 0755))
+
+	runGit := func(dir string, args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "HOME=/dev/null", "GIT_AUTHOR_NAME=Alice", "GIT_AUTHOR_EMAIL=alice@example.com", "GIT_COMMITTER_NAME=Alice", "GIT_COMMITTER_EMAIL=alice@example.com")
+		out, err := cmd.Output()
+		assert.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+	runGit(serverDir, "init")
+	runGit(serverDir, "config", "uploadpack.allowFilter", "true")
+	runGit(serverDir, "config", "uploadpack.allowAnySHA1InWant", "true")
+	var commitSHAs []string
+	for _, name := range []string{"a.yaml", "b.yaml", "c.yaml"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(serverDir, name), []byte("kind: ConfigMap\n"), 0644))
+		runGit(serverDir, "add", name)
+		runGit(serverDir, "commit", "-m", "Add "+name)
+		commitSHAs = append(commitSHAs, runGit(serverDir, "rev-parse", "HEAD"))
+	}
+
+	// Only the commit of the revision is fetched, without its history
+	client, err := NewFactoryWithFetchOptions(FetchOptions{Depth: 1, PartialClone: true}).NewClient("file://"+serverDir, repoDir, NopCreds{}, false, false, "")
+	assert.NoError(t, err)
+	assert.NoError(t, client.Init())
+	assert.NoError(t, client.Fetch(commitSHAs[1]))
+	assert.NoError(t, client.Checkout(commitSHAs[1]))
+	files, err := client.LsFiles("*")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.yaml", "b.yaml"}, files)
+	assert.Equal(t, "1", runGit(repoDir, "rev-list", "--count", "HEAD"))
+	assert.Equal(t, "true", runGit(repoDir, "config", "remote.origin.promisor"))
+
+	// Without a depth, the history is fetched again
+	client, err = NewFactory().NewClient("file://"+serverDir, repoDir, NopCreds{}, false, false, "")
+	assert.NoError(t, err)
+	assert.NoError(t, client.Fetch(commitSHAs[2]))
+	assert.NoError(t, client.Checkout(commitSHAs[2]))
+	assert.Equal(t, "3", runGit(repoDir, "rev-list", "--count", "HEAD"))
+}
+
+func TestWorktree(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-worktree-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	serverDir := filepath.Join(dir, "server")
+	repoDir := filepath.Join(dir, "repo")
+	worktreeDir := filepath.Join(dir, "repo-worktree-0")
+	assert.NoError(t, os.MkdirAll(serverDir, 0755))
+
+	runGit := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = serverDir
+		cmd.Env = append(os.Environ(), "HOME=/dev/null", "GIT_AUTHOR_NAME=Alice", "GIT_AUTHOR_EMAIL=alice@example.com", "GIT_COMMITTER_NAME=Alice", "GIT_COMMITTER_EMAIL=alice@example.com")
+		out, err := cmd.Output()
+		assert.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+	runGit("init")
+	var commitSHAs []string
+	for _, name := range []string{"a.yaml", "b.yaml"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(serverDir, name), []byte("kind: ConfigMap\n"), 0644))
+		runGit("add", name)
+		runGit("commit", "-m", "Add "+name)
+		commitSHAs = append(commitSHAs, runGit("rev-parse", "HEAD"))
+	}
+
+	client, err := NewFactory().NewClient("file://"+serverDir, repoDir, NopCreds{}, false, false, "")
+	assert.NoError(t, err)
+	assert.NoError(t, client.Init())
+	assert.NoError(t, client.Fetch(commitSHAs[1]))
+	assert.NoError(t, client.Checkout(commitSHAs[0]))
+
+	// The worktree is checked out independently of the clone
+	worktree, err := client.Worktree(worktreeDir, commitSHAs[1])
+	assert.NoError(t, err)
+	assert.Equal(t, worktreeDir, worktree.Root())
+	commitSHA, err := worktree.CommitSHA()
+	assert.NoError(t, err)
+	assert.Equal(t, commitSHAs[1], commitSHA)
+	_, err = os.Stat(filepath.Join(worktreeDir, "b.yaml"))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(repoDir, "b.yaml"))
+	assert.True(t, os.IsNotExist(err))
+
+	// An existing worktree is reused
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(worktreeDir, "generated.yaml"), []byte("kind: ConfigMap\n"), 0644))
+	worktree, err = client.Worktree(worktreeDir, commitSHAs[1])
+	assert.NoError(t, err)
+	assert.NoError(t, worktree.Checkout(commitSHAs[0]))
+	commitSHA, err = worktree.CommitSHA()
+	assert.NoError(t, err)
+	assert.Equal(t, commitSHAs[0], commitSHA)
+	_, err = os.Stat(filepath.Join(worktreeDir, "generated.yaml"))
+	assert.True(t, os.IsNotExist(err))
+
+	// A worktree of a clone which was initialized again is added again
+	assert.NoError(t, os.RemoveAll(repoDir))
+	assert.NoError(t, client.Init())
+	assert.NoError(t, client.Fetch(commitSHAs[1]))
+	worktree, err = client.Worktree(worktreeDir, commitSHAs[1])
+	assert.NoError(t, err)
+	commitSHA, err = worktree.CommitSHA()
+	assert.NoError(t, err)
+	assert.Equal(t, commitSHAs[1], commitSHA)
+}
+
+func TestSparseCheckout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-client-sparse-checkout-test-")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	serverDir := filepath.Join(dir, "server")
+	repoDir := filepath.Join(dir, "repo")
+	assert.NoError(t, os.MkdirAll(filepath.Join(serverDir, "apps", "guestbook"), 0755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(serverDir, "apps", "other"), 0755))
+
+	runGit := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = serverDir
+		cmd.Env = append(os.Environ(), "HOME=/dev/null", "GIT_AUTHOR_NAME=Alice", "GIT_AUTHOR_EMAIL=alice@example.com", "GIT_COMMITTER_NAME=Alice", "GIT_COMMITTER_EMAIL=alice@example.com")
+		out, err := cmd.Output()
+		assert.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+	runGit("init")
+	for _, name := range []string{"apps/guestbook/guestbook.yaml", "apps/other/other.yaml", "values.yaml"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(serverDir, name), []byte("kind: ConfigMap\n"), 0644))
+	}
+	runGit("add", ".")
+	runGit("commit", "-m", "Initial commit")
+	commitSHA := runGit("rev-parse", "HEAD")
+
+	client, err := NewFactory().NewClient("file://"+serverDir, repoDir, NopCreds{}, false, false, "")
+	assert.NoError(t, err)
+	assert.NoError(t, client.Init())
+	assert.NoError(t, client.Fetch(commitSHA))
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(repoDir, name))
+		return err == nil
+	}
+
+	assert.NoError(t, client.SparseCheckout(commitSHA, []string{"apps/guestbook", "values.yaml"}))
+	assert.True(t, exists("apps/guestbook/guestbook.yaml"))
+	assert.True(t, exists("values.yaml"))
+	assert.False(t, exists("apps/other/other.yaml"))
+
+	// The patterns of a previous sparse checkout of the same revision are replaced
+	assert.NoError(t, client.SparseCheckout(commitSHA, []string{"apps/other"}))
+	assert.False(t, exists("apps/guestbook/guestbook.yaml"))
+	assert.True(t, exists("apps/other/other.yaml"))
+
+	// A regular checkout restores all files
+	assert.NoError(t, client.Checkout(commitSHA))
+	assert.True(t, exists("apps/guestbook/guestbook.yaml"))
+	assert.True(t, exists("apps/other/other.yaml"))
+	assert.True(t, exists("values.yaml"))
+	assert.False(t, exists(".git/info/sparse-checkout"))
+}
@@ -10,6 +10,34 @@ type Client struct {
 	mock.Mock
 }
 
+// CatFile provides a mock function with given fields: revision
+func (_m *Client) CatFile(revision string) (string, string, error) {
+	ret := _m.Called(revision)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(revision)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string) string); ok {
+		r1 = rf(revision)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(revision)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Checkout provides a mock function with given fields: revision
 func (_m *Client) Checkout(revision string) error {
 	ret := _m.Called(revision)
@@ -45,13 +73,13 @@ func (_m *Client) CommitSHA() (string, error) {
 	return r0, r1
 }
 
-// Fetch provides a mock function with given fields:
-func (_m *Client) Fetch() error {
-	ret := _m.Called()
+// Fetch provides a mock function with given fields: revision
+func (_m *Client) Fetch(revision string) error {
+	ret := _m.Called(revision)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(revision)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -176,3 +204,77 @@ func (_m *Client) Root() string {
 
 	return r0
 }
+
+// SparseCheckout provides a mock function with given fields: revision, paths
+func (_m *Client) SparseCheckout(revision string, paths []string) error {
+	ret := _m.Called(revision, paths)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(revision, paths)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Submodules provides a mock function with given fields:
+func (_m *Client) Submodules() ([]git.Submodule, error) {
+	ret := _m.Called()
+
+	var r0 []git.Submodule
+	if rf, ok := ret.Get(0).(func() []git.Submodule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]git.Submodule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateSubmodule provides a mock function with given fields: path, creds
+func (_m *Client) UpdateSubmodule(path string, creds git.Creds) error {
+	ret := _m.Called(path, creds)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, git.Creds) error); ok {
+		r0 = rf(path, creds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Worktree provides a mock function with given fields: path, revision
+func (_m *Client) Worktree(path string, revision string) (git.Client, error) {
+	ret := _m.Called(path, revision)
+
+	var r0 git.Client
+	if rf, ok := ret.Get(0).(func(string, string) git.Client); ok {
+		r0 = rf(path, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(git.Client)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(path, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
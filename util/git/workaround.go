@@ -13,8 +13,8 @@ import (
 // As workaround methods `newUploadPackSession`, `newClient` and `listRemote` were copied from https://github.com/src-d/go-git/blob/master/remote.go and modified to use
 // transport with InsecureSkipVerify flag is verification should be disabled.
 
-func newUploadPackSession(url string, auth transport.AuthMethod, insecure bool, creds Creds) (transport.UploadPackSession, error) {
-	c, ep, err := newClient(url, insecure, creds)
+func newUploadPackSession(url string, auth transport.AuthMethod, insecure bool, creds Creds, proxy string) (transport.UploadPackSession, error) {
+	c, ep, err := newClient(url, insecure, creds, proxy)
 	if err != nil {
 		return nil, err
 	}
@@ -22,7 +22,7 @@ func newUploadPackSession(url string, auth transport.AuthMethod, insecure bool,
 	return c.NewUploadPackSession(ep, auth)
 }
 
-func newClient(url string, insecure bool, creds Creds) (transport.Transport, *transport.Endpoint, error) {
+func newClient(url string, insecure bool, creds Creds, proxy string) (transport.Transport, *transport.Endpoint, error) {
 	ep, err := transport.NewEndpoint(url)
 	if err != nil {
 		return nil, nil, err
@@ -32,7 +32,7 @@ func newClient(url string, insecure bool, creds Creds) (transport.Transport, *tr
 	// For HTTPS repositories, we get a custom Transport. Everything else will
 	// be default.
 	if IsHTTPSURL(url) {
-		c = http.NewClient(GetRepoHTTPClient(url, insecure, creds))
+		c = http.NewClient(GetRepoHTTPClient(url, insecure, creds, proxy))
 	} else {
 		c, err = client.NewClient(ep)
 		if err != nil {
@@ -42,8 +42,8 @@ func newClient(url string, insecure bool, creds Creds) (transport.Transport, *tr
 	return c, ep, err
 }
 
-func listRemote(r *git.Remote, o *git.ListOptions, insecure bool, creds Creds) (rfs []*plumbing.Reference, err error) {
-	s, err := newUploadPackSession(r.Config().URLs[0], o.Auth, insecure, creds)
+func listRemote(r *git.Remote, o *git.ListOptions, insecure bool, creds Creds, proxy string) (rfs []*plumbing.Reference, err error) {
+	s, err := newUploadPackSession(r.Config().URLs[0], o.Auth, insecure, creds, proxy)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,251 @@
+// Utility functions for managing GnuPG public keys and verifying the
+// signatures of Git commits and tags with them
+package gpg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	pgperrors "golang.org/x/crypto/openpgp/errors"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+const (
+	// Start of the header line of an armored block
+	armorStart = "-----BEGIN PGP "
+	// Armor header of a signature, which starts the signature of a signed tag
+	signatureHeader = "-----BEGIN PGP SIGNATURE-----"
+)
+
+// A key ID in long format, i.e. 16 hexadecimal digits
+var keyIDRegex = regexp.MustCompile("^[0-9A-F]{16}$")
+
+// A representation of a GnuPG public key
+type PublicKey struct {
+	// ID of the key in long format, e.g. 4AEE18F83AFDEB23
+	KeyID string
+	// Fingerprint of the key
+	Fingerprint string
+	// First identity of the key, e.g. "GitHub <noreply@github.com>"
+	Owner string
+	// Algorithm and size of the key, e.g. "rsa2048"
+	SubType string
+	// ASCII armored data of the public key
+	KeyData string
+}
+
+// IsKeyID returns whether the given string is a key ID in long format, i.e.
+// 16 upper case hexadecimal digits
+func IsKeyID(keyID string) bool {
+	return keyIDRegex.MatchString(keyID)
+}
+
+// ParsePublicKeys parses ASCII armored data containing one or more public
+// keys. The data of each returned key contains only that key.
+func ParsePublicKeys(data string) ([]*PublicKey, error) {
+	entities, err := readArmoredKeyRing(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(entities) == 0 {
+		return nil, fmt.Errorf("no public key found in key data")
+	}
+	keys := make([]*PublicKey, 0)
+	for _, entity := range entities {
+		if entity.PrivateKey != nil {
+			return nil, fmt.Errorf("key data contains the private key %s", formatKeyID(entity.PrimaryKey.KeyId))
+		}
+		var buf bytes.Buffer
+		w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+		if err != nil {
+			return nil, err
+		}
+		err = entity.Serialize(w)
+		if err != nil {
+			return nil, fmt.Errorf("could not serialize key %s: %v", formatKeyID(entity.PrimaryKey.KeyId), err)
+		}
+		err = w.Close()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, &PublicKey{
+			KeyID:       formatKeyID(entity.PrimaryKey.KeyId),
+			Fingerprint: strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint[:])),
+			Owner:       getOwner(entity),
+			SubType:     getSubType(entity.PrimaryKey),
+			KeyData:     buf.String() + "\n",
+		})
+	}
+	return keys, nil
+}
+
+// VerifyCommitSignature verifies the signature of a raw commit object, as
+// printed by "git cat-file commit", with the given ASCII armored public keys.
+// Returns the ID of the key the commit is signed with.
+func VerifyCommitSignature(commit string, keys []string) (string, error) {
+	payload, signature := splitCommitSignature(commit)
+	if signature == "" {
+		return "", fmt.Errorf("commit is not signed")
+	}
+	return verifySignature(payload, signature, keys)
+}
+
+// VerifyTagSignature verifies the signature of a raw annotated tag object, as
+// printed by "git cat-file tag", with the given ASCII armored public keys.
+// Returns the ID of the key the tag is signed with.
+func VerifyTagSignature(tag string, keys []string) (string, error) {
+	i := strings.Index(tag, signatureHeader)
+	if i < 0 {
+		return "", fmt.Errorf("tag is not signed")
+	}
+	return verifySignature(tag[:i], tag[i:], keys)
+}
+
+// GetTagObject returns the name of the object a raw annotated tag object
+// points to
+func GetTagObject(tag string) string {
+	for _, line := range strings.Split(tag, "\n") {
+		if line == "" {
+			break
+		}
+		if strings.HasPrefix(line, "object ") {
+			return strings.TrimPrefix(line, "object ")
+		}
+	}
+	return ""
+}
+
+// Reads the keys of ASCII armored data, which may consist of several armored
+// blocks, e.g. when the exports of multiple keys are concatenated
+func readArmoredKeyRing(data string) (openpgp.EntityList, error) {
+	entities := make(openpgp.EntityList, 0)
+	blocks := strings.Split(data, armorStart)
+	for _, block := range blocks[1:] {
+		keyRing, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armorStart + block))
+		if _, ok := err.(pgperrors.UnsupportedError); ok {
+			return nil, fmt.Errorf("invalid key data: %v, only RSA, DSA and ECDSA keys are supported", err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key data: %v", err)
+		}
+		entities = append(entities, keyRing...)
+	}
+	if len(blocks) == 1 {
+		return nil, fmt.Errorf("invalid key data: no armored key block found")
+	}
+	return entities, nil
+}
+
+// Splits a raw commit object into the signed payload, which is the commit
+// without its gpgsig header, and the signature in the gpgsig header
+func splitCommitSignature(commit string) (string, string) {
+	var payload, signature strings.Builder
+	inHeaders, inSignature := true, false
+	for _, line := range strings.SplitAfter(commit, "\n") {
+		switch {
+		case !inHeaders:
+			payload.WriteString(line)
+		case inSignature && strings.HasPrefix(line, " "):
+			// Continuation lines of the header are indented by a space
+			signature.WriteString(line[1:])
+		case strings.HasPrefix(line, "gpgsig "):
+			inSignature = true
+			signature.WriteString(strings.TrimPrefix(line, "gpgsig "))
+		default:
+			inSignature = false
+			inHeaders = line != "\n"
+			payload.WriteString(line)
+		}
+	}
+	return payload.String(), signature.String()
+}
+
+func verifySignature(payload string, signature string, keys []string) (string, error) {
+	keyRing := make(openpgp.EntityList, 0)
+	for _, key := range keys {
+		entities, err := readArmoredKeyRing(key)
+		if err != nil {
+			return "", err
+		}
+		keyRing = append(keyRing, entities...)
+	}
+	signer, err := openpgp.CheckArmoredDetachedSignature(keyRing, strings.NewReader(payload), strings.NewReader(signature))
+	if err == pgperrors.ErrUnknownIssuer {
+		if issuer := getSignatureIssuer(signature); issuer != "" {
+			return "", fmt.Errorf("signed with key %s, which is not trusted", issuer)
+		}
+		return "", fmt.Errorf("signed with a key which is not trusted")
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid signature: %v", err)
+	}
+	return formatKeyID(signer.PrimaryKey.KeyId), nil
+}
+
+// Returns the ID of the key an ASCII armored signature was made with, if the
+// signature contains it
+func getSignatureIssuer(signature string) string {
+	block, err := armor.Decode(strings.NewReader(signature))
+	if err != nil {
+		return ""
+	}
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return ""
+	}
+	switch sig := p.(type) {
+	case *packet.Signature:
+		if sig.IssuerKeyId != nil {
+			return formatKeyID(*sig.IssuerKeyId)
+		}
+	case *packet.SignatureV3:
+		return formatKeyID(sig.IssuerKeyId)
+	}
+	return ""
+}
+
+func formatKeyID(keyID uint64) string {
+	return fmt.Sprintf("%016X", keyID)
+}
+
+// Returns the primary identity of the key, or else the first by name
+func getOwner(entity *openpgp.Entity) string {
+	names := make([]string, 0)
+	for name, identity := range entity.Identities {
+		if identity.SelfSignature != nil && identity.SelfSignature.IsPrimaryId != nil && *identity.SelfSignature.IsPrimaryId {
+			return name
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	return names[0]
+}
+
+func getSubType(key *packet.PublicKey) string {
+	var algorithm string
+	switch key.PubKeyAlgo {
+	case packet.PubKeyAlgoRSA, packet.PubKeyAlgoRSASignOnly, packet.PubKeyAlgoRSAEncryptOnly:
+		algorithm = "rsa"
+	case packet.PubKeyAlgoDSA:
+		algorithm = "dsa"
+	case packet.PubKeyAlgoECDSA:
+		algorithm = "ecdsa"
+	case packet.PubKeyAlgoElGamal:
+		algorithm = "elgamal"
+	default:
+		return "unknown"
+	}
+	if bitLength, err := key.BitLength(); err == nil {
+		return fmt.Sprintf("%s%d", algorithm, bitLength)
+	}
+	return algorithm
+}
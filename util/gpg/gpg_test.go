@@ -0,0 +1,156 @@
+package gpg
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+const testCommitPayload = `tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904
+author Alice <alice@example.com> 1570000000 +0200
+committer Alice <alice@example.com> 1570000000 +0200
+
+Initial commit
+`
+
+const testTagPayload = `object aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd
+type commit
+tag v1.0.0
+tagger Alice <alice@example.com> 1570000000 +0200
+
+Release v1.0.0
+`
+
+func newTestEntity(t *testing.T, name string) (*openpgp.Entity, string) {
+	entity, err := openpgp.NewEntity(name, "", strings.ToLower(name)+"@example.com", nil)
+	assert.NoError(t, err)
+	// Serializing the private key signs the identities of the new entity
+	assert.NoError(t, entity.SerializePrivate(ioutil.Discard, nil))
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.Serialize(w))
+	assert.NoError(t, w.Close())
+	return entity, buf.String()
+}
+
+func sign(t *testing.T, entity *openpgp.Entity, payload string) string {
+	var buf bytes.Buffer
+	assert.NoError(t, openpgp.ArmoredDetachSign(&buf, entity, strings.NewReader(payload), nil))
+	return buf.String() + "\n"
+}
+
+// Returns a raw commit object carrying the signature in its gpgsig header
+func signCommit(t *testing.T, entity *openpgp.Entity, payload string) string {
+	signature := strings.TrimSuffix(sign(t, entity, payload), "\n")
+	header := "gpgsig " + strings.Replace(signature, "\n", "\n ", -1) + "\n"
+	i := strings.Index(payload, "\n\n")
+	return payload[:i+1] + header + payload[i+1:]
+}
+
+func TestIsKeyID(t *testing.T) {
+	assert.True(t, IsKeyID("4AEE18F83AFDEB23"))
+	assert.False(t, IsKeyID("4aee18f83afdeb23"))
+	assert.False(t, IsKeyID("3AFDEB23"))
+	assert.False(t, IsKeyID("5DE3E0509C47EA3CF04A42D34AEE18F83AFDEB23"))
+	assert.False(t, IsKeyID(""))
+}
+
+func TestParsePublicKeys(t *testing.T) {
+	alice, aliceKey := newTestEntity(t, "Alice")
+	bob, bobKey := newTestEntity(t, "Bob")
+
+	keys, err := ParsePublicKeys(aliceKey + bobKey)
+	assert.NoError(t, err)
+	if assert.Len(t, keys, 2) {
+		assert.Equal(t, fmt.Sprintf("%016X", alice.PrimaryKey.KeyId), keys[0].KeyID)
+		assert.True(t, IsKeyID(keys[0].KeyID))
+		assert.Len(t, keys[0].Fingerprint, 40)
+		assert.True(t, strings.HasSuffix(keys[0].Fingerprint, keys[0].KeyID))
+		assert.Equal(t, "Alice <alice@example.com>", keys[0].Owner)
+		assert.Equal(t, "rsa2048", keys[0].SubType)
+		assert.Equal(t, fmt.Sprintf("%016X", bob.PrimaryKey.KeyId), keys[1].KeyID)
+
+		// The data of each key contains only that key
+		parsed, err := ParsePublicKeys(keys[1].KeyData)
+		assert.NoError(t, err)
+		assert.Len(t, parsed, 1)
+		assert.Equal(t, keys[1].KeyID, parsed[0].KeyID)
+	}
+
+	_, err = ParsePublicKeys("not a key")
+	assert.Error(t, err)
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, alice.SerializePrivate(w, nil))
+	assert.NoError(t, w.Close())
+	_, err = ParsePublicKeys(buf.String())
+	assert.EqualError(t, err, fmt.Sprintf("key data contains the private key %016X", alice.PrimaryKey.KeyId))
+}
+
+func TestVerifyCommitSignature(t *testing.T) {
+	alice, aliceKey := newTestEntity(t, "Alice")
+	mallory, _ := newTestEntity(t, "Mallory")
+	commit := signCommit(t, alice, testCommitPayload)
+
+	keyID, err := VerifyCommitSignature(commit, []string{aliceKey})
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%016X", alice.PrimaryKey.KeyId), keyID)
+
+	_, err = VerifyCommitSignature(testCommitPayload, []string{aliceKey})
+	assert.EqualError(t, err, "commit is not signed")
+
+	_, err = VerifyCommitSignature(signCommit(t, mallory, testCommitPayload), []string{aliceKey})
+	assert.EqualError(t, err, fmt.Sprintf("signed with key %016X, which is not trusted", mallory.PrimaryKey.KeyId))
+
+	_, err = VerifyCommitSignature(commit, nil)
+	assert.EqualError(t, err, fmt.Sprintf("signed with key %016X, which is not trusted", alice.PrimaryKey.KeyId))
+
+	tampered := strings.Replace(commit, "Initial commit", "Malicious commit", 1)
+	_, err = VerifyCommitSignature(tampered, []string{aliceKey})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid signature")
+}
+
+func TestVerifyTagSignature(t *testing.T) {
+	alice, aliceKey := newTestEntity(t, "Alice")
+	tag := testTagPayload + sign(t, alice, testTagPayload)
+
+	keyID, err := VerifyTagSignature(tag, []string{aliceKey})
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%016X", alice.PrimaryKey.KeyId), keyID)
+
+	_, err = VerifyTagSignature(testTagPayload, []string{aliceKey})
+	assert.EqualError(t, err, "tag is not signed")
+
+	_, err = VerifyTagSignature(strings.Replace(tag, "v1.0.0", "v6.6.6", -1), []string{aliceKey})
+	assert.Error(t, err)
+}
+
+func TestGetTagObject(t *testing.T) {
+	assert.Equal(t, "aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd", GetTagObject(testTagPayload))
+	assert.Equal(t, "", GetTagObject("tag v1.0.0\n\nobject aaaaaaaaaabbbbbbbbbbccccccccccdddddddddd\n"))
+}
+
+func TestSplitCommitSignature(t *testing.T) {
+	commit := "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n" +
+		"gpgsig -----BEGIN PGP SIGNATURE-----\n" +
+		" \n" +
+		" abcdef\n" +
+		" -----END PGP SIGNATURE-----\n" +
+		"committer Alice <alice@example.com> 1570000000 +0200\n" +
+		"\n" +
+		"message\n" +
+		" indented line\n"
+	payload, signature := splitCommitSignature(commit)
+	assert.Equal(t, "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\ncommitter Alice <alice@example.com> 1570000000 +0200\n\nmessage\n indented line\n", payload)
+	assert.Equal(t, "-----BEGIN PGP SIGNATURE-----\n\nabcdef\n-----END PGP SIGNATURE-----\n", signature)
+}
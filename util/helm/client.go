@@ -0,0 +1,326 @@
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+
+	certutil "github.com/argoproj/argo-cd/util/cert"
+)
+
+// Maximum size of an index.yaml or a chart archive which is downloaded
+const maxDownloadSize = 100 * 1024 * 1024
+
+// Creds holds the credentials for accessing a Helm chart repository
+type Creds struct {
+	Username           string
+	Password           string
+	CertData           []byte
+	KeyData            []byte
+	InsecureSkipVerify bool
+	// URL of the HTTP(S) proxy used instead of the proxy configured by the environment
+	Proxy string
+}
+
+// Client provides access to the charts of a Helm chart repository
+type Client interface {
+	// GetIndex returns the index of the repository
+	GetIndex() (*Index, error)
+	// ExtractChart downloads given version of a chart and extracts it to a
+	// temporary directory, which is removed by the returned closer. Returns
+	// the path of the chart's directory.
+	ExtractChart(chart string, version string) (string, io.Closer, error)
+}
+
+// NewClient returns a client for the Helm chart repository at repoURL.
+// Certificates of the server are verified against the certificates
+// configured for its host, or against the system's certificates if there
+// are none.
+func NewClient(repoURL string, creds Creds) Client {
+	return &client{repoURL: strings.TrimSuffix(repoURL, "/"), creds: creds}
+}
+
+type client struct {
+	repoURL string
+	creds   Creds
+}
+
+// Index is the index.yaml of a Helm chart repository
+type Index struct {
+	Entries map[string]Entries `json:"entries"`
+}
+
+// Entry is a single version of a chart in the index
+type Entry struct {
+	Version string   `json:"version"`
+	URLs    []string `json:"urls"`
+}
+
+// Entries are the versions of a chart in the index
+type Entries []Entry
+
+// GetEntries returns all versions of a chart in the index
+func (i *Index) GetEntries(chart string) (Entries, error) {
+	entries, ok := i.Entries[chart]
+	if !ok || len(entries) == 0 {
+		return nil, fmt.Errorf("chart '%s' not found in index", chart)
+	}
+	return entries, nil
+}
+
+// Resolve returns the entry for the given version of the chart. The version
+// is either an exact version, or a semantic version constraint such as
+// "1.2.*" or ">=1.0, <2.0", for which the latest matching version is
+// returned. An empty version or "*" resolves to the latest version.
+func (e Entries) Resolve(version string) (*Entry, error) {
+	for i := range e {
+		if e[i].Version == version {
+			return &e[i], nil
+		}
+	}
+	if version == "" {
+		version = "*"
+	}
+	constraints, err := semver.NewConstraint(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chart version '%s': %v", version, err)
+	}
+	var latest *Entry
+	var latestVersion *semver.Version
+	for i := range e {
+		v, err := semver.NewVersion(e[i].Version)
+		if err != nil {
+			// Versions the index lists must be semantic versions, but we
+			// don't fail on the odd one which isn't
+			continue
+		}
+		if constraints.Check(v) && (latestVersion == nil || v.GreaterThan(latestVersion)) {
+			latest, latestVersion = &e[i], v
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no chart version matches '%s'", version)
+	}
+	return latest, nil
+}
+
+func (c *client) GetIndex() (*Index, error) {
+	start := time.Now()
+	data, err := c.get(c.repoURL + "/index.yaml")
+	if err != nil {
+		return nil, err
+	}
+	index := &Index{}
+	err = yaml.Unmarshal(data, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse index of Helm chart repository %s: %v", c.repoURL, err)
+	}
+	log.WithFields(log.Fields{"repo": c.repoURL, "seconds": time.Since(start).Seconds()}).Info("got index")
+	return index, nil
+}
+
+func (c *client) ExtractChart(chart string, version string) (string, io.Closer, error) {
+	index, err := c.GetIndex()
+	if err != nil {
+		return "", nil, err
+	}
+	entries, err := index.GetEntries(chart)
+	if err != nil {
+		return "", nil, err
+	}
+	entry, err := entries.Resolve(version)
+	if err != nil {
+		return "", nil, err
+	}
+	if entry.Version != version {
+		return "", nil, fmt.Errorf("chart '%s' has no version '%s'", chart, version)
+	}
+	if len(entry.URLs) == 0 {
+		return "", nil, fmt.Errorf("index has no URL for version '%s' of chart '%s'", version, chart)
+	}
+	chartURL, err := c.resolveURL(entry.URLs[0])
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := c.get(chartURL)
+	if err != nil {
+		return "", nil, err
+	}
+
+	dir, err := ioutil.TempDir("", "helm-chart")
+	if err != nil {
+		return "", nil, err
+	}
+	closer := &tempDirCloser{dir: dir}
+	chartPath, err := extractChartArchive(dir, chart, data)
+	if err != nil {
+		_ = closer.Close()
+		return "", nil, fmt.Errorf("failed to extract version '%s' of chart '%s': %v", version, chart, err)
+	}
+	return chartPath, closer, nil
+}
+
+// Extracts the archive of a chart to dir, returning the path of the chart's directory
+func extractChartArchive(dir string, chart string, data []byte) (string, error) {
+	err := untar(dir, bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	chartPath := filepath.Join(dir, chart)
+	if info, err := os.Stat(chartPath); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("archive does not contain the chart")
+	}
+	return chartPath, nil
+}
+
+// Resolves a chart URL of the index, which might be relative to the repository
+func (c *client) resolveURL(chartURL string) (string, error) {
+	base, err := url.Parse(c.repoURL + "/")
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(chartURL)
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(ref).String(), nil
+}
+
+func (c *client) get(resourceURL string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, resourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.creds.Username != "" || c.creds.Password != "" {
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+	host := ""
+	if parsedURL, err := url.Parse(c.repoURL); err == nil {
+		host = parsedURL.Host
+	}
+	httpClient, err := newHTTPClient(host, c.creds)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s: %s", resourceURL, resp.Status)
+	}
+	return readBody(resourceURL, resp)
+}
+
+// Reads the body of a response, up to the maximum download size
+func readBody(resourceURL string, resp *http.Response) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDownloadSize {
+		return nil, fmt.Errorf("failed to get %s: exceeds maximum size of %d bytes", resourceURL, maxDownloadSize)
+	}
+	return data, nil
+}
+
+// Returns a HTTP client for connecting to host, which verifies the host's certificate against the
+// certificates configured for it, and presents the TLS client certificate of the credentials
+func newHTTPClient(host string, creds Creds) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify}
+	if len(creds.CertData) > 0 && len(creds.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(creds.CertData, creds.KeyData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TLS client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if !creds.InsecureSkipVerify && host != "" {
+		serverCertificatePem, err := certutil.GetCertificateForConnect(host)
+		if err != nil {
+			log.Warnf("Could not get certificates for host '%s': %v", host, err)
+		} else if len(serverCertificatePem) > 0 {
+			tlsConfig.RootCAs = certutil.GetCertPoolFromPEMData(serverCertificatePem)
+		}
+	}
+	proxy := http.ProxyFromEnvironment
+	if creds.Proxy != "" {
+		proxyURL, err := url.Parse(creds.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL '%s': %v", creds.Proxy, err)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           proxy,
+			TLSClientConfig: tlsConfig,
+		},
+		Timeout: 90 * time.Second,
+	}, nil
+}
+
+// Extracts a gzipped tar archive to dir, refusing entries outside of it
+func untar(dir string, r io.Reader) error {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = gzr.Close() }()
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, header.Name)
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("illegal file path in archive: %s", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			_ = f.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type tempDirCloser struct {
+	dir string
+}
+
+func (c *tempDirCloser) Close() error {
+	return os.RemoveAll(c.dir)
+}
@@ -0,0 +1,171 @@
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testIndex = `
+apiVersion: v1
+entries:
+  mychart:
+  - name: mychart
+    version: 0.2.0
+    urls:
+    - charts/mychart-0.2.0.tgz
+  - name: mychart
+    version: 0.1.0
+    urls:
+    - charts/mychart-0.1.0.tgz
+  - name: mychart
+    version: 1.0.0-rc.1
+    urls:
+    - charts/mychart-1.0.0-rc.1.tgz
+  - name: mychart
+    version: latest
+    urls:
+    - charts/mychart-latest.tgz
+`
+
+func newTestArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		assert.NoError(t, err)
+		_, err = tw.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func newTestRepoServer(t *testing.T) *httptest.Server {
+	chart := newTestArchive(t, map[string]string{
+		"mychart/Chart.yaml":            "name: mychart\nversion: 0.2.0\n",
+		"mychart/templates/config.yaml": "kind: ConfigMap\n",
+	})
+	evil := newTestArchive(t, map[string]string{"../evil.yaml": "kind: ConfigMap\n"})
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/index.yaml":
+			_, _ = w.Write([]byte(testIndex))
+		case "/charts/mychart-0.2.0.tgz":
+			_, _ = w.Write(chart)
+		case "/charts/mychart-0.1.0.tgz":
+			_, _ = w.Write(evil)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestEntriesResolve(t *testing.T) {
+	entries := Entries{{Version: "0.2.0"}, {Version: "0.1.0"}, {Version: "1.0.0-rc.1"}, {Version: "latest"}}
+
+	for version, expected := range map[string]string{
+		"":           "0.2.0",
+		"*":          "0.2.0",
+		"0.1.0":      "0.1.0",
+		"0.1.*":      "0.1.0",
+		"< 0.2.0":    "0.1.0",
+		">= 0.1.0":   "0.2.0",
+		"1.0.0-rc.1": "1.0.0-rc.1",
+		"latest":     "latest",
+	} {
+		entry, err := entries.Resolve(version)
+		if assert.NoError(t, err, version) {
+			assert.Equal(t, expected, entry.Version, version)
+		}
+	}
+
+	_, err := entries.Resolve("0.3.0")
+	assert.EqualError(t, err, "no chart version matches '0.3.0'")
+	_, err = entries.Resolve("not a version")
+	assert.Error(t, err)
+}
+
+func TestClient_GetIndex(t *testing.T) {
+	server := newTestRepoServer(t)
+	defer server.Close()
+
+	index, err := NewClient(server.URL, Creds{Username: "user", Password: "pass"}).GetIndex()
+	assert.NoError(t, err)
+	entries, err := index.GetEntries("mychart")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+	_, err = index.GetEntries("otherchart")
+	assert.EqualError(t, err, "chart 'otherchart' not found in index")
+
+	_, err = NewClient(server.URL, Creds{}).GetIndex()
+	assert.Error(t, err)
+}
+
+func TestClient_ExtractChart(t *testing.T) {
+	server := newTestRepoServer(t)
+	defer server.Close()
+	client := NewClient(server.URL+"/", Creds{Username: "user", Password: "pass"})
+
+	chartPath, closer, err := client.ExtractChart("mychart", "0.2.0")
+	if assert.NoError(t, err) {
+		data, err := ioutil.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "name: mychart")
+		assert.NoError(t, closer.Close())
+		_, err = os.Stat(chartPath)
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	// Versions must be resolved before extracting a chart
+	_, _, err = client.ExtractChart("mychart", "0.2.*")
+	assert.EqualError(t, err, "chart 'mychart' has no version '0.2.*'")
+
+	// Archives must not write outside of the temporary directory
+	_, _, err = client.ExtractChart("mychart", "0.1.0")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "illegal file path in archive")
+
+	_, _, err = client.ExtractChart("mychart", "1.0.0-rc.1")
+	assert.Error(t, err)
+}
+
+func TestClient_Proxy(t *testing.T) {
+	server := newTestRepoServer(t)
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	// The proxy is the only way to reach the repository
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+		r.URL.Scheme, r.URL.Host = serverURL.Scheme, serverURL.Host
+		httputil.NewSingleHostReverseProxy(serverURL).ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	index, err := NewClient("http://charts.example.com", Creds{Username: "user", Password: "pass", Proxy: proxy.URL}).GetIndex()
+	assert.NoError(t, err)
+	assert.NotNil(t, index)
+	assert.Equal(t, []string{"http://charts.example.com/index.yaml"}, proxied)
+
+	_, err = NewClient("http://charts.example.com", Creds{Proxy: "://invalid"}).GetIndex()
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -32,15 +33,20 @@ type Helm interface {
 	DependencyBuild() error
 	// SetHome sets the helm home location (default "~/.helm")
 	SetHome(path string)
+	// SetEnv sets additional environment variables of the helm commands, of the form NAME=VALUE
+	SetEnv(env []string)
 	// Init runs `helm init --client-only`
 	Init() error
 	// Dispose deletes temp resources
 	Dispose()
+	// Version returns the version of the helm binary, e.g. v3.1.2+gd878d4d
+	Version() (string, error)
 }
 
-// NewHelmApp create a new wrapper to run commands on the `helm` command-line tool.
-func NewHelmApp(path string, helmRepos []*argoappv1.HelmRepository) Helm {
-	return &helm{path: path, helmRepos: helmRepos}
+// NewHelmApp create a new wrapper to run commands on the `helm` command-line tool. The binary path overrides the
+// bundled helm binary, if set.
+func NewHelmApp(path string, helmRepos []*argoappv1.HelmRepository, binaryPath string) Helm {
+	return &helm{path: path, helmRepos: helmRepos, binaryPath: binaryPath}
 }
 
 type helm struct {
@@ -49,31 +55,103 @@ type helm struct {
 	helmRepos        []*argoappv1.HelmRepository
 	reposInitialized bool
 	tempDirs         []string
+	// the path of the helm binary of the version selected by the application, if any
+	binaryPath string
+	// the version of the helm binary, once it is determined
+	version string
+	// additional environment variables of the helm commands
+	env []string
+}
+
+// Version returns the version of the helm client used when running helm commands
+func Version() (string, error) {
+	return getVersion("helm")
+}
+
+func getVersion(command string) (string, error) {
+	cmd := exec.Command(command, "version", "--client", "--short")
+	out, err := argoexec.RunCommandExt(cmd, config.CmdOpts())
+	if err != nil {
+		return "", fmt.Errorf("unable to determine helm version: %v", err)
+	}
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(out), "Client:")), nil
 }
 
 // IsMissingDependencyErr tests if the error is related to a missing chart dependency
 func IsMissingDependencyErr(err error) bool {
-	return strings.Contains(err.Error(), "found in requirements.yaml, but missing in charts")
+	return strings.Contains(err.Error(), "found in requirements.yaml, but missing in charts") ||
+		strings.Contains(err.Error(), "found in Chart.yaml, but missing in charts/ directory")
+}
+
+func (h *helm) command() string {
+	if h.binaryPath != "" {
+		return h.binaryPath
+	}
+	return "helm"
+}
+
+func (h *helm) Version() (string, error) {
+	if h.version == "" {
+		version, err := getVersion(h.command())
+		if err != nil {
+			return "", err
+		}
+		h.version = version
+	}
+	return h.version, nil
+}
+
+// isHelm3 returns whether the helm binary is Helm 3. The bundled helm is Helm 2, so only the version of a binary
+// selected by the application is determined.
+func (h *helm) isHelm3() (bool, error) {
+	if h.binaryPath == "" {
+		return false, nil
+	}
+	version, err := h.Version()
+	if err != nil {
+		return false, err
+	}
+	return strings.HasPrefix(strings.TrimPrefix(version, "v"), "3."), nil
 }
 
 func (h *helm) Template(appName string, namespace string, opts *argoappv1.ApplicationSourceHelm) ([]*unstructured.Unstructured, error) {
+	helm3, err := h.isHelm3()
+	if err != nil {
+		return nil, err
+	}
+	releaseName := appName
+	if opts != nil && opts.ReleaseName != "" {
+		releaseName = opts.ReleaseName
+	}
 	args := []string{
 		"template", ".",
 	}
-
-	setReleaseName := true
+	if helm3 {
+		// Helm 3 takes the release name as an argument instead of the --name flag
+		args = []string{"template", releaseName, "."}
+	}
 
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
 	if opts != nil {
-		if opts.ReleaseName != "" {
-			args = append(args, "--name", opts.ReleaseName)
-			setReleaseName = false
-		}
 		for _, valuesFile := range opts.ValueFiles {
 			args = append(args, "-f", valuesFile)
 		}
+		if opts.Values != "" {
+			// the inline values are given last, so they override the values of the value files
+			file, err := ioutil.TempFile("", "values-*.yaml")
+			if err != nil {
+				return nil, err
+			}
+			defer func() { _ = os.RemoveAll(file.Name()) }()
+			_, err = file.WriteString(opts.Values)
+			util.Close(file)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, "-f", file.Name())
+		}
 		for _, p := range opts.Parameters {
 			if p.ForceString {
 				args = append(args, "--set-string", fmt.Sprintf("%s=%s", p.Name, p.Value))
@@ -81,10 +159,13 @@ func (h *helm) Template(appName string, namespace string, opts *argoappv1.Applic
 				args = append(args, "--set", fmt.Sprintf("%s=%s", p.Name, p.Value))
 			}
 		}
+		for _, p := range opts.FileParameters {
+			args = append(args, "--set-file", fmt.Sprintf("%s=%s", p.Name, p.Path))
+		}
 	}
 
-	if setReleaseName {
-		args = append(args, "--name", appName)
+	if !helm3 {
+		args = append(args, "--name", releaseName)
 	}
 
 	out, err := h.helmCmd(args...)
@@ -150,6 +231,10 @@ func (h *helm) SetHome(home string) {
 	h.home = home
 }
 
+func (h *helm) SetEnv(env []string) {
+	h.env = env
+}
+
 func (h *helm) Init() error {
 	if h.home == "" {
 		home, err := ioutil.TempDir("", "helm")
@@ -159,7 +244,12 @@ func (h *helm) Init() error {
 		h.home = home
 		h.tempDirs = append(h.tempDirs, home)
 	}
-	_, err := h.helmCmd("init", "--client-only", "--skip-refresh")
+	helm3, err := h.isHelm3()
+	if err != nil || helm3 {
+		// Helm 3 has no init command
+		return err
+	}
+	_, err = h.helmCmd("init", "--client-only", "--skip-refresh")
 	return err
 }
 
@@ -221,11 +311,18 @@ func (h *helm) helmCmd(args ...string) (string, error) {
 
 func (h *helm) helmCmdExt(args []string, redactor func(string) string) (string, error) {
 	cleanHelmParameters(args)
-	cmd := exec.Command("helm", args...)
-	cmd.Env = os.Environ()
+	cmd := exec.Command(h.command(), args...)
+	cmd.Env = append(os.Environ(), h.env...)
 	cmd.Dir = h.path
 	if h.home != "" {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("HELM_HOME=%s", h.home))
+		if helm3, _ := h.isHelm3(); helm3 {
+			// Helm 3 uses separate directories instead of HELM_HOME
+			cmd.Env = append(cmd.Env,
+				fmt.Sprintf("HELM_CACHE_HOME=%s", filepath.Join(h.home, "cache")),
+				fmt.Sprintf("HELM_CONFIG_HOME=%s", filepath.Join(h.home, "config")),
+				fmt.Sprintf("HELM_DATA_HOME=%s", filepath.Join(h.home, "data")))
+		}
 	}
 
 	return argoexec.RunCommandExt(cmd, argoexec.CmdOpts{
@@ -3,11 +3,13 @@ package helm
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -23,7 +25,7 @@ func findParameter(params []*argoappv1.HelmParameter, name string) *argoappv1.He
 }
 
 func TestHelmTemplateParams(t *testing.T) {
-	h := NewHelmApp("./testdata/minio", []*argoappv1.HelmRepository{})
+	h := NewHelmApp("./testdata/minio", []*argoappv1.HelmRepository{}, "")
 	opts := argoappv1.ApplicationSourceHelm{
 		Parameters: []argoappv1.HelmParameter{
 			{
@@ -58,7 +60,7 @@ func TestHelmTemplateParams(t *testing.T) {
 }
 
 func TestHelmTemplateValues(t *testing.T) {
-	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{})
+	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{}, "")
 	opts := argoappv1.ApplicationSourceHelm{
 		ValueFiles: []string{"values-production.yaml"},
 	}
@@ -76,8 +78,28 @@ func TestHelmTemplateValues(t *testing.T) {
 	}
 }
 
+func TestHelmTemplateInlineValues(t *testing.T) {
+	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{}, "")
+	opts := argoappv1.ApplicationSourceHelm{
+		ValueFiles: []string{"values-production.yaml"},
+		Values:     "cluster:\n  slaveCount: 5\n",
+	}
+	objs, err := h.Template("test", "", &opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(objs))
+
+	for _, obj := range objs {
+		if obj.GetKind() == "Deployment" && obj.GetName() == "test-redis-slave" {
+			var dep appsv1.Deployment
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &dep)
+			assert.Nil(t, err)
+			assert.Equal(t, int32(5), *dep.Spec.Replicas)
+		}
+	}
+}
+
 func TestHelmTemplateValuesURL(t *testing.T) {
-	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{})
+	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{}, "")
 	opts := argoappv1.ApplicationSourceHelm{
 		ValueFiles: []string{"https://raw.githubusercontent.com/argoproj/argo-cd/master/util/helm/testdata/redis/values-production.yaml"},
 	}
@@ -90,7 +112,7 @@ func TestHelmTemplateValuesURL(t *testing.T) {
 }
 
 func TestHelmGetParams(t *testing.T) {
-	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{})
+	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{}, "")
 	params, err := h.GetParameters([]string{})
 	assert.Nil(t, err)
 
@@ -100,7 +122,7 @@ func TestHelmGetParams(t *testing.T) {
 }
 
 func TestHelmGetParamsValueFiles(t *testing.T) {
-	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{})
+	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{}, "")
 	params, err := h.GetParameters([]string{"values-production.yaml"})
 	assert.Nil(t, err)
 
@@ -115,7 +137,7 @@ func TestHelmDependencyBuild(t *testing.T) {
 	}
 	clean()
 	defer clean()
-	h := NewHelmApp("./testdata/wordpress", []*argoappv1.HelmRepository{})
+	h := NewHelmApp("./testdata/wordpress", []*argoappv1.HelmRepository{}, "")
 	helmHome, err := ioutil.TempDir("", "")
 	assert.NoError(t, err)
 	defer func() { _ = os.RemoveAll(helmHome) }()
@@ -131,7 +153,7 @@ func TestHelmDependencyBuild(t *testing.T) {
 }
 
 func TestHelmTemplateReleaseNameOverwrite(t *testing.T) {
-	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{})
+	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{}, "")
 	opts := argoappv1.ApplicationSourceHelm{
 		ReleaseName: "my-release",
 	}
@@ -150,7 +172,7 @@ func TestHelmTemplateReleaseNameOverwrite(t *testing.T) {
 }
 
 func TestHelmTemplateReleaseName(t *testing.T) {
-	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{})
+	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{}, "")
 	opts := argoappv1.ApplicationSourceHelm{}
 	objs, err := h.Template("test", "", &opts)
 	assert.Nil(t, err)
@@ -166,6 +188,38 @@ func TestHelmTemplateReleaseName(t *testing.T) {
 	}
 }
 
+func TestHelmTemplateHelm3(t *testing.T) {
+	dir, err := ioutil.TempDir("", "helm3")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	// a fake Helm 3 binary, which renders its arguments into a config map
+	binaryPath := filepath.Join(dir, "helm")
+	err = ioutil.WriteFile(binaryPath, []byte(`#!/bin/sh
+if [ "$1" = "version" ]; then
+  echo v3.1.2+gd878d4d
+  exit 0
+fi
+printf 'apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: args\ndata:\n  args: "%s"\n  token: "%s"\n' "$*" "$TOKEN"
+`), 0755)
+	assert.NoError(t, err)
+
+	h := NewHelmApp("./testdata/redis", []*argoappv1.HelmRepository{}, binaryPath)
+	h.SetEnv([]string{"TOKEN=secret"})
+	err = h.Init()
+	assert.NoError(t, err)
+	defer h.Dispose()
+	version, err := h.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, "v3.1.2+gd878d4d", version)
+	objs, err := h.Template("test", "", &argoappv1.ApplicationSourceHelm{ReleaseName: "redis"})
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+	args, _, _ := unstructured.NestedString(objs[0].Object, "data", "args")
+	assert.Equal(t, "template redis .", args)
+	token, _, _ := unstructured.NestedString(objs[0].Object, "data", "token")
+	assert.Equal(t, "secret", token)
+}
+
 func TestHelmArgCleaner(t *testing.T) {
 	cleanArgs := []string{`--these-args`, `are-clean`, `--foo`, `bar`}
 	argsToBeCleaned := make([]string, len(cleanArgs))
@@ -0,0 +1,325 @@
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ociScheme = "oci://"
+	// Media type of the manifests of charts
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	// Media type of the layer containing the chart archive
+	ociChartLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	// Media type of the chart layer used by the experimental OCI support of Helm 3.0
+	ociLegacyChartLayerMediaType = "application/tar+gzip"
+)
+
+var digestRegex = regexp.MustCompile("^sha256:[a-f0-9]{64}$")
+
+// IsOCIURL returns whether the URL is the URL of an OCI registry, e.g. oci://registry.example.com/charts
+func IsOCIURL(repoURL string) bool {
+	return strings.HasPrefix(strings.ToLower(repoURL), ociScheme)
+}
+
+// IsDigest returns whether the revision is the digest of a manifest, e.g. sha256:2c26b46b68ffc68ff99b453c1d3041341342...
+func IsDigest(revision string) bool {
+	return digestRegex.MatchString(revision)
+}
+
+// OCIClient provides access to the charts stored in an OCI registry
+type OCIClient interface {
+	// TestAccess tests whether the registry is accessible with the credentials of the client
+	TestAccess() error
+	// ResolveDigest returns the digest of the manifest of the chart with the given tag or digest
+	ResolveDigest(chart string, reference string) (string, error)
+	// ExtractChart pulls the chart whose manifest has the given digest, and extracts it to a
+	// temporary directory, which is removed by the returned closer. Returns the path of the
+	// chart's directory.
+	ExtractChart(chart string, digest string) (string, io.Closer, error)
+}
+
+// NewOCIClient returns a client for the charts stored under the URL of an OCI registry, such as
+// oci://registry.example.com/charts. The registry is always accessed using HTTPS.
+func NewOCIClient(repoURL string, creds Creds) OCIClient {
+	ref := repoURL
+	if IsOCIURL(ref) {
+		ref = ref[len(ociScheme):]
+	}
+	ref = strings.Trim(ref, "/")
+	host, repository := ref, ""
+	if i := strings.Index(ref, "/"); i >= 0 {
+		host, repository = ref[:i], ref[i+1:]
+	}
+	return &ociClient{host: host, repository: repository, creds: creds}
+}
+
+type ociClient struct {
+	host       string
+	repository string
+	creds      Creds
+	// Authorization header obtained from the last authentication challenge of the registry
+	authorization string
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+func (c *ociClient) name(chart string) string {
+	if c.repository == "" {
+		return chart
+	}
+	return c.repository + "/" + chart
+}
+
+func (c *ociClient) TestAccess() error {
+	_, err := c.get("/v2/", "", "")
+	return err
+}
+
+func (c *ociClient) ResolveDigest(chart string, reference string) (string, error) {
+	if reference == "" {
+		return "", fmt.Errorf("a tag or digest of chart '%s' is required", chart)
+	}
+	_, digest, err := c.getManifest(chart, reference)
+	return digest, err
+}
+
+func (c *ociClient) getManifest(chart string, reference string) (*ociManifest, string, error) {
+	name := c.name(chart)
+	data, err := c.get(fmt.Sprintf("/v2/%s/manifests/%s", name, reference), ociManifestMediaType, fmt.Sprintf("repository:%s:pull", name))
+	if err != nil {
+		return nil, "", err
+	}
+	digest := sha256Digest(data)
+	if IsDigest(reference) && digest != reference {
+		return nil, "", fmt.Errorf("digest of manifest of chart '%s' is %s instead of %s", chart, digest, reference)
+	}
+	manifest := &ociManifest{}
+	err = json.Unmarshal(data, manifest)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse manifest of chart '%s': %v", chart, err)
+	}
+	return manifest, digest, nil
+}
+
+func (c *ociClient) ExtractChart(chart string, digest string) (string, io.Closer, error) {
+	if !IsDigest(digest) {
+		return "", nil, fmt.Errorf("invalid digest '%s' of chart '%s'", digest, chart)
+	}
+	start := time.Now()
+	manifest, _, err := c.getManifest(chart, digest)
+	if err != nil {
+		return "", nil, err
+	}
+	var layer *ociDescriptor
+	for i := range manifest.Layers {
+		if manifest.Layers[i].MediaType == ociChartLayerMediaType || manifest.Layers[i].MediaType == ociLegacyChartLayerMediaType {
+			layer = &manifest.Layers[i]
+			break
+		}
+	}
+	if layer == nil {
+		return "", nil, fmt.Errorf("manifest %s of chart '%s' has no chart layer", digest, chart)
+	}
+	name := c.name(chart)
+	data, err := c.get(fmt.Sprintf("/v2/%s/blobs/%s", name, layer.Digest), "", fmt.Sprintf("repository:%s:pull", name))
+	if err != nil {
+		return "", nil, err
+	}
+	if sha256Digest(data) != layer.Digest {
+		return "", nil, fmt.Errorf("digest of chart layer of chart '%s' does not match %s", chart, layer.Digest)
+	}
+
+	dir, err := ioutil.TempDir("", "helm-chart")
+	if err != nil {
+		return "", nil, err
+	}
+	closer := &tempDirCloser{dir: dir}
+	// The archive contains the chart in a directory named after the chart
+	chartPath, err := extractChartArchive(dir, path.Base(chart), data)
+	if err != nil {
+		_ = closer.Close()
+		return "", nil, fmt.Errorf("failed to extract chart '%s' of manifest %s: %v", chart, digest, err)
+	}
+	log.WithFields(log.Fields{"registry": c.host, "chart": name, "digest": digest, "seconds": time.Since(start).Seconds()}).Info("pulled chart")
+	return chartPath, closer, nil
+}
+
+// Gets a resource of the registry. If the registry challenges the client to authenticate, the
+// request is retried with the credentials of the client, or with a token obtained for the scope.
+func (c *ociClient) get(resourcePath string, accept string, scope string) ([]byte, error) {
+	httpClient, err := newHTTPClient(c.host, c.creds)
+	if err != nil {
+		return nil, err
+	}
+	resourceURL := "https://" + c.host + resourcePath
+	resp, err := c.do(httpClient, resourceURL, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		c.authorization, err = c.authorize(httpClient, challenge, scope)
+		if err != nil {
+			return nil, err
+		}
+		resp, err = c.do(httpClient, resourceURL, accept)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s: %s", resourceURL, resp.Status)
+	}
+	return readBody(resourceURL, resp)
+}
+
+func (c *ociClient) do(httpClient *http.Client, resourceURL string, accept string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, resourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if c.authorization != "" {
+		req.Header.Set("Authorization", c.authorization)
+	}
+	return httpClient.Do(req)
+}
+
+// Returns the Authorization header answering the authentication challenge of the registry
+func (c *ociClient) authorize(httpClient *http.Client, challenge string, scope string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.creds.Username == "" && c.creds.Password == "" {
+			return "", fmt.Errorf("registry %s requires credentials", c.host)
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(c.creds.Username+":"+c.creds.Password)), nil
+	case "bearer":
+		token, err := c.getToken(httpClient, params, scope)
+		if err != nil {
+			return "", err
+		}
+		return "Bearer " + token, nil
+	default:
+		return "", fmt.Errorf("registry %s requires unsupported authentication '%s'", c.host, challenge)
+	}
+}
+
+// Gets a token from the authorization service of the registry, see
+// https://docs.docker.com/registry/spec/auth/token/
+func (c *ociClient) getToken(httpClient *http.Client, params map[string]string, scope string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("registry %s has invalid authorization service '%s'", c.host, params["realm"])
+	}
+	query := realm.Query()
+	if service, ok := params["service"]; ok {
+		query.Set("service", service)
+	}
+	if challengeScope, ok := params["scope"]; ok {
+		scope = challengeScope
+	}
+	if scope != "" {
+		query.Set("scope", scope)
+	}
+	realm.RawQuery = query.Encode()
+	req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.creds.Username != "" || c.creds.Password != "" {
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get token for registry %s: %s", c.host, resp.Status)
+	}
+	data, err := readBody(realm.String(), resp)
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse token of registry %s: %v", c.host, err)
+	}
+	if res.Token != "" {
+		return res.Token, nil
+	}
+	if res.AccessToken != "" {
+		return res.AccessToken, nil
+	}
+	return "", fmt.Errorf("registry %s returned no token", c.host)
+}
+
+// Parses a WWW-Authenticate header such as: Bearer realm="https://auth.example.com/token",service="registry.example.com"
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := make(map[string]string)
+	challenge = strings.TrimSpace(challenge)
+	i := strings.Index(challenge, " ")
+	if i < 0 {
+		return challenge, params
+	}
+	scheme, rest := challenge[:i], challenge[i+1:]
+	for rest != "" {
+		rest = strings.TrimLeft(rest, " ,")
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = rest[eq+1:]
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else if end := strings.Index(rest, ","); end >= 0 {
+			value, rest = rest[:end], rest[end:]
+		} else {
+			value, rest = rest, ""
+		}
+		params[key] = value
+	}
+	return scheme, params
+}
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,118 @@
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRegistry(t *testing.T) (*httptest.Server, string) {
+	chart := newTestArchive(t, map[string]string{"mychart/Chart.yaml": "name: mychart\nversion: 0.2.0\n"})
+	chartDigest := sha256Digest(chart)
+	manifest, err := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"layers":        []ociDescriptor{{MediaType: ociChartLayerMediaType, Digest: chartDigest}},
+	})
+	assert.NoError(t, err)
+	manifestDigest := sha256Digest(manifest)
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			// Tokens are only issued for the credentials of the test
+			if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"token": "token-%s"}`, r.URL.Query().Get("scope"))))
+			return
+		}
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer token-") {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry.example.com"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/":
+		case "/v2/charts/mychart/manifests/0.2.0", "/v2/charts/mychart/manifests/" + manifestDigest:
+			_, _ = w.Write(manifest)
+		case "/v2/charts/mychart/blobs/" + chartDigest:
+			_, _ = w.Write(chart)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server, manifestDigest
+}
+
+func TestIsOCIURL(t *testing.T) {
+	assert.True(t, IsOCIURL("oci://registry.example.com/charts"))
+	assert.True(t, IsOCIURL("OCI://registry.example.com"))
+	assert.False(t, IsOCIURL("https://charts.example.com"))
+	assert.False(t, IsOCIURL("registry.example.com/charts"))
+}
+
+func TestIsDigest(t *testing.T) {
+	assert.True(t, IsDigest(sha256Digest([]byte("test"))))
+	assert.False(t, IsDigest("0.2.0"))
+	assert.False(t, IsDigest("sha256:1234"))
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:charts/mychart:pull"`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:charts/mychart:pull",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm="Registry Realm"`)
+	assert.Equal(t, "Basic", scheme)
+	assert.Equal(t, map[string]string{"realm": "Registry Realm"}, params)
+}
+
+func TestOCIClient(t *testing.T) {
+	server, manifestDigest := newTestRegistry(t)
+	defer server.Close()
+	repoURL := "oci://" + strings.TrimPrefix(server.URL, "https://") + "/charts"
+	client := NewOCIClient(repoURL, Creds{Username: "user", Password: "pass", InsecureSkipVerify: true})
+
+	assert.NoError(t, client.TestAccess())
+
+	digest, err := client.ResolveDigest("mychart", "0.2.0")
+	assert.NoError(t, err)
+	assert.Equal(t, manifestDigest, digest)
+	digest, err = client.ResolveDigest("mychart", manifestDigest)
+	assert.NoError(t, err)
+	assert.Equal(t, manifestDigest, digest)
+	_, err = client.ResolveDigest("mychart", "0.3.0")
+	assert.Error(t, err)
+	_, err = client.ResolveDigest("mychart", "")
+	assert.EqualError(t, err, "a tag or digest of chart 'mychart' is required")
+
+	chartPath, closer, err := client.ExtractChart("mychart", manifestDigest)
+	if assert.NoError(t, err) {
+		data, err := ioutil.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "name: mychart")
+		assert.NoError(t, closer.Close())
+		_, err = os.Stat(chartPath)
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	// Charts are only pulled by digest
+	_, _, err = client.ExtractChart("mychart", "0.2.0")
+	assert.EqualError(t, err, "invalid digest '0.2.0' of chart 'mychart'")
+
+	err = NewOCIClient(repoURL, Creds{InsecureSkipVerify: true}).TestAccess()
+	assert.Error(t, err)
+}
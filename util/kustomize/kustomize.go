@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -47,12 +48,16 @@ type Kustomize interface {
 	Build(opts *v1alpha1.ApplicationSourceKustomize) ([]*unstructured.Unstructured, []ImageTag, []Image, error)
 }
 
-// NewKustomizeApp create a new wrapper to run commands on the `kustomize` command-line tool.
-func NewKustomizeApp(path string, creds git.Creds, fromRepo string) Kustomize {
+// NewKustomizeApp create a new wrapper to run commands on the `kustomize` command-line tool. The binary path
+// overrides the bundled kustomize binary, if set. The environment variables of the form NAME=VALUE are added to the
+// environment of `kustomize build`.
+func NewKustomizeApp(path string, creds git.Creds, fromRepo string, binaryPath string, env []string) Kustomize {
 	return &kustomize{
-		path:  path,
-		creds: creds,
-		repo:  fromRepo,
+		path:       path,
+		creds:      creds,
+		repo:       fromRepo,
+		binaryPath: binaryPath,
+		env:        env,
 	}
 }
 
@@ -63,6 +68,10 @@ type kustomize struct {
 	creds git.Creds
 	// the Git repository URL where we checked out
 	repo string
+	// the path of the kustomize binary of the version selected by the application, if any
+	binaryPath string
+	// additional environment variables of `kustomize build`
+	env []string
 }
 
 func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize) ([]*unstructured.Unstructured, []ImageTag, []Image, error) {
@@ -73,6 +82,9 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize) ([]*unstruc
 	}
 
 	commandName := GetCommandName(version)
+	if k.binaryPath != "" {
+		commandName = k.binaryPath
+	}
 
 	if opts != nil {
 		if opts.NamePrefix != "" {
@@ -134,10 +146,18 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize) ([]*unstruc
 				return nil, nil, nil, err
 			}
 		}
+
+		if len(opts.Components) > 0 || len(opts.Patches) > 0 || len(opts.Replicas) > 0 {
+			if version != 2 {
+				log.Warn("ignoring components, patches and replicas as kustomize is not version 2")
+			} else if err := k.editKustomization(opts); err != nil {
+				return nil, nil, nil, err
+			}
+		}
 	}
 
 	cmd := exec.Command(commandName, "build", k.path)
-	cmd.Env = os.Environ()
+	cmd.Env = append(os.Environ(), k.env...)
 	closer, environ, err := k.creds.Environ()
 	if err != nil {
 		return nil, nil, nil, err
@@ -183,6 +203,23 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize) ([]*unstruc
 	return objs, nil, getImageParameters(objs), nil
 }
 
+// Version returns the version of kustomize used when building kustomizations, which are not
+// kustomize 1 kustomizations
+func Version() (string, error) {
+	cmd := exec.Command(GetCommandName(2), "version")
+	out, err := argoexec.RunCommandExt(cmd, config.CmdOpts())
+	if err != nil {
+		return "", fmt.Errorf("unable to determine kustomize version: %v", err)
+	}
+	// e.g. "Version: {KustomizeVersion:v2.0.3 GitCommit:a6f6514... BuildDate:... GoOs:linux GoArch:amd64}"
+	if match := versionRegex.FindStringSubmatch(out); match != nil {
+		return match[1], nil
+	}
+	return strings.TrimSpace(out), nil
+}
+
+var versionRegex = regexp.MustCompile(`Version:([^\s}]+)`)
+
 func GetCommandName(version int) string {
 	if version == 1 {
 		return "kustomize1"
@@ -241,6 +278,100 @@ func (k *kustomize) getKustomizationVersion() (int, error) {
 	return 1, nil
 }
 
+// editKustomization adds the components, patches and replicas of the options to the kustomization. Replicas
+// override the replicas of the kustomization with the same name.
+func (k *kustomize) editKustomization(opts *v1alpha1.ApplicationSourceKustomize) error {
+	kustomizationFile, err := k.findKustomization()
+	if err != nil {
+		return err
+	}
+	dat, err := ioutil.ReadFile(kustomizationFile)
+	if err != nil {
+		return err
+	}
+	var obj yaml.MapSlice
+	err = yaml.Unmarshal(dat, &obj)
+	if err != nil {
+		return err
+	}
+
+	var components []interface{}
+	for _, component := range opts.Components {
+		components = append(components, component)
+	}
+	obj = appendToList(obj, "components", components, nil)
+
+	var patches []interface{}
+	for _, p := range opts.Patches {
+		patch := yaml.MapSlice{{Key: "patch", Value: p.Patch}}
+		if p.Target != nil {
+			var target yaml.MapSlice
+			for _, field := range []yaml.MapItem{
+				{Key: "group", Value: p.Target.Group},
+				{Key: "version", Value: p.Target.Version},
+				{Key: "kind", Value: p.Target.Kind},
+				{Key: "name", Value: p.Target.Name},
+				{Key: "namespace", Value: p.Target.Namespace},
+				{Key: "labelSelector", Value: p.Target.LabelSelector},
+				{Key: "annotationSelector", Value: p.Target.AnnotationSelector},
+			} {
+				if field.Value != "" {
+					target = append(target, field)
+				}
+			}
+			patch = append(patch, yaml.MapItem{Key: "target", Value: target})
+		}
+		patches = append(patches, patch)
+	}
+	obj = appendToList(obj, "patches", patches, nil)
+
+	var replicas []interface{}
+	names := make(map[string]bool)
+	for _, r := range opts.Replicas {
+		replicas = append(replicas, yaml.MapSlice{{Key: "name", Value: r.Name}, {Key: "count", Value: r.Count}})
+		names[r.Name] = true
+	}
+	obj = appendToList(obj, "replicas", replicas, func(item interface{}) bool {
+		if replica, ok := item.(yaml.MapSlice); ok {
+			for _, field := range replica {
+				if field.Key == "name" {
+					return names[fmt.Sprintf("%v", field.Value)]
+				}
+			}
+		}
+		return false
+	})
+
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(kustomizationFile, out, 0644)
+}
+
+// appendToList appends items to the list of a key of a YAML object, after removing the existing items which are
+// overridden
+func appendToList(obj yaml.MapSlice, key string, items []interface{}, overridden func(item interface{}) bool) yaml.MapSlice {
+	if len(items) == 0 {
+		return obj
+	}
+	for i := range obj {
+		if obj[i].Key != key {
+			continue
+		}
+		existing, _ := obj[i].Value.([]interface{})
+		var list []interface{}
+		for _, item := range existing {
+			if overridden == nil || !overridden(item) {
+				list = append(list, item)
+			}
+		}
+		obj[i].Value = append(list, items...)
+		return obj
+	}
+	return append(obj, yaml.MapItem{Key: key, Value: items})
+}
+
 func getImageTagParameters(objs []*unstructured.Unstructured) []ImageTag {
 	var images []ImageTag
 	for _, image := range getImageParameters(objs) {
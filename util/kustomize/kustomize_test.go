@@ -2,6 +2,7 @@ package kustomize
 
 import (
 	"io/ioutil"
+	"os"
 	"path"
 	"path/filepath"
 	"testing"
@@ -33,7 +34,7 @@ func TestKustomizeBuild(t *testing.T) {
 	appPath, err := testDataDir()
 	assert.Nil(t, err)
 	namePrefix := "namePrefix-"
-	kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "")
+	kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "", "", nil)
 	kustomizeSource := v1alpha1.ApplicationSourceKustomize{
 		NamePrefix: namePrefix,
 		ImageTags: []v1alpha1.KustomizeImageTag{
@@ -128,3 +129,51 @@ func TestNewImageTag(t *testing.T) {
 	assert.Equal(t, tag.Name, "k8s.gcr.io/nginx-slim")
 	assert.Equal(t, tag.Value, "0.8")
 }
+
+func TestEditKustomization(t *testing.T) {
+	appPath, err := ioutil.TempDir("", "kustomize-test")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(appPath) }()
+	err = ioutil.WriteFile(filepath.Join(appPath, "kustomization.yaml"), []byte(`kind: Kustomization
+resources:
+- ./deployment.yaml
+replicas:
+- name: guestbook
+  count: 1
+- name: redis
+  count: 1
+`), 0644)
+	assert.NoError(t, err)
+
+	k := &kustomize{path: appPath}
+	err = k.editKustomization(&v1alpha1.ApplicationSourceKustomize{
+		Components: []string{"../components/ha"},
+		Patches: []v1alpha1.KustomizePatch{{
+			Patch:  "- op: add\n  path: /spec/paused\n  value: true\n",
+			Target: &v1alpha1.KustomizeSelector{Kind: "Deployment", Name: "guestbook"},
+		}},
+		Replicas: []v1alpha1.KustomizeReplica{{Name: "guestbook", Count: 3}},
+	})
+	assert.NoError(t, err)
+	dat, err := ioutil.ReadFile(filepath.Join(appPath, "kustomization.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, `kind: Kustomization
+resources:
+- ./deployment.yaml
+replicas:
+- name: redis
+  count: 1
+- name: guestbook
+  count: 3
+components:
+- ../components/ha
+patches:
+- patch: |
+    - op: add
+      path: /spec/paused
+      value: true
+  target:
+    kind: Deployment
+    name: guestbook
+`, string(dat))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/gobuffalo/packr"
@@ -19,6 +20,7 @@ import (
 const (
 	incorrectReturnType              = "expect %s output from Lua script, not %s"
 	invalidHealthStatus              = "Lua returned an invalid health status"
+	actionParamsGlobal               = "actionParams"
 	resourceCustomizationBuiltInPath = "../../resource_customizations"
 	healthScriptFile                 = "health.lua"
 	actionScriptFile                 = "action.lua"
@@ -40,7 +42,7 @@ type VM struct {
 	UseOpenLibs bool
 }
 
-func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState, error) {
+func (vm VM) runLua(obj *unstructured.Unstructured, script string, params []appv1.ResourceActionParam) (*lua.LState, error) {
 	l := lua.NewState(lua.Options{
 		SkipOpenLibs: !vm.UseOpenLibs,
 	})
@@ -68,13 +70,18 @@ func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState,
 	l.SetContext(ctx)
 	objectValue := decodeValue(l, obj.Object)
 	l.SetGlobal("obj", objectValue)
-	err := l.DoString(script)
+	paramsValue, err := decodeActionParams(l, params)
+	if err != nil {
+		return nil, err
+	}
+	l.SetGlobal(actionParamsGlobal, paramsValue)
+	err = l.DoString(script)
 	return l, err
 }
 
 // ExecuteHealthLua runs the lua script to generate the health status of a resource
 func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*appv1.HealthStatus, error) {
-	l, err := vm.runLua(obj, script)
+	l, err := vm.runLua(obj, script, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,8 +117,10 @@ func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, error) {
 	return vm.getPredefinedLuaScripts(key, healthScriptFile)
 }
 
-func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) (*unstructured.Unstructured, error) {
-	l, err := vm.runLua(obj, script)
+// ExecuteResourceAction runs the lua script of an action with the given parameters, which are available to the script
+// as the actionParams table
+func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string, params []appv1.ResourceActionParam) (*unstructured.Unstructured, error) {
+	l, err := vm.runLua(obj, script, params)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +197,7 @@ func cleanReturnedArray(newObj, obj []interface{}) []interface{} {
 }
 
 func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, script string) ([]appv1.ResourceAction, error) {
-	l, err := vm.runLua(obj, script)
+	l, err := vm.runLua(obj, script, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -289,6 +298,79 @@ func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string
 	}, nil
 }
 
+// ValidateResourceActionParams validates the given parameter values against the parameters declared by an action and
+// returns the parameters to run the action with. Declared parameters which are not given fall back to their default.
+func ValidateResourceActionParams(declared []appv1.ResourceActionParam, given []appv1.ResourceActionParam) ([]appv1.ResourceActionParam, error) {
+	declaredNames := make(map[string]bool)
+	for _, param := range declared {
+		declaredNames[param.Name] = true
+	}
+	values := make(map[string]string)
+	for _, param := range given {
+		if !declaredNames[param.Name] {
+			return nil, fmt.Errorf("unknown parameter '%s'", param.Name)
+		}
+		if _, ok := values[param.Name]; ok {
+			return nil, fmt.Errorf("parameter '%s' is given more than once", param.Name)
+		}
+		values[param.Name] = param.Value
+	}
+	var params []appv1.ResourceActionParam
+	for _, param := range declared {
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Default == "" {
+				continue
+			}
+			value = param.Default
+		}
+		if _, err := parseActionParamValue(param.Type, value); err != nil {
+			return nil, fmt.Errorf("invalid value '%s' of parameter '%s': %v", value, param.Name, err)
+		}
+		params = append(params, appv1.ResourceActionParam{Name: param.Name, Type: param.Type, Value: value})
+	}
+	return params, nil
+}
+
+func parseActionParamValue(paramType string, value string) (lua.LValue, error) {
+	switch paramType {
+	case "", "string":
+		return lua.LString(value), nil
+	case "number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number")
+		}
+		return lua.LNumber(f), nil
+	case "integer":
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer")
+		}
+		return lua.LNumber(i), nil
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected a boolean")
+		}
+		return lua.LBool(b), nil
+	}
+	return nil, fmt.Errorf("unknown parameter type '%s'", paramType)
+}
+
+// decodeActionParams converts the parameters of an action into a table of their typed values
+func decodeActionParams(L *lua.LState, params []appv1.ResourceActionParam) (*lua.LTable, error) {
+	tbl := L.CreateTable(0, len(params))
+	for _, param := range params {
+		value, err := parseActionParamValue(param.Type, param.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' of parameter '%s': %v", param.Value, param.Name, err)
+		}
+		tbl.RawSetH(lua.LString(param.Name), value)
+	}
+	return tbl, nil
+}
+
 func getConfigMapKey(obj *unstructured.Unstructured) string {
 	gvk := obj.GroupVersionKind()
 	if gvk.Group == "" {
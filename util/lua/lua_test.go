@@ -162,6 +162,7 @@ func TestGetResourceActionWithOverride(t *testing.T) {
 	test := appv1.ResourceActionDefinition{
 		Name:      "test",
 		ActionLua: "return obj",
+		Params:    []appv1.ResourceActionParam{{Name: "replicas", Type: "integer", Default: "1"}},
 	}
 
 	vm := VM{
@@ -296,7 +297,7 @@ func TestExecuteResourceAction(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	expectedObj := StrToUnstructured(expectedUpdatedObj)
 	vm := VM{}
-	newObj, err := vm.ExecuteResourceAction(testObj, validActionLua)
+	newObj, err := vm.ExecuteResourceAction(testObj, validActionLua, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedObj, newObj)
 }
@@ -304,7 +305,7 @@ func TestExecuteResourceAction(t *testing.T) {
 func TestExecuteResourceActionNonTableReturn(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
-	_, err := vm.ExecuteResourceAction(testObj, returnInt)
+	_, err := vm.ExecuteResourceAction(testObj, returnInt, nil)
 	assert.Errorf(t, err, incorrectReturnType, "table", "number")
 }
 
@@ -316,7 +317,7 @@ return newObj
 func TestExecuteResourceActionInvalidUnstructured(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
-	_, err := vm.ExecuteResourceAction(testObj, invalidTableReturn)
+	_, err := vm.ExecuteResourceAction(testObj, invalidTableReturn, nil)
 	assert.Error(t, err)
 }
 
@@ -371,8 +372,63 @@ func TestCleanPatch(t *testing.T) {
 	testObj := StrToUnstructured(objWithEmptyStruct)
 	expectedObj := StrToUnstructured(expectedUpdatedObjWithEmptyStruct)
 	vm := VM{}
-	newObj, err := vm.ExecuteResourceAction(testObj, pausedToFalseLua)
+	newObj, err := vm.ExecuteResourceAction(testObj, pausedToFalseLua, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedObj, newObj)
 
 }
+
+const paramsActionLua = `
+obj.metadata.labels["test"] = actionParams.label
+if actionParams.paused then
+  obj.spec = {replicas = actionParams.replicas}
+end
+return obj
+`
+
+func TestExecuteResourceActionWithParams(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	newObj, err := vm.ExecuteResourceAction(testObj, paramsActionLua, []appv1.ResourceActionParam{
+		{Name: "label", Value: "test"},
+		{Name: "paused", Type: "boolean", Value: "true"},
+		{Name: "replicas", Type: "integer", Value: "3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", newObj.GetLabels()["test"])
+	replicas, _, _ := unstructured.NestedInt64(newObj.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+
+	_, err = vm.ExecuteResourceAction(testObj, paramsActionLua, []appv1.ResourceActionParam{{Name: "replicas", Type: "integer", Value: "three"}})
+	assert.Error(t, err)
+}
+
+func TestValidateResourceActionParams(t *testing.T) {
+	declared := []appv1.ResourceActionParam{
+		{Name: "replicas", Type: "integer", Default: "1"},
+		{Name: "paused", Type: "boolean"},
+		{Name: "label"},
+	}
+	t.Run("Defaults", func(t *testing.T) {
+		params, err := ValidateResourceActionParams(declared, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []appv1.ResourceActionParam{{Name: "replicas", Type: "integer", Value: "1"}}, params)
+	})
+	t.Run("Given", func(t *testing.T) {
+		params, err := ValidateResourceActionParams(declared, []appv1.ResourceActionParam{{Name: "label", Value: "test"}, {Name: "replicas", Value: "3"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []appv1.ResourceActionParam{{Name: "replicas", Type: "integer", Value: "3"}, {Name: "label", Value: "test"}}, params)
+	})
+	t.Run("InvalidType", func(t *testing.T) {
+		_, err := ValidateResourceActionParams(declared, []appv1.ResourceActionParam{{Name: "paused", Value: "maybe"}})
+		assert.EqualError(t, err, "invalid value 'maybe' of parameter 'paused': expected a boolean")
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := ValidateResourceActionParams(declared, []appv1.ResourceActionParam{{Name: "foo", Value: "bar"}})
+		assert.EqualError(t, err, "unknown parameter 'foo'")
+	})
+	t.Run("Duplicate", func(t *testing.T) {
+		_, err := ValidateResourceActionParams(declared, []appv1.ResourceActionParam{{Name: "label", Value: "a"}, {Name: "label", Value: "b"}})
+		assert.EqualError(t, err, "parameter 'label' is given more than once")
+	})
+}
@@ -197,6 +197,28 @@ func (mgr *SessionManager) provider() (oidcutil.Provider, error) {
 	return mgr.prov, nil
 }
 
+// ImpersonatorClaimKey is the claim holding the username of the caller which performs a request on behalf of the user
+// of the claims
+const ImpersonatorClaimKey = "impersonator"
+
+// AuditUsername returns the username of a context for audit events, which includes the impersonator if the request is
+// performed on behalf of the user
+func AuditUsername(ctx context.Context) string {
+	user := Username(ctx)
+	claims, ok := ctx.Value("claims").(jwt.Claims)
+	if !ok || user == "" {
+		return user
+	}
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return user
+	}
+	if impersonator := jwtutil.GetField(mapClaims, ImpersonatorClaimKey); impersonator != "" {
+		return fmt.Sprintf("%s (impersonated by %s)", user, impersonator)
+	}
+	return user
+}
+
 // Username is a helper to extract a human readable username from a context
 func Username(ctx context.Context) string {
 	claims, ok := ctx.Value("claims").(jwt.Claims)
@@ -9,6 +9,9 @@ type FilteredResource struct {
 	APIGroups []string `json:"apiGroups,omitempty"`
 	Kinds     []string `json:"kinds,omitempty"`
 	Clusters  []string `json:"clusters,omitempty"`
+	// Namespaces are the names of the namespaces of the filtered resources. If empty, the resources of all namespaces
+	// are filtered.
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 func (r FilteredResource) matchGroup(apiGroup string) bool {
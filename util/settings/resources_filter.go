@@ -1,9 +1,9 @@
 package settings
 
 type ResourcesFilter struct {
-	// ResourceExclusions holds the api groups, kinds per cluster to exclude from Argo CD's watch
+	// ResourceExclusions holds the api groups, kinds and namespaces per cluster to exclude from Argo CD's watch
 	ResourceExclusions []FilteredResource
-	// ResourceInclusions holds the only api groups, kinds per cluster that Argo CD will watch
+	// ResourceInclusions holds the only api groups, kinds and namespaces per cluster that Argo CD will watch
 	ResourceInclusions []FilteredResource
 }
 
@@ -31,7 +31,14 @@ func (rf *ResourcesFilter) isIncludedResource(apiGroup, kind, cluster string) bo
 }
 
 func (rf *ResourcesFilter) isExcludedResource(apiGroup, kind, cluster string) bool {
-	return rf.checkResourcePresence(apiGroup, kind, cluster, rf.getExcludedResources())
+	// the exclusions of namespaces only exclude the resources of these namespaces
+	var excludedResources []FilteredResource
+	for _, excludedResource := range rf.getExcludedResources() {
+		if len(excludedResource.Namespaces) == 0 {
+			excludedResources = append(excludedResources, excludedResource)
+		}
+	}
+	return rf.checkResourcePresence(apiGroup, kind, cluster, excludedResources)
 }
 
 // Behavior of this function is as follows:
@@ -68,3 +75,60 @@ func (rf *ResourcesFilter) IsExcludedResource(apiGroup, kind, cluster string) bo
 		return rf.isExcludedResource(apiGroup, kind, cluster)
 	}
 }
+
+// GetNamespaces returns the namespaces in which the resources of a namespaced api group and kind of a cluster are not
+// excluded. If the included namespaces are nil, the resources of all namespaces except the excluded namespaces are not
+// excluded. If the resources are included in some namespaces only, the excluded namespaces are nil.
+func (rf *ResourcesFilter) GetNamespaces(apiGroup, kind, cluster string) (included []string, excluded []string) {
+	for _, excludedResource := range rf.getExcludedResources() {
+		if len(excludedResource.Namespaces) > 0 && excludedResource.Match(apiGroup, kind, cluster) {
+			excluded = appendNamespaces(excluded, excludedResource.Namespaces, nil)
+		}
+	}
+	var namespaces []string
+	for _, includedResource := range rf.ResourceInclusions {
+		if !includedResource.Match(apiGroup, kind, cluster) {
+			continue
+		}
+		if len(includedResource.Namespaces) == 0 {
+			return nil, excluded
+		}
+		namespaces = append(namespaces, includedResource.Namespaces...)
+	}
+	if namespaces == nil {
+		return nil, excluded
+	}
+	return appendNamespaces([]string{}, namespaces, excluded), nil
+}
+
+// IsExcludedNamespacedResource returns whether the resources of a namespaced api group and kind in a namespace of a
+// cluster are excluded
+func (rf *ResourcesFilter) IsExcludedNamespacedResource(apiGroup, kind, cluster, namespace string) bool {
+	if rf.IsExcludedResource(apiGroup, kind, cluster) {
+		return true
+	}
+	included, excluded := rf.GetNamespaces(apiGroup, kind, cluster)
+	if included != nil {
+		return !containsNamespace(included, namespace)
+	}
+	return containsNamespace(excluded, namespace)
+}
+
+// appendNamespaces appends the namespaces which are neither appended yet nor skipped
+func appendNamespaces(namespaces []string, add []string, skip []string) []string {
+	for _, namespace := range add {
+		if !containsNamespace(namespaces, namespace) && !containsNamespace(skip, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+func containsNamespace(namespaces []string, namespace string) bool {
+	for _, ns := range namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -49,3 +49,39 @@ func TestResourceInclusionsExclusionNonMutex(t *testing.T) {
 	assert.True(t, filter.IsExcludedResource("not-whitelisted-resource", "whitelisted-kind", ""))
 	assert.True(t, filter.IsExcludedResource("not-whitelisted-resource", "", ""))
 }
+
+func TestResourceNamespaces(t *testing.T) {
+	filter := ResourcesFilter{
+		ResourceExclusions: []FilteredResource{{APIGroups: []string{""}, Kinds: []string{"Secret"}, Namespaces: []string{"kube-system", "monitoring"}}},
+	}
+	assert.False(t, filter.IsExcludedResource("", "Secret", ""))
+	included, excluded := filter.GetNamespaces("", "Secret", "")
+	assert.Nil(t, included)
+	assert.Equal(t, []string{"kube-system", "monitoring"}, excluded)
+	assert.True(t, filter.IsExcludedNamespacedResource("", "Secret", "", "kube-system"))
+	assert.False(t, filter.IsExcludedNamespacedResource("", "Secret", "", "default"))
+	assert.False(t, filter.IsExcludedNamespacedResource("", "ConfigMap", "", "kube-system"))
+
+	filter = ResourcesFilter{
+		ResourceInclusions: []FilteredResource{
+			{APIGroups: []string{"apps"}, Namespaces: []string{"default", "guestbook"}, Clusters: []string{"https://cluster"}},
+			{APIGroups: []string{"apps"}, Namespaces: []string{"guestbook", "monitoring"}},
+		},
+		ResourceExclusions: []FilteredResource{{APIGroups: []string{"apps"}, Namespaces: []string{"monitoring"}}},
+	}
+	assert.False(t, filter.IsExcludedResource("apps", "Deployment", "https://cluster"))
+	included, excluded = filter.GetNamespaces("apps", "Deployment", "https://cluster")
+	assert.Equal(t, []string{"default", "guestbook"}, included)
+	assert.Nil(t, excluded)
+	included, _ = filter.GetNamespaces("apps", "Deployment", "https://other-cluster")
+	assert.Equal(t, []string{"guestbook"}, included)
+	assert.True(t, filter.IsExcludedNamespacedResource("apps", "Deployment", "https://other-cluster", "default"))
+	assert.False(t, filter.IsExcludedNamespacedResource("apps", "Deployment", "https://other-cluster", "guestbook"))
+	assert.True(t, filter.IsExcludedNamespacedResource("", "Service", "https://cluster", "guestbook"))
+
+	// inclusions of all namespaces take precedence over inclusions of some namespaces
+	filter.ResourceInclusions = append(filter.ResourceInclusions, FilteredResource{APIGroups: []string{"apps"}})
+	included, excluded = filter.GetNamespaces("apps", "Deployment", "https://cluster")
+	assert.Nil(t, included)
+	assert.Equal(t, []string{"monitoring"}, excluded)
+}
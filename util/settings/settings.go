@@ -6,9 +6,11 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -102,6 +104,14 @@ type RepoCredentials struct {
 	TLSClientCertDataSecret *apiv1.SecretKeySelector `json:"tlsClientCertDataSecret,omitempty"`
 	// Name of the secret storing the TLS client cert's key data
 	TLSClientCertKeySecret *apiv1.SecretKeySelector `json:"tlsClientCertKeySecret,omitempty"`
+	// Type of the repository, either "git" (the default) or "helm"
+	Type string `json:"type,omitempty"`
+	// Name of the repository, used for Helm chart repositories
+	Name string `json:"name,omitempty"`
+	// URL of the HTTP(S) proxy used to access the repository
+	Proxy string `json:"proxy,omitempty"`
+	// Whether the submodules of the repository are initialized on checkout
+	EnableSubmodules bool `json:"enableSubmodules,omitempty"`
 }
 
 type HelmRepoCredentials struct {
@@ -165,6 +175,18 @@ const (
 	configManagementPluginsKey = "configManagementPlugins"
 	// anonymousUserEnabledKey is the key which enables or disables anonymous user
 	anonymousUserEnabledKey = "users.anonymous.enabled"
+	// submodulesEnabledKey is the key which enables the initialization of submodules for all Git repositories
+	submodulesEnabledKey = "submodules.enabled"
+	// sshHostKeyScanEnabledKey is the key which allows the API server to scan SSH servers for their host keys
+	sshHostKeyScanEnabledKey = "certificates.sshScan.enabled"
+	// kustomizeVersionKeyPrefix is the prefix of the keys to the paths of the kustomize binaries of registered versions
+	kustomizeVersionKeyPrefix = "kustomize.version."
+	// helmVersionKeyPrefix is the prefix of the keys to the paths of the helm binaries of registered versions
+	helmVersionKeyPrefix = "helm.version."
+	// jsonnetLibsKey designates the key for the jsonnet library search dirs of all applications
+	jsonnetLibsKey = "jsonnet.libs"
+	// execProviderCommandsKey designates the key for the commands which may be used by the exec providers of clusters
+	execProviderCommandsKey = "cluster.execProviderCommands"
 )
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -179,6 +201,10 @@ type SettingsManager struct {
 	// mutex protects concurrency sensitive parts of settings manager: access to subscribers list and initialization flag
 	mutex             *sync.Mutex
 	initContextCancel func()
+	// certificatesSyncedAt is the time the certificate ConfigMaps were last synchronized by the informer
+	certificatesSyncedAt time.Time
+	// certificatesSyncMutex protects certificatesSyncedAt
+	certificatesSyncMutex sync.RWMutex
 }
 
 type incompleteSettingsError struct {
@@ -265,6 +291,24 @@ func (mgr *SettingsManager) GetAppInstanceLabelKey() (string, error) {
 	return label, nil
 }
 
+// GetSubmodulesEnabled returns whether the submodules of all Git repositories are initialized on checkout
+func (mgr *SettingsManager) GetSubmodulesEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	return argoCDCM.Data[submodulesEnabledKey] == "true", nil
+}
+
+// GetSSHHostKeyScanEnabled returns whether the API server may scan SSH servers for their host keys
+func (mgr *SettingsManager) GetSSHHostKeyScanEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	return argoCDCM.Data[sshHostKeyScanEnabledKey] == "true", nil
+}
+
 func (mgr *SettingsManager) GetConfigManagementPlugins() ([]v1alpha1.ConfigManagementPlugin, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -280,6 +324,75 @@ func (mgr *SettingsManager) GetConfigManagementPlugins() ([]v1alpha1.ConfigManag
 	return plugins, nil
 }
 
+// GetJsonnetLibs returns the jsonnet library search dirs, relative to the root of the repository, of all applications
+func (mgr *SettingsManager) GetJsonnetLibs() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	libs := make([]string, 0)
+	if value, ok := argoCDCM.Data[jsonnetLibsKey]; ok {
+		err := yaml.Unmarshal([]byte(value), &libs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return libs, nil
+}
+
+// GetExecProviderCommands returns the commands which the exec providers of clusters may run. Exec providers are not
+// allowed if no commands are configured.
+func (mgr *SettingsManager) GetExecProviderCommands() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	commands := make([]string, 0)
+	if value, ok := argoCDCM.Data[execProviderCommandsKey]; ok {
+		err := yaml.Unmarshal([]byte(value), &commands)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return commands, nil
+}
+
+// GetKustomizeVersions returns the kustomize versions registered with the keys kustomize.version.<name>, sorted by name
+func (mgr *SettingsManager) GetKustomizeVersions() ([]v1alpha1.KustomizeVersion, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]v1alpha1.KustomizeVersion, 0)
+	for k, v := range argoCDCM.Data {
+		if strings.HasPrefix(k, kustomizeVersionKeyPrefix) {
+			versions = append(versions, v1alpha1.KustomizeVersion{Name: strings.TrimPrefix(k, kustomizeVersionKeyPrefix), Path: v})
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Name < versions[j].Name
+	})
+	return versions, nil
+}
+
+// GetHelmVersions returns the helm versions registered with the keys helm.version.<name>, sorted by name
+func (mgr *SettingsManager) GetHelmVersions() ([]v1alpha1.HelmVersion, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]v1alpha1.HelmVersion, 0)
+	for k, v := range argoCDCM.Data {
+		if strings.HasPrefix(k, helmVersionKeyPrefix) {
+			versions = append(versions, v1alpha1.HelmVersion{Name: strings.TrimPrefix(k, helmVersionKeyPrefix), Path: v})
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Name < versions[j].Name
+	})
+	return versions, nil
+}
+
 // GetResouceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -364,6 +477,24 @@ func (mgr *SettingsManager) GetRepositoryCredentials() ([]RepoCredentials, error
 	return repositoryCredentials, nil
 }
 
+func (mgr *SettingsManager) SaveRepositoryCredentials(creds []RepoCredentials) error {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return err
+	}
+	if len(creds) > 0 {
+		yamlStr, err := yaml.Marshal(creds)
+		if err != nil {
+			return err
+		}
+		argoCDCM.Data[repositoryCredentialsKey] = string(yamlStr)
+	} else {
+		delete(argoCDCM.Data, repositoryCredentialsKey)
+	}
+	_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(argoCDCM)
+	return err
+}
+
 func (mgr *SettingsManager) GetGoogleAnalytics() (*GoogleAnalytics, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -425,6 +556,9 @@ func (mgr *SettingsManager) initialize(ctx context.Context) error {
 		return fmt.Errorf("Timed out waiting for settings cache to sync")
 	}
 	log.Info("Configmap/secret informer synced")
+	mgr.certificatesSyncMutex.Lock()
+	mgr.certificatesSyncedAt = time.Now()
+	mgr.certificatesSyncMutex.Unlock()
 
 	tryNotify := func() {
 		newSettings, err := mgr.GetSettings()
@@ -452,13 +586,50 @@ func (mgr *SettingsManager) initialize(ctx context.Context) error {
 			}
 		},
 	}
+	// The informer periodically resyncs all ConfigMaps, so every event for one
+	// of the certificate ConfigMaps tells us our view of them is up to date.
+	certHandler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			mgr.markCertificatesSynced(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			mgr.markCertificatesSynced(newObj)
+		},
+	}
 	secretsInformer.AddEventHandler(handler)
 	cmInformer.AddEventHandler(handler)
+	cmInformer.AddEventHandler(certHandler)
 	mgr.secrets = v1listers.NewSecretLister(secretsInformer.GetIndexer())
 	mgr.configmaps = v1listers.NewConfigMapLister(cmInformer.GetIndexer())
 	return nil
 }
 
+func (mgr *SettingsManager) markCertificatesSynced(obj interface{}) {
+	metaObj, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	if metaObj.GetName() != common.ArgoCDKnownHostsConfigMapName && metaObj.GetName() != common.ArgoCDTLSCertsConfigMapName {
+		return
+	}
+	mgr.certificatesSyncMutex.Lock()
+	defer mgr.certificatesSyncMutex.Unlock()
+	mgr.certificatesSyncedAt = time.Now()
+}
+
+// GetCertificatesSyncedAt returns the time the certificate ConfigMaps were
+// last synchronized from the cluster. Returns the zero time if they have not
+// been seen yet.
+func (mgr *SettingsManager) GetCertificatesSyncedAt() (time.Time, error) {
+	err := mgr.ensureSynced(false)
+	if err != nil {
+		return time.Time{}, err
+	}
+	mgr.certificatesSyncMutex.RLock()
+	defer mgr.certificatesSyncMutex.RUnlock()
+	return mgr.certificatesSyncedAt, nil
+}
+
 func (mgr *SettingsManager) ensureSynced(forceResync bool) error {
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
@@ -649,7 +820,7 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 }
 
 // Save the SSH known host data into the corresponding ConfigMap
-func (mgr *SettingsManager) SaveSSHKnownHostsData(ctx context.Context, knownHostsList []string) error {
+func (mgr *SettingsManager) SaveSSHKnownHostsData(ctx context.Context, knownHostsList []string, certAnnotations map[string]map[string]string) error {
 	err := mgr.ensureSynced(false)
 	if err != nil {
 		return err
@@ -666,6 +837,10 @@ func (mgr *SettingsManager) SaveSSHKnownHostsData(ctx context.Context, knownHost
 
 	sshKnownHostsData := strings.Join(knownHostsList, "\n") + "\n"
 	certCM.Data["ssh_known_hosts"] = sshKnownHostsData
+	err = setCertificateAnnotations(certCM, certAnnotations)
+	if err != nil {
+		return err
+	}
 	_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(certCM)
 	if err != nil {
 		return err
@@ -686,7 +861,7 @@ func (mgr *SettingsManager) SaveSSHKnownHostsData(ctx context.Context, knownHost
 	return mgr.ResyncInformers()
 }
 
-func (mgr *SettingsManager) SaveTLSCertificateData(ctx context.Context, tlsCertificates map[string]string) error {
+func (mgr *SettingsManager) SaveTLSCertificateData(ctx context.Context, tlsCertificates map[string]string, certAnnotations map[string]map[string]string) error {
 	err := mgr.ensureSynced(false)
 	if err != nil {
 		return err
@@ -698,6 +873,10 @@ func (mgr *SettingsManager) SaveTLSCertificateData(ctx context.Context, tlsCerti
 	}
 
 	certCM.Data = tlsCertificates
+	err = setCertificateAnnotations(certCM, certAnnotations)
+	if err != nil {
+		return err
+	}
 	_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(certCM)
 	if err != nil {
 		return err
@@ -742,6 +921,73 @@ func (mgr *SettingsManager) SaveTLSCertificateData(ctx context.Context, tlsCerti
 	return mgr.ResyncInformers()
 }
 
+// Save the GnuPG public key data into the corresponding ConfigMap, which is
+// created if it does not exist yet
+func (mgr *SettingsManager) SaveGPGPublicKeyData(ctx context.Context, gpgPublicKeys map[string]string) error {
+	err := mgr.ensureSynced(false)
+	if err != nil {
+		return err
+	}
+
+	keysCM, err := mgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		keysCM = &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: common.ArgoCDGPGKeysConfigMapName,
+				Labels: map[string]string{
+					"app.kubernetes.io/name":    common.ArgoCDGPGKeysConfigMapName,
+					"app.kubernetes.io/part-of": "argocd",
+				},
+			},
+			Data: gpgPublicKeys,
+		}
+		_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Create(keysCM)
+	} else {
+		keysCM = keysCM.DeepCopy()
+		keysCM.Data = gpgPublicKeys
+		_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(keysCM)
+	}
+	if err != nil {
+		return err
+	}
+
+	return mgr.ResyncInformers()
+}
+
+// GetCertificateAnnotations returns the annotations of the certificates stored
+// in the given ConfigMap, keyed by the identity of each certificate.
+func GetCertificateAnnotations(certCM *apiv1.ConfigMap) (map[string]map[string]string, error) {
+	certAnnotations := make(map[string]map[string]string)
+	if data, ok := certCM.Annotations[common.AnnotationKeyCertificateAnnotations]; ok && data != "" {
+		err := json.Unmarshal([]byte(data), &certAnnotations)
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse annotation %s of ConfigMap %s: %v", common.AnnotationKeyCertificateAnnotations, certCM.Name, err)
+		}
+	}
+	return certAnnotations, nil
+}
+
+// Stores the annotations of the certificates as annotation at the ConfigMap.
+// The annotation is removed when there are no certificate annotations.
+func setCertificateAnnotations(certCM *apiv1.ConfigMap, certAnnotations map[string]map[string]string) error {
+	if len(certAnnotations) == 0 {
+		delete(certCM.Annotations, common.AnnotationKeyCertificateAnnotations)
+		return nil
+	}
+	data, err := json.Marshal(certAnnotations)
+	if err != nil {
+		return err
+	}
+	if certCM.Annotations == nil {
+		certCM.Annotations = make(map[string]string)
+	}
+	certCM.Annotations[common.AnnotationKeyCertificateAnnotations] = string(data)
+	return nil
+}
+
 // NewSettingsManager generates a new SettingsManager pointer and returns it
 func NewSettingsManager(ctx context.Context, clientset kubernetes.Interface, namespace string) *SettingsManager {
 
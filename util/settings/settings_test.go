@@ -120,6 +120,88 @@ func TestGetConfigManagementPlugins(t *testing.T) {
 	}}, plugins)
 }
 
+func TestGetKustomizeVersions(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: "default",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"kustomize.version.v3.5.4": "/custom-tools/kustomize_3_5_4",
+			"kustomize.version.v2.0.3": "/custom-tools/kustomize_2_0_3",
+			"url":                      "https://argocd.example.com",
+		},
+	})
+	settingsManager := NewSettingsManager(context.Background(), kubeClient, "default")
+	versions, err := settingsManager.GetKustomizeVersions()
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.KustomizeVersion{
+		{Name: "v2.0.3", Path: "/custom-tools/kustomize_2_0_3"},
+		{Name: "v3.5.4", Path: "/custom-tools/kustomize_3_5_4"},
+	}, versions)
+}
+
+func TestGetHelmVersions(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: "default",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"helm.version.v3.1.2":      "/custom-tools/helm_3_1_2",
+			"kustomize.version.v3.5.4": "/custom-tools/kustomize_3_5_4",
+		},
+	})
+	settingsManager := NewSettingsManager(context.Background(), kubeClient, "default")
+	versions, err := settingsManager.GetHelmVersions()
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.HelmVersion{{Name: "v3.1.2", Path: "/custom-tools/helm_3_1_2"}}, versions)
+}
+
+func TestGetJsonnetLibs(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: "default",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"jsonnet.libs": "- vendor\n- lib/jsonnet\n",
+		},
+	})
+	settingsManager := NewSettingsManager(context.Background(), kubeClient, "default")
+	libs, err := settingsManager.GetJsonnetLibs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vendor", "lib/jsonnet"}, libs)
+}
+
+func TestGetExecProviderCommands(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: "default",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"cluster.execProviderCommands": "- aws\n- aws-iam-authenticator\n",
+		},
+	})
+	settingsManager := NewSettingsManager(context.Background(), kubeClient, "default")
+	commands, err := settingsManager.GetExecProviderCommands()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aws", "aws-iam-authenticator"}, commands)
+}
+
 func TestGetAppInstanceLabelKey(t *testing.T) {
 	kubeClient := fake.NewSimpleClientset(&v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
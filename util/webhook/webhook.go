@@ -167,16 +167,21 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 	}
 
 	for _, app := range apps.Items {
-		if !repoRegexp.MatchString(app.Spec.Source.RepoURL) {
-			log.Debugf("%s does not match", app.Spec.Source.RepoURL)
-			continue
-		}
-		targetRev := app.Spec.Source.TargetRevision
-		if targetRev == "HEAD" || targetRev == "" {
-			if !touchedHead {
+		// applications with multiple sources are refreshed if any of their sources was pushed to
+		touched := false
+		for _, source := range app.Spec.GetSources() {
+			if !repoRegexp.MatchString(source.RepoURL) {
+				log.Debugf("%s does not match", source.RepoURL)
 				continue
 			}
-		} else if targetRev != revision {
+			targetRev := source.TargetRevision
+			if targetRev == "HEAD" || targetRev == "" {
+				touched = touched || touchedHead
+			} else {
+				touched = touched || targetRev == revision
+			}
+		}
+		if !touched {
 			continue
 		}
 		_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)